@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rivo/tview"
+)
+
+// maxAnnouncements is how many announcements the accessible layout keeps.
+const maxAnnouncements = 5
+
+var (
+	accessibleMode bool
+	accessibleMu   sync.Mutex
+	announcements  []string // Alarms and timer completions, oldest first
+)
+
+// SetAccessibleMode turns the screen-reader friendly layout on or off.
+func SetAccessibleMode(on bool) {
+	accessibleMode = on
+}
+
+// IsAccessibleMode returns true if the accessible layout replaces the map.
+func IsAccessibleMode() bool {
+	return accessibleMode
+}
+
+// Announce adds a line to the accessible layout's announcements, stamped
+// with the local time. Announcements are kept in every mode so that none
+// are lost, but only the accessible layout shows them.
+func Announce(message string) {
+	line := strings.TrimSpace(FormatClockMinutes(time.Now())) + "  " + message
+	accessibleMu.Lock()
+	defer accessibleMu.Unlock()
+	announcements = append(announcements, line)
+	if len(announcements) > maxAnnouncements {
+		announcements = announcements[len(announcements)-maxAnnouncements:]
+	}
+}
+
+// AnnounceAlarm announces an alarm that has gone off.
+func AnnounceAlarm(a Alarm) {
+	city := a.CityName
+	if city == "" {
+		city = a.Timezone
+	}
+	Announce(fmt.Sprintf(T("Alarm %s %s"), formatAlarmTime(a.Time), LocalCityName(city)))
+}
+
+// renderAccessibleLines builds the accessible layout: the local time, one
+// line per city with its time, date, day phase and UTC offset, then the
+// announcements. Times are shown to the minute so lines only change when
+// their content does.
+func renderAccessibleLines(left, right []Region, now time.Time) []string {
+	lines := []string{
+		fmt.Sprintf("%s  %s  %s", T("Local"), strings.TrimSpace(FormatClockMinutes(now)), FormatDate(now)),
+		"",
+	}
+	for _, panel := range []struct {
+		name    string
+		regions []Region
+	}{{"left", left}, {"right", right}} {
+		for i, r := range panel.regions {
+			lines = append(lines, accessibleCityLine(r, now,
+				IsNavigationActive() && navState.selectedPanel == panel.name && navState.selectedIndex == i))
+		}
+	}
+
+	accessibleMu.Lock()
+	if len(announcements) > 0 {
+		lines = append(lines, "", T("Announcements:"))
+		lines = append(lines, announcements...)
+	}
+	accessibleMu.Unlock()
+	return lines
+}
+
+// accessibleCityLine returns the accessible layout's line for one city.
+// The selected city is marked with ">" rather than a colour.
+func accessibleCityLine(r Region, now time.Time, selected bool) string {
+	prefix := "  "
+	if selected {
+		prefix = "> "
+	}
+	name := padTagged(LocalCityName(r.Name), 16)
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return prefix + name + "  " + T("unknown time zone")
+	}
+	local := now.In(loc)
+	phase := ""
+	if city := GetCityByName(r.Name); city != nil {
+		phase = stripTags(getDayPhase(local, city.Coordinates[0], city.Coordinates[1]))
+	}
+	return fmt.Sprintf("%s%s  %s  %s  %s  UTC%s", prefix, name,
+		FormatClockMinutes(local), FormatShortDate(local), padTagged(phase, 14), local.Format("-07:00"))
+}
+
+// accessibleList shows the accessible layout in place of the map, with a
+// text view per line so that a change only rewrites the lines it touches.
+type accessibleList struct {
+	*tview.Flex
+	rows  []*tview.TextView
+	lines []string // Lines on screen
+}
+
+// newAccessibleList creates an empty accessible layout.
+func newAccessibleList() *accessibleList {
+	l := &accessibleList{Flex: tview.NewFlex().SetDirection(tview.FlexRow)}
+	l.SetBackgroundColor(themeTcellColor(roleBackground))
+	return l
+}
+
+// SetLines shows lines, setting only the rows whose text differs from what
+// is on screen. It reports whether any row changed, so unchanged screens
+// are not redrawn and re-announced.
+func (l *accessibleList) SetLines(lines []string) bool {
+	changed := false
+	for i, line := range lines {
+		if i == len(l.rows) {
+			row := tview.NewTextView().SetWrap(false)
+			row.SetBackgroundColor(themeTcellColor(roleBackground))
+			l.rows = append(l.rows, row)
+			l.lines = append(l.lines, "")
+			l.AddItem(row, 1, 0, false)
+		} else if l.lines[i] == line {
+			continue
+		}
+		l.rows[i].SetText(line)
+		l.lines[i] = line
+		changed = true
+	}
+	for len(l.rows) > len(lines) {
+		last := len(l.rows) - 1
+		l.RemoveItem(l.rows[last])
+		l.rows, l.lines = l.rows[:last], l.lines[:last]
+		changed = true
+	}
+	return changed
+}
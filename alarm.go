@@ -65,44 +65,6 @@ func (am *alarmMode) HandleKey(key rune) bool {
 		am.inputStep = 0
 		am.inputTime = ""
 		return false
-	case 'a', 'A':
-		// Start adding new alarm
-		if am.inputMode == "none" {
-			am.inputMode = "add"
-			am.inputStep = 0
-			am.selectedZone = ""
-			am.inputTime = ""
-			am.selectedRepeat = ""
-			am.currentZone = 0
-		}
-		return true
-	case 'd', 'D':
-		// Delete alarm - simple implementation: delete last alarm
-		if len(am.config.Alarms) > 0 && am.inputMode == "none" {
-			am.config.Alarms = am.config.Alarms[:len(am.config.Alarms)-1]
-			am.saveAlarms()
-		}
-		return true
-	case ' ', 's', 'S':
-		// Toggle start/pause
-		return true
-	case 'j', 'J':
-		// Navigate down in selection lists
-		if am.inputMode == "add" && am.inputStep == 0 {
-			allZones := am.getAllZones()
-			if am.currentZone < len(allZones)-1 {
-				am.currentZone++
-			}
-		}
-		return true
-	case 'k', 'K':
-		// Navigate up in selection lists
-		if am.inputMode == "add" && am.inputStep == 0 {
-			if am.currentZone > 0 {
-				am.currentZone--
-			}
-		}
-		return true
 	case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 		// Handle digit input
 		if am.inputMode == "add" && am.inputStep == 2 {
@@ -137,6 +99,46 @@ func (am *alarmMode) HandleKey(key rune) bool {
 	return false
 }
 
+// HandleAction runs a bound alarm action.
+func (am *alarmMode) HandleAction(action string) bool {
+	switch action {
+	case "alarm.add":
+		// Start adding new alarm
+		if am.inputMode == "none" {
+			am.inputMode = "add"
+			am.inputStep = 0
+			am.selectedZone = ""
+			am.inputTime = ""
+			am.selectedRepeat = ""
+			am.currentZone = 0
+		}
+	case "alarm.delete-last":
+		// Delete alarm - simple implementation: delete last alarm
+		if len(am.config.Alarms) > 0 && am.inputMode == "none" {
+			am.config.Alarms = am.config.Alarms[:len(am.config.Alarms)-1]
+			am.saveAlarms()
+		}
+	case "alarm.next":
+		// Navigate down in selection lists
+		if am.inputMode == "add" && am.inputStep == 0 {
+			allZones := am.getAllZones()
+			if am.currentZone < len(allZones)-1 {
+				am.currentZone++
+			}
+		}
+	case "alarm.prev":
+		// Navigate up in selection lists
+		if am.inputMode == "add" && am.inputStep == 0 {
+			if am.currentZone > 0 {
+				am.currentZone--
+			}
+		}
+	default:
+		return false
+	}
+	return true
+}
+
 // HandleSpecialKeyEvent handles non-rune key events (Enter, Backspace, etc.).
 func (am *alarmMode) HandleSpecialKeyEvent(key tcell.Key) bool {
 	switch key {
@@ -294,6 +296,16 @@ func (am *alarmMode) CheckAlarms() []Alarm {
 	return triggered
 }
 
+// formatAlarmTime formats an alarm's stored HH:MM time in the chosen clock
+// format.
+func formatAlarmTime(hhmm string) string {
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return hhmm
+	}
+	return FormatClockMinutes(t)
+}
+
 // GetTriggeredAlarms returns currently triggered alarms for display.
 func (am *alarmMode) GetTriggeredAlarms() []Alarm {
 	var triggered []Alarm
@@ -316,59 +328,60 @@ func (am *alarmMode) Render() string {
 	var b strings.Builder
 
 	// Header
-	b.WriteString("\n[yellow::b]━━━ ALARMS ━━━[-::-]\n\n")
+	b.WriteString("\n[yellow::b]━━━ " + T("ALARMS") + " ━━━[-::-]\n\n")
 
 	// Check for triggered alarms
 	triggered := am.GetTriggeredAlarms()
 	if len(triggered) > 0 {
-		b.WriteString("  [red::b]⚠ ALARM! ⚠[-::-]\n")
+		b.WriteString("  [red::b]⚠ " + T("ALARM!") + " ⚠[-::-]\n")
 		for _, alarm := range triggered {
 			b.WriteString(fmt.Sprintf("  [red]%s @ %s (%s)[-]\n",
-				alarm.Time, alarm.CityName, alarm.Repeat))
+				formatAlarmTime(alarm.Time), LocalCityName(alarm.CityName), alarm.Repeat))
 		}
-		b.WriteString("\n  [darkgray]Press any key to dismiss[white]\n\n")
+		b.WriteString("\n  [darkgray]" + T("Press any key to dismiss") + "[white]\n\n")
 	}
 
 	// Input mode for adding alarm
 	if am.inputMode == "add" {
-		b.WriteString("  [aqua::b]Add New Alarm:[-::-]\n\n")
+		b.WriteString("  [aqua::b]" + T("Add New Alarm:") + "[-::-]\n\n")
 		allZones := am.getAllZones()
 
 		switch am.inputStep {
 		case 0: // Select timezone
-			b.WriteString("  [darkgray]Select city/timezone: (↑/↓ to navigate, Enter to select)[-]\n\n")
+			b.WriteString("  [darkgray]" + fmt.Sprintf(T("Select city/timezone: (%s/%s to navigate, Enter to select)"),
+				KeyLabel("alarm.prev"), KeyLabel("alarm.next")) + "[-]\n\n")
 			for i, zone := range allZones {
 				marker := "  "
 				if i == am.currentZone {
 					marker = "> "
 				}
-				b.WriteString(fmt.Sprintf("%s%s (%s)\n", marker, zone.Name, zone.Timezone))
+				b.WriteString(fmt.Sprintf("%s%s (%s)\n", marker, LocalCityName(zone.Name), zone.Timezone))
 			}
 		case 1: // Enter time
-			b.WriteString(fmt.Sprintf("  City: %s\n", am.getCityForZone(am.selectedZone)))
+			b.WriteString("  " + fmt.Sprintf(T("City: %s"), LocalCityName(am.getCityForZone(am.selectedZone))) + "\n")
 			displayTime := am.inputTime
 			if displayTime == "" {
 				displayTime = "HH:MM"
 			}
-			b.WriteString(fmt.Sprintf("  [::b]Enter time: [yellow]%s[-]\n\n", displayTime))
+			b.WriteString(fmt.Sprintf("  [::b]%s [yellow]%s[-]\n\n", T("Enter time:"), displayTime))
 		case 2: // Select repeat
-			b.WriteString(fmt.Sprintf("  City: %s, Time: %s\n\n", am.getCityForZone(am.selectedZone), am.inputTime))
-			b.WriteString("  [::b]Select repeat:[-]\n")
-			b.WriteString("    1) Once\n")
-			b.WriteString("    2) Daily\n")
-			b.WriteString("    3) Weekday (Mon-Fri)\n")
+			b.WriteString("  " + fmt.Sprintf(T("City: %s, Time: %s"), LocalCityName(am.getCityForZone(am.selectedZone)), am.inputTime) + "\n\n")
+			b.WriteString("  [::b]" + T("Select repeat:") + "[-]\n")
+			b.WriteString("    1) " + T("Once") + "\n")
+			b.WriteString("    2) " + T("Daily") + "\n")
+			b.WriteString("    3) " + T("Weekday (Mon-Fri)") + "\n")
 		}
 
-		b.WriteString("\n  [darkgray]Press Esc to cancel[white]\n")
+		b.WriteString("\n  [darkgray]" + T("Press Esc to cancel") + "[white]\n")
 		return b.String()
 	}
 
 	// Display alarms
 	if len(am.config.Alarms) == 0 {
-		b.WriteString("  [darkgray]No alarms set.[white]\n")
-		b.WriteString("  Press A to add a new alarm.\n")
+		b.WriteString("  [darkgray]" + T("No alarms set.") + "[white]\n")
+		b.WriteString("  " + fmt.Sprintf(T("Press %s to add a new alarm."), KeyLabel("alarm.add")) + "\n")
 	} else {
-		b.WriteString(fmt.Sprintf("  [aqua::b]Active Alarms (%d):[-::-]\n\n", len(am.config.Alarms)))
+		b.WriteString("  [aqua::b]" + fmt.Sprintf(T("Active Alarms (%d):"), len(am.config.Alarms)) + "[-::-]\n\n")
 		for _, alarm := range am.config.Alarms {
 			enabled := "[green]●[white]"
 			if !alarm.Enabled {
@@ -379,18 +392,29 @@ func (am *alarmMode) Render() string {
 				repeatLabel = "weekdays"
 			}
 			b.WriteString(fmt.Sprintf("  %s %s @ %s (%s) [%s]\n",
-				enabled, alarm.Time, alarm.CityName, alarm.Timezone, repeatLabel))
+				enabled, formatAlarmTime(alarm.Time), LocalCityName(alarm.CityName), alarm.Timezone, repeatLabel))
 		}
 	}
 
-	b.WriteString("\n  [darkgray]Controls:[white] A=Add  D=Delete Last  Esc=Exit\n")
+	b.WriteString("\n  [darkgray]" + T("Controls:") + "[white] " + KeyHelp([]string{"alarm.add", "alarm.delete-last"}, T("Esc=Exit")) + "\n")
 
 	return b.String()
 }
 
 // GetHelpText returns the help text for alarm mode.
 func (am *alarmMode) GetHelpText() string {
-	return "[darkgray]Keys:[white] A=Add Alarm  D=Delete Last  Esc=Exit"
+	return "[darkgray]" + T("Keys:") + "[white] " + ContextKeyHelp(keyContextAlarm, T("Esc=Exit"))
+}
+
+// HelpGroups returns the keys the alarm handles itself while adding an
+// alarm, for the help overlay.
+func (am *alarmMode) HelpGroups() []helpGroup {
+	return []helpGroup{{title: T("Adding an alarm"), entries: []helpEntry{
+		{keys: "Enter", help: T("Choose the zone, then confirm the time")},
+		{keys: "0-9  :", help: T("Type the time as HH:MM")},
+		{keys: "Backspace", help: T("Delete the last digit")},
+		{keys: "1  2  3", help: T("Repeat once, daily or on weekdays")},
+	}}}
 }
 
 // Stop stops background processes.
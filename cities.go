@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gdamore/tcell/v2"
 )
@@ -35,19 +36,11 @@ func (c City) Abbreviation() string {
 	}
 
 	// Fallback: first 3 letters of city name
-	name := c.Name
-	if len(name) > 3 {
-		name = name[:3]
+	runes := []rune(c.Name)
+	if len(runes) > 3 {
+		runes = runes[:3]
 	}
-	upper := make([]byte, len(name))
-	for i := 0; i < len(name); i++ {
-		ch := name[i]
-		if ch >= 'a' && ch <= 'z' {
-			ch -= 'a' - 'A'
-		}
-		upper[i] = ch
-	}
-	return string(upper)
+	return strings.ToUpper(string(runes))
 }
 
 // AllCities is the comprehensive database of cities.
@@ -141,12 +134,60 @@ var cityAliases = map[string]string{
 	"bue":       "Buenos Aires",
 }
 
-// GetCityByName looks up a city by name, handling aliases.
+// cityNameTranslations are the names of cities in languages where they
+// differ from the English name, keyed by English name and language.
+var cityNameTranslations = map[string]map[string]string{
+	"New York":     {"es": "Nueva York"},
+	"Mexico City":  {"de": "Mexiko-Stadt", "fr": "Mexico", "es": "Ciudad de México"},
+	"Sao Paulo":    {"de": "São Paulo", "fr": "São Paulo", "es": "São Paulo"},
+	"London":       {"fr": "Londres", "es": "Londres"},
+	"Lisbon":       {"de": "Lissabon", "fr": "Lisbonne", "es": "Lisboa"},
+	"Amsterdam":    {"es": "Ámsterdam"},
+	"Paris":        {"es": "París"},
+	"Berlin":       {"es": "Berlín"},
+	"Stockholm":    {"es": "Estocolmo"},
+	"Warsaw":       {"de": "Warschau", "fr": "Varsovie", "es": "Varsovia"},
+	"Athens":       {"de": "Athen", "fr": "Athènes", "es": "Atenas"},
+	"Moscow":       {"de": "Moskau", "fr": "Moscou", "es": "Moscú"},
+	"Istanbul":     {"es": "Estambul"},
+	"Dubai":        {"fr": "Dubaï", "es": "Dubái"},
+	"Riyadh":       {"de": "Riad", "fr": "Riyad", "es": "Riad"},
+	"Cairo":        {"de": "Kairo", "fr": "Le Caire", "es": "El Cairo"},
+	"Johannesburg": {"fr": "Johannesbourg", "es": "Johannesburgo"},
+	"Karachi":      {"de": "Karatschi"},
+	"Mumbai":       {"fr": "Bombay", "es": "Bombay"},
+	"Jakarta":      {"es": "Yakarta"},
+	"Singapore":    {"de": "Singapur", "fr": "Singapour", "es": "Singapur"},
+	"Hong Kong":    {"de": "Hongkong"},
+	"Shanghai":     {"de": "Schanghai", "es": "Shanghái"},
+	"Seoul":        {"fr": "Séoul", "es": "Seúl"},
+	"Tokyo":        {"de": "Tokio", "es": "Tokio"},
+	"Sydney":       {"es": "Sídney"},
+}
+
+// LocalCityName returns the name of a city in the current language, or
+// the name itself if it has no translation.
+func LocalCityName(name string) string {
+	if local, ok := cityNameTranslations[name][currentLocale.tag]; ok {
+		return local
+	}
+	return name
+}
+
+// GetCityByName looks up a city by name, handling aliases and the
+// translated names of every language.
 func GetCityByName(name string) *City {
 	// First check aliases
-	if canonical, ok := cityAliases[name]; ok {
+	if canonical, ok := cityAliases[toLower(name)]; ok {
 		name = canonical
 	}
+	for english, names := range cityNameTranslations {
+		for _, local := range names {
+			if strings.EqualFold(local, name) {
+				name = english
+			}
+		}
+	}
 
 	// Case-insensitive search
 	lowerName := toLower(name)
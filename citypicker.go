@@ -0,0 +1,412 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Picker panes.
+const (
+	pickerPaneResults  = iota // Filtered list of all cities
+	pickerPaneSelected        // Cities already picked
+)
+
+// Column widths for the picker layout.
+const (
+	pickerListWidth     = 50
+	pickerSelectedWidth = 22
+	pickerPageSize      = 14
+)
+
+// cityPicker is a filterable, scrollable list of cities for the meeting planner.
+type cityPicker struct {
+	query     string // Current search text
+	filtered  []City // Cities matching the query, in AllCities order
+	cursor    int    // Highlighted row in filtered
+	offset    int    // First visible row in filtered
+	pane      int    // One of the pickerPane* constants
+	selCursor int    // Highlighted row in the selected pane
+	listRow   int    // Row of the first list entry in the last render
+}
+
+// newCityPicker creates a picker showing every city.
+func newCityPicker() *cityPicker {
+	p := &cityPicker{}
+	p.refilter()
+	return p
+}
+
+// cityMatchesQuery reports whether every word of query appears in the city's
+// name, country, category, timezone or one of its aliases.
+func cityMatchesQuery(city City, query string) bool {
+	fields := []string{city.Name, LocalCityName(city.Name), city.Country, city.Category, city.Timezone}
+	for alias, name := range cityAliases {
+		if name == city.Name {
+			fields = append(fields, alias)
+		}
+	}
+	haystack := toLower(strings.Join(fields, "\x00"))
+
+	for _, term := range strings.Fields(toLower(query)) {
+		if !strings.Contains(haystack, term) {
+			return false
+		}
+	}
+	return true
+}
+
+// refilter recomputes the filtered list after the query changes.
+func (p *cityPicker) refilter() {
+	p.filtered = p.filtered[:0]
+	for _, city := range AllCities {
+		if cityMatchesQuery(city, p.query) {
+			p.filtered = append(p.filtered, city)
+		}
+	}
+	p.cursor = 0
+	p.offset = 0
+}
+
+// current returns the highlighted city in the results pane.
+func (p *cityPicker) current() (City, bool) {
+	if p.cursor < 0 || p.cursor >= len(p.filtered) {
+		return City{}, false
+	}
+	return p.filtered[p.cursor], true
+}
+
+// move shifts the cursor in the focused pane, keeping it visible.
+func (p *cityPicker) move(delta int, selectedCount int) {
+	if p.pane == pickerPaneSelected {
+		p.selCursor = clampInt(p.selCursor+delta, 0, selectedCount-1)
+		return
+	}
+	p.cursor = clampInt(p.cursor+delta, 0, len(p.filtered)-1)
+	if p.cursor < p.offset {
+		p.offset = p.cursor
+	}
+	if p.cursor >= p.offset+pickerPageSize {
+		p.offset = p.cursor - pickerPageSize + 1
+	}
+}
+
+// clampInt limits v to [lo, hi]; hi below lo yields lo.
+func clampInt(v, lo, hi int) int {
+	if v > hi {
+		v = hi
+	}
+	if v < lo {
+		v = lo
+	}
+	return v
+}
+
+// toggleCategory selects every city in the highlighted city's category,
+// or deselects them all if they are already selected.
+func (mp *MeetingPlanner) toggleCategory() {
+	city, ok := mp.picker.current()
+	if !ok {
+		return
+	}
+	cities := GetCitiesByCategory(city.Category)
+	allSelected := true
+	for _, c := range cities {
+		if !mp.IsSelected(c) {
+			allSelected = false
+			break
+		}
+	}
+	for _, c := range cities {
+		if allSelected {
+			mp.RemoveCity(c)
+		} else {
+			mp.AddCity(c)
+		}
+	}
+}
+
+// handlePickerKey types into the search query in the city selection view.
+// The picker's bound actions all use modifiers or named keys, so every
+// printable key reaches it.
+func (mp *MeetingPlanner) handlePickerKey(ch rune) bool {
+	p := mp.picker
+	if unicode.IsPrint(ch) && len([]rune(p.query)) < 32 {
+		p.query += string(ch)
+		p.pane = pickerPaneResults
+		p.refilter()
+	}
+	return true
+}
+
+// handlePickerAction runs a bound action of the picker key context.
+func (mp *MeetingPlanner) handlePickerAction(action string) bool {
+	p := mp.picker
+	switch action {
+	case "picker.toggle":
+		if p.pane == pickerPaneSelected {
+			mp.removeSelectedAtCursor()
+		} else if city, ok := p.current(); ok {
+			mp.ToggleCity(city)
+		}
+	case "picker.remove":
+		if p.pane == pickerPaneSelected {
+			mp.removeSelectedAtCursor()
+		}
+	case "picker.pane":
+		if p.pane == pickerPaneResults && len(mp.selectedCities) > 0 {
+			p.pane = pickerPaneSelected
+			p.selCursor = clampInt(p.selCursor, 0, len(mp.selectedCities)-1)
+		} else {
+			p.pane = pickerPaneResults
+		}
+	case "picker.category":
+		mp.toggleCategory()
+	case "picker.down":
+		p.move(1, len(mp.selectedCities))
+	case "picker.up":
+		p.move(-1, len(mp.selectedCities))
+	case "picker.timeline":
+		if len(mp.selectedCities) > 0 {
+			mp.mode = meetingViewTimeline
+		}
+	case "picker.groups":
+		mp.openGroupList()
+	case "picker.save":
+		mp.promptGroupName()
+	case "picker.clear":
+		mp.ClearSelection()
+	default:
+		return false
+	}
+	return true
+}
+
+// handlePickerSpecialKey handles the editing and paging keys of the city
+// selection view. It returns false for keys the planner handles itself.
+func (mp *MeetingPlanner) handlePickerSpecialKey(key tcell.Key) bool {
+	p := mp.picker
+	switch key {
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if runes := []rune(p.query); len(runes) > 0 {
+			p.query = string(runes[:len(runes)-1])
+			p.refilter()
+		}
+		return true
+	case tcell.KeyPgUp:
+		p.move(-pickerPageSize, len(mp.selectedCities))
+		return true
+	case tcell.KeyPgDn:
+		p.move(pickerPageSize, len(mp.selectedCities))
+		return true
+	case tcell.KeyHome:
+		p.move(-len(AllCities), len(mp.selectedCities))
+		return true
+	case tcell.KeyEnd:
+		p.move(len(AllCities), len(mp.selectedCities))
+		return true
+	case tcell.KeyEscape:
+		// First Escape clears an active filter; the next one closes the planner
+		if p.query != "" {
+			p.query = ""
+			p.refilter()
+			return true
+		}
+	}
+	return false
+}
+
+// removeSelectedAtCursor removes the highlighted city from the selected pane.
+func (mp *MeetingPlanner) removeSelectedAtCursor() {
+	p := mp.picker
+	if p.selCursor >= len(mp.selectedCities) {
+		return
+	}
+	mp.RemoveCity(mp.selectedCities[p.selCursor])
+	if len(mp.selectedCities) == 0 {
+		p.pane = pickerPaneResults
+		p.selCursor = 0
+		return
+	}
+	p.selCursor = clampInt(p.selCursor, 0, len(mp.selectedCities)-1)
+}
+
+// renderPickerResults renders the visible page of the results pane.
+func (mp *MeetingPlanner) renderPickerResults() []string {
+	p := mp.picker
+	var lines []string
+
+	if len(p.filtered) == 0 {
+		return append(lines, "["+themeColor(roleMuted)+"]  "+T("No cities match.")+"[-]")
+	}
+
+	end := p.offset + pickerPageSize
+	if end > len(p.filtered) {
+		end = len(p.filtered)
+	}
+	for i := p.offset; i < end; i++ {
+		city := p.filtered[i]
+		marker := "[ ] "
+		if mp.IsSelected(city) {
+			marker = "[[" + themeColor(roleGood) + "]✓[-]] "
+		}
+		prefix := "  "
+		if i == p.cursor && p.pane == pickerPaneResults {
+			prefix = "[" + themeColor(roleAccent) + "]►[-] "
+		}
+		lines = append(lines, fmt.Sprintf("%s%s[%s]%s[-] [%s]%-12s %s[-]",
+			prefix, marker, getCategoryColor(city.Category),
+			padTagged(truncateText(LocalCityName(city.Name), 15), 15), themeColor(roleSubtle), truncateText(city.Country, 12), truncateText(city.Timezone, 14)))
+	}
+	return lines
+}
+
+// renderPickerSelected renders the selected pane.
+func (mp *MeetingPlanner) renderPickerSelected() []string {
+	p := mp.picker
+	title := "[::b]" + fmt.Sprintf(T("Selected (%d)"), len(mp.selectedCities)) + "[::-]"
+	if p.pane == pickerPaneSelected {
+		title = "[" + themeColor(roleAccent) + "::b]" + fmt.Sprintf(T("Selected (%d)"), len(mp.selectedCities)) + "[::-]"
+	}
+	lines := []string{title}
+
+	if len(mp.selectedCities) == 0 {
+		return append(lines, "["+themeColor(roleMuted)+"]"+T("none yet")+"[-]")
+	}
+
+	start := p.selectedScrollStart()
+	for i := start; i < len(mp.selectedCities) && len(lines) < pickerPageSize; i++ {
+		city := mp.selectedCities[i]
+		prefix := "• "
+		if i == p.selCursor && p.pane == pickerPaneSelected {
+			prefix = "[" + themeColor(roleAccent) + "]►[-] "
+		}
+		lines = append(lines, fmt.Sprintf("%s[%s]%s[-]", prefix, colorToTag(city.Color), truncateText(LocalCityName(city.Name), pickerSelectedWidth-2)))
+	}
+	return lines
+}
+
+// selectedScrollStart returns the first city shown in the selected pane,
+// scrolled so the cursor stays visible below the pane's title.
+func (p *cityPicker) selectedScrollStart() int {
+	if p.selCursor >= pickerPageSize-1 {
+		return p.selCursor - pickerPageSize + 2
+	}
+	return 0
+}
+
+// handlePickerMouse toggles the city clicked in either pane, as Enter does,
+// and scrolls the focused pane with the wheel.
+func (mp *MeetingPlanner) handlePickerMouse(action mouseAction, x, y int) bool {
+	p := mp.picker
+	switch action {
+	case mouseScrollUp:
+		p.move(-1, len(mp.selectedCities))
+		return true
+	case mouseScrollDown:
+		p.move(1, len(mp.selectedCities))
+		return true
+	}
+	if action != mouseClick {
+		return false
+	}
+
+	row := y - p.listRow
+	if row < 0 || row >= pickerPageSize {
+		return false
+	}
+	switch {
+	case x < pickerListWidth:
+		i := p.offset + row
+		if i >= len(p.filtered) {
+			return false
+		}
+		p.pane = pickerPaneResults
+		p.cursor = i
+		mp.ToggleCity(p.filtered[i])
+	case x >= pickerListWidth+3:
+		// Row 0 of the selected pane is its title
+		i := p.selectedScrollStart() + row - 1
+		if row == 0 || i >= len(mp.selectedCities) {
+			return false
+		}
+		p.pane = pickerPaneSelected
+		p.selCursor = i
+		mp.removeSelectedAtCursor()
+	default:
+		return false
+	}
+	return true
+}
+
+// RenderCitySelection renders the city selection view.
+func (mp *MeetingPlanner) RenderCitySelection() string {
+	p := mp.picker
+	var b strings.Builder
+	b.WriteString("[" + themeColor(roleAccent) + "::b]" + T("Meeting Time Planner") + "[::-]\n\n")
+
+	// Search field
+	query := tview.Escape(p.query)
+	if p.query != "" {
+		b.WriteString(fmt.Sprintf("[::b]%s[::-] [%s]%s[-]▏ [%s]%s[-]\n",
+			T("Search:"), themeColor(roleAccent), query, themeColor(roleMuted), T("(Esc to clear)")))
+	} else {
+		b.WriteString(fmt.Sprintf("[::b]%s[::-] ▏[%s]%s[-]\n", T("Search:"), themeColor(roleMuted), T("type to filter by name, country, alias or zone")))
+	}
+
+	// Scroll position
+	position := ""
+	if len(p.filtered) > pickerPageSize {
+		end := p.offset + pickerPageSize
+		if end > len(p.filtered) {
+			end = len(p.filtered)
+		}
+		position = fmt.Sprintf(T("%d-%d of %d"), p.offset+1, end, len(p.filtered))
+	} else {
+		position = fmt.Sprintf(T("%d cities"), len(p.filtered))
+	}
+	b.WriteString(fmt.Sprintf("[%s]%s[-]\n\n", themeColor(roleMuted), position))
+
+	// Results and selected panes side by side
+	p.listRow = strings.Count(b.String(), "\n")
+	left := mp.renderPickerResults()
+	right := mp.renderPickerSelected()
+	for i := 0; i < pickerPageSize; i++ {
+		l, r := "", ""
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		b.WriteString(padTagged(l, pickerListWidth))
+		b.WriteString(" │ ")
+		b.WriteString(r)
+		b.WriteString("\n")
+	}
+
+	if mp.statusMsg != "" {
+		b.WriteString(fmt.Sprintf("\n[%s]%s[-]\n", themeColor(roleSubtle), mp.statusMsg))
+	} else {
+		b.WriteString("\n")
+	}
+	b.WriteString("[" + themeColor(roleSubtle) + "]" + KeyHelp([]string{"picker.toggle", "picker.pane", "picker.category", "picker.groups", "picker.timeline"},
+		T("PgUp/PgDn=Page")) + "[::-]\n")
+
+	return b.String()
+}
+
+// truncateText shortens s to at most n runes, marking the cut with an ellipsis.
+func truncateText(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	if n <= 1 {
+		return string(runes[:n])
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// Seven-segment digits. Each digit lists its lit segments: a top, b upper
+// right, c lower right, d bottom, e lower left, f upper left, g middle.
+var digitSegments = map[rune]string{
+	'0': "abcdef", '1': "bc", '2': "abdeg", '3': "abcdg", '4': "bcfg",
+	'5': "acdfg", '6': "acdefg", '7': "abc", '8': "abcdefg", '9': "abcdfg",
+	'-': "g",
+}
+
+// bigDigitPixels returns the pixels of one seven-segment character at a
+// size: size+2 pixels wide and 2*size+3 high, or one pixel wide for ':'
+// and '.'.
+func bigDigitPixels(r rune, size int) [][]bool {
+	height := 2*size + 3
+	width := size + 2
+	if r == ':' || r == '.' {
+		width = 1
+	}
+	pixels := make([][]bool, height)
+	for y := range pixels {
+		pixels[y] = make([]bool, width)
+	}
+	switch r {
+	case ':':
+		upper := (size + 1) / 2
+		pixels[upper][0] = true
+		pixels[height-1-upper][0] = true
+		return pixels
+	case '.':
+		pixels[height-1][0] = true
+		return pixels
+	}
+
+	middle, bottom, right := size+1, height-1, width-1
+	for _, segment := range digitSegments[r] {
+		switch segment {
+		case 'a', 'g', 'd':
+			row := map[rune]int{'a': 0, 'g': middle, 'd': bottom}[segment]
+			for x := 0; x < width; x++ {
+				pixels[row][x] = true
+			}
+		case 'b', 'c', 'e', 'f':
+			col, top, end := 0, 0, middle
+			if segment == 'b' || segment == 'c' {
+				col = right
+			}
+			if segment == 'c' || segment == 'e' {
+				top, end = middle, bottom
+			}
+			for y := top; y <= end; y++ {
+				pixels[y][col] = true
+			}
+		}
+	}
+	return pixels
+}
+
+// BigDigitRows returns how many lines RenderBigDigits draws at a size.
+func BigDigitRows(size int) int {
+	if MapRenderer() == rendererASCII {
+		return 2*size + 3
+	}
+	return size + 2
+}
+
+// RenderBigDigits draws a time such as "15:04" or "3:04 pm" in big
+// seven-segment digits, size pixels per segment, two pixels per line with
+// half blocks or one per line in ASCII. Text from the first character
+// without a digit shape, such as "pm", is written small after the digits
+// on the first line. Every line has the same width.
+func RenderBigDigits(text string, size int) []string {
+	size = max(1, size)
+	digits, suffix := text, ""
+	for i, r := range text {
+		if _, ok := digitSegments[r]; !ok && r != ':' && r != '.' {
+			digits, suffix = text[:i], strings.TrimSpace(text[i:])
+			break
+		}
+	}
+
+	// Lay the characters out side by side, one pixel apart
+	height := 2*size + 3
+	canvas := make([][]bool, height)
+	for _, r := range strings.TrimSpace(digits) {
+		glyph := bigDigitPixels(r, size)
+		for y := range canvas {
+			if len(canvas[y]) > 0 {
+				canvas[y] = append(canvas[y], false)
+			}
+			canvas[y] = append(canvas[y], glyph[y]...)
+		}
+	}
+
+	var lines []string
+	if MapRenderer() == rendererASCII {
+		for _, row := range canvas {
+			var b strings.Builder
+			for _, on := range row {
+				if on {
+					b.WriteByte('#')
+				} else {
+					b.WriteByte(' ')
+				}
+			}
+			lines = append(lines, b.String())
+		}
+	} else {
+		blocks := [4]rune{' ', '▀', '▄', '█'} // Indexed by top | bottom<<1
+		for y := 0; y < height; y += 2 {
+			var b strings.Builder
+			for x := range canvas[y] {
+				index := 0
+				if canvas[y][x] {
+					index |= 1
+				}
+				if y+1 < height && canvas[y+1][x] {
+					index |= 2
+				}
+				b.WriteRune(blocks[index])
+			}
+			lines = append(lines, b.String())
+		}
+	}
+
+	if suffix != "" {
+		for i := range lines {
+			if i == 0 {
+				lines[i] += " " + suffix
+			} else {
+				lines[i] += strings.Repeat(" ", textWidth(suffix)+1)
+			}
+		}
+	}
+	return lines
+}
+
+// Layers of an analog clock face; a cell takes the colour of its highest.
+const (
+	faceLayerNone = iota
+	faceLayerDial
+	faceLayerSecond
+	faceLayerMinute
+	faceLayerHour
+)
+
+// brailleDotBits are the bits of the braille dots, indexed by row and
+// column within a cell.
+var brailleDotBits = [4][2]rune{{0x01, 0x08}, {0x02, 0x10}, {0x04, 0x20}, {0x40, 0x80}}
+
+// clockCanvas is a grid of dots an analog clock face is drawn on. A dot is
+// about square: a braille cell holds 2×4 dots and other renderers 1×2.
+type clockCanvas struct {
+	dots         [][]uint8 // Layer of each dot
+	cellW, cellH int       // Dots per cell
+	cx, cy       float64   // Centre
+	radius       float64
+}
+
+// newClockCanvas returns a canvas for a face cols cells wide and rows
+// high, for the active renderer.
+func newClockCanvas(cols, rows int) *clockCanvas {
+	c := &clockCanvas{cellW: 1, cellH: 2}
+	if MapRenderer() == rendererBraille {
+		c.cellW, c.cellH = 2, 4
+	}
+	w, h := cols*c.cellW, rows*c.cellH
+	c.dots = make([][]uint8, h)
+	for y := range c.dots {
+		c.dots[y] = make([]uint8, w)
+	}
+	c.cx, c.cy = float64(w-1)/2, float64(h-1)/2
+	c.radius = float64(min(w, h))/2 - 0.5
+	return c
+}
+
+// plot sets a dot to a layer unless a higher layer is already there.
+func (c *clockCanvas) plot(x, y float64, layer uint8) {
+	px, py := int(math.Round(x)), int(math.Round(y))
+	if py < 0 || py >= len(c.dots) || px < 0 || px >= len(c.dots[py]) {
+		return
+	}
+	if c.dots[py][px] < layer {
+		c.dots[py][px] = layer
+	}
+}
+
+// ray draws a line at an angle, clockwise from 12 o'clock in radians, from
+// one distance from the centre to another.
+func (c *clockCanvas) ray(angle, from, to float64, layer uint8) {
+	dx, dy := math.Sin(angle), -math.Cos(angle)
+	for d := from; d <= to; d += 0.5 {
+		c.plot(c.cx+dx*d, c.cy+dy*d, layer)
+	}
+}
+
+// render encodes the canvas as lines of tagged text, colouring each cell
+// by its highest layer.
+func (c *clockCanvas) render(colors map[uint8]string) []string {
+	rows := len(c.dots) / c.cellH
+	cols := 0
+	if rows > 0 {
+		cols = len(c.dots[0]) / c.cellW
+	}
+	asciiChars := map[uint8]byte{faceLayerDial: '.', faceLayerSecond: ':', faceLayerMinute: '+', faceLayerHour: '#'}
+
+	lines := make([]string, rows)
+	for row := 0; row < rows; row++ {
+		var b strings.Builder
+		current := uint8(faceLayerNone)
+		for col := 0; col < cols; col++ {
+			var layer uint8
+			var bits rune
+			for dy := 0; dy < c.cellH; dy++ {
+				for dx := 0; dx < c.cellW; dx++ {
+					l := c.dots[row*c.cellH+dy][col*c.cellW+dx]
+					if l == faceLayerNone {
+						continue
+					}
+					layer = max(layer, l)
+					if c.cellW == 2 {
+						bits |= brailleDotBits[dy][dx]
+					} else {
+						bits |= 1 << dy
+					}
+				}
+			}
+			if layer != current && layer != faceLayerNone {
+				if current != faceLayerNone {
+					b.WriteString("[-::-]")
+				}
+				b.WriteString("[" + colors[layer] + "]")
+				current = layer
+			}
+			switch {
+			case layer == faceLayerNone:
+				b.WriteByte(' ')
+			case c.cellW == 2:
+				b.WriteRune(0x2800 + bits)
+			case MapRenderer() == rendererASCII:
+				b.WriteByte(asciiChars[layer])
+			default:
+				b.WriteRune([4]rune{' ', '▀', '▄', '█'}[bits])
+			}
+		}
+		if current != faceLayerNone {
+			b.WriteString("[-::-]")
+		}
+		lines[row] = b.String()
+	}
+	return lines
+}
+
+// RenderAnalogFace draws an analog clock showing t, cols cells wide and
+// rows high: a dial with hour ticks in faceColor and white hour and minute
+// hands, plus a red second hand if seconds is set. Braille gives the hands
+// sub-cell detail; other renderers draw with half blocks or ASCII.
+func RenderAnalogFace(t time.Time, cols, rows int, faceColor string, seconds bool) []string {
+	c := newClockCanvas(cols, rows)
+	r := c.radius
+	if r < 1 {
+		return make([]string, rows)
+	}
+
+	// Dial and hour ticks, longer at the quarters
+	for i, steps := 0, int(2*math.Pi*r*2)+8; i < steps; i++ {
+		angle := 2 * math.Pi * float64(i) / float64(steps)
+		c.plot(c.cx+math.Sin(angle)*r, c.cy-math.Cos(angle)*r, faceLayerDial)
+	}
+	for hour := 0; hour < 12; hour++ {
+		inner := 0.85
+		if hour%3 == 0 {
+			inner = 0.72
+		}
+		c.ray(2*math.Pi*float64(hour)/12, r*inner, r, faceLayerDial)
+	}
+
+	// Hands
+	second := float64(t.Second())
+	minute := float64(t.Minute()) + second/60
+	hour := float64(t.Hour()%12) + minute/60
+	if seconds {
+		c.ray(2*math.Pi*second/60, 0, r*0.88, faceLayerSecond)
+	}
+	c.ray(2*math.Pi*minute/60, 0, r*0.78, faceLayerMinute)
+	c.ray(2*math.Pi*hour/12, 0, r*0.5, faceLayerHour)
+
+	return c.render(map[uint8]string{
+		faceLayerDial:   faceColor,
+		faceLayerSecond: "red",
+		faceLayerMinute: "white",
+		faceLayerHour:   "white::b",
+	})
+}
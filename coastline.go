@@ -0,0 +1,253 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+//go:generate go run gen_coastlines.go gen_geometry.go -geojson data/ne_110m_admin_0_countries.geojson
+
+// coastlineLevel is one embedded level of detail for the coastline polygons.
+type coastlineLevel struct {
+	Tolerance float64   // Simplification tolerance in degrees
+	Polygons  [][]int16 // Flat (lon, lat) pairs in hundredths of a degree
+}
+
+// coastPolygon is a decoded land polygon with its bounding box.
+type coastPolygon struct {
+	lons, lats     []float64
+	minLon, maxLon float64
+	minLat, maxLat float64
+}
+
+// thinFeatureDots is the size, in dots, below which a polygon is treated as
+// a thin feature and drawn at its vertices so it cannot vanish.
+const thinFeatureDots = 1.5
+
+var (
+	coastPolygonsOnce sync.Once
+	coastPolygons     [][]coastPolygon // Decoded polygons per level
+)
+
+// coastRasterKey identifies a cached coastline raster.
+type coastRasterKey struct {
+	w, h int
+	vp   Viewport
+}
+
+var (
+	coastRasterMu    sync.Mutex
+	coastRasterCache = make(map[coastRasterKey][][]bool)
+)
+
+// maxCoastRasterCache bounds the number of cached rasters (one per size and viewport).
+const maxCoastRasterCache = 8
+
+// decodeCoastlines converts the embedded fixed-point polygons to degrees.
+func decodeCoastlines() {
+	coastPolygons = make([][]coastPolygon, len(coastlineLevels))
+	for i, level := range coastlineLevels {
+		polys := make([]coastPolygon, 0, len(level.Polygons))
+		for _, flat := range level.Polygons {
+			if p, ok := decodePolygon(flat); ok {
+				polys = append(polys, p)
+			}
+		}
+		coastPolygons[i] = polys
+	}
+}
+
+// decodePolygon converts one embedded fixed-point ring to degrees. It
+// returns false for an empty ring.
+func decodePolygon(flat []int16) (coastPolygon, bool) {
+	n := len(flat) / 2
+	if n == 0 {
+		return coastPolygon{}, false
+	}
+	p := coastPolygon{
+		lons:   make([]float64, n),
+		lats:   make([]float64, n),
+		minLon: math.Inf(1), maxLon: math.Inf(-1),
+		minLat: math.Inf(1), maxLat: math.Inf(-1),
+	}
+	for j := 0; j < n; j++ {
+		lon := float64(flat[2*j]) / 100
+		lat := float64(flat[2*j+1]) / 100
+		p.lons[j], p.lats[j] = lon, lat
+		p.minLon, p.maxLon = math.Min(p.minLon, lon), math.Max(p.maxLon, lon)
+		p.minLat, p.maxLat = math.Min(p.minLat, lat), math.Max(p.maxLat, lat)
+	}
+	return p, true
+}
+
+// contains reports whether a point is inside the ring (even-odd rule).
+func (p *coastPolygon) contains(lat, lon float64) bool {
+	if lat < p.minLat || lat > p.maxLat || lon < p.minLon || lon > p.maxLon {
+		return false
+	}
+	inside := false
+	n := len(p.lats)
+	for j, k := 0, n-1; j < n; k, j = j, j+1 {
+		if (p.lats[j] > lat) != (p.lats[k] > lat) &&
+			lon < p.lons[j]+(lat-p.lats[j])*(p.lons[k]-p.lons[j])/(p.lats[k]-p.lats[j]) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// coastlineLevelFor picks the coarsest level whose simplification is still
+// finer than one dot.
+func coastlineLevelFor(degreesPerDot float64) int {
+	level := 0
+	for i, l := range coastlineLevels {
+		if l.Tolerance <= degreesPerDot {
+			level = i
+		}
+	}
+	return level
+}
+
+// RasterizeCoastlines fills the land polygons inside a viewport onto a dot
+// grid of dstW x dstH, using the viewport's projection. Dots are land if
+// their centre is inside a polygon (nonzero winding); polygons thinner than
+// thinFeatureDots are also drawn at their vertices so small islands and
+// narrow peninsulas stay visible. Results are cached per size and viewport.
+func RasterizeCoastlines(dstW, dstH int, vp Viewport) [][]bool {
+	key := coastRasterKey{dstW, dstH, vp}
+	coastRasterMu.Lock()
+	defer coastRasterMu.Unlock()
+	if grid, ok := coastRasterCache[key]; ok {
+		return grid
+	}
+
+	coastPolygonsOnce.Do(decodeCoastlines)
+
+	grid := make([][]bool, dstH)
+	for i := range grid {
+		grid[i] = make([]bool, dstW)
+	}
+	if dstW <= 0 || dstH <= 0 || len(coastPolygons) == 0 {
+		return grid
+	}
+
+	lonPerDot := vp.LonSpan() / float64(dstW)
+	latPerDot := vp.LatSpan() / float64(dstH)
+	polys := coastPolygons[coastlineLevelFor(math.Min(lonPerDot, latPerDot))]
+	proj := newMapProjector(vp, dstW, dstH)
+
+	// Project the visible polygons to dot coordinates, shifted by whole
+	// turns so views across the antimeridian fill
+	var rings []projectedRing
+	for i := range polys {
+		p := &polys[i]
+		if p.maxLat < vp.South || p.minLat > vp.North {
+			continue
+		}
+		for _, shift := range []float64{-360, 0, 360} {
+			if p.maxLon+shift > vp.West && p.minLon+shift < vp.East {
+				rings = append(rings, projectRing(p, shift-proj.centre, proj))
+			}
+		}
+	}
+
+	type crossing struct {
+		x   float64
+		dir int
+	}
+	var xs []crossing
+	for y := 0; y < dstH; y++ {
+		fy := float64(y) + 0.5
+		xs = xs[:0]
+		for _, r := range rings {
+			if fy < r.minY || fy > r.maxY {
+				continue
+			}
+			n := len(r.ys)
+			for j := 0; j < n; j++ {
+				k := (j + 1) % n
+				y1, y2 := r.ys[j], r.ys[k]
+				if (y1 <= fy) == (y2 <= fy) {
+					continue
+				}
+				x := r.xs[j] + (fy-y1)*(r.xs[k]-r.xs[j])/(y2-y1)
+				dir := 1
+				if y2 < y1 {
+					dir = -1
+				}
+				xs = append(xs, crossing{x, dir})
+			}
+		}
+		sort.Slice(xs, func(a, b int) bool { return xs[a].x < xs[b].x })
+
+		// Fill dot centres between crossings where the winding is nonzero
+		winding := 0
+		for i := 0; i+1 < len(xs); i++ {
+			winding += xs[i].dir
+			if winding == 0 {
+				continue
+			}
+			from := int(math.Ceil(xs[i].x - 0.5))
+			to := int(math.Floor(xs[i+1].x - 0.5))
+			for x := max(from, 0); x <= to && x < dstW; x++ {
+				grid[y][x] = true
+			}
+		}
+	}
+
+	// Thin-feature pass: keep small islands and slivers visible
+	for _, r := range rings {
+		if r.maxX-r.minX >= thinFeatureDots && r.maxY-r.minY >= thinFeatureDots {
+			continue
+		}
+		for j := range r.xs {
+			x, y := int(r.xs[j]), int(r.ys[j])
+			if x >= 0 && x < dstW && y >= 0 && y < dstH {
+				grid[y][x] = true
+			}
+		}
+	}
+
+	if len(coastRasterCache) >= maxCoastRasterCache {
+		coastRasterCache = make(map[coastRasterKey][][]bool)
+	}
+	coastRasterCache[key] = grid
+	return grid
+}
+
+// projectedRing is a polygon projected to dot coordinates.
+type projectedRing struct {
+	xs, ys     []float64
+	minX, maxX float64
+	minY, maxY float64
+}
+
+// maxEdgeDegrees is the longest polygon edge projected as a straight line;
+// longer edges are split so they follow curved parallels and meridians.
+const maxEdgeDegrees = 2.0
+
+// projectRing projects a polygon whose longitudes are offset by dLon onto
+// the projector's grid.
+func projectRing(p *coastPolygon, dLon float64, proj mapProjector) projectedRing {
+	r := projectedRing{
+		minX: math.Inf(1), maxX: math.Inf(-1),
+		minY: math.Inf(1), maxY: math.Inf(-1),
+	}
+	add := func(lat, lon float64) {
+		x, y := proj.toGrid(lat, lon+dLon)
+		r.xs, r.ys = append(r.xs, x), append(r.ys, y)
+		r.minX, r.maxX = math.Min(r.minX, x), math.Max(r.maxX, x)
+		r.minY, r.maxY = math.Min(r.minY, y), math.Max(r.maxY, y)
+	}
+	n := len(p.lats)
+	for j := 0; j < n; j++ {
+		k := (j + 1) % n
+		steps := int(math.Max(math.Abs(p.lats[k]-p.lats[j]), math.Abs(p.lons[k]-p.lons[j])) / maxEdgeDegrees)
+		for s := 0; s <= steps; s++ {
+			f := float64(s) / float64(steps+1)
+			add(p.lats[j]+(p.lats[k]-p.lats[j])*f, p.lons[j]+(p.lons[k]-p.lons[j])*f)
+		}
+	}
+	return r
+}
@@ -0,0 +1,1745 @@
+// Code generated by gen_coastlines.go; DO NOT EDIT.
+
+package main
+
+// coastlineSource is the data the coastlines were generated from.
+const coastlineSource = "ne_110m_admin_0_countries.geojson"
+
+// coastlineLevels holds land polygons from finest to coarsest detail. Each
+// polygon is a flat list of (lon, lat) pairs in hundredths of a degree.
+var coastlineLevels = []coastlineLevel{
+	{Tolerance: 0.05, Polygons: [][]int16{
+		{6080, 3440, 6053, 3368, 6096, 3353, 6054, 3298, 6086, 3218, 6094, 3155, 6170, 3138, 6178, 3074, 6087, 2983, 6255, 2932, 6355, 2947, 6415, 2934, 6435, 2956, 6505, 2947, 6635, 2989, 6638, 3074, 6694, 3130, 6768, 3130, 6779, 3158, 6856, 3171, 6893, 3162, 6932, 3190, 6926, 3250, 6969, 3311, 7032, 3336, 6993, 3402, 7088, 3399, 7116, 3435, 7112, 3473, 7161, 3515, 7150, 3565, 7126, 3607, 7185, 3651, 7292, 3672, 7407, 3684, 7516, 3713, 7498, 3742, 7326, 3750, 7264, 3705, 7219, 3695, 7184, 3674, 7145, 3707, 7154, 3791, 7124, 3795, 7135, 3826, 7081, 3849, 7038, 3814, 7027, 3774, 7012, 3759, 6952, 3761, 6920, 3715, 6886, 3734, 6814, 3702, 6708, 3736, 6622, 3739, 6575, 3766, 6559, 3731, 6475, 3711, 6455, 3631, 6398, 3601, 6319, 3586, 6298, 3540, 6223, 3527, 6121, 3565},
+		{1338, -586, 1232, -610, 1223, -629, 1273, -693, 1324, -856, 1293, -896, 1288, -917, 1339, -1037, 1369, -1073, 1374, -1130, 1363, -1204, 1274, -1314, 1250, -1355, 1178, -1579, 1164, -1667, 1173, -1730, 1281, -1694, 1346, -1697, 1406, -1742, 1421, -1735, 1826, -1731, 1896, -1779, 2138, -1793, 2322, -1752, 2256, -1690, 2189, -1608, 2193, -1290, 2402, -1291, 2393, -1257, 2408, -1219, 2390, -1172, 2402, -1124, 2391, -1093, 2346, -1087, 2216, -1108, 2221, -989, 2188, -952, 2180, -891, 2195, -831, 2175, -792, 2173, -729, 2051, -730, 2060, -694, 2009, -694, 2004, -712, 1942, -716, 1902, -799, 1846, -785, 1813, -799, 1747, -807, 1686, -722, 1633, -588},
+		{1247, -525, 1263, -499, 1300, -478, 1262, -444, 1232, -461, 1191, -504, 1218, -579, 1244, -568},
+		{2052, 4222, 2028, 4232, 2007, 4259, 1980, 4250, 1974, 4269, 1930, 4220, 1937, 4188, 1954, 4172, 1940, 4141, 1932, 4073, 1941, 4025, 1996, 3992, 1998, 3969, 2015, 3962, 2062, 4011, 2067, 4043, 2100, 4058, 2102, 4084, 2061, 4109, 2046, 4152, 2059, 4186},
+		{5162, 2401, 5200, 2300, 5501, 2250, 5521, 2271, 5523, 2311, 5553, 2352, 5553, 2393, 5598, 2413, 5580, 2427, 5589, 2492, 5640, 2492, 5626, 2571, 5607, 2606, 5401, 2412, 5258, 2418, 5179, 2402, 5176, 2429, 5158, 2425},
+		{-6505, -5470, -6645, -5445, -6775, -5385, -6863, -5264, -6863, -5487, -6696, -5490, -6645, -5525, -6550, -5520},
+		{-6627, -2183, -6711, -2274, -6699, -2299, -6733, -2403, -6842, -2452, -6839, -2619, -6859, -2651, -6830, -2690, -6900, -2752, -6966, -2846, -7001, -2937, -6992, -3034, -7054, -3137, -7007, -3309, -6981, -3327, -6982, -3419, -7039, -3517, -7036, -3601, -7112, -3666, -7112, -3758, -7081, -3855, -7141, -3892, -7192, -4083, -7175, -4205, -7215, -4225, -7192, -4341, -7146, -4379, -7179, -4421, -7133, -4441, -7122, -4478, -7166, -4497, -7155, -4556, -7192, -4688, -7245, -4774, -7233, -4824, -7265, -4888, -7342, -4932, -7333, -5038, -7298, -5074, -7231, -5068, -7233, -5143, -7191, -5201, -6950, -5214, -6815, -5235, -6882, -5177, -6914, -5073, -6873, -5026, -6782, -4987, -6717, -4870, -6599, -4813, -6564, -4724, -6660, -4703, -6758, -4630, -6729, -4555, -6651, -4504, -6557, -4504, -6533, -4450, -6518, -4350, -6438, -4287, -6346, -4256, -6376, -4204, -6430, -4236, -6498, -4206, -6512, -4106, -6473, -4080, -6377, -4117, -6275, -4103, -6215, -4068, -6233, -4017, -6213, -3942, -6234, -3883, -6124, -3893, -5923, -3872, -5775, -3818, -5679, -3690, -5674, -3641, -5736, -3598, -5723, -3529, -5850, -3443, -5835, -3326, -5813, -3304, -5814, -3204, -5763, -3022, -5629, -2885, -5516, -2788, -5365, -2692, -5363, -2612, -5413, -2555, -5463, -2574, -5479, -2662, -5570, -2739, -5649, -2755, -5761, -2740, -5862, -2712, -5763, -2560, -5778, -2516, -5881, -2477, -6003, -2403, -6085, -2388, -6285, -2203, -6399, -2199, -6438, -2280, -6496, -2208},
+		{4375, 4074, 4366, 4025, 4440, 4001, 4479, 3971, 4500, 3974, 4530, 3947, 4574, 3947, 4574, 3932, 4614, 3874, 4651, 3877, 4648, 3946, 4603, 3963, 4561, 3990, 4589, 4022, 4536, 4056, 4556, 4081, 4518, 4099, 4497, 4125, 4358, 4109},
+		{-6061, -7963, -6188, -8039, -6629, -8026, -6574, -8059, -6449, -8092, -6226, -8086, -6016, -8100, -5957, -8004},
+		{-15948, -7905, -16025, -7869, -16125, -7838, -16311, -7822, -16371, -7860, -16307, -7887, -16244, -7928, -16113, -7963, -15921, -7950},
+		{-4666, -7783, -4815, -7805, -4866, -7805, -4991, -7881, -5099, -7961, -5185, -7995, -5399, -8022, -5416, -8063, -5285, -8097, -5048, -8103, -4651, -8059, -4333, -8003, -4349, -7909, -4392, -7848, -4515, -7805},
+		{-12241, -7332, -12262, -7366, -12162, -7401, -12023, -7409, -11929, -7383, -11872, -7348, -11992, -7366},
+		{-12656, -7325, -12728, -7346, -12591, -7374, -12403, -7387},
+		{-10043, -7185, -10170, -7172, -10233, -7189, -10180, -7231, -10078, -7250, -9698, -7244, -9620, -7252, -9679, -7195, -9788, -7207},
+		{-6873, -7051, -6972, -6925, -7025, -6888, -7117, -6904, -7174, -6951, -7178, -7068, -7207, -7119, -7323, -7115, -7392, -7127, -7501, -7166, -7495, -7207, -7419, -7237, -7190, -7209, -7239, -7248, -7108, -7250, -6878, -7217, -6833, -7141, -6845, -7096},
+		{-5760, -6386, -5722, -6353, -5781, -6327, -5859, -6339, -5916, -6370, -5989, -6396, -6071, -6407, -6141, -6427, -6204, -6458, -6300, -6464, -6418, -6517, -6457, -6560, -6606, -6621, -6725, -6688, -6774, -6733, -6743, -6815, -6758, -6854, -6845, -6933, -6854, -6972, -6849, -7011, -6725, -7164, -6713, -7205, -6737, -7248, -6796, -7279, -6894, -7301, -7283, -7340, -7489, -7387, -7622, -7397, -7691, -7364, -7793, -7342, -7930, -7352, -8030, -7313, -8069, -7348, -8147, -7385, -8267, -7364, -8519, -7348, -8601, -7309, -8727, -7319, -8842, -7301, -8923, -7256, -9009, -7332, -9142, -7340, -9244, -7317, -9634, -7362, -9769, -7356, -9812, -7321, -9914, -7291, -10031, -7275, -10161, -7281, -10292, -7275, -10368, -7262, -10311, -7373, -10255, -7411, -10125, -7419, -10012, -7487, -10065, -7530, -10337, -7499, -10488, -7495, -10615, -7513, -10756, -7518, -10871, -7491, -11007, -7479, -11126, -7442, -11230, -7471, -11295, -7438, -11330, -7403, -11394, -7371, -11502, -7407, -11622, -7424, -11747, -7403, -11868, -7419, -11970, -7448, -12107, -7452, -12540, -7452, -12824, -7432, -12955, -7446, -13093, -7448, -13226, -7430, -13375, -7444, -13521, -7430, -13886, -7497, -14164, -7509, -14279, -7534, -14432, -7554, -14491, -7520, -14620, -7538, -14650, -7573, -14614, -7611, -14610, -7648, -14761, -7658, -14875, -7691, -15133, -7740, -15292, -7750, -15374, -7707, -15697, -7730, -15837, -7689, -15805, -7803, -15727, -7838, -15598, -7869, -15533, -7906, -14953, -7936, -14677, -7993, -14642, -8034, -14722, -8067, -14887, -8104, -15065, -8134, -15210, -8100, -15441, -8116, -15684, -8110, -15529, -8142, -15453, -8177, -15286, -8204, -15267, -8245, -15341, -8324, -15359, -8369, -15090, -8390, -15006, -8430, -14683, -8453, -14289, -8457, -14311, -8504, -14853, -8561, -15094, -8530, -15519, -8510, -15807, -8537, -16193, -8514, -16418, -8483, -16702, -8457, -16995, -8388, -17289, -8406, -17438, -8453, -17583, -8412, -17608, -8410, -17726, -8445, -17906, -8414, -18000, -8471, -18000, -9000, 18000, -9000, 18000, -8471, 17599, -8416, 17322, -8441, 17228, -8404, 16940, -8383, 16890, -8334, 16660, -8302, 16371, -8240, 16249, -8206, 16163, -8169, 16112, -8128, 15979, -8095, 16075, -8020, 16092, -7973, 16177, -7916, 16367, -7912, 16700, -7875, 16660, -7832, 16474, -7818, 16427, -7783, 16406, -7746, 16349, -7707, 16347, -7669, 16357, -7624, 16423, -7546, 16564, -7477, 16609, -7438, 16739, -7417, 16798, -7381, 16929, -7366, 17056, -7244, 17109, -7209, 17121, -7170, 17050, -7140, 16843, -7097, 16731, -7083, 16611, -7076, 16269, -7074, 16157, -7058, 16081, -7023, 15967, -6999, 15918, -6960, 15681, -6938, 15428, -6856, 15364, -6889, 15250, -6887, 14884, -6839, 14665, -6790, 14600, -6760, 14620, -6723, 14549, -6692, 14306, -6680, 13746, -6695, 13662, -6678, 13621, -6645, 13587, -6603, 13570, -6558, 13507, -6531, 13503, -6572, 13476, -6621, 13294, -6639, 13078, -6643, 12880, -6676, 12700, -6656, 12610, -6656, 12516, -6672, 12322, -6648, 12232, -6656, 12087, -6719, 11983, -6727, 11858, -6717, 11738, -6692, 11670, -6666, 11560, -6670, 11439, -6607, 11360, -6588, 11286, -6609, 11174, -6613, 11024, -6670, 10808, -6695, 10618, -6693, 10424, -6597, 10283, -6556, 10158, -6631, 10089, -6658, 9972, -6725, 9868, -6711, 9776, -6725, 9668, -6725, 9578, -6739, 9502, -6717, 9418, -6711, 9355, -6721, 9159, -6711, 9063, -6723, 8967, -6715, 8883, -6695, 8799, -6621, 8748, -6688, 8675, -6715, 8566, -6709, 8378, -6731, 8278, -6721, 8148, -6754, 8094, -6788, 7911, -6833, 7843, -6870, 7813, -6907, 7764, -6946, 7563, -6974, 7386, -6987, 7334, -7036, 7308, -7072, 7191, -7132, 7157, -7170, 7102, -7209, 6987, -7226, 6871, -7217, 6795, -7185, 6893, -7107, 6907, -7068, 6795, -7070, 6781, -7031, 6860, -6993, 6956, -6968, 6967, -6923, 6971, -6897, 6889, -6793, 6691, -6786, 6499, -6762, 6405, -6741, 6319, -6782, 6239, -6801, 6143, -6795, 5994, -6741, 5874, -6729, 5726, -6668, 5716, -6625, 5636, -6597, 5453, -6582, 5261, -6605, 5179, -6625, 5095, -6652, 5075, -6688, 4993, -6711, 4899, -6709, 4744, -6772, 4650, -6760, 4411, -6827, 4196, -6860, 4092, -6893, 4002, -6911, 3967, -6954, 3865, -6978, 3791, -6952, 3720, -6917, 3616, -6925, 3530, -6901, 3491, -6866, 3387, -6850, 3330, -6884, 3275, -6938, 3199, -6966, 3003, -6993, 2915, -7021, 2709, -7046, 2367, -7052, 2257, -7070, 2192, -7040, 2145, -7007, 1926, -6989, 1703, -6991, 1595, -7003, 1513, -7040, 1473, -7003, 1342, -6997, 1240, -7025, 1195, -7064, 1082, -7083, 953, -7001, 849, -7015, 774, -6989, 714, -7025, 627, -7046, 414, -7085, 87, -7130, -23, -7164, -66, -7123, -180, -7117, -434, -7146, -554, -7140, -579, -7103, -687, -7093, -738, -7132, -742, -7170, -861, -7166, -910, -7132, -1030, -7127, -1102, -7154, -1151, -7201, -1229, -7240, -1331, -7272, -1545, -7315, -1611, -7346, -1647, -7387, -1541, -7411, -1570, -7450, -1752, -7513, -2001, -7567, -2246, -7611, -2393, -7624, -2547, -7628, -2888, -7667, -2978, -7707, -3221, -7765, -3533, -7812, -3578, -7834, -3591, -7908, -3564, -7946, -3368, -7946, -3162, -7930, -2969, -7926, -2969, -7963, -2925, -7999, -2855, -8034, -3010, -8059, -3439, -8091, -3824, -8134, -4077, -8136, -4216, -8165, -4281, -8208, -4483, -8185, -4727, -8171, -4976, -8173, -5362, -8226, -5701, -8287, -5822, -8322, -5871, -8285, -5969, -8238, -6326, -8175, -6570, -8147, -6819, -8132, -7324, -8042, -7536, -8026, -7663, -7989, -7685, -7951, -7802, -7918, -7793, -7838, -7650, -7812, -7477, -7822, -7366, -7791, -7428, -7756, -7540, -7728, -7693, -7710, -7724, -7671, -7060, -7663, -6980, -7622, -6586, -7564, -6435, -7526, -6375, -7493, -6330, -7458, -6196, -7444, -6138, -7411, -6083, -7370, -6069, -7317, -6100, -7277, -6108, -7238, -6138, -7201, -6151, -7109, -6181, -7072, -6228, -7038, -6279, -6962, -6320, -6923, -6478, -6868, -6531, -6837, -6567, -6795, -6551, -6758, -6375, -6650, -6281, -6643, -6212, -6619, -6259, -6586, -6265, -6548, -6251, -6509, -6202, -6480, -6061, -6431, -5979, -6421, -5905, -6437, -5861, -6415},
+		{6887, -4883, 6872, -4924, 6875, -4978, 7028, -4971, 7056, -4926, 7053, -4907, 6958, -4894, 6894, -4863},
+		{14474, -4070, 14472, -4116, 14530, -4203, 14543, -4269, 14605, -4355, 14687, -4363, 14756, -4294, 14791, -4321, 14802, -4241, 14836, -4206, 14829, -4088, 14769, -4081, 14636, -4114, 14540, -4079},
+		{14360, -1340, 14352, -1283, 14316, -1233, 14312, -1191, 14287, -1178, 14280, -1116, 14252, -1067, 14214, -1104, 14212, -1133, 14169, -1241, 14184, -1274, 14165, -1294, 14152, -1370, 14164, -1427, 14156, -1456, 14170, -1504, 14127, -1639, 14088, -1737, 14022, -1771, 13926, -1737, 13911, -1706, 13859, -1681, 13830, -1681, 13707, -1587, 13630, -1555, 13550, -1500, 13543, -1472, 13608, -1372, 13596, -1332, 13631, -1329, 13669, -1289, 13695, -1235, 13649, -1186, 13626, -1205, 13588, -1196, 13530, -1225, 13468, -1194, 13439, -1204, 13355, -1179, 13302, -1138, 13236, -1113, 13182, -1127, 13256, -1160, 13258, -1211, 13174, -1230, 13122, -1218, 13062, -1254, 13018, -1311, 13034, -1336, 12989, -1362, 12941, -1442, 12962, -1497, 12836, -1487, 12780, -1428, 12707, -1382, 12614, -1410, 12613, -1435, 12569, -1423, 12567, -1451, 12517, -1468, 12493, -1508, 12438, -1557, 12426, -1633, 12382, -1611, 12350, -1660, 12386, -1707, 12343, -1727, 12301, -1641, 12231, -1725, 12224, -1820, 12166, -1871, 12140, -1924, 12086, -1968, 11981, -1998, 11925, -1995, 11899, -2004, 11884, -2026, 11823, -2037, 11744, -2075, 11717, -2062, 11671, -2070, 11595, -2107, 11546, -2150, 11465, -2183, 11423, -2252, 11415, -2176, 11374, -2248, 11384, -2306, 11371, -2356, 11350, -2381, 11339, -2438, 11422, -2579, 11423, -2630, 11394, -2591, 11344, -2562, 11378, -2655, 11334, -2612, 11348, -2654, 11405, -2733, 11417, -2812, 11462, -2852, 11464, -2881, 11504, -2946, 11500, -3003, 11516, -3060, 11569, -3161, 11580, -3221, 11568, -3290, 11571, -3326, 11555, -3349, 11505, -3362, 11503, -3420, 11556, -3439, 11663, -3503, 11802, -3506, 11901, -3446, 11930, -3451, 11989, -3398, 12130, -3382, 12218, -3400, 12366, -3389, 12403, -3348, 12422, -3296, 12509, -3273, 12615, -3222, 12710, -3228, 12954, -3159, 13133, -3150, 13229, -3198, 13299, -3201, 13427, -3262, 13409, -3285, 13461, -3322, 13524, -3395, 13521, -3448, 13599, -3489, 13637, -3409, 13700, -3375, 13781, -3290, 13789, -3364, 13750, -3413, 13735, -3471, 13683, -3526, 13772, -3508, 13821, -3438, 13845, -3513, 13812, -3561, 13908, -3573, 13957, -3614, 13981, -3664, 13999, -3740, 14064, -3802, 14361, -3881, 14449, -3809, 14503, -3790, 14488, -3842, 14549, -3859, 14632, -3904, 14738, -3822, 14830, -3781, 14942, -3777, 15000, -3743, 14995, -3711, 15008, -3642, 15033, -3567, 15071, -3517, 15101, -3431, 15134, -3382, 15171, -3304, 15245, -3255, 15289, -3164, 15309, -3092, 15307, -3035, 15351, -2900, 15357, -2811, 15309, -2726, 15314, -2607, 15286, -2527, 15207, -2446, 15090, -2346, 15073, -2240, 15048, -2256, 15008, -2212, 14968, -2234, 14929, -2126, 14872, -2063, 14885, -2039, 14747, -1948, 14639, -1896, 14606, -1828, 14616, -1776, 14589, -1691, 14564, -1678, 14549, -1629, 14527, -1543, 14537, -1498, 14489, -1459, 14456, -1417, 14392, -1455, 14356, -1376},
+		{1688, 4847, 1696, 4860, 1650, 4879, 1603, 4873, 1525, 4904, 1490, 4896, 1434, 4856, 1360, 4888, 1324, 4842, 1288, 4829, 1303, 4764, 1293, 4747, 1262, 4767, 1214, 4770, 1143, 4752, 1054, 4757, 1040, 4730, 990, 4758, 959, 4753, 963, 4735, 948, 4710, 993, 4692, 1105, 4675, 1116, 4694, 1215, 4712, 1238, 4677, 1463, 4643, 1514, 4666, 1601, 4668, 1620, 4685, 1653, 4750, 1634, 4771, 1690, 4771, 1698, 4812},
+		{4479, 3971, 4495, 3934, 4546, 3887, 4614, 3874, 4574, 3932, 4574, 3947, 4530, 3947, 4500, 3974},
+		{4669, 4183, 4640, 4186, 4615, 4172, 4664, 4118, 4650, 4106, 4596, 4112, 4522, 4141, 4497, 4125, 4518, 4099, 4556, 4081, 4536, 4056, 4589, 4022, 4561, 3990, 4603, 3963, 4648, 3946, 4651, 3877, 4769, 3951, 4806, 3958, 4836, 3929, 4801, 3879, 4863, 3827, 4888, 3832, 4886, 3882, 4922, 3905, 4940, 3940, 4957, 4018, 5039, 4026, 5008, 4053, 4962, 4057, 4911, 4128, 4858, 4181, 4799, 4141, 4782, 4115, 4737, 4122},
+		{2975, -445, 3075, -336, 3074, -303, 3053, -281, 3047, -241, 2994, -235, 2963, -292, 2902, -284, 2928, -329, 2934, -450},
+		{251, 5115, 266, 5080, 312, 5078, 429, 4991, 480, 4999, 567, 4953, 578, 5009, 604, 5013, 616, 5080, 561, 5104, 497, 5148, 405, 5127, 331, 5135},
+		{275, 787, 272, 851, 291, 914, 371, 1006, 360, 1033, 380, 1073, 357, 1133, 361, 1166, 285, 1224, 249, 1223, 194, 1164, 145, 1155, 124, 1111, 90, 1100, 77, 1047, 143, 983, 146, 933, 166, 913, 162, 683, 187, 614, 269, 626},
+		{-296, 1040, -294, 1096, -120, 1101, -76, 1094, -44, 1110, 90, 1100, 124, 1111, 145, 1155, 194, 1164, 215, 1194, 218, 1263, 102, 1285, 99, 1334, 43, 1399, 30, 1444, 37, 1493, -27, 1492, -52, 1512, -107, 1497, -200, 1456, -219, 1425, -297, 1380, -310, 1354, -352, 1334, -401, 1347, -428, 1323, -443, 1254, -522, 1171, -520, 1138, -547, 1095, -540, 1037, -495, 1015, -478, 982, -433, 961, -398, 986, -351, 990, -283, 964},
+		{9215, 2363, 9187, 2362, 9171, 2299, 9116, 2350, 9147, 2407, 9192, 2413, 9238, 2498, 9180, 2515, 9087, 2513, 8992, 2527, 8983, 2597, 8936, 2601, 8856, 2645, 8821, 2577, 8893, 2524, 8831, 2487, 8808, 2450, 8870, 2423, 8853, 2363, 8888, 2288, 8903, 2206, 8970, 2186, 8985, 2204, 9027, 2184, 9059, 2239, 9050, 2281, 9142, 2277, 9183, 2218, 9203, 2170, 9208, 2119, 9237, 2067, 9230, 2148, 9265, 2132, 9267, 2204},
+		{2241, 4401, 2250, 4364, 2299, 4321, 2260, 4290, 2244, 4258, 2255, 4246, 2238, 4232, 2288, 4200, 2295, 4134, 2369, 4131, 2449, 4158, 2520, 4123, 2611, 4133, 2612, 4183, 2714, 4214, 2800, 4201, 2767, 4258, 2804, 4329, 2856, 4371, 2797, 4381, 2724, 4418, 2607, 4394, 2557, 4369, 2410, 4374, 2333, 4390, 2294, 4382, 2266, 4423},
+		{-7754, 2434, -7789, 2517, -7819, 2521, -7841, 2458, -7803, 2429, -7778, 2371, -7753, 2376},
+		{-7785, 2684, -7851, 2687, -7898, 2679, -7891, 2642, -7782, 2658},
+		{-7779, 2704, -7779, 2693, -7734, 2653, -7736, 2601, -7717, 2588, -7700, 2659},
+		{1855, 4508, 1786, 4507, 1700, 4523, 1653, 4521, 1632, 4500, 1596, 4523, 1575, 4482, 1646, 4404, 1730, 4345, 1767, 4303, 1856, 4265, 1871, 4320, 1903, 4343, 1945, 4357, 1960, 4404, 1912, 4442, 1937, 4486, 1901, 4486},
+		{2353, 5347, 2380, 5309, 2380, 5269, 2320, 5249, 2351, 5202, 2353, 5158, 2401, 5162, 2455, 5189, 2533, 5191, 2634, 5183, 2745, 5159, 2824, 5157, 2862, 5143, 2899, 5160, 2925, 5137, 3016, 5142, 3056, 5132, 3062, 5182, 3093, 5204, 3179, 5210, 3154, 5274, 3131, 5307, 3150, 5317, 3230, 5313, 3269, 5335, 3241, 5362, 3173, 5379, 3179, 5397, 3138, 5416, 3076, 5481, 3097, 5508, 3087, 5555, 2990, 5579, 2937, 5567, 2923, 5592, 2818, 5617, 2649, 5562, 2659, 5517, 2577, 5485, 2554, 5428, 2445, 5391, 2348, 5391},
+		{-8915, 1702, -8923, 1589, -8893, 1589, -8873, 1623, -8855, 1627, -8836, 1653, -8820, 1749, -8829, 1764, -8812, 1808, -8811, 1835, -8830, 1835, -8830, 1850, -8849, 1849, -8885, 1788, -8903, 1800, -8915, 1796},
+		{-6269, -2225, -6229, -2105, -6227, -2051, -6179, -1963, -6004, -1934, -5912, -1936, -5818, -1987, -5817, -2018, -5785, -1997, -5795, -1940, -5768, -1896, -5750, -1817, -5773, -1755, -5828, -1727, -5839, -1688, -5824, -1630, -6016, -1626, -6054, -1509, -6025, -1508, -6026, -1465, -6046, -1435, -6050, -1378, -6108, -1348, -6171, -1349, -6213, -1320, -6280, -1300, -6320, -1263, -6432, -1246, -6540, -1157, -6532, -1090, -6544, -1051, -6534, -976, -6665, -993, -6717, -1031, -6805, -1071, -6827, -1101, -6953, -1095, -6867, -1256, -6888, -1290, -6895, -1445, -6934, -1495, -6916, -1532, -6939, -1566, -6896, -1650, -6959, -1758, -6910, -1826, -6897, -1898, -6844, -1941, -6876, -2037, -6822, -2149, -6783, -2287, -6711, -2274, -6627, -2183, -6496, -2208, -6438, -2280, -6399, -2199, -6285, -2203},
+		{-5698, -3011, -5597, -3088, -5560, -3085, -5379, -3205, -5321, -3273, -5365, -3320, -5337, -3377, -5271, -3320, -5226, -3225, -5158, -3178, -5070, -3098, -4959, -2922, -4889, -2867, -4866, -2819, -4847, -2718, -4864, -2662, -4850, -2588, -4765, -2489, -4647, -2409, -4535, -2380, -4465, -2335, -4307, -2297, -4199, -2297, -4175, -2237, -4094, -2194, -4077, -2090, -3976, -1960, -3958, -1826, -3927, -1787, -3888, -1567, -3895, -1379, -3867, -1306, -3842, -1304, -3768, -1217, -3705, -1104, -3564, -965, -3513, -900, -3473, -734, -3524, -546, -3560, -515, -3645, -511, -3722, -482, -3850, -370, -3998, -287, -4147, -291, -4342, -238, -4458, -269, -4442, -214, -4491, -155, -4657, -94, -4782, -58, -4858, -124, -4862, -24, -5039, -8, -5070, 22, -4995, 105, -4997, 174, -5051, 190, -5107, 365, -5132, 420, -5166, 416, -5225, 324, -5256, 250, -5294, 212, -5342, 205, -5355, 233, -5378, 238, -5409, 211, -5510, 252, -5557, 242, -5597, 251, -5607, 222, -5591, 202, -5600, 182, -5734, 195, -5766, 168, -5843, 146, -5854, 127, -5903, 132, -5965, 179, -5972, 225, -5997, 276, -5982, 361, -5954, 396, -5977, 442, -6011, 457, -5998, 501, -6021, 524, -6073, 520, -6060, 492, -6097, 454, -6209, 416, -6280, 401, -6309, 377, -6389, 402, -6463, 415, -6482, 406, -6437, 380, -6441, 313, -6427, 250, -6342, 241, -6337, 220, -6408, 192, -6420, 149, -6535, 110, -6555, 79, -6633, 72, -6688, 125, -6707, 113, -6726, 172, -6754, 204, -6787, 169, -6982, 171, -6980, 109, -6922, 99, -6925, 60, -6945, 71, -7002, 54, -7002, -19, -6958, -55, -6942, -112, -6989, -430, -7079, -425, -7093, -440, -7175, -459, -7289, -527, -7296, -574, -7322, -609, -7312, -663, -7372, -692, -7372, -734, -7399, -752, -7357, -842, -7302, -903, -7323, -946, -7256, -952, -7218, -1005, -7130, -1008, -7048, -949, -7055, -1101, -7009, -1112, -6953, -1095, -6827, -1101, -6805, -1071, -6717, -1031, -6665, -993, -6534, -976, -6544, -1051, -6532, -1090, -6540, -1157, -6432, -1246, -6320, -1263, -6280, -1300, -6213, -1320, -6171, -1349, -6108, -1348, -6050, -1378, -6046, -1435, -6026, -1465, -6025, -1508, -6054, -1509, -6016, -1626, -5824, -1630, -5839, -1688, -5828, -1727, -5773, -1755, -5750, -1817, -5768, -1896, -5795, -1940, -5785, -1997, -5817, -2018, -5787, -2073, -5794, -2209, -5688, -2228, -5647, -2209, -5580, -2236, -5561, -2266, -5552, -2357, -5540, -2396, -5503, -2400, -5465, -2384, -5429, -2402, -5429, -2457, -5463, -2574, -5413, -2555, -5363, -2612, -5365, -2692, -5516, -2788, -5629, -2885, -5763, -3022},
+		{11466, 401, 11487, 435, 11535, 432, 11545, 545, 11460, 490, 11420, 453},
+		{9126, 2804, 9073, 2806, 9002, 2830, 8948, 2804, 8881, 2730, 8884, 2710, 8974, 2672, 9037, 2688, 9203, 2684, 9210, 2745},
+		{2526, -1774, 2508, -1766, 2452, -1789, 2422, -1789, 2358, -1828, 2320, -1787, 2166, -1822, 2091, -1825, 2088, -2181, 1990, -2185, 1990, -2477, 2017, -2492, 2076, -2587, 2067, -2648, 2089, -2683, 2161, -2673, 2258, -2598, 2282, -2550, 2331, -2527, 2373, -2539, 2421, -2567, 2503, -2572, 2566, -2549, 2594, -2470, 2649, -2462, 2679, -2424, 2712, -2357, 2802, -2283, 2943, -2209, 2879, -2164, 2802, -2149, 2773, -2085, 2772, -2050, 2730, -2039, 2616, -1929, 2585, -1871, 2565, -1854},
+		{1478, 641, 1454, 623, 1446, 545, 1456, 503, 1448, 473, 1495, 421, 1504, 385, 1541, 334, 1586, 301, 1601, 227, 1654, 320, 1713, 373, 1781, 356, 1845, 350, 1854, 420, 1893, 471, 1947, 503, 2029, 469, 2093, 432, 2241, 403, 2270, 463, 2284, 471, 2330, 461, 2441, 511, 2481, 490, 2513, 493, 2528, 517, 2565, 526, 2704, 513, 2737, 523, 2721, 555, 2647, 595, 2621, 655, 2580, 698, 2512, 750, 2511, 783, 2346, 895, 2339, 927, 2356, 968, 2355, 1009, 2298, 1071, 2286, 1114, 2223, 1097, 2172, 1057, 2100, 948, 2006, 901, 1909, 907, 1881, 898, 1891, 863, 1796, 789, 1671, 751, 1646, 773, 1629, 775, 1611, 750, 1528, 742},
+		{-6401, 4704, -6439, 4673, -6414, 4639, -6287, 4597, -6250, 4603, -6201, 4644, -6294, 4642, -6366, 4655},
+		{-6184, 4929, -6286, 4971, -6417, 4996, -6452, 4987, -6359, 4940, -6229, 4909, -6181, 4911},
+		{-12392, 4906, -12492, 4948, -12576, 5030, -12670, 5040, -12836, 5077, -12844, 5054, -12806, 4999, -12703, 4981, -12685, 4953, -12595, 4918, -12566, 4883, -12401, 4837, -12351, 4851},
+		{-5560, 5132, -5541, 5159, -5587, 5163, -5674, 5129, -5736, 5072, -5839, 4913, -5923, 4852, -5880, 4825, -5942, 4790, -5927, 4760, -5625, 4763, -5529, 4739, -5600, 4692, -5540, 4688, -5424, 4775, -5396, 4763, -5418, 4681, -5352, 4662, -5307, 4666, -5265, 4754, -5296, 4816, -5309, 4869, -5379, 4852, -5348, 4925, -5447, 4956, -5494, 4931, -5582, 4959, -5547, 4994, -5614, 5015, -5680, 4981},
+		{-13324, 5385, -13305, 5341, -13255, 5310, -13218, 5264, -13158, 5218, -13118, 5218, -13205, 5298, -13175, 5412, -13271, 5404, -13318, 5417},
+		{-7952, 6236, -7993, 6239, -8036, 6202, -8010, 6172, -7966, 6163, -7927, 6216},
+		{-8188, 6290, -8325, 6291, -8399, 6245, -8377, 6218, -8307, 6216, -8190, 6271},
+		{-8588, 6574, -8622, 6482, -8635, 6404, -8722, 6354, -8587, 6364, -8552, 6305, -8410, 6357, -8311, 6410, -8255, 6365, -8099, 6341, -8010, 6373, -8082, 6406, -8155, 6398, -8164, 6446, -8388, 6511, -8446, 6537, -8498, 6522, -8516, 6566},
+		{-7522, 6744, -7510, 6758, -7511, 6801, -7590, 6829, -7681, 6815, -7724, 6759, -7699, 6710, -7587, 6715},
+		{-9626, 6949, -9656, 6968, -9822, 7014, -9892, 6971, -9980, 6940, -9843, 6895, -9762, 6906, -9627, 6876, -9565, 6911},
+		{-9241, 6970, -9152, 7019, -9288, 7132, -9389, 7176, -9521, 7192, -9639, 7119, -9647, 7009, -9530, 6969, -9423, 6907, -9469, 6806, -9549, 6809, -9613, 6729, -9612, 6824, -9767, 6858, -9856, 6840, -9844, 6778, -9990, 6781, -10145, 6765, -10322, 6810, -10434, 6802, -10534, 6856, -10615, 6880, -10817, 6865, -10881, 6831, -10779, 6789, -10888, 6738, -10995, 6798, -11080, 6781, -11350, 6769, -11530, 6790, -11390, 6840, -11525, 6891, -11623, 6884, -11760, 6901, -11994, 6938, -12147, 6980, -12268, 6986, -12306, 6956, -12429, 6940, -12442, 7016, -12576, 6948, -12745, 7038, -12814, 7048, -12836, 7001, -12911, 6978, -12979, 7019, -13143, 6994, -13293, 6951, -13441, 6963, -13563, 6932, -13650, 6890, -13755, 6899, -13912, 6947, -14099, 6971, -14100, 6031, -14001, 6028, -13904, 6000, -13745, 5891, -13648, 5946, -13548, 5979, -13495, 5927, -13336, 5841, -13171, 5655, -13001, 5592, -12998, 5529, -13054, 5480, -13051, 5429, -12931, 5356, -12913, 5276, -12785, 5233, -12799, 5172, -12744, 5083, -12562, 5042, -12297, 4900, -9516, 4900, -9516, 4938, -9482, 4939, -9464, 4884, -9433, 4867, -9261, 4845, -9164, 4814, -9083, 4827, -8960, 4801, -8927, 4802, -8838, 4830, -8488, 4690, -8478, 4664, -8454, 4654, -8460, 4644, -8434, 4641, -8414, 4651, -8409, 4628, -8389, 4612, -8362, 4612, -8347, 4599, -8359, 4582, -8255, 4535, -8214, 4357, -8243, 4298, -8312, 4208, -8314, 4198, -8303, 4183, -8269, 4168, -8244, 4168, -8128, 4221, -8025, 4237, -7894, 4286, -7901, 4327, -7917, 4347, -7872, 4363, -7682, 4363, -7650, 4402, -7532, 4482, -7487, 4500, -7151, 4501, -7141, 4526, -7066, 4546, -7031, 4592, -7000, 4669, -6924, 4745, -6891, 4719, -6823, 4735, -6779, 4707, -6779, 4570, -6714, 4514, -6603, 4526, -6443, 4529, -6616, 4447, -6612, 4362, -6536, 4355, -6425, 4427, -6325, 4467, -6104, 4527, -5980, 4592, -6045, 4628, -6052, 4701, -6152, 4588, -6317, 4574, -6447, 4624, -6480, 4699, -6512, 4807, -6417, 4874, -6506, 4923, -6655, 4913, -6865, 4830, -7026, 4699, -7110, 4682, -6995, 4774, -6851, 4907, -6724, 4951, -6640, 5023, -6386, 5029, -6172, 5008, -6003, 5024, -5877, 5106, -5713, 5142, -5568, 5215, -5576, 5327, -5616, 5365, -5694, 5378, -5733, 5463, -5798, 5495, -5957, 5520, -6047, 5578, -6180, 5634, -6140, 5697, -6458, 6034, -6525, 5987, -6620, 5877, -6765, 5821, -6837, 5880, -6929, 5896, -6962, 6022, -6959, 6106, -7137, 6114, -7168, 6153, -7291, 6211, -7384, 6244, -7467, 6218, -7741, 6255, -7811, 6232, -7777, 6076, -7734, 5985, -7852, 5880, -7730, 5805, -7662, 5720, -7654, 5653, -7710, 5584, -7823, 5514, -7983, 5467, -7912, 5414, -7860, 5256, -7914, 5153, -7991, 5121, -8140, 5216, -8213, 5328, -8244, 5428, -8227, 5515, -8501, 5530, -8607, 5572, -8732, 5600, -8804, 5647, -8904, 5685, -9090, 5728, -9230, 5709, -9322, 5878, -9468, 5895, -9463, 6011, -9424, 6090, -9316, 6202, -9193, 6284, -9077, 6296, -9070, 6361, -8991, 6403, -8848, 6410, -8732, 6478, -8703, 6521, -8607, 6606, -8577, 6656, -8474, 6626, -8334, 6641, -8139, 6711, -8126, 6760, -8196, 6813, -8122, 6867, -8128, 6916, -8262, 6966, -8552, 6988, -8558, 6878, -8631, 6792, -8735, 6720, -8832, 6787, -8802, 6862, -8922, 6926, -9055, 6847, -9055, 6950},
+		{-11519, 7331, -11787, 7271, -11856, 7231, -11940, 7156, -11766, 7130, -11611, 7131, -11843, 7091, -11790, 7054, -11435, 7060, -11242, 7037, -11372, 7019, -11513, 7024, -11734, 6996, -11611, 6917, -11522, 6928, -11385, 6901, -11331, 6854, -10900, 6878, -10712, 6912, -10596, 6918, -10424, 6891, -10243, 6875, -10209, 6912, -10273, 6950, -10109, 6958, -10098, 7002, -10446, 7099, -10477, 7170, -10540, 7267, -10652, 7308, -10752, 7324, -10840, 7309, -10769, 7207, -10819, 7165, -10901, 7263, -10992, 7296, -11105, 7245, -11244, 7296, -11467, 7265, -11417, 7312},
+		{-10526, 7364, -10660, 7360, -10694, 7346, -10538, 7276, -10450, 7342},
+		{-7806, 7365, -8035, 7376, -8083, 7369, -8088, 7333, -7978, 7280, -7949, 7274, -7839, 7288, -7625, 7283, -7634, 7310},
+		{-8583, 7380, -8841, 7354, -8944, 7313, -9021, 7224, -8989, 7122, -8847, 7122, -8951, 7076, -8868, 7041, -8494, 6997, -8131, 6974, -7949, 6987, -7896, 7017, -7817, 6983, -7729, 6977, -7623, 6915, -7687, 6889, -7484, 6855, -7331, 6807, -7293, 6773, -7265, 6728, -7394, 6631, -7429, 6581, -7396, 6545, -7602, 6533, -7790, 6531, -7856, 6457, -7771, 6423, -7482, 6439, -7483, 6468, -7189, 6368, -7224, 6340, -7102, 6291, -6888, 6233, -6617, 6193, -6633, 6228, -6878, 6375, -6628, 6295, -6501, 6267, -6467, 6339, -6532, 6438, -6573, 6465, -6709, 6511, -6814, 6569, -6802, 6626, -6672, 6639, -6515, 6543, -6392, 6500, -6216, 6616, -6185, 6686, -6342, 6693, -6486, 6785, -6645, 6807, -6881, 6872, -6697, 6919, -6791, 7012, -6879, 7053, -7120, 7092, -7224, 7156, -7410, 7133, -7423, 7177, -7561, 7224, -7782, 7275, -7877, 7235, -8075, 7206, -8060, 7272, -8232, 7375, -8485, 7334, -8577, 7253, -8656, 7316},
+		{-10154, 7336, -10044, 7271, -10248, 7283, -10250, 7251, -10001, 7174, -9932, 7136, -9836, 7127, -9672, 7166, -9654, 7256, -9805, 7299, -9712, 7347, -9738, 7376, -9916, 7363, -10036, 7384},
+		{-9200, 7297, -9051, 7386, -9242, 7410, -9450, 7413, -9550, 7386, -9602, 7344, -9603, 7294, -9541, 7206, -9427, 7202, -9320, 7277},
+		{-12046, 7182, -11922, 7252, -11677, 7322, -11551, 7348, -11756, 7419, -12011, 7424, -12154, 7445, -12492, 7429, -12394, 7368, -12481, 7302, -12593, 7187, -12362, 7134, -12309, 7090, -12046, 7138},
+		{-9398, 7530, -9485, 7565, -9629, 7538, -9682, 7493, -9561, 7467, -9416, 7459, -9361, 7498},
+		{-9858, 7659, -9998, 7665, -10149, 7631, -10257, 7634, -10250, 7556, -10086, 7564, -10088, 7506, -9981, 7490, -9816, 7500, -9770, 7574, -9774, 7626, -9850, 7672},
+		{-10855, 7668, -10958, 7679, -11050, 7643, -10907, 7547, -11081, 7555, -11259, 7614, -11540, 7648, -11635, 7620, -11771, 7522, -11631, 7504, -11179, 7516, -11387, 7472, -11374, 7439, -11222, 7442, -10970, 7485, -10631, 7501, -10570, 7548, -10588, 7597, -10693, 7601, -10782, 7585, -10821, 7620},
+		{-9675, 7716, -9712, 7675, -9596, 7644, -9389, 7632, -9289, 7588, -9277, 7539, -9242, 7484, -8815, 7439, -8610, 7441, -8323, 7456, -8195, 7444, -8046, 7466, -7983, 7492, -8006, 7534, -8113, 7571, -8275, 7578, -8479, 7570, -8638, 7548, -8919, 7561, -8982, 7585, -9097, 7607, -9074, 7645, -9161, 7678, -9357, 7678, -9468, 7710},
+		{-11757, 7750, -11910, 7751, -12116, 7686, -12285, 7612, -12150, 7590, -11990, 7605, -11804, 7648, -11711, 7653, -11634, 7688, -11620, 7765},
+		{-9372, 7763, -9442, 7782, -9644, 7783, -9617, 7756, -9430, 7749, -9384, 7752},
+		{-10985, 7800, -11126, 7815, -11272, 7805, -11353, 7773, -11205, 7741, -11019, 7770},
+		{-11096, 7880, -11150, 7885, -11253, 7855, -11254, 7841, -11088, 7841, -10966, 7860},
+		{-9556, 7842, -9675, 7877, -9863, 7887, -9855, 7846, -9812, 7808, -9731, 7785, -9583, 7806},
+		{-10083, 7880, -10353, 7917, -10549, 7930, -10542, 7892, -10421, 7868, -10518, 7838, -10295, 7834, -10130, 7802, -9967, 7791, -10006, 7832},
+		{-8781, 8032, -9113, 8072, -9241, 8126, -9474, 8121, -9430, 8098, -9532, 8091, -9602, 8060, -9671, 8016, -9608, 7971, -9497, 7937, -9315, 7938, -9394, 7911, -9395, 7875, -9288, 7834, -9080, 7822, -8904, 7829, -8719, 7904, -8581, 7934, -8702, 7966},
+		{-7067, 8317, -7283, 8323, -7572, 8306, -7625, 8317, -7931, 8313, -8242, 8286, -8318, 8232, -8426, 8260, -8550, 8265, -8697, 8228, -9010, 8209, -9159, 8189, -9137, 8155, -9020, 8126, -8937, 8086, -8760, 8052, -8410, 8058, -8185, 8046, -8341, 8010, -8420, 8021, -8693, 8025, -8651, 7974, -8509, 7935, -8538, 7900, -8715, 7876, -8796, 7837, -8634, 7818, -8498, 7754, -8765, 7797, -8826, 7790, -8777, 7718, -8962, 7695, -8949, 7647, -8611, 7630, -8317, 7645, -8056, 7618, -7789, 7678, -7791, 7702, -7962, 7698, -7976, 7721, -7836, 7751, -7789, 7790, -7634, 7818, -7539, 7853, -7622, 7902, -7553, 7920, -7691, 7932, -7388, 7943, -7324, 7963, -7118, 7980, -6947, 8062, -6784, 8090, -6548, 8151, -6766, 8150, -6675, 8173, -6433, 8193, -6189, 8236, -6185, 8263, -6368, 8290},
+		{852, 4783, 832, 4761, 747, 4762, 719, 4745, 674, 4754, 677, 4729, 604, 4673, 602, 4627, 650, 4643, 684, 4599, 727, 4578, 776, 4582, 832, 4616, 849, 4601, 897, 4604, 918, 4644, 992, 4631, 1036, 4648, 1044, 4689, 993, 4692, 948, 4710, 963, 4735, 959, 4753},
+		{-6935, -5252, -7027, -5293, -7059, -5362, -7111, -5407, -7243, -5372, -7384, -5305, -7466, -5284, -7329, -5396, -7101, -5505, -6996, -5520, -6923, -5550, -6864, -5558, -6815, -5561, -6729, -5530, -6696, -5490, -6863, -5487, -6863, -5264},
+		{-6876, -2037, -6844, -1941, -6897, -1898, -6910, -1826, -6959, -1758, -6986, -1809, -7037, -1835, -7016, -1976, -7009, -2139, -7072, -2571, -7091, -2764, -7149, -2886, -7137, -3010, -7167, -3092, -7144, -3242, -7186, -3391, -7317, -3712, -7359, -3716, -7351, -3828, -7322, -3926, -7368, -3994, -7433, -4322, -7370, -4337, -7339, -4212, -7272, -4238, -7324, -4445, -7435, -4410, -7469, -4576, -7564, -4665, -7413, -4694, -7518, -4771, -7561, -4867, -7548, -5038, -7498, -5104, -7526, -5163, -7495, -5226, -7370, -5284, -7256, -5353, -7143, -5386, -7101, -5383, -7085, -5290, -6946, -5229, -6857, -5230, -6950, -5214, -7191, -5201, -7233, -5143, -7231, -5068, -7298, -5074, -7333, -5038, -7342, -4932, -7265, -4888, -7233, -4824, -7245, -4774, -7192, -4688, -7155, -4556, -7166, -4497, -7122, -4478, -7133, -4441, -7179, -4421, -7146, -4379, -7192, -4341, -7215, -4225, -7175, -4205, -7192, -4083, -7141, -3892, -7081, -3855, -7112, -3758, -7112, -3666, -7036, -3601, -7039, -3517, -6982, -3419, -6981, -3327, -7007, -3309, -7054, -3137, -6992, -3034, -7001, -2937, -6966, -2846, -6900, -2752, -6830, -2690, -6859, -2651, -6839, -2619, -6842, -2452, -6733, -2403, -6699, -2299, -6711, -2274, -6783, -2287, -6822, -2149},
+		{11057, 1926, 11101, 1970, 11079, 2008, 11021, 2010, 10912, 1982, 10863, 1937, 10866, 1851, 10948, 1820, 11034, 1868},
+		{12729, 5074, 12595, 5279, 12507, 5316, 12357, 5346, 12225, 5343, 12100, 5325, 12018, 5275, 12073, 5252, 12074, 5196, 12018, 5164, 11928, 5058, 11929, 5014, 11788, 4951, 11668, 4989, 11549, 4814, 11574, 4773, 11631, 4785, 11730, 4770, 11806, 4807, 11887, 4775, 11977, 4705, 11966, 4669, 11887, 4681, 11742, 4667, 11672, 4639, 11599, 4573, 11446, 4534, 11346, 4481, 11187, 4510, 11135, 4446, 11167, 4407, 11183, 4374, 11113, 4341, 11041, 4287, 10924, 4252, 10774, 4248, 10613, 4213, 10496, 4160, 10452, 4191, 10331, 4191, 10183, 4251, 10085, 4266, 9952, 4252, 9745, 4275, 9635, 4273, 9576, 4332, 9531, 4424, 9469, 4435, 9348, 4498, 9095, 4529, 9059, 4572, 9097, 4689, 9028, 4769, 8885, 4807, 8801, 4860, 8775, 4930, 8736, 4921, 8660, 4855, 8577, 4846, 8572, 4745, 8516, 4700, 8318, 4733, 8246, 4554, 8195, 4532, 7997, 4492, 8087, 4318, 8018, 4292, 8026, 4235, 8012, 4212, 7854, 4158, 7819, 4119, 7690, 4107, 7653, 4043, 7547, 4056, 7478, 4037, 7382, 3989, 7396, 3966, 7368, 3943, 7393, 3851, 7426, 3861, 7486, 3838, 7483, 3799, 7498, 3742, 7516, 3713, 7590, 3667, 7619, 3590, 7784, 3549, 7891, 3432, 7881, 3351, 7921, 3299, 7918, 3248, 7846, 3262, 7874, 3152, 7972, 3088, 8111, 3018, 8153, 3042, 8233, 3012, 8334, 2946, 8390, 2932, 8423, 2884, 8501, 2864, 8582, 2820, 8695, 2797, 8812, 2788, 8873, 2809, 8881, 2730, 8948, 2804, 9002, 2830, 9073, 2806, 9126, 2804, 9170, 2777, 9250, 2790, 9341, 2864, 9457, 2928, 9540, 2903, 9612, 2945, 9659, 2883, 9625, 2841, 9733, 2826, 9791, 2834, 9825, 2775, 9868, 2751, 9867, 2592, 9772, 2508, 9760, 2390, 9866, 2406, 9890, 2314, 9953, 2295, 9924, 2212, 10042, 2156, 10115, 2185, 10127, 2120, 10180, 2117, 10165, 2232, 10271, 2271, 10350, 2270, 10448, 2282, 10533, 2335, 10581, 2298, 10673, 2279, 10657, 2222, 10704, 2181, 10805, 2155, 10852, 2172, 10986, 2140, 10963, 2101, 10989, 2028, 11044, 2034, 11079, 2140, 11184, 2155, 11324, 2205, 11381, 2255, 11415, 2222, 11476, 2267, 11589, 2278, 11866, 2455, 11959, 2574, 12113, 2814, 12168, 2823, 12194, 2902, 12209, 2983, 12150, 3014, 12126, 3068, 12189, 3095, 12191, 3169, 12123, 3246, 12062, 3338, 12023, 3436, 11915, 3491, 11966, 3561, 12064, 3611, 12110, 3665, 12252, 3693, 12236, 3745, 12171, 3748, 12082, 3787, 11970, 3716, 11891, 3745, 11888, 3790, 11806, 3806, 11753, 3874, 11804, 3920, 11902, 3925, 11964, 3990, 12077, 4059, 12164, 4095, 12217, 4042, 12138, 3975, 12159, 3936, 12105, 3890, 12213, 3917, 12287, 3964, 12427, 3993, 12508, 4057, 12618, 4111, 12687, 4182, 12734, 4150, 12821, 4147, 12805, 4199, 12960, 4242, 12999, 4299, 13064, 4240, 13063, 4290, 13114, 4293, 13129, 4411, 13103, 4497, 13188, 4532, 13310, 4514, 13377, 4612, 13411, 4721, 13450, 4758, 13503, 4848, 13337, 4818, 13251, 4779, 13099, 4779, 13058, 4873, 12940, 4944, 12766, 4976},
+		{-281, 539, -324, 625, -298, 738, -256, 822, -283, 964, -351, 990, -398, 986, -433, 961, -478, 982, -495, 1015, -540, 1037, -605, 1010, -621, 1052, -649, 1041, -667, 1043, -685, 1014, -762, 1015, -790, 1030, -823, 1013, -831, 979, -808, 938, -783, 858, -820, 846, -830, 832, -822, 812, -828, 769, -844, 769, -849, 740, -839, 691, -860, 647, -831, 619, -799, 613, -757, 571, -754, 531, -764, 519, -771, 436, -752, 434, -583, 499, -465, 517, -401, 518, -331, 498, -286, 499},
+		{1434, 223, 1594, 173, 1601, 227, 1586, 301, 1541, 334, 1504, 385, 1495, 421, 1448, 473, 1456, 503, 1446, 545, 1454, 623, 1478, 641, 1544, 769, 1498, 880, 1454, 897, 1395, 955, 1417, 1002, 1463, 992, 1491, 999, 1547, 998, 1492, 1089, 1496, 1156, 1489, 1222, 1450, 1286, 1421, 1280, 1418, 1248, 1458, 1209, 1442, 1157, 1357, 1080, 1317, 964, 1296, 942, 1275, 872, 1222, 831, 1206, 780, 1184, 740, 1175, 698, 1106, 664, 1050, 706, 1012, 704, 952, 645, 923, 644, 876, 548, 850, 477, 849, 450, 874, 435, 895, 390, 940, 373, 980, 307, 965, 228, 1128, 226, 1175, 233, 1236, 219, 1295, 232},
+		{2995, 417, 2972, 460, 2916, 439, 2870, 446, 2843, 429, 2798, 441, 2737, 523, 2704, 513, 2565, 526, 2528, 517, 2513, 493, 2481, 490, 2441, 511, 2330, 461, 2284, 471, 2270, 463, 2241, 403, 2093, 432, 2029, 469, 1947, 503, 1893, 471, 1854, 420, 1839, 290, 1809, 237, 1790, 174, 1777, 86, 1783, 29, 1766, -6, 1764, -42, 1752, -74, 1687, -123, 1641, -174, 1597, -271, 1601, -354, 1458, -497, 1421, -479, 1414, -451, 1360, -450, 1326, -488, 1300, -478, 1263, -499, 1247, -525, 1244, -568, 1218, -579, 1232, -610, 1338, -586, 1633, -588, 1686, -722, 1747, -807, 1813, -799, 1846, -785, 1902, -799, 1942, -716, 2004, -712, 2009, -694, 2060, -694, 2051, -730, 2173, -729, 2175, -792, 2195, -831, 2180, -891, 2188, -952, 2221, -989, 2216, -1108, 2346, -1087, 2426, -1095, 2431, -1126, 2478, -1124, 2542, -1133, 2575, -1178, 2655, -1192, 2716, -1161, 2739, -1213, 2816, -1227, 2893, -1325, 2970, -1326, 2962, -1218, 2934, -1236, 2837, -1179, 2867, -961, 2845, -916, 2873, -853, 2900, -841, 3035, -824, 3074, -834, 3020, -708, 2962, -652, 2942, -594, 2952, -542, 2934, -450, 2928, -329, 2902, -284, 2912, -229, 2925, -222, 2929, -162, 2958, -134, 2959, -59, 2982, -21, 2988, 60, 3009, 106, 3047, 158, 3085, 185, 3117, 220, 3077, 234, 3083, 351},
+		{1326, -488, 1360, -450, 1414, -451, 1421, -479, 1458, -497, 1601, -354, 1597, -271, 1641, -174, 1687, -123, 1752, -74, 1764, -42, 1766, -6, 1783, 29, 1777, 86, 1790, 174, 1809, 237, 1839, 290, 1845, 350, 1781, 356, 1713, 373, 1654, 320, 1601, 227, 1594, 173, 1434, 223, 1308, 227, 1300, 183, 1328, 131, 1403, 140, 1428, 120, 1384, 4, 1432, -55, 1443, -133, 1430, -200, 1399, -247, 1311, -243, 1258, -195, 1250, -239, 1182, -251, 1148, -277, 1186, -343, 1109, -398, 1191, -504, 1232, -461, 1262, -444, 1300, -478},
+		{-7511, -6, -7444, -53, -7412, -100, -7366, -126, -7307, -231, -7233, -243, -7177, -217, -7141, -234, -7081, -226, -7005, -273, -7069, -374, -7039, -377, -6989, -430, -6942, -112, -6958, -55, -7002, -19, -7002, 54, -6945, 71, -6925, 60, -6922, 99, -6980, 109, -6982, 171, -6787, 169, -6754, 204, -6726, 172, -6707, 113, -6688, 125, -6718, 225, -6745, 260, -6781, 282, -6730, 332, -6734, 354, -6762, 384, -6782, 450, -6774, 522, -6752, 556, -6734, 610, -6770, 627, -6827, 615, -6899, 621, -6939, 610, -7009, 696, -7067, 709, -7196, 699, -7220, 734, -7244, 742, -7248, 763, -7236, 800, -7244, 841, -7266, 863, -7279, 909, -7330, 915, -7303, 974, -7291, 1045, -7261, 1082, -7223, 1111, -7197, 1161, -7133, 1178, -7114, 1211, -7140, 1238, -7175, 1244, -7224, 1196, -7341, 1123, -7420, 1131, -7428, 1110, -7491, 1108, -7548, 1062, -7567, 944, -7609, 934, -7684, 864, -7735, 867, -7747, 852, -7724, 794, -7743, 764, -7775, 771, -7788, 722, -7748, 669, -7732, 585, -7753, 558, -7731, 467, -7750, 409, -7713, 385, -7793, 270, -7843, 263, -7866, 227, -7862, 177, -7899, 169, -7886, 138, -7786, 81, -7767, 83, -7742, 40, -7658, 26, -7629, 42, -7537, -15},
+		{-8291, 842, -8283, 863, -8287, 881, -8272, 893, -8293, 907, -8293, 948, -8255, 957, -8340, 1040, -8366, 1094, -8390, 1073, -8419, 1079, -8436, 1100, -8467, 1108, -8490, 1095, -8556, 1122, -8594, 1090, -8566, 1075, -8579, 1044, -8580, 1013, -8566, 993, -8534, 983, -8511, 956, -8491, 980, -8498, 1009, -8471, 991, -8465, 962, -8391, 929, -8363, 905, -8360, 883, -8371, 866, -8351, 845, -8297, 823},
+		{-8251, 2308, -8327, 2298, -8378, 2279, -8423, 2257, -8445, 2220, -8497, 2190, -8455, 2180, -8405, 2191, -8391, 2215, -8349, 2217, -8278, 2269, -8180, 2264, -8217, 2239, -8182, 2219, -8052, 2204, -8022, 2183, -7928, 2156, -7872, 2160, -7848, 2103, -7814, 2074, -7749, 2067, -7709, 2041, -7776, 1986, -7632, 1995, -7563, 1987, -7496, 1992, -7430, 2005, -7418, 2028, -7493, 2069, -7567, 2074, -7560, 2102, -7619, 2122, -7652, 2121, -7835, 2251, -7928, 2240, -7968, 2277, -8062, 2311, -8227, 2319},
+		{3292, 3509, 3319, 3517, 3338, 3516, 3348, 3500, 3397, 3506, 3390, 3525, 3458, 3567, 3367, 3537, 3295, 3539, 3273, 3514},
+		{3387, 3509, 3348, 3500, 3338, 3516, 3292, 3509, 3226, 3510, 3249, 3470, 3298, 3457, 3400, 3498},
+		{1710, 4882, 1755, 4880, 1789, 4890, 1791, 4900, 1810, 4904, 1817, 4927, 1840, 4932, 1855, 4950, 1885, 4950, 1839, 4999, 1765, 5005, 1755, 5036, 1687, 5047, 1672, 5022, 1618, 5042, 1624, 5070, 1549, 5078, 1502, 5111, 1457, 5100, 1431, 5112, 1406, 5093, 1334, 5073, 1297, 5048, 1224, 5027, 1242, 4997, 1252, 4955, 1303, 4931, 1360, 4888, 1434, 4856, 1490, 4896, 1525, 4904, 1603, 4873, 1650, 4879, 1696, 4860},
+		{928, 5483, 853, 5496, 857, 5440, 880, 5402, 812, 5353, 794, 5375, 710, 5369, 691, 5348, 709, 5314, 684, 5223, 659, 5185, 599, 5185, 616, 5080, 604, 5013, 624, 4990, 619, 4946, 666, 4920, 810, 4902, 759, 4833, 747, 4762, 832, 4761, 852, 4783, 959, 4753, 990, 4758, 1040, 4730, 1054, 4757, 1143, 4752, 1214, 4770, 1262, 4767, 1293, 4747, 1303, 4764, 1288, 4829, 1324, 4842, 1360, 4888, 1303, 4931, 1252, 4955, 1242, 4997, 1224, 5027, 1297, 5048, 1334, 5073, 1406, 5093, 1431, 5112, 1457, 5100, 1502, 5111, 1461, 5175, 1469, 5209, 1444, 5262, 1407, 5298, 1435, 5325, 1412, 5376, 1365, 5408, 1252, 5447, 1196, 5420, 1094, 5401, 1095, 5436, 994, 5460, 992, 5498},
+		{4278, 1246, 4235, 1254, 4200, 1210, 4166, 1163, 4176, 1105, 4231, 1103, 4255, 1111, 4278, 1093, 4315, 1146, 4272, 1174, 4329, 1197, 4332, 1239, 4308, 1270},
+		{1237, 5611, 1090, 5578, 1104, 5536, 1209, 5480, 1269, 5561},
+		{1037, 5661, 1025, 5689, 1055, 5722, 1058, 5773, 978, 5745, 942, 5717, 854, 5711, 809, 5654, 812, 5552, 853, 5496, 928, 5483, 992, 5498, 965, 5547, 1037, 5619, 1067, 5608, 1091, 5646},
+		{-7162, 1917, -7170, 1879, -7195, 1862, -7169, 1832, -7166, 1776, -7140, 1760, -7100, 1828, -7067, 1843, -7052, 1818, -7013, 1825, -6995, 1843, -6916, 1842, -6869, 1821, -6832, 1861, -6881, 1898, -6925, 1902, -6922, 1931, -6977, 1929, -6995, 1965, -7021, 1962, -7081, 1988, -7159, 1988, -7171, 1971},
+		{1156, 2410, 1077, 2456, 1030, 2438, 995, 2494, 991, 2537, 932, 2609, 972, 2651, 963, 2714, 976, 2769, 968, 2814, 986, 2896, 981, 2942, 948, 3031, 906, 3210, 844, 3251, 843, 3275, 761, 3334, 752, 3410, 814, 3466, 838, 3548, 822, 3643, 842, 3695, 774, 3689, 733, 3712, 626, 3711, 532, 3672, 482, 3687, 147, 3661, 50, 3630, -13, 3589, -121, 3571, -217, 3517, -179, 3453, -173, 3392, -139, 3286, -112, 3265, -131, 3226, -262, 3209, -307, 3172, -365, 3164, -369, 3090, -486, 3050, -524, 3000, -606, 2973, -706, 2958, -867, 2884, -868, 2740, 182, 2061, 206, 2014, 315, 1969, 316, 1906, 427, 1916, 568, 1960, 857, 2157, 1200, 2347},
+		{-8018, -382, -8047, -406, -8044, -443, -8003, -435, -7962, -445, -7921, -496, -7864, -455, -7845, -387, -7784, -300, -7664, -261, -7554, -156, -7523, -91, -7537, -15, -7629, 42, -7658, 26, -7742, 40, -7767, 83, -7786, 81, -7886, 138, -7954, 98, -8009, 77, -8002, 36, -8040, -28, -8058, -91, -8093, -106, -8076, -197, -8097, -225, -8037, -269, -7999, -222, -7977, -266, -8030, -340},
+		{3427, 3122, 3377, 3097, 3299, 3102, 3219, 3126, 3196, 3093, 3169, 3143, 3098, 3156, 3010, 3147, 2968, 3119, 2891, 3087, 2650, 3159, 2516, 3157, 2480, 3109, 2496, 3066, 2470, 3004, 2500, 2924, 2500, 2200, 3687, 2200, 3553, 2310, 3549, 2375, 3569, 2393, 3480, 2503, 3410, 2614, 3335, 2770, 3273, 2871, 3232, 2976, 3242, 2985, 3314, 2842, 3392, 2765, 3415, 2782, 3443, 2834, 3464, 2910, 3492, 2950},
+		{4278, 1246, 4308, 1270, 4259, 1300, 4118, 1449, 3981, 1544, 3927, 1592, 3899, 1684, 3841, 1800, 3790, 1743, 3717, 1726, 3685, 1696, 3675, 1629, 3632, 1482, 3643, 1442, 3759, 1421, 3791, 1496, 3851, 1451, 3910, 1474, 3934, 1453, 4003, 1452, 4090, 1412, 4116, 1377, 4160, 1345, 4201, 1287, 4235, 1254},
+		{-867, 4213, -826, 4228, -801, 4179, -742, 4179, -725, 4192, -667, 4188, -639, 4138, -685, 4111, -686, 4033, -703, 4018, -707, 3971, -750, 3963, -710, 3903, -737, 3837, -703, 3808, -717, 3780, -754, 3743, -745, 3710, -652, 3694, -624, 3637, -587, 3603, -538, 3595, -500, 3632, -437, 3668, -215, 3667, -144, 3744, -68, 3764, -47, 3829, 11, 3874, -28, 3931, 11, 4012, 72, 4068, 81, 4101, 209, 4123, 304, 4189, 299, 4247, 183, 4234, 70, 4280, 34, 4258, -150, 4303, -190, 4342, -435, 4340, -541, 4357, -675, 4357, -798, 4375, -939, 4303, -898, 4259, -903, 4188},
+		{2516, 5797, 2646, 5748, 2729, 5747, 2772, 5779, 2742, 5872, 2813, 5930, 2798, 5948, 2695, 5945, 2586, 5961, 2460, 5947, 2334, 5919, 2343, 5861, 2406, 5826, 2443, 5838, 2431, 5779},
+		{3759, 1421, 3643, 1442, 3627, 1356, 3586, 1258, 3526, 1208, 3483, 1132, 3473, 1091, 3426, 1063, 3396, 958, 3397, 868, 3383, 838, 3329, 835, 3295, 778, 3357, 771, 3408, 723, 3425, 683, 3471, 659, 3530, 551, 3582, 534, 3582, 478, 3616, 445, 3686, 445, 3812, 360, 3867, 362, 3889, 350, 3956, 342, 3985, 384, 4077, 426, 4117, 392, 4186, 392, 4213, 423, 4277, 425, 4366, 496, 4496, 500, 4779, 800, 4695, 800, 4368, 918, 4330, 954, 4256, 1057, 4278, 1093, 4255, 1111, 4231, 1103, 4176, 1105, 4166, 1163, 4235, 1254, 4201, 1287, 4160, 1345, 4116, 1377, 4090, 1412, 4003, 1452, 3934, 1453, 3910, 1474, 3851, 1451, 3791, 1496},
+		{2902, 6977, 2773, 7016, 2618, 6983, 2569, 6909, 2474, 6865, 2366, 6889, 2236, 6884, 2124, 6937, 2065, 6911, 2354, 6794, 2357, 6640, 2390, 6601, 2529, 6553, 2540, 6511, 2473, 6490, 2244, 6382, 2154, 6319, 2106, 6261, 2154, 6171, 2132, 6072, 2229, 6039, 2287, 5985, 2450, 6006, 2626, 6042, 2807, 6050, 3114, 6236, 3152, 6287, 3004, 6355, 3044, 6420, 2954, 6495, 3022, 6581, 2905, 6694, 2998, 6770, 2845, 6836, 2859, 6906},
+		{17813, -1750, 17767, -1738, 17729, -1772, 17738, -1816, 17793, -1829, 17855, -1815, 17872, -1763, 17837, -1734},
+		{18000, -1656, 18000, -1607, 17941, -1638, 17860, -1664, 17873, -1701},
+		{-17979, -1602, -18000, -1607, -18000, -1656, -17992, -1650},
+		{-6070, -5230, -5985, -5185, -5940, -5220, -5805, -5190, -5775, -5155, -5855, -5110, -5915, -5150, -6000, -5125, -6120, -5185},
+		{-5225, 324, -5166, 416, -5182, 457, -5288, 541, -5396, 576, -5448, 490, -5440, 421, -5401, 362, -5427, 274, -5452, 231, -5409, 211, -5378, 238, -5355, 233, -5342, 205, -5294, 212, -5256, 250},
+		{939, 4301, 875, 4263, 854, 4226, 878, 4158, 923, 4138, 956, 4215},
+		{312, 5078, 266, 5080, 251, 5115, 164, 5095, 134, 5013, -99, 4935, -193, 4978, -162, 4864, -330, 4890, -459, 4868, -449, 4795, -296, 4757, -223, 4706, -119, 4601, -138, 4402, -190, 4342, -150, 4303, 34, 4258, 70, 4280, 183, 4234, 299, 4247, 310, 4308, 456, 4340, 653, 4313, 744, 4369, 755, 4413, 701, 4425, 675, 4503, 710, 4533, 680, 4571, 684, 4599, 650, 4643, 602, 4627, 604, 4673, 677, 4729, 674, 4754, 719, 4745, 747, 4762, 759, 4833, 810, 4902, 666, 4920, 619, 4946, 590, 4944, 567, 4953, 480, 4999, 429, 4991},
+		{1186, -343, 1148, -277, 1182, -251, 1250, -239, 1258, -195, 1311, -243, 1399, -247, 1430, -200, 1443, -133, 1432, -55, 1384, 4, 1428, 120, 1403, 140, 1328, 131, 1300, 183, 1308, 227, 1295, 232, 1236, 219, 1175, 233, 1128, 226, 1129, 106, 949, 101, 905, -46, 883, -78, 880, -111, 941, -214, 1007, -297, 1109, -398},
+		{-673, 5517, -757, 5513, -737, 5460, -757, 5406, -695, 5407, -620, 5387, -566, 5455},
+		{-421, 5855, -501, 5863, -579, 5782, -615, 5679, -564, 5628, -559, 5531, -505, 5578, -472, 5551, -508, 5506, -484, 5479, -363, 5462, -295, 5398, -309, 5340, -458, 5350, -477, 5284, -422, 5230, -527, 5199, -498, 5159, -341, 5143, -431, 5121, -578, 5016, -525, 4996, -454, 5034, -362, 5023, -296, 5070, -249, 5050, -79, 5077, 55, 5077, 145, 5129, 105, 5181, 156, 5210, 168, 5274, 47, 5293, -43, 5446, -111, 5462, -209, 5591, -312, 5597, -222, 5687, -196, 5768, -306, 5769, -407, 5755, -301, 5864},
+		{4262, 4158, 4358, 4109, 4497, 4125, 4522, 4141, 4596, 4112, 4650, 4106, 4664, 4118, 4615, 4172, 4640, 4186, 4578, 4209, 4547, 4250, 4454, 4271, 4393, 4255, 4376, 4274, 4239, 4322, 4008, 4355, 3996, 4343, 4032, 4313, 4088, 4301, 4145, 4265, 4170, 4196, 4155, 4154},
+		{84, 628, 57, 691, 49, 741, 71, 831, 46, 868, 37, 1019, -5, 1071, 2, 1102, -44, 1110, -76, 1094, -120, 1101, -294, 1096, -296, 1040, -256, 822, -298, 738, -324, 625, -281, 539, -286, 499, -196, 471, -106, 500, -51, 534, 106, 593},
+		{-828, 769, -822, 812, -830, 832, -820, 846, -783, 858, -808, 938, -831, 979, -823, 1013, -803, 1021, -834, 1049, -828, 1079, -841, 1091, -862, 1081, -858, 1114, -838, 1139, -879, 1181, -891, 1209, -913, 1231, -933, 1233, -1017, 1184, -1059, 1192, -1087, 1218, -1104, 1221, -1146, 1208, -1151, 1244, -1166, 1239, -1220, 1247, -1228, 1235, -1250, 1233, -1322, 1258, -1370, 1259, -1372, 1225, -1383, 1214, -1374, 1181, -1390, 1168, -1412, 1168, -1438, 1151, -1469, 1153, -1513, 1104, -1484, 1088, -1469, 1066, -1458, 1021, -1407, 989, -1325, 890, -1271, 934, -1243, 984, -1215, 986, -1192, 1005, -1112, 1005, -1062, 927, -1065, 898, -1049, 872, -1051, 835, -976, 854, -934, 793, -940, 753, -921, 731, -893, 731, -872, 771},
+		{-1593, 1313, -1514, 1351, -1471, 1330, -1428, 1328, -1384, 1351, -1405, 1379, -1438, 1363, -1469, 1363, -1508, 1388, -1540, 1386, -1562, 1362, -1671, 1359, -1684, 1315},
+		{-1469, 1153, -1438, 1151, -1412, 1168, -1390, 1168, -1374, 1181, -1383, 1214, -1372, 1225, -1370, 1259, -1555, 1263, -1582, 1252, -1615, 1255, -1668, 1238, -1661, 1217, -1631, 1196, -1631, 1181, -1609, 1152, -1566, 1146, -1513, 1104},
+		{983, 107, 1129, 106, 1128, 226, 965, 228, 931, 116, 949, 101},
+		{2351, 3528, 2474, 3508, 2472, 3492, 2616, 3500, 2629, 3530, 2575, 3518, 2577, 3535, 2503, 3542, 2425, 3537, 2370, 3571},
+		{2612, 4183, 2611, 4133, 2520, 4123, 2449, 4158, 2369, 4131, 2276, 4130, 2260, 4113, 2206, 4115, 2167, 4093, 2102, 4084, 2100, 4058, 2067, 4043, 2062, 4011, 2015, 3962, 2022, 3934, 2112, 3831, 2130, 3764, 2167, 3684, 2249, 3641, 2315, 3642, 2277, 3731, 2341, 3741, 2312, 3792, 2404, 3766, 2403, 3822, 2353, 3851, 2297, 3897, 2335, 3919, 2285, 3966, 2263, 4026, 2281, 4048, 2334, 3996, 2390, 3996, 2441, 4012, 2371, 4069, 2493, 4095, 2606, 4082, 2629, 4094, 2660, 4156},
+		{-4690, 8220, -4452, 8166, -4660, 8199, -4800, 8206, -5039, 8244, -5304, 8189, -5413, 8220, -5721, 8219, -6028, 8203, -6265, 8177, -6223, 8132, -6369, 8121, -6715, 8052, -6802, 8012, -6532, 7976, -6571, 7939, -7316, 7843, -7330, 7804, -7104, 7764, -6676, 7738, -6878, 7732, -7140, 7701, -6966, 7638, -6850, 7606, -6339, 7618, -6127, 7610, -5859, 7552, -5860, 7510, -5732, 7471, -5533, 7296, -5472, 7259, -5583, 7165, -5500, 7141, -5400, 7155, -5139, 7057, -5343, 7084, -5436, 7082, -5475, 7029, -5468, 6961, -5346, 6928, -5256, 6943, -5087, 6993, -5108, 6915, -5148, 6873, -5298, 6836, -5397, 6719, -5330, 6684, -5366, 6610, -5228, 6518, -5214, 6428, -5163, 6363, -4990, 6238, -4923, 6141, -4826, 6086, -4626, 6085, -4479, 6004, -4338, 6010, -4242, 6190, -4282, 6268, -4119, 6348, -4068, 6414, -4067, 6484, -3981, 6546, -3704, 6594, -3635, 6598, -3420, 6668, -3281, 6774, -3178, 6812, -3067, 6813, -2775, 6847, -2235, 7013, -2636, 7023, -2520, 7075, -2554, 7143, -2354, 7047, -2175, 7066, -2213, 7147, -2344, 7208, -2479, 7233, -2428, 7260, -2230, 7218, -2231, 7263, -2357, 7331, -2217, 7331, -2076, 7346, -2043, 7382, -2159, 7422, -1937, 7430, -2067, 7516, -1960, 7525, -1983, 7610, -2168, 7663, -2004, 7694, -1847, 7699, -1967, 7764, -1970, 7875, -1890, 7940, -1773, 8013, -2005, 8018, -1685, 8035, -1629, 8058, -1221, 8129, -1277, 8172, -1577, 8191, -2062, 8152, -2317, 8115, -2207, 8173, -2290, 8209, -2484, 8179, -2786, 8213, -3140, 8202, -3190, 8220, -2269, 8234, -2085, 8273, -2710, 8352, -3509, 8365, -3862, 8355, -3990, 8318, -4341, 8323, -4676, 8263},
+		{-9006, 1388, -8953, 1424, -8959, 1436, -8935, 1442, -8915, 1468, -8923, 1487, -8915, 1507, -8823, 1573, -8852, 1586, -8860, 1571, -8893, 1589, -8923, 1589, -8914, 1781, -9100, 1782, -9100, 1725, -9145, 1725, -9071, 1669, -9060, 1647, -9044, 1641, -9046, 1607, -9175, 1607, -9223, 1525, -9209, 1506, -9220, 1483, -9223, 1454, -9169, 1413, -9123, 1393, -9061, 1391, -9010, 1374},
+		{-6055, 778, -6064, 741, -6030, 704, -6054, 686, -6116, 670, -6114, 623, -6141, 596, -6073, 520, -6021, 524, -5998, 501, -6011, 457, -5977, 442, -5954, 396, -5982, 361, -5997, 276, -5972, 225, -5965, 179, -5903, 132, -5854, 127, -5843, 146, -5766, 168, -5734, 195, -5678, 186, -5654, 190, -5715, 277, -5728, 333, -5760, 333, -5804, 406, -5786, 458, -5791, 481, -5731, 507, -5715, 597, -5808, 681, -5845, 683, -5848, 735, -5910, 800, -5976, 837},
+		{-8701, 1303, -8688, 1325, -8673, 1326, -8676, 1375, -8631, 1377, -8610, 1404, -8580, 1384, -8517, 1435, -8515, 1456, -8505, 1455, -8492, 1479, -8482, 1482, -8465, 1467, -8445, 1462, -8423, 1475, -8398, 1475, -8363, 1488, -8349, 1502, -8315, 1500, -8341, 1527, -8377, 1542, -8437, 1584, -8498, 1600, -8544, 1589, -8600, 1601, -8644, 1578, -8690, 1576, -8790, 1586, -8812, 1569, -8823, 1573, -8915, 1507, -8923, 1487, -8915, 1468, -8935, 1442, -8906, 1434, -8854, 1398, -8850, 1385, -8807, 1396, -8786, 1389, -8772, 1379, -8779, 1338, -8749, 1330, -8732, 1298},
+		{1846, 4576, 1763, 4595, 1656, 4650, 1577, 4624, 1567, 4583, 1532, 4573, 1533, 4545, 1494, 4547, 1460, 4563, 1441, 4547, 1372, 4550, 1366, 4514, 1395, 4480, 1426, 4523, 1490, 4508, 1492, 4474, 1538, 4432, 1517, 4424, 1602, 4351, 1693, 4321, 1751, 4285, 1845, 4248, 1856, 4265, 1767, 4303, 1730, 4345, 1646, 4404, 1575, 4482, 1596, 4523, 1632, 4500, 1653, 4521, 1700, 4523, 1786, 4507, 1855, 4508, 1901, 4486, 1939, 4524, 1907, 4552, 1883, 4591},
+		{-7342, 1964, -7278, 1948, -7279, 1910, -7233, 1867, -7269, 1845, -7437, 1866, -7446, 1834, -7392, 1803, -7345, 1822, -7284, 1815, -7237, 1821, -7171, 1804, -7169, 1832, -7195, 1862, -7170, 1879, -7162, 1917, -7171, 1971, -7319, 1992},
+		{1637, 4684, 1656, 4650, 1763, 4595, 1846, 4576, 1960, 4617, 2022, 4613, 2102, 4632, 2163, 4699, 2210, 4767, 2271, 4788, 2264, 4815, 2209, 4842, 2187, 4832, 2080, 4862, 2047, 4856, 2024, 4833, 1878, 4808, 1870, 4788, 1786, 4776, 1698, 4812, 1690, 4771, 1634, 4771, 1653, 4750, 1620, 4685},
+		{12078, -997, 11990, -936, 11897, -956, 12030, -1026, 12072, -1024},
+		{12509, -939, 12507, -909, 12497, -889, 12398, -929, 12355, -990, 12346, -1024, 12358, -1036, 12444, -1014},
+		{11763, -845, 11708, -846, 11674, -903, 11728, -904, 11913, -871, 11888, -828, 11826, -836, 11790, -810},
+		{12201, -846, 12134, -854, 12072, -824, 11992, -844, 11992, -881, 12125, -893, 12276, -865, 12290, -809},
+		{10849, -642, 10807, -635, 10727, -595, 10605, -590, 10537, -685, 10628, -692, 10645, -735, 10828, -777, 10869, -764, 10943, -774, 11059, -812, 11152, -830, 11346, -835, 11456, -875, 11571, -837, 11448, -778, 11298, -759, 11261, -695, 11076, -647, 11054, -688, 10862, -678},
+		{13473, -574, 13450, -545, 13411, -614, 13421, -690, 13472, -621},
+		{12700, -313, 12599, -318, 12618, -361, 12687, -379, 12725, -346},
+		{12937, -280, 12814, -284, 12790, -339, 12859, -343, 12916, -336, 12999, -345, 13083, -386, 13047, -309},
+		{13399, -78, 13238, -37, 13187, -70, 13052, -94, 13094, -143, 13184, -162, 13223, -221, 13370, -221, 13378, -248, 13307, -246, 13199, -282, 13275, -331, 13276, -375, 13298, -411, 13337, -402, 13366, -354, 13516, -446, 13599, -455, 13793, -539, 13841, -623, 13867, -732, 13804, -760, 13761, -841, 13888, -838, 13913, -810, 14014, -830, 14103, -912, 14100, -260, 13993, -241, 13833, -170, 13744, -170, 13629, -231, 13546, -337, 13442, -277, 13414, -115},
+		{12507, 164, 12408, 92, 12293, 88, 12167, 101, 12089, 131, 12004, 57, 11983, 15, 11932, -135, 11918, -215, 11877, -280, 11908, -349, 11950, -349, 11965, -446, 11937, -538, 11980, -567, 12043, -553, 12031, -293, 12097, -263, 12090, -360, 12162, -419, 12149, -457, 12174, -485, 12272, -446, 12224, -528, 12263, -563, 12316, -534, 12317, -468, 12227, -353, 12245, -319, 12151, -190, 12239, -152, 12282, -93, 12326, -108, 12334, -62, 12148, -96, 12094, -141, 12004, -52, 12018, 24, 12106, 38, 12272, 43, 12369, 24, 12444, 43, 12524, 142},
+		{12859, 154, 12800, 163, 12793, 217, 12760, 181, 12740, 101, 12770, -27, 12810, -90, 12838, -78, 12797, -25, 12812, 36, 12864, 26, 12869, 113},
+		{11805, 229, 11731, 323, 11788, 414, 11702, 431, 11587, 431, 11552, 317, 11513, 282, 11462, 143, 11381, 122, 11286, 150, 11238, 141, 11180, 90, 11116, 98, 11051, 77, 10983, 134, 10966, 201, 10907, 134, 10895, 42, 10909, -46, 10957, -131, 11007, -159, 11022, -293, 11105, -305, 11170, -299, 11207, -348, 11326, -312, 11376, -344, 11447, -350, 11486, -411, 11600, -366, 11615, -401, 11653, -248, 11656, -149, 11752, -80, 11748, 10, 11781, 78, 11900, 90, 11788, 183},
+		{10586, -431, 10611, -306, 10562, -243, 10489, -234, 10454, -178, 10437, -108, 10401, -106, 10344, -71, 10384, 10, 10308, 56, 10250, 140, 10166, 208, 10064, 210, 9969, 317, 9837, 427, 9748, 525, 9529, 548, 9538, 497, 9642, 387, 9718, 331, 9770, 245, 9860, 182, 9926, 18, 10014, -65, 10090, -205, 10140, -280, 10216, -361, 10258, -422, 10387, -504, 10471, -587, 10582, -585},
+		{7687, 3465, 7576, 3450, 7424, 3475, 7375, 3432, 7445, 3276, 7526, 3227, 7441, 3169, 7442, 3098, 7345, 2998, 7282, 2896, 7178, 2791, 7062, 2799, 6951, 2694, 7017, 2649, 7028, 2572, 7084, 2522, 7104, 2436, 6884, 2436, 6818, 2369, 6935, 2284, 6964, 2245, 6916, 2209, 7047, 2088, 7118, 2076, 7263, 2136, 7282, 2042, 7282, 1921, 7353, 1599, 7444, 1462, 7486, 1274, 7540, 1178, 7575, 1131, 7659, 890, 7754, 797, 7794, 825, 7828, 893, 7919, 922, 7889, 955, 7934, 1031, 7986, 1036, 7986, 1206, 8029, 1301, 8003, 1514, 8032, 1590, 8079, 1595, 8219, 1656, 8219, 1702, 8319, 1767, 8394, 1830, 8506, 1948, 8650, 2015, 8703, 2074, 8698, 2150, 8821, 2170, 8889, 2169, 8903, 2206, 8888, 2288, 8853, 2363, 8870, 2423, 8808, 2450, 8831, 2487, 8893, 2524, 8821, 2577, 8856, 2645, 8936, 2601, 8983, 2597, 8992, 2527, 9087, 2513, 9180, 2515, 9238, 2498, 9192, 2413, 9147, 2407, 9116, 2350, 9171, 2299, 9187, 2362, 9215, 2363, 9267, 2204, 9317, 2228, 9306, 2270, 9329, 2304, 9333, 2408, 9411, 2385, 9455, 2468, 9460, 2516, 9516, 2600, 9512, 2657, 9642, 2726, 9713, 2708, 9705, 2770, 9740, 2788, 9733, 2826, 9625, 2841, 9659, 2883, 9612, 2945, 9540, 2903, 9457, 2928, 9341, 2864, 9250, 2790, 9170, 2777, 9210, 2745, 9203, 2684, 9037, 2688, 8974, 2672, 8884, 2710, 8873, 2809, 8812, 2788, 8804, 2745, 8817, 2681, 8806, 2641, 8723, 2640, 8525, 2673, 8468, 2723, 8330, 2736, 8009, 2879, 8048, 2973, 8111, 3018, 7972, 3088, 7874, 3152, 7846, 3262, 7918, 3248, 7921, 3299, 7881, 3351, 7891, 3432, 7784, 3549},
+		{-695, 5407, -757, 5406, -737, 5460, -757, 5513, -969, 5388, -917, 5286, -998, 5182, -856, 5167, -679, 5226, -603, 5315, -620, 5387},
+		{5383, 3697, 5226, 3670, 5084, 3687, 5015, 3737, 4920, 3758, 4888, 3832, 4863, 3827, 4801, 3879, 4836, 3929, 4806, 3958, 4769, 3951, 4651, 3877, 4614, 3874, 4546, 3887, 4495, 3934, 4479, 3971, 4411, 3943, 4442, 3828, 4423, 3797, 4477, 3717, 4542, 3598, 4608, 3568, 4615, 3509, 4565, 3475, 4542, 3397, 4611, 3302, 4733, 3247, 4785, 3171, 4769, 3098, 4800, 3099, 4801, 3045, 4857, 2993, 4894, 3032, 4958, 2999, 5012, 3015, 5085, 2881, 5152, 2787, 5248, 2758, 5349, 2681, 5472, 2648, 5572, 2696, 5649, 2714, 5697, 2697, 5740, 2574, 5853, 2561, 6150, 2508, 6187, 2624, 6332, 2676, 6323, 2722, 6276, 2738, 6273, 2826, 6177, 2870, 6137, 2930, 6087, 2983, 6178, 3074, 6170, 3138, 6094, 3155, 6086, 3218, 6054, 3298, 6096, 3353, 6053, 3368, 6121, 3565, 6112, 3649, 6038, 3653, 5923, 3741, 5844, 3752, 5733, 3803, 5662, 3812, 5618, 3794, 5551, 3796, 5480, 3739, 5392, 3720},
+		{4477, 3717, 4429, 3700, 4394, 3726, 4278, 3739, 4235, 3723, 4184, 3661, 4129, 3636, 4138, 3563, 4101, 3442, 3879, 3338, 3920, 3216, 4040, 3189, 4189, 3119, 4471, 2918, 4657, 2910, 4730, 3006, 4857, 2993, 4801, 3045, 4800, 3099, 4769, 3098, 4785, 3171, 4733, 3247, 4611, 3302, 4542, 3397, 4565, 3475, 4615, 3509, 4608, 3568, 4542, 3598},
+		{-1617, 6653, -1780, 6599, -1906, 6628, -2058, 6573, -2213, 6641, -2365, 6626, -2433, 6561, -2223, 6538, -2218, 6508, -2396, 6489, -2178, 6440, -2276, 6396, -1866, 6350, -1491, 6436, -1361, 6513, -1474, 6581, -1451, 6646},
+		{3570, 3272, 3584, 3287, 3582, 3328, 3555, 3326, 3546, 3309, 3510, 3308, 3496, 3283, 3475, 3207, 3449, 3161, 3456, 3155, 3427, 3122, 3492, 2950, 3542, 3110, 3540, 3149, 3493, 3135, 3497, 3162, 3523, 3175, 3497, 3187, 3518, 3253, 3555, 3239},
+		{1476, 3814, 1374, 3803, 1257, 3813, 1243, 3761, 1383, 3710, 1434, 3700, 1510, 3662, 1531, 3713, 1516, 3744, 1552, 3823},
+		{871, 4090, 816, 4095, 839, 4038, 843, 3917, 881, 3891, 921, 3924, 967, 3918, 981, 4050, 921, 4121},
+		{1215, 4712, 1116, 4694, 1105, 4675, 1044, 4689, 1036, 4648, 992, 4631, 918, 4644, 897, 4604, 849, 4601, 832, 4616, 776, 4582, 727, 4578, 684, 4599, 680, 4571, 710, 4533, 675, 4503, 701, 4425, 755, 4413, 744, 4369, 785, 4377, 843, 4423, 889, 4437, 970, 4404, 1020, 4392, 1051, 4293, 1119, 4236, 1289, 4125, 1363, 4119, 1406, 4079, 1470, 4060, 1500, 4017, 1541, 4005, 1611, 3896, 1589, 3875, 1569, 3821, 1568, 3791, 1610, 3799, 1664, 3884, 1705, 3890, 1717, 3942, 1645, 3980, 1687, 4044, 1774, 4028, 1829, 3981, 1848, 4017, 1838, 4036, 1752, 4088, 1589, 4154, 1617, 4174, 1593, 4196, 1514, 4196, 1403, 4276, 1353, 4359, 1259, 4409, 1226, 4460, 1238, 4489, 1233, 4538, 1314, 4574, 1394, 4559, 1370, 4602, 1381, 4651, 1238, 4677},
+		{-7780, 1852, -7822, 1845, -7834, 1823, -7777, 1786, -7721, 1770, -7690, 1787, -7620, 1789, -7637, 1816, -7690, 1840},
+		{3555, 3178, 3540, 3149, 3542, 3110, 3492, 2950, 3496, 2936, 3607, 2920, 3650, 2951, 3674, 2987, 3750, 3000, 3767, 3034, 3800, 3051, 3700, 3151, 3900, 3201, 3920, 3216, 3879, 3338, 3683, 3231, 3572, 3271, 3555, 3239},
+		{13390, 3436, 13349, 3394, 13292, 3406, 13237, 3346, 13236, 3299, 13301, 3270, 13328, 3329, 13379, 3352, 13420, 3320, 13477, 3381, 13464, 3415},
+		{14096, 3817, 14188, 3918, 14191, 3999, 14137, 4138, 14031, 4120, 13988, 4056, 14005, 3944, 13943, 3822, 13739, 3683, 13672, 3730, 13568, 3553, 13461, 3573, 13262, 3543, 13188, 3475, 13088, 3423, 13035, 3360, 12941, 3330, 12981, 3261, 13045, 3232, 13020, 3142, 13069, 3103, 13133, 3145, 13200, 3315, 13099, 3389, 13216, 3390, 13334, 3438, 13508, 3460, 13512, 3385, 13579, 3346, 13722, 3461, 13898, 3467, 14025, 3514, 14077, 3584, 14060, 3634, 14098, 3714},
+		{14314, 4451, 14197, 4555, 14167, 4477, 14138, 4339, 14031, 4333, 13982, 4256, 13996, 4157, 14107, 4158, 14161, 4268, 14318, 4200, 14406, 4299, 14554, 4326, 14532, 4438, 14461, 4396},
+		{7119, 4270, 7184, 4285, 7349, 4250, 7365, 4309, 7421, 4330, 7564, 4288, 7600, 4299, 7914, 4286, 7964, 4250, 8026, 4235, 8018, 4292, 8087, 4318, 7997, 4492, 8195, 4532, 8246, 4554, 8318, 4733, 8516, 4700, 8572, 4745, 8577, 4846, 8660, 4855, 8736, 4921, 8683, 4983, 8554, 4969, 8512, 5012, 8442, 5031, 8394, 5089, 8338, 5107, 8195, 5081, 8057, 5139, 8004, 5086, 7780, 5340, 7653, 5418, 7689, 5449, 7438, 5355, 7343, 5349, 7351, 5404, 7222, 5438, 7118, 5413, 7087, 5517, 6907, 5539, 6817, 5497, 6567, 5460, 6518, 5435, 6144, 5401, 6098, 5366, 6170, 5298, 6074, 5272, 6093, 5245, 5997, 5196, 6159, 5127, 6134, 5080, 5993, 5084, 5964, 5055, 5836, 5106, 5678, 5104, 5572, 5062, 5233, 5172, 5077, 5169, 4870, 5061, 4858, 4987, 4755, 5045, 4675, 4936, 4704, 4915, 4647, 4839, 4732, 4772, 4806, 4774, 4869, 4708, 4859, 4656, 4910, 4640, 5003, 4661, 5119, 4705, 5204, 4680, 5304, 4685, 5322, 4623, 5304, 4526, 5217, 4541, 5132, 4525, 5128, 4451, 5031, 4461, 5034, 4428, 5089, 4403, 5134, 4313, 5250, 4279, 5269, 4244, 5245, 4203, 5250, 4178, 5294, 4212, 5408, 4232, 5476, 4204, 5546, 4126, 5597, 4131, 5593, 4500, 5850, 4559, 6106, 4441, 6201, 4350, 6319, 4365, 6490, 4373, 6610, 4300, 6602, 4199, 6651, 4199, 6671, 4117, 6799, 4114, 6826, 4066, 6863, 4067, 6907, 4138, 7039, 4208, 7096, 4227},
+		{4098, 278, 4186, 392, 4117, 392, 4077, 426, 3985, 384, 3956, 342, 3889, 350, 3867, 362, 3812, 360, 3686, 445, 3616, 445, 3582, 478, 3582, 534, 3530, 551, 3401, 425, 3448, 356, 3504, 191, 3467, 118, 3389, 11, 3390, -95, 3770, -310, 3777, -368, 3920, -468, 3960, -435, 3980, -368, 4012, -328, 4026, -257, 4064, -250, 4088, -208, 4159, -168, 4099, -86},
+		{7126, 4217, 7042, 4152, 7116, 4114, 7187, 4139, 7306, 4087, 7177, 4015, 7101, 4024, 7065, 3994, 6956, 4010, 6946, 3953, 7055, 3960, 7178, 3928, 7368, 3943, 7396, 3966, 7382, 3989, 7478, 4037, 7547, 4056, 7653, 4043, 7690, 4107, 7819, 4119, 7854, 4158, 8012, 4212, 8026, 4235, 7964, 4250, 7914, 4286, 7600, 4299, 7564, 4288, 7421, 4330, 7365, 4309, 7349, 4250, 7184, 4285, 7119, 4270, 7096, 4227},
+		{10433, 1049, 10520, 1089, 10625, 1096, 10581, 1157, 10749, 1234, 10761, 1354, 10738, 1420, 10650, 1457, 10604, 1388, 10522, 1427, 10428, 1442, 10299, 1423, 10235, 1339, 10258, 1219, 10309, 1115, 10350, 1063},
+		{12821, 3837, 12707, 3826, 12668, 3780, 12624, 3784, 12617, 3775, 12686, 3689, 12612, 3673, 12656, 3568, 12637, 3493, 12649, 3439, 12739, 3448, 12819, 3489, 12909, 3508, 12947, 3563, 12946, 3678, 12921, 3743, 12835, 3861},
+		{2135, 4221, 2158, 4225, 2154, 4232, 2178, 4268, 2163, 4268, 2081, 4327, 2064, 4322, 2050, 4288, 2026, 4281, 2007, 4259, 2028, 4232, 2052, 4222, 2059, 4186, 2072, 4185, 2076, 4205},
+		{4730, 3006, 4657, 2910, 4746, 2900, 4771, 2853, 4842, 2855, 4809, 2931, 4818, 2953, 4797, 2998},
+		{10604, 1388, 10650, 1457, 10738, 1420, 10756, 1520, 10731, 1591, 10656, 1660, 10509, 1867, 10390, 1927, 10418, 1962, 10482, 1989, 10444, 2076, 10320, 2077, 10275, 2168, 10217, 2246, 10165, 2232, 10180, 2117, 10127, 2120, 10118, 2144, 10033, 2079, 10012, 2042, 10055, 2011, 10061, 1951, 10128, 1946, 10104, 1841, 10106, 1751, 10211, 1811, 10241, 1793, 10300, 1796, 10320, 1831, 10396, 1824, 10472, 1743, 10478, 1644, 10559, 1557, 10554, 1472, 10522, 1427},
+		{3607, 3382, 3661, 3420, 3645, 3459, 3600, 3464, 3548, 3391, 3513, 3309, 3546, 3309, 3555, 3326, 3582, 3328},
+		{-764, 519, -754, 531, -757, 571, -799, 613, -831, 619, -860, 647, -839, 691, -849, 740, -844, 769, -872, 771, -893, 731, -921, 731, -940, 753, -934, 793, -976, 854, -1023, 841, -1115, 740, -1120, 711, -1144, 679, -1077, 614, -991, 559, -900, 483, -797, 436, -771, 436},
+		{1586, 2341, 2384, 1958, 2385, 2000, 2500, 2000, 2500, 2924, 2470, 3004, 2496, 3066, 2480, 3109, 2516, 3157, 2492, 3190, 2393, 3202, 2361, 3219, 2324, 3219, 2290, 3264, 2154, 3284, 2085, 3271, 2013, 3224, 1982, 3175, 2005, 3099, 1957, 3053, 1909, 3027, 1802, 3076, 1571, 3138, 1525, 3227, 1392, 3271, 1308, 3288, 1266, 3279, 1149, 3314, 1143, 3237, 1094, 3208, 1064, 3176, 995, 3138, 1006, 3096, 997, 3054, 948, 3031, 981, 2942, 986, 2896, 968, 2814, 976, 2769, 963, 2714, 972, 2651, 932, 2609, 991, 2537, 995, 2494, 1030, 2438, 1077, 2456, 1156, 2410, 1200, 2347, 1358, 2304, 1414, 2249},
+		{8130, 856, 8084, 927, 8015, 982, 7970, 820, 7987, 676, 8035, 597, 8122, 620, 8164, 648, 8179, 752},
+		{2854, -2865, 2807, -2885, 2753, -2924, 2700, -2988, 2775, -3065, 2811, -3055, 2829, -3023, 2885, -3007, 2933, -2926},
+		{2324, 5422, 2348, 5391, 2445, 5391, 2554, 5428, 2577, 5485, 2659, 5517, 2649, 5562, 2553, 5610, 2500, 5616, 2486, 5637, 2388, 5627, 2220, 5634, 2106, 5603, 2127, 5519, 2232, 5502, 2276, 5486, 2265, 5458, 2273, 5433},
+		{578, 5009, 567, 4953, 590, 4944, 619, 4946, 624, 4990, 604, 5013},
+		{2220, 5634, 2388, 5627, 2486, 5637, 2500, 5616, 2553, 5610, 2649, 5562, 2818, 5617, 2786, 5676, 2777, 5724, 2729, 5747, 2646, 5748, 2516, 5797, 2431, 5779, 2412, 5703, 2332, 5701, 2252, 5775, 2158, 5741, 2109, 5678, 2106, 5603},
+		{-593, 3576, -691, 3411, -866, 3324, -930, 3256, -943, 3204, -981, 3118, -956, 2993, -1040, 2910, -1090, 2883, -1169, 2815, -1262, 2804, -1314, 2764, -1377, 2662, -1444, 2625, -1480, 2564, -1482, 2510, -1509, 2452, -1543, 2436, -1598, 2372, -1633, 2302, -1626, 2268, -1659, 2216, -1697, 2189, -1702, 2142, -1475, 2150, -1463, 2186, -1422, 2231, -1389, 2369, -1250, 2477, -1203, 2603, -1172, 2610, -1139, 2688, -1055, 2699, -1019, 2686, -974, 2686, -941, 2709, -879, 2712, -882, 2766, -867, 2766, -867, 2884, -706, 2958, -606, 2973, -524, 3000, -486, 3050, -369, 3090, -365, 3164, -307, 3172, -262, 3209, -131, 3226, -112, 3265, -139, 3286, -173, 3392, -179, 3453, -217, 3517, -260, 3518, -364, 3540, -459, 3533, -519, 3576},
+		{2692, 4812, 2813, 4681, 2816, 4637, 2805, 4594, 2823, 4549, 2849, 4560, 2893, 4626, 2886, 4644, 2907, 4652, 2917, 4638, 2976, 4635, 3002, 4642, 2984, 4653, 2991, 4667, 2956, 4693, 2942, 4735, 2905, 4751, 2912, 4785, 2867, 4812, 2826, 4816, 2752, 4847, 2686, 4837, 2662, 4822},
+		{4919, -1204, 4886, -1249, 4885, -1309, 4829, -1378, 4787, -1366, 4801, -1409, 4771, -1459, 4688, -1521, 4631, -1578, 4587, -1579, 4494, -1618, 4445, -1622, 4431, -1685, 4396, -1741, 4404, -1833, 4446, -1944, 4437, -2007, 4390, -2083, 4389, -2116, 4343, -2134, 4325, -2206, 4335, -2278, 4370, -2357, 4376, -2446, 4404, -2499, 4541, -2560, 4628, -2518, 4710, -2494, 4755, -2378, 4793, -2239, 4944, -1795, 4950, -1711, 4977, -1688, 4986, -1645, 4967, -1571, 4986, -1541, 5020, -1600, 5038, -1571, 5048, -1523, 5022, -1476, 5006, -1356, 4981, -1290},
+		{-9753, 2584, -9902, 2637, -9930, 2684, -9952, 2754, -10011, 2811, -10096, 2938, -10166, 2978, -10248, 2976, -10311, 2897, -10394, 2927, -10446, 2957, -10504, 3064, -10614, 3140, -10651, 3175, -10824, 3175, -10824, 3134, -11102, 3133, -11482, 3253, -11472, 3272, -11713, 3254, -11672, 3164, -11552, 2956, -11493, 2928, -11416, 2857, -11420, 2812, -11457, 2774, -11498, 2780, -11506, 2772, -11447, 2714, -11385, 2690, -11360, 2664, -11346, 2677, -11230, 2601, -11215, 2547, -11218, 2474, -11095, 2400, -11030, 2343, -11003, 2282, -10985, 2282, -10943, 2319, -10941, 2336, -11017, 2427, -11066, 2430, -11071, 2483, -11128, 2573, -11162, 2666, -11224, 2717, -11246, 2753, -11276, 2778, -11296, 2843, -11314, 2841, -11327, 2875, -11342, 2883, -11467, 3016, -11477, 3091, -11494, 3139, -11478, 3180, -11421, 3152, -11387, 3157, -11315, 3117, -11316, 3079, -11281, 3002, -11227, 2927, -11223, 2895, -11118, 2794, -11064, 2786, -11039, 2716, -10980, 2668, -10929, 2644, -10944, 2582, -10926, 2558, -10840, 2517, -10792, 2455, -10691, 2377, -10603, 2277, -10569, 2227, -10560, 2187, -10527, 2142, -10527, 2108, -10550, 2082, -10540, 2053, -10573, 2043, -10549, 1995, -10499, 1932, -10392, 1875, -10350, 1829, -10248, 1798, -10192, 1792, -10167, 1765, -10083, 1717, -9970, 1671, -9895, 1657, -9801, 1611, -9656, 1565, -9605, 1575, -9525, 1613, -9469, 1620, -9388, 1594, -9336, 1562, -9223, 1454, -9220, 1483, -9209, 1506, -9223, 1525, -9175, 1607, -9046, 1607, -9044, 1641, -9060, 1647, -9071, 1669, -9145, 1725, -9100, 1725, -9100, 1782, -8914, 1781, -8915, 1796, -8903, 1800, -8885, 1788, -8849, 1849, -8809, 1852, -8784, 1826, -8744, 1947, -8762, 1965, -8738, 2026, -8685, 2085, -8681, 2133, -8705, 2154, -8766, 2146, -8854, 2149, -8960, 2126, -9028, 2100, -9045, 2071, -9053, 1987, -9077, 1928, -9141, 1888, -9279, 1852, -9355, 1842, -9443, 1814, -9484, 1856, -9590, 1883, -9629, 1932, -9653, 1989, -9719, 2064, -9739, 2141, -9770, 2190, -9787, 2244, -9778, 2293, -9770, 2427, -9753, 2499, -9714, 2587},
+		{2046, 4152, 2061, 4109, 2102, 4084, 2167, 4093, 2206, 4115, 2260, 4113, 2276, 4130, 2295, 4134, 2288, 4200, 2238, 4232, 2192, 4230, 2076, 4205, 2072, 4185, 2059, 4186},
+		{-1212, 1399, -1193, 1342, -1155, 1314, -1146, 1208, -1104, 1221, -1087, 1218, -1059, 1192, -1017, 1184, -933, 1233, -913, 1231, -891, 1209, -879, 1181, -838, 1139, -858, 1114, -862, 1081, -841, 1091, -828, 1079, -834, 1049, -803, 1021, -790, 1030, -762, 1015, -685, 1014, -667, 1043, -649, 1041, -621, 1052, -605, 1010, -540, 1037, -547, 1095, -520, 1138, -522, 1171, -443, 1254, -428, 1323, -401, 1347, -352, 1334, -310, 1354, -297, 1380, -219, 1425, -200, 1456, -107, 1497, -52, 1512, -27, 1492, 102, 1497, 139, 1532, 275, 1541, 364, 1557, 372, 1618, 427, 1685, 427, 1916, 316, 1906, 315, 1969, 206, 2014, 182, 2061, -492, 2497, -645, 2496, -549, 1633, -532, 1620, -554, 1550, -955, 1549, -970, 1526, -1009, 1533, -1065, 1513, -1135, 1541, -1167, 1539, -1183, 1480, -1217, 1462},
+		{10012, 2042, 10033, 2079, 10118, 2144, 10115, 2185, 10042, 2156, 9924, 2212, 9953, 2295, 9890, 2314, 9866, 2406, 9760, 2390, 9772, 2508, 9867, 2592, 9868, 2751, 9825, 2775, 9791, 2834, 9733, 2826, 9740, 2788, 9705, 2770, 9713, 2708, 9642, 2726, 9512, 2657, 9516, 2600, 9460, 2516, 9455, 2468, 9411, 2385, 9333, 2408, 9329, 2304, 9306, 2270, 9317, 2228, 9267, 2204, 9265, 2132, 9230, 2148, 9237, 2067, 9308, 1986, 9366, 1973, 9354, 1937, 9432, 1821, 9453, 1728, 9419, 1604, 9481, 1580, 9537, 1571, 9716, 1693, 9760, 1610, 9778, 1484, 9810, 1364, 9851, 1312, 9843, 1203, 9876, 1144, 9846, 1068, 9855, 993, 9904, 1096, 9959, 1189, 9920, 1280, 9921, 1327, 9910, 1383, 9843, 1462, 9819, 1512, 9854, 1531, 9890, 1618, 9849, 1684, 9786, 1757, 9738, 1845, 9780, 1863, 9825, 1971, 9896, 1975, 9954, 2019},
+		{2007, 4259, 2034, 4290, 1963, 4321, 1922, 4352, 1871, 4320, 1845, 4248, 1888, 4228, 1916, 4196, 1937, 4188, 1930, 4220, 1974, 4269, 1980, 4250},
+		{8801, 4860, 8885, 4807, 9028, 4769, 9097, 4689, 9059, 4572, 9095, 4529, 9348, 4498, 9469, 4435, 9531, 4424, 9576, 4332, 9635, 4273, 9745, 4275, 9952, 4252, 10085, 4266, 10183, 4251, 10331, 4191, 10452, 4191, 10496, 4160, 10613, 4213, 10774, 4248, 10924, 4252, 11041, 4287, 11113, 4341, 11183, 4374, 11167, 4407, 11135, 4446, 11187, 4510, 11346, 4481, 11446, 4534, 11599, 4573, 11672, 4639, 11742, 4667, 11887, 4681, 11966, 4669, 11977, 4705, 11887, 4775, 11806, 4807, 11730, 4770, 11631, 4785, 11574, 4773, 11549, 4814, 11668, 4989, 11549, 4981, 11496, 5014, 11436, 5025, 11290, 4954, 11158, 4938, 11066, 4913, 10940, 4929, 10848, 4928, 10787, 4979, 10689, 5027, 10589, 5041, 10368, 5009, 10226, 5051, 10207, 5126, 9998, 5163, 9886, 5205, 9783, 5101, 9823, 5042, 9726, 4973, 9581, 4998, 9482, 5001, 9415, 5048, 9310, 5050, 9223, 5080, 9071, 5033, 8881, 4947, 8775, 4930},
+		{3428, -1228, 3456, -1358, 3491, -1357, 3527, -1389, 3569, -1461, 3577, -1590, 3534, -1611, 3503, -1680, 3438, -1618, 3431, -1548, 3452, -1501, 3446, -1461, 3406, -1436, 3379, -1445, 3321, -1397, 3018, -1480, 3034, -1588, 3117, -1586, 3164, -1607, 3185, -1632, 3233, -1639, 3285, -1671, 3285, -1798, 3265, -1867, 3261, -1942, 3277, -1972, 3266, -2030, 3251, -2040, 3224, -2112, 3119, -2225, 3193, -2437, 3175, -2548, 3207, -2673, 3283, -2674, 3292, -2622, 3266, -2615, 3257, -2573, 3301, -2536, 3504, -2448, 3546, -2412, 3561, -2371, 3537, -2354, 3553, -2307, 3556, -2209, 3539, -2214, 3537, -2184, 3518, -2125, 3470, -2050, 3479, -1978, 3520, -1955, 3590, -1884, 3628, -1866, 3741, -1759, 3945, -1672, 4009, -1610, 4078, -1469, 4060, -1420, 4056, -1264, 4044, -1176, 4048, -1077, 4032, -1032, 3952, -1090, 3843, -1129, 3783, -1127, 3747, -1157, 3678, -1159, 3651, -1172, 3531, -1144, 3456, -1152},
+		{-1183, 1480, -1167, 1539, -1135, 1541, -1065, 1513, -1009, 1533, -970, 1526, -955, 1549, -554, 1550, -532, 1620, -549, 1633, -645, 2496, -492, 2497, -868, 2740, -869, 2588, -1197, 2593, -1194, 2337, -1287, 2328, -1312, 2277, -1293, 2133, -1685, 2133, -1706, 2100, -1654, 2057, -1628, 2009, -1638, 1959, -1615, 1811, -1627, 1717, -1655, 1667, -1646, 1614, -1612, 1646, -1562, 1637, -1514, 1659, -1458, 1660, -1410, 1630, -1344, 1604, -1217, 1462},
+		{3428, -1016, 3374, -942, 3276, -923, 3323, -968, 3349, -1053, 3332, -1080, 3311, -1161, 3331, -1244, 3299, -1278, 3269, -1371, 3321, -1397, 3379, -1445, 3406, -1436, 3446, -1461, 3452, -1501, 3431, -1548, 3438, -1618, 3503, -1680, 3534, -1611, 3577, -1590, 3569, -1461, 3527, -1389, 3491, -1357, 3456, -1358, 3428, -1228, 3456, -1152},
+		{10026, 664, 10009, 646, 10031, 604, 10020, 531, 10056, 477, 10070, 394, 10127, 327, 10139, 276, 10352, 123, 10423, 129, 10425, 163, 10385, 252, 10350, 279, 10333, 373, 10344, 418, 10338, 486, 10296, 552, 10237, 613, 10214, 622, 10181, 581, 10115, 569, 10108, 620},
+		{11844, 497, 11911, 502, 11918, 541, 11769, 599, 11764, 642, 11713, 693, 11673, 692, 11622, 614, 11545, 545, 11535, 432, 11487, 435, 11466, 401, 11420, 453, 11300, 310, 11180, 289, 11137, 270, 11117, 185, 11040, 166, 10966, 201, 10983, 134, 11051, 77, 11116, 98, 11180, 90, 11238, 141, 11286, 150, 11381, 122, 11462, 143, 11513, 282, 11552, 317, 11587, 431, 11702, 431, 11788, 414, 11862, 448},
+		{1682, -2808, 1722, -2836, 1739, -2878, 1846, -2905, 1900, -2897, 1989, -2846, 1990, -2185, 2088, -2181, 2091, -1825, 2166, -1822, 2320, -1787, 2358, -1828, 2422, -1789, 2452, -1789, 2508, -1766, 2508, -1758, 2468, -1735, 2403, -1730, 2138, -1793, 1896, -1779, 1826, -1731, 1421, -1735, 1406, -1742, 1346, -1697, 1281, -1694, 1173, -1730, 1179, -1807, 1261, -1905, 1335, -2087, 1387, -2170, 1426, -2211, 1439, -2266, 1441, -2385, 1521, -2709, 1560, -2782, 1634, -2858},
+		{16546, -2080, 16446, -2012, 16403, -2011, 16417, -2044, 16483, -2115, 16547, -2168, 16674, -2240, 16712, -2216},
+		{249, 1223, 285, 1224, 361, 1166, 368, 1255, 397, 1296, 411, 1353, 437, 1375, 544, 1387, 645, 1349, 682, 1312, 733, 1310, 780, 1334, 901, 1283, 952, 1285, 1011, 1328, 1070, 1325, 1099, 1339, 1153, 1333, 1230, 1304, 1308, 1360, 1332, 1356, 1400, 1246, 1418, 1248, 1421, 1280, 1450, 1286, 1460, 1333, 1395, 1335, 1396, 1400, 1354, 1437, 1397, 1568, 1525, 1663, 1530, 1793, 1569, 1996, 1590, 2039, 1549, 2073, 1547, 2105, 1510, 2131, 1485, 2286, 1414, 2249, 1358, 2304, 1200, 2347, 857, 2157, 568, 1960, 427, 1916, 427, 1685, 372, 1618, 364, 1557, 275, 1541, 139, 1532, 102, 1497, 37, 1493, 30, 1444, 43, 1399, 99, 1334, 102, 1285, 218, 1263, 215, 1194},
+		{876, 548, 923, 644, 952, 645, 1012, 704, 1050, 706, 1106, 664, 1175, 698, 1184, 740, 1206, 780, 1222, 831, 1275, 872, 1296, 942, 1317, 964, 1357, 1080, 1442, 1157, 1458, 1209, 1418, 1248, 1400, 1246, 1332, 1356, 1308, 1360, 1230, 1304, 1153, 1333, 1099, 1339, 1070, 1325, 1011, 1328, 952, 1285, 901, 1283, 780, 1334, 733, 1310, 682, 1312, 645, 1349, 544, 1387, 437, 1375, 411, 1353, 397, 1296, 368, 1255, 357, 1133, 380, 1073, 360, 1033, 371, 1006, 291, 914, 272, 851, 269, 626, 433, 627, 503, 561, 536, 489, 590, 426, 670, 424, 708, 446, 746, 441, 850, 477},
+		{-8556, 1122, -8490, 1095, -8467, 1108, -8436, 1100, -8419, 1079, -8390, 1073, -8366, 1094, -8381, 1110, -8386, 1137, -8365, 1163, -8372, 1189, -8363, 1232, -8347, 1242, -8355, 1313, -8352, 1357, -8341, 1397, -8318, 1431, -8328, 1468, -8315, 1500, -8349, 1502, -8363, 1488, -8398, 1475, -8423, 1475, -8445, 1462, -8465, 1467, -8482, 1482, -8492, 1479, -8505, 1455, -8515, 1456, -8517, 1435, -8580, 1384, -8610, 1404, -8631, 1377, -8676, 1375, -8673, 1326, -8688, 1325, -8701, 1303, -8739, 1291, -8756, 1306, -8767, 1291, -8675, 1214, -8653, 1181, -8571, 1109},
+		{471, 5309, 383, 5162, 331, 5135, 405, 5127, 497, 5148, 561, 5104, 616, 5080, 599, 5185, 659, 5185, 684, 5223, 709, 5314, 691, 5348, 607, 5351},
+		{2637, 7099, 2455, 7103, 2302, 7020, 2138, 7026, 1918, 6982, 1476, 6781, 1053, 6449, 855, 6345, 591, 6261, 499, 6197, 531, 5966, 567, 5859, 705, 5808, 838, 5831, 1036, 5947, 1103, 5886, 1147, 5943, 1230, 6012, 1263, 6129, 1199, 6180, 1193, 6313, 1258, 6407, 1357, 6405, 1392, 6445, 1356, 6479, 1511, 6619, 1677, 6801, 1773, 6801, 1799, 6857, 1988, 6841, 2003, 6907, 2065, 6911, 2124, 6937, 2236, 6884, 2366, 6889, 2474, 6865, 2569, 6909, 2618, 6983, 2773, 7016, 2902, 6977, 2859, 6906, 2940, 6916, 3110, 6956, 3001, 7019, 3129, 7045, 2817, 7119},
+		{2328, 7808, 2288, 7845, 2081, 7825, 2142, 7794, 2073, 7768, 2249, 7744, 2472, 7785},
+		{1699, 8005, 1552, 8002, 1514, 7967, 1372, 7966, 1317, 8001, 1044, 7965, 1122, 7887, 1317, 7802, 1467, 7774, 1376, 7738, 1591, 7677, 1712, 7681, 1759, 7764, 1847, 7783, 1903, 7856, 2154, 7896},
+		{2292, 8066, 2191, 8036, 2046, 8060, 1737, 8032, 1846, 7986, 1990, 7984, 2008, 7957, 2302, 7940, 2592, 7952, 2741, 8006, 2545, 8041},
+		{8695, 2797, 8582, 2820, 8501, 2864, 8423, 2884, 8390, 2932, 8334, 2946, 8233, 3012, 8153, 3042, 8048, 2973, 8009, 2879, 8330, 2736, 8468, 2723, 8525, 2673, 8723, 2640, 8806, 2641, 8817, 2681, 8804, 2745, 8812, 2788},
+		{17280, -4049, 17210, -4096, 17195, -4151, 17157, -4177, 17113, -4251, 17052, -4303, 16895, -4394, 16830, -4412, 16705, -4511, 16651, -4585, 16668, -4622, 16776, -4629, 16841, -4662, 16933, -4664, 17062, -4591, 17119, -4490, 17145, -4424, 17231, -4387, 17308, -4385, 17271, -4337, 17322, -4297, 17425, -4177, 17425, -4135, 17396, -4093, 17325, -4133},
+		{17433, -3527, 17355, -3501, 17301, -3445, 17264, -3453, 17305, -3524, 17384, -3612, 17432, -3653, 17429, -3671, 17470, -3738, 17474, -3803, 17457, -3880, 17385, -3915, 17382, -3951, 17490, -3991, 17523, -4046, 17465, -4128, 17507, -4143, 17524, -4169, 17601, -4129, 17703, -3988, 17694, -3945, 17721, -3915, 17797, -3917, 17827, -3858, 17852, -3770, 17801, -3758, 17744, -3796, 17676, -3788, 17596, -3756, 17581, -3680, 17536, -3653, 17534, -3721, 17461, -3616},
+		{5928, 2143, 5981, 2231, 5981, 2253, 5945, 2266, 5873, 2357, 5740, 2388, 5685, 2424, 5640, 2492, 5589, 2492, 5580, 2427, 5598, 2413, 5553, 2393, 5553, 2352, 5523, 2311, 5521, 2271, 5567, 2200, 5500, 2000, 5200, 1900, 5311, 1665, 5357, 1671, 5424, 1704, 5479, 1695, 5527, 1723, 5527, 1763, 5566, 1788, 5628, 1788, 5651, 1809, 5661, 1857, 5723, 1895, 5769, 1894, 5779, 1907, 5767, 1974, 5783, 2024, 5803, 2048, 5849, 2043, 5886, 2111},
+		{5649, 2631, 5636, 2640, 5607, 2606, 5626, 2571, 5639, 2590},
+		{7458, 3702, 7407, 3684, 7292, 3672, 7185, 3651, 7126, 3607, 7150, 3565, 7161, 3515, 7112, 3473, 7116, 3435, 7088, 3399, 6993, 3402, 7032, 3336, 6969, 3311, 6926, 3250, 6932, 3190, 6893, 3162, 6856, 3171, 6779, 3158, 6768, 3130, 6694, 3130, 6638, 3074, 6635, 2989, 6505, 2947, 6435, 2956, 6415, 2934, 6355, 2947, 6255, 2932, 6087, 2983, 6137, 2930, 6177, 2870, 6273, 2826, 6276, 2738, 6323, 2722, 6332, 2676, 6187, 2624, 6150, 2508, 6291, 2522, 6453, 2524, 6637, 2543, 6715, 2466, 6744, 2394, 6818, 2369, 6884, 2436, 7104, 2436, 7084, 2522, 7028, 2572, 7017, 2649, 6951, 2694, 7062, 2799, 7178, 2791, 7282, 2896, 7345, 2998, 7442, 3098, 7441, 3169, 7526, 3227, 7445, 3276, 7375, 3432, 7424, 3475, 7576, 3450, 7687, 3465, 7784, 3549, 7619, 3590, 7590, 3667, 7516, 3713},
+		{-7775, 771, -7743, 764, -7724, 794, -7747, 852, -7735, 867, -7806, 925, -7850, 942, -7906, 945, -7902, 955, -7957, 961, -7991, 931, -8052, 911, -8095, 886, -8144, 879, -8171, 903, -8181, 895, -8221, 900, -8219, 921, -8255, 957, -8293, 948, -8293, 907, -8272, 893, -8287, 881, -8283, 863, -8297, 823, -8285, 807, -8282, 829, -8239, 829, -8172, 811, -8152, 771, -8119, 765, -8106, 782, -8089, 722, -8042, 727, -8000, 755, -8048, 809, -8038, 830, -8016, 833, -7976, 858, -7956, 893, -7912, 900, -7862, 872, -7844, 839, -7818, 832, -7843, 805, -7821, 751, -7788, 722},
+		{-6896, -1650, -6939, -1566, -6916, -1532, -6934, -1495, -6895, -1445, -6888, -1290, -6867, -1256, -6953, -1095, -7009, -1112, -7055, -1101, -7048, -949, -7130, -1008, -7218, -1005, -7256, -952, -7323, -946, -7302, -903, -7357, -842, -7399, -752, -7372, -734, -7372, -692, -7312, -663, -7322, -609, -7296, -574, -7289, -527, -7175, -459, -7093, -440, -7079, -425, -6989, -430, -7039, -377, -7069, -374, -7005, -273, -7081, -226, -7141, -234, -7177, -217, -7233, -243, -7307, -231, -7366, -126, -7412, -100, -7444, -53, -7511, -6, -7537, -15, -7523, -91, -7554, -156, -7664, -261, -7784, -300, -7845, -387, -7864, -455, -7921, -496, -7962, -445, -8003, -435, -8044, -443, -8047, -406, -8018, -382, -8030, -340, -8110, -404, -8141, -474, -8093, -569, -8125, -614, -8054, -654, -7976, -719, -7945, -793, -7904, -839, -7809, -1038, -7711, -1222, -7626, -1354, -7642, -1382, -7601, -1465, -7524, -1527, -7344, -1636, -7146, -1736, -7138, -1777, -7037, -1835, -6986, -1809},
+		{12631, 878, 12622, 929, 12541, 976, 12547, 899, 12476, 896, 12460, 851, 12384, 824, 12349, 869, 12294, 832, 12231, 803, 12192, 719, 12209, 690, 12283, 746, 12330, 742, 12361, 783, 12424, 736, 12394, 689, 12422, 616, 12540, 558, 12568, 605, 12536, 679, 12583, 729, 12620, 627, 12654, 719},
+		{12408, 1123, 12334, 1027, 12350, 1094, 12295, 1088, 12284, 1026, 12238, 971, 12300, 902, 12331, 932, 12362, 995, 12398, 1028},
+		{11903, 1000, 11969, 1055, 11951, 1137, 11899, 1038, 11839, 968, 11766, 907, 11717, 837, 11850, 932},
+		{12204, 1142, 12197, 1091, 12200, 1044, 12264, 1074, 12310, 1117, 12312, 1158, 12248, 1158, 12188, 1189},
+		{12523, 1254, 12427, 1256, 12488, 1179, 12489, 1142, 12430, 1150, 12446, 1089, 12476, 1084, 12480, 1013, 12528, 1036, 12503, 1098, 12501, 1131, 12578, 1105, 12550, 1216},
+		{12118, 1343, 12032, 1347, 12126, 1221, 12153, 1307},
+		{12072, 1851, 12039, 1760, 12029, 1603, 11988, 1636, 11992, 1541, 12007, 1497, 12056, 1440, 12069, 1476, 12099, 1453, 12068, 1427, 12063, 1386, 12113, 1364, 12203, 1378, 12267, 1319, 12293, 1355, 12330, 1303, 12408, 1254, 12418, 1300, 12386, 1324, 12395, 1378, 12270, 1434, 12226, 1422, 12173, 1433, 12151, 1512, 12166, 1593, 12225, 1626, 12252, 1709, 12217, 1781, 12234, 1822, 12225, 1848, 12194, 1822, 12132, 1850},
+		{15602, -654, 15555, -620, 15506, -557, 15476, -534, 15465, -504, 15451, -514, 15473, -590, 15517, -654, 15560, -692, 15588, -682},
+		{15232, -487, 15234, -431, 15214, -415, 15154, -417, 15165, -476, 15109, -511, 15081, -546, 15024, -553, 15014, -500, 15000, -503, 14985, -551, 14930, -558, 14840, -544, 14832, -575, 14971, -632, 15024, -632, 15130, -584, 15146, -556, 15198, -548},
+		{14697, -672, 14789, -661, 14765, -608, 14598, -547, 14583, -488, 14458, -386, 14274, -329, 14100, -260, 14103, -912, 14207, -916, 14263, -933, 14341, -898, 14329, -825, 14390, -792, 14474, -763, 14605, -807, 14657, -894, 14791, -1013, 14978, -1039, 15003, -1065, 15069, -1058, 15080, -1029, 14974, -987, 15004, -968, 14927, -951, 14931, -907, 14873, -910, 14808, -804, 14719, -739},
+		{15302, -398, 15224, -324, 15148, -278, 15094, -250, 15066, -274, 15138, -304, 15241, -379, 15264, -418, 15283, -477, 15314, -450},
+		{1549, 5078, 1624, 5070, 1618, 5042, 1672, 5022, 1687, 5047, 1755, 5036, 1765, 5005, 1839, 4999, 1891, 4944, 1932, 4957, 1983, 4922, 2042, 4943, 2089, 4933, 2161, 4947, 2278, 4903, 2252, 4948, 2343, 5031, 2392, 5042, 2403, 5071, 2353, 5158, 2351, 5202, 2320, 5249, 2380, 5269, 2380, 5309, 2353, 5347, 2348, 5391, 2324, 5422, 2273, 5433, 2089, 5431, 1870, 5444, 1862, 5468, 1762, 5485, 1636, 5451, 1480, 5405, 1412, 5376, 1435, 5325, 1407, 5298, 1444, 5262, 1469, 5209, 1461, 5175, 1502, 5111},
+		{-6710, 1852, -6724, 1837, -6718, 1795, -6585, 1798, -6559, 1823, -6577, 1843, -6628, 1851},
+		{12999, 4299, 12960, 4242, 12805, 4199, 12821, 4147, 12734, 4150, 12687, 4182, 12618, 4111, 12508, 4057, 12427, 3993, 12474, 3966, 12532, 3955, 12539, 3939, 12513, 3885, 12522, 3867, 12499, 3855, 12471, 3811, 12524, 3786, 12528, 3767, 12557, 3775, 12569, 3794, 12617, 3775, 12624, 3784, 12668, 3780, 12707, 3826, 12821, 3837, 12835, 3861, 12778, 3905, 12739, 3921, 12750, 3932, 12753, 3976, 12797, 4003, 12863, 4019, 12919, 4066, 12971, 4088, 12967, 4160, 13040, 4228, 13078, 4222},
+		{-899, 4154, -879, 4118, -877, 4076, -905, 3976, -945, 3939, -953, 3874, -929, 3836, -884, 3827, -875, 3765, -890, 3687, -838, 3698, -786, 3684, -745, 3710, -754, 3743, -717, 3780, -703, 3808, -737, 3837, -710, 3903, -750, 3963, -707, 3971, -703, 4018, -686, 4033, -685, 4111, -639, 4138, -667, 4188, -725, 4192, -742, 4179, -801, 4179, -826, 4228, -867, 4213, -903, 4188},
+		{-6085, -2388, -6003, -2403, -5881, -2477, -5778, -2516, -5763, -2560, -5862, -2712, -5761, -2740, -5649, -2755, -5570, -2739, -5479, -2662, -5429, -2457, -5429, -2402, -5465, -2384, -5503, -2400, -5540, -2396, -5552, -2357, -5561, -2266, -5580, -2236, -5647, -2209, -5688, -2228, -5794, -2209, -5787, -2073, -5817, -2018, -5818, -1987, -5912, -1936, -6004, -1934, -6179, -1963, -6227, -2051, -6229, -2105, -6269, -2225},
+		{3518, 3253, 3497, 3187, 3523, 3175, 3497, 3162, 3493, 3135, 3540, 3149, 3555, 3178, 3555, 3239},
+		{5111, 2456, 5139, 2463, 5161, 2522, 5159, 2580, 5129, 2611, 5101, 2601, 5074, 2548, 5081, 2475},
+		{2210, 4767, 2163, 4699, 2102, 4632, 2022, 4613, 2076, 4573, 2087, 4542, 2148, 4518, 2156, 4477, 2215, 4448, 2246, 4470, 2271, 4458, 2247, 4441, 2294, 4382, 2333, 4390, 2410, 4374, 2557, 4369, 2607, 4394, 2724, 4418, 2797, 4381, 2856, 4371, 2884, 4491, 2914, 4482, 2963, 4504, 2960, 4529, 2915, 4546, 2868, 4530, 2823, 4549, 2805, 4594, 2816, 4637, 2813, 4681, 2692, 4812, 2662, 4822, 2620, 4822, 2595, 4799, 2521, 4789, 2487, 4774, 2440, 4798, 2376, 4799, 2314, 4810},
+		{14324, 5176, 14326, 5274, 14265, 5437, 14221, 5423, 14261, 5376, 14168, 5330, 14159, 5194, 14218, 5095, 14214, 4962, 14190, 4886, 14202, 4778, 14191, 4681, 14209, 4597, 14275, 4674, 14351, 4614, 14353, 4684, 14256, 4786, 14317, 4931, 14465, 4898, 14365, 5075},
+		{2265, 5458, 2276, 5486, 2232, 5502, 2127, 5519, 1989, 5487, 1966, 5443, 2089, 5431, 2273, 5433},
+		{-17493, 6721, -17755, 6820, -18000, 6896, -18000, 6498, -17943, 6540, -17988, 6587, -17869, 6611, -17890, 6574, -17836, 6539, -17722, 6552, -17621, 6536, -17598, 6492, -17465, 6463, -17389, 6428, -17296, 6425, -17256, 6446, -17253, 6544, -17089, 6554, -16990, 6598, -17186, 6691, -17457, 6706, -17434, 6634, -17501, 6658},
+		{18000, 7152, 17873, 7110, 17890, 7078, 18000, 7083},
+		{-17766, 7113, -17758, 7127, -17902, 7156, -18000, 7152, -18000, 7083, -17869, 7089},
+		{14348, 7348, 14206, 7386, 14081, 7377, 13986, 7337, 14209, 7321, 14360, 7321},
+		{14822, 7535, 14636, 7550, 14612, 7517, 14798, 7478, 14958, 7469, 15073, 7508},
+		{14147, 7609, 13883, 7614, 13751, 7595, 13697, 7526, 13896, 7461, 14061, 7485, 14430, 7482, 14509, 7556},
+		{5562, 7154, 5542, 7237, 5848, 7431, 6158, 7526, 6818, 7623, 6885, 7654, 6816, 7694, 6621, 7681, 6450, 7644, 6117, 7625, 5787, 7561, 5563, 7508, 5590, 7463, 5351, 7375, 5443, 7363, 5244, 7277, 5248, 7223, 5146, 7201, 5160, 7147, 5341, 7121, 5368, 7076, 5694, 7063, 5754, 7072},
+		{10471, 7713, 10607, 7737, 10435, 7770, 10199, 7729, 10104, 7686, 10076, 7643, 9892, 7645, 9668, 7592, 9586, 7614, 9323, 7605, 9290, 7577, 9026, 7564, 8832, 7514, 8717, 7512, 8601, 7446, 8682, 7394, 8466, 7381, 8225, 7385, 8051, 7365, 8061, 7258, 8150, 7175, 7965, 7232, 7758, 7227, 7590, 7187, 7636, 7115, 7529, 7134, 7568, 7230, 7516, 7285, 7466, 7283, 7489, 7212, 7310, 7145, 7440, 7063, 7360, 6963, 7384, 6907, 7494, 6899, 7447, 6833, 7505, 6776, 7419, 6728, 7392, 6679, 7282, 6653, 7242, 6617, 7128, 6632, 7324, 6774, 7367, 6841, 7256, 6902, 7279, 7039, 7247, 7109, 7185, 7141, 7280, 7222, 7259, 7278, 6994, 7304, 6920, 7284, 6854, 7193, 6669, 7103, 6672, 7071, 6726, 6993, 6693, 6945, 6814, 6936, 6816, 6914, 6918, 6862, 6851, 6809, 6489, 6923, 6350, 6955, 6055, 6985, 6003, 6952, 6108, 6894, 5994, 6828, 5880, 6888, 5732, 6847, 5544, 6844, 5473, 6810, 5349, 6820, 5447, 6881, 5372, 6886, 4814, 6752, 4789, 6688, 4635, 6667, 4556, 6701, 4556, 6757, 4682, 6769, 4625, 6825, 4345, 6857, 4419, 6795, 4370, 6735, 4453, 6676, 4395, 6607, 4302, 6642, 4209, 6648, 3976, 6550, 4044, 6476, 3959, 6452, 3718, 6514, 3654, 6476, 3714, 6433, 3701, 6385, 3494, 6441, 3481, 6590, 3318, 6663, 3392, 6676, 3838, 6600, 4002, 6627, 4113, 6679, 4106, 6746, 4029, 6793, 3651, 6906, 3378, 6930, 3213, 6991, 3110, 6956, 2940, 6916, 2859, 6906, 2845, 6836, 2998, 6770, 2905, 6694, 3022, 6581, 2954, 6495, 3044, 6420, 3004, 6355, 3152, 6287, 3114, 6236, 2807, 6050, 2912, 6003, 2798, 5948, 2813, 5930, 2742, 5872, 2772, 5779, 2729, 5747, 2777, 5724, 2786, 5676, 2818, 5617, 2923, 5592, 2937, 5567, 2990, 5579, 3087, 5555, 3097, 5508, 3076, 5481, 3138, 5416, 3179, 5397, 3173, 5379, 3241, 5362, 3269, 5335, 3230, 5313, 3150, 5317, 3131, 5307, 3154, 5274, 3179, 5210, 3216, 5206, 3241, 5229, 3272, 5224, 3375, 5234, 3439, 5177, 3414, 5157, 3422, 5126, 3502, 5121, 3538, 5077, 3536, 5058, 3663, 5023, 3739, 5038, 3801, 4992, 3859, 4993, 4007, 4960, 4008, 4931, 3967, 4878, 3990, 4823, 3974, 4790, 3877, 4783, 3826, 4755, 3822, 4710, 3912, 4726, 3915, 4704, 3767, 4664, 3823, 4624, 3740, 4540, 3668, 4524, 3754, 4466, 3868, 4428, 3996, 4343, 4008, 4355, 4239, 4322, 4376, 4274, 4393, 4255, 4454, 4271, 4547, 4250, 4578, 4209, 4669, 4183, 4737, 4122, 4782, 4115, 4799, 4141, 4858, 4181, 4749, 4299, 4759, 4366, 4668, 4461, 4768, 4564, 4865, 4581, 4910, 4640, 4859, 4656, 4869, 4708, 4806, 4774, 4732, 4772, 4647, 4839, 4704, 4915, 4675, 4936, 4755, 5045, 4858, 4987, 4870, 5061, 5077, 5169, 5233, 5172, 5572, 5062, 5678, 5104, 5836, 5106, 5964, 5055, 5993, 5084, 6134, 5080, 6159, 5127, 5997, 5196, 6093, 5245, 6074, 5272, 6170, 5298, 6098, 5366, 6144, 5401, 6518, 5435, 6567, 5460, 6817, 5497, 6907, 5539, 7087, 5517, 7118, 5413, 7222, 5438, 7351, 5404, 7343, 5349, 7438, 5355, 7689, 5449, 7653, 5418, 7780, 5340, 8004, 5086, 8057, 5139, 8195, 5081, 8338, 5107, 8394, 5089, 8442, 5031, 8512, 5012, 8554, 4969, 8683, 4983, 8736, 4921, 8881, 4947, 9071, 5033, 9223, 5080, 9310, 5050, 9415, 5048, 9482, 5001, 9581, 4998, 9726, 4973, 9823, 5042, 9783, 5101, 9886, 5205, 9998, 5163, 10207, 5126, 10226, 5051, 10368, 5009, 10589, 5041, 10689, 5027, 10787, 4979, 10848, 4928, 10940, 4929, 11066, 4913, 11158, 4938, 11290, 4954, 11436, 5025, 11496, 5014, 11549, 4981, 11668, 4989, 11788, 4951, 11929, 5014, 11928, 5058, 12018, 5164, 12074, 5196, 12073, 5252, 12018, 5275, 12100, 5325, 12225, 5343, 12357, 5346, 12507, 5316, 12595, 5279, 12729, 5074, 12766, 4976, 12940, 4944, 13058, 4873, 13099, 4779, 13251, 4779, 13337, 4818, 13503, 4848, 13450, 4758, 13411, 4721, 13377, 4612, 13310, 4514, 13188, 4532, 13103, 4497, 13129, 4411, 13114, 4293, 13063, 4290, 13064, 4240, 13078, 4222, 13094, 4255, 13228, 4328, 13291, 4280, 13354, 4281, 13487, 4340, 13822, 4631, 13855, 4700, 14006, 4845, 14051, 5005, 14060, 5124, 14138, 5224, 14135, 5309, 13990, 5419, 13880, 5425, 13816, 5376, 13719, 5398, 13670, 5460, 13513, 5473, 14220, 5904, 14549, 5934, 14854, 5916, 14978, 5966, 15134, 5950, 15127, 5878, 15504, 5914, 15422, 5976, 15672, 6143, 15930, 6177, 16012, 6054, 16266, 6164, 16326, 6247, 16447, 6255, 16367, 6114, 16187, 6034, 16015, 5931, 15836, 5806, 15681, 5783, 15676, 5736, 15591, 5677, 15543, 5538, 15642, 5170, 15679, 5101, 15823, 5194, 15853, 5296, 16002, 5320, 16037, 5434, 16212, 5486, 16170, 5529, 16213, 5612, 16306, 5616, 16319, 5762, 16205, 5784, 16202, 5824, 16322, 5921, 16354, 5987, 16488, 5973, 16584, 6016, 16629, 5979, 16890, 6057, 17033, 5988, 17070, 6034, 17368, 6165, 17457, 6177, 17736, 6252, 17923, 6230, 17949, 6257, 17937, 6298, 17891, 6325, 17831, 6408, 17741, 6461, 17871, 6453, 18000, 6498, 18000, 6896, 17860, 6940, 17572, 6988, 17364, 6982, 17045, 7010, 17001, 6965, 17082, 6901, 16958, 6869, 16784, 6958, 16594, 6947, 16405, 6967, 16228, 6964, 16094, 6944, 15971, 6972, 15983, 7045, 15900, 7087, 15701, 7103, 15297, 7084, 15035, 7161, 14950, 7220, 14047, 7285, 13915, 7242, 13987, 7149, 13823, 7163, 13750, 7135, 13556, 7166, 13386, 7139, 13225, 7184, 13129, 7079, 12972, 7119, 12846, 7198, 12905, 7240, 12859, 7304, 12698, 7357, 12538, 7356, 12326, 7374, 12320, 7297, 11902, 7312, 11878, 7359, 11557, 7375, 11397, 7359, 11353, 7334, 11302, 7398, 11212, 7379, 10940, 7418, 11015, 7448, 11389, 7533, 11413, 7585, 11333, 7622, 11108, 7671, 10815, 7672, 10724, 7648, 10697, 7697},
+		{10537, 7871, 10284, 7928, 10209, 7935, 10126, 7923, 9944, 7792, 10508, 7831},
+		{5152, 8070, 5004, 8092, 4910, 8075, 4852, 8051, 4832, 8078, 4680, 8077, 4485, 8059, 4707, 8056, 4650, 8025, 4759, 8001, 4875, 8018, 4889, 8034, 5114, 8055},
+		{10019, 7978, 9788, 8075, 9594, 8125, 9378, 8102, 9118, 8034, 9255, 8014, 9331, 7943, 9497, 7904, 9776, 7876, 9994, 7888},
+		{2982, -144, 2958, -134, 2929, -162, 2925, -222, 2912, -229, 2902, -284, 2963, -292, 2994, -235, 3047, -241, 3076, -229, 3082, -170, 3042, -113},
+		{-941, 2709, -974, 2686, -1019, 2686, -1055, 2699, -1139, 2688, -1172, 2610, -1203, 2603, -1250, 2477, -1389, 2369, -1422, 2231, -1463, 2186, -1475, 2150, -1702, 2142, -1706, 2100, -1685, 2133, -1293, 2133, -1312, 2277, -1287, 2328, -1194, 2337, -1197, 2593, -869, 2588, -867, 2766, -882, 2766, -879, 2712},
+		{4322, 1667, 4312, 1709, 4338, 1758, 4379, 1732, 4406, 1741, 4522, 1743, 4540, 1733, 4637, 1723, 4675, 1728, 4700, 1695, 4747, 1712, 4818, 1817, 4912, 1862, 5200, 1900, 5500, 2000, 5567, 2200, 5521, 2271, 5501, 2250, 5200, 2300, 5139, 2463, 5111, 2456, 5081, 2475, 5053, 2533, 5024, 2561, 5011, 2594, 5021, 2628, 5015, 2669, 4947, 2711, 4930, 2746, 4881, 2769, 4842, 2855, 4771, 2853, 4746, 2900, 4471, 2918, 4189, 3119, 4040, 3189, 3920, 3216, 3900, 3201, 3700, 3151, 3800, 3051, 3767, 3034, 3750, 3000, 3674, 2987, 3650, 2951, 3607, 2920, 3496, 2936, 3463, 2806, 3513, 2806, 3625, 2657, 3664, 2583, 3693, 2560, 3721, 2508, 3715, 2486, 3748, 2429, 3802, 2408, 3849, 2369, 3907, 2258, 3902, 2199, 3914, 2129, 3980, 2034, 4025, 2017, 4094, 1949, 4122, 1867, 4175, 1783, 4227, 1747, 4235, 1708, 4265, 1677, 4278, 1635},
+		{3396, 958, 3426, 1063, 3473, 1091, 3483, 1132, 3526, 1208, 3586, 1258, 3627, 1356, 3643, 1442, 3632, 1482, 3675, 1629, 3685, 1696, 3717, 1726, 3790, 1743, 3841, 1800, 3748, 1861, 3711, 1981, 3697, 2084, 3719, 2102, 3687, 2200, 2500, 2200, 2500, 2000, 2385, 2000, 2389, 1561, 2302, 1568, 2257, 1494, 2230, 1433, 2251, 1409, 2218, 1379, 2230, 1337, 2204, 1296, 2194, 1259, 2229, 1265, 2250, 1226, 2251, 1168, 2288, 1138, 2298, 1071, 2355, 1009, 2356, 968, 2339, 927, 2346, 895, 2389, 862, 2454, 892, 2479, 981, 2507, 1027, 2579, 1041, 2648, 955, 2675, 947, 2711, 964, 2783, 960, 2797, 940, 2897, 940, 2900, 960, 2952, 979, 2962, 1008, 3000, 1029, 3084, 971, 3135, 981, 3185, 1053, 3240, 1108, 3231, 1168, 3207, 1197, 3267, 1202, 3274, 1225, 3321, 1218, 3309, 1144, 3321, 1072, 3372, 1033, 3384, 998, 3382, 948, 3396, 946},
+		{3382, 948, 3384, 998, 3372, 1033, 3321, 1072, 3309, 1144, 3321, 1218, 3274, 1225, 3267, 1202, 3207, 1197, 3231, 1168, 3240, 1108, 3185, 1053, 3135, 981, 3084, 971, 3000, 1029, 2962, 1008, 2952, 979, 2900, 960, 2897, 940, 2797, 940, 2783, 960, 2711, 964, 2675, 947, 2648, 955, 2579, 1041, 2507, 1027, 2479, 981, 2454, 892, 2389, 862, 2511, 783, 2512, 750, 2580, 698, 2621, 655, 2647, 595, 2721, 555, 2798, 441, 2843, 429, 2870, 446, 2916, 439, 2972, 460, 2995, 417, 3083, 351, 3125, 378, 3188, 356, 3269, 379, 3339, 379, 3401, 425, 3530, 551, 3471, 659, 3425, 683, 3408, 723, 3357, 771, 3295, 778, 3329, 835, 3383, 838, 3397, 868, 3396, 946},
+		{-1562, 1362, -1540, 1386, -1508, 1388, -1469, 1363, -1438, 1363, -1405, 1379, -1384, 1351, -1428, 1328, -1471, 1330, -1514, 1351, -1593, 1313, -1684, 1315, -1668, 1238, -1615, 1255, -1582, 1252, -1555, 1263, -1322, 1258, -1250, 1233, -1228, 1235, -1220, 1247, -1166, 1239, -1151, 1244, -1147, 1275, -1155, 1314, -1193, 1342, -1212, 1399, -1217, 1462, -1344, 1604, -1410, 1630, -1458, 1660, -1514, 1659, -1562, 1637, -1612, 1646, -1646, 1614, -1670, 1562, -1719, 1492, -1763, 1473, -1713, 1437, -1671, 1359},
+		{16192, -1045, 16132, -1020, 16170, -1082, 16240, -1083, 16212, -1048},
+		{16069, -961, 16036, -940, 15970, -924, 15964, -964, 15985, -979, 16046, -990, 16085, -987},
+		{16128, -912, 16092, -832, 16058, -832, 16079, -892, 16153, -978, 16168, -960},
+		{15964, -802, 15836, -732, 15821, -742, 15913, -811, 15992, -854, 15988, -834},
+		{15714, -702, 15654, -660, 15649, -677, 15690, -718, 15734, -740, 15754, -735},
+		{-1120, 711, -1115, 740, -1023, 841, -1051, 835, -1049, 872, -1065, 898, -1062, 927, -1112, 1005, -1192, 1005, -1215, 986, -1243, 984, -1271, 934, -1325, 890, -1312, 816, -1295, 780, -1243, 726, -1171, 686, -1144, 679},
+		{-8772, 1379, -8807, 1396, -8850, 1385, -8854, 1398, -8906, 1434, -8935, 1442, -8959, 1436, -8953, 1424, -9006, 1388, -9010, 1374, -8981, 1352, -8926, 1346, -8884, 1326, -8848, 1316, -8790, 1315},
+		{4894, 997, 4895, 1141, 4838, 1138, 4802, 1119, 4753, 1113, 4665, 1082, 4556, 1070, 4461, 1044, 4412, 1045, 4367, 1086, 4347, 1128, 4315, 1146, 4256, 1057, 4330, 954, 4368, 918, 4695, 800, 4779, 800, 4894, 945},
+		{4927, 1143, 4894, 1139, 4894, 945, 4779, 800, 4496, 500, 4366, 496, 4277, 425, 4213, 423, 4098, 278, 4099, -86, 4159, -168, 4181, -145, 4204, -92, 4314, 29, 4407, 105, 4556, 205, 4656, 286, 4859, 534, 4945, 680, 5055, 920, 5083, 1028, 5105, 1064, 5111, 1202, 5073, 1202, 5026, 1168},
+		{2076, 4573, 2022, 4613, 1960, 4617, 1883, 4591, 1907, 4552, 1939, 4524, 1901, 4486, 1937, 4486, 1912, 4442, 1960, 4404, 1945, 4357, 1922, 4352, 1963, 4321, 2034, 4290, 2026, 4281, 2050, 4288, 2064, 4322, 2081, 4327, 2163, 4268, 2178, 4268, 2154, 4232, 2158, 4225, 2238, 4232, 2255, 4246, 2244, 4258, 2260, 4290, 2299, 4321, 2250, 4364, 2241, 4401, 2266, 4423, 2247, 4441, 2271, 4458, 2246, 4470, 2215, 4448, 2156, 4477, 2148, 4518, 2087, 4542},
+		{-5731, 507, -5791, 481, -5786, 458, -5804, 406, -5760, 333, -5728, 333, -5715, 277, -5654, 190, -5600, 182, -5591, 202, -5607, 222, -5597, 251, -5557, 242, -5510, 252, -5452, 231, -5427, 273, -5401, 362, -5440, 421, -5448, 490, -5396, 576, -5503, 603, -5584, 595, -5595, 577, -5715, 597},
+		{1855, 4950, 1840, 4932, 1817, 4927, 1810, 4904, 1791, 4900, 1789, 4890, 1755, 4880, 1710, 4882, 1688, 4847, 1698, 4812, 1786, 4776, 1870, 4788, 1878, 4808, 2024, 4833, 2047, 4856, 2080, 4862, 2187, 4832, 2209, 4842, 2228, 4883, 2256, 4909, 2161, 4947, 2089, 4933, 2042, 4943, 1983, 4922, 1932, 4957, 1891, 4944, 1885, 4950},
+		{1370, 4602, 1394, 4559, 1372, 4550, 1441, 4547, 1460, 4563, 1494, 4547, 1533, 4545, 1532, 4573, 1567, 4583, 1577, 4624, 1656, 4650, 1637, 4684, 1620, 4685, 1601, 4668, 1514, 4666, 1463, 4643, 1381, 4651},
+		{2390, 6601, 2357, 6640, 2354, 6794, 2065, 6911, 2003, 6907, 1988, 6841, 1799, 6857, 1773, 6801, 1677, 6801, 1511, 6619, 1356, 6479, 1392, 6445, 1357, 6405, 1258, 6407, 1193, 6313, 1199, 6180, 1263, 6129, 1230, 6012, 1147, 5943, 1103, 5886, 1179, 5744, 1263, 5631, 1294, 5536, 1410, 5541, 1467, 5620, 1588, 5610, 1645, 5704, 1683, 5872, 1787, 5895, 1879, 6008, 1783, 6064, 1712, 6134, 1785, 6275, 2137, 6441, 2121, 6503, 2218, 6572},
+		{3199, -2629, 3184, -2584, 3133, -2566, 3104, -2573, 3095, -2602, 3068, -2640, 3069, -2674, 3128, -2729, 3187, -2718, 3207, -2673},
+		{4101, 3442, 4138, 3563, 4129, 3636, 4184, 3661, 4235, 3723, 4121, 3707, 4067, 3709, 3952, 3672, 3870, 3671, 3817, 3690, 3707, 3662, 3674, 3682, 3669, 3626, 3615, 3582, 3591, 3541, 3600, 3464, 3645, 3459, 3661, 3420, 3607, 3382, 3582, 3328, 3584, 3287, 3570, 3272, 3683, 3231, 3879, 3338},
+		{1489, 1222, 1496, 1156, 1492, 1089, 1547, 998, 1491, 999, 1463, 992, 1417, 1002, 1395, 955, 1454, 897, 1498, 880, 1544, 769, 1528, 742, 1611, 750, 1629, 775, 1646, 773, 1671, 751, 1796, 789, 1891, 863, 1881, 898, 1909, 907, 2006, 901, 2100, 948, 2172, 1057, 2223, 1097, 2286, 1114, 2288, 1138, 2251, 1168, 2250, 1226, 2229, 1265, 2194, 1259, 2204, 1296, 2230, 1337, 2218, 1379, 2251, 1409, 2230, 1433, 2257, 1494, 2302, 1568, 2389, 1561, 2384, 1958, 1586, 2341, 1485, 2286, 1510, 2131, 1547, 2105, 1549, 2073, 1590, 2039, 1569, 1996, 1530, 1793, 1525, 1663, 1397, 1568, 1354, 1437, 1396, 1400, 1395, 1335, 1460, 1333, 1450, 1286},
+		{162, 683, 166, 913, 146, 933, 143, 983, 77, 1047, 90, 1100, 2, 1102, -5, 1071, 37, 1019, 46, 868, 71, 831, 49, 741, 57, 691, 106, 593, 187, 614},
+		{10235, 1339, 10299, 1423, 10428, 1442, 10522, 1427, 10554, 1472, 10559, 1557, 10478, 1644, 10472, 1743, 10396, 1824, 10320, 1831, 10300, 1796, 10241, 1793, 10211, 1811, 10106, 1751, 10104, 1841, 10128, 1946, 10061, 1951, 10055, 2011, 10012, 2042, 9954, 2019, 9896, 1975, 9825, 1971, 9780, 1863, 9738, 1845, 9786, 1757, 9849, 1684, 9890, 1618, 9854, 1531, 9819, 1512, 9843, 1462, 9910, 1383, 9921, 1327, 9920, 1280, 9959, 1189, 9904, 1096, 9855, 993, 9815, 835, 9834, 779, 9850, 838, 9952, 734, 9969, 685, 10009, 646, 10026, 664, 10108, 620, 10115, 569, 10181, 581, 10214, 622, 10162, 674, 10102, 686, 10046, 743, 10028, 830, 9987, 921, 9922, 924, 9915, 996, 10002, 1231, 10010, 1341, 10098, 1341, 10083, 1263, 10169, 1265, 10258, 1219},
+		{7060, 4022, 7046, 4050, 7067, 4096, 6933, 4073, 6901, 4009, 6854, 3953, 6770, 3958, 6744, 3914, 6818, 3890, 6839, 3816, 6783, 3714, 6814, 3702, 6886, 3734, 6920, 3715, 6952, 3761, 7012, 3759, 7027, 3774, 7038, 3814, 7081, 3849, 7135, 3826, 7124, 3795, 7154, 3791, 7145, 3707, 7184, 3674, 7219, 3695, 7264, 3705, 7326, 3750, 7498, 3742, 7483, 3799, 7486, 3838, 7426, 3861, 7393, 3851, 7368, 3943, 7178, 3928, 7055, 3960, 6946, 3953, 6956, 4010, 7065, 3994, 7101, 4024},
+		{6223, 3527, 6298, 3540, 6319, 3586, 6398, 3601, 6455, 3631, 6475, 3711, 6559, 3731, 6575, 3766, 6622, 3739, 6652, 3736, 6655, 3797, 6522, 3840, 6417, 3889, 6237, 4005, 6188, 4108, 6155, 4127, 6047, 4122, 6008, 4143, 5998, 4222, 5863, 4275, 5779, 4217, 5693, 4183, 5710, 4132, 5546, 4126, 5476, 4204, 5408, 4232, 5294, 4212, 5250, 4178, 5281, 4114, 5292, 4187, 5372, 4212, 5401, 4155, 5474, 4095, 5386, 4063, 5292, 4088, 5269, 4003, 5336, 3998, 5310, 3929, 5388, 3895, 5374, 3791, 5392, 3720, 5480, 3739, 5551, 3796, 5618, 3794, 5662, 3812, 5733, 3803, 5844, 3752, 5923, 3741, 6038, 3653, 6112, 3649, 6121, 3565},
+		{12507, -909, 12509, -939, 12697, -867, 12734, -840, 12696, -827, 12664, -840, 12595, -843, 12509, -866, 12497, -889},
+		{-6166, 1037, -6195, 1009, -6177, 1000, -6094, 1011, -6090, 1086, -6111, 1089, -6168, 1076},
+		{997, 3054, 1006, 3096, 995, 3138, 1064, 3176, 1094, 3208, 1143, 3237, 1149, 3314, 1111, 3329, 1086, 3377, 1034, 3379, 1015, 3433, 1081, 3483, 1094, 3570, 1059, 3595, 1060, 3641, 1110, 3690, 1103, 3709, 1018, 3672, 1021, 3723, 951, 3735, 842, 3695, 822, 3643, 838, 3548, 814, 3466, 752, 3410, 761, 3334, 843, 3275, 844, 3251, 906, 3210, 948, 3031},
+		{3517, 4204, 3351, 4202, 3235, 4174, 3115, 4109, 2924, 4122, 2882, 4046, 2728, 4042, 2617, 3946, 2680, 3899, 2632, 3821, 2705, 3765, 2764, 3666, 2873, 3668, 2970, 3614, 3039, 3626, 3062, 3668, 3170, 3664, 3251, 3611, 3403, 3622, 3471, 3680, 3555, 3657, 3616, 3665, 3578, 3627, 3615, 3582, 3669, 3626, 3674, 3682, 3707, 3662, 3817, 3690, 3870, 3671, 3952, 3672, 4067, 3709, 4121, 3707, 4235, 3723, 4278, 3739, 4394, 3726, 4429, 3700, 4477, 3717, 4423, 3797, 4442, 3828, 4411, 3943, 4479, 3971, 4440, 4001, 4366, 4025, 4375, 4074, 4358, 4109, 4262, 4158, 4155, 4154, 4037, 4101, 3951, 4110, 3835, 4095, 3691, 4134},
+		{2762, 4100, 2881, 4105, 2899, 4130, 2812, 4162, 2800, 4201, 2714, 4214, 2612, 4183, 2660, 4156, 2629, 4094, 2606, 4082, 2604, 4062, 2636, 4015},
+		{12195, 2500, 12150, 2530, 12069, 2454, 12011, 2356, 12022, 2281, 12075, 2197, 12118, 2279},
+		{3187, -103, 3077, -101, 3042, -113, 3082, -170, 3076, -229, 3047, -241, 3053, -281, 3074, -303, 3075, -336, 2975, -445, 2934, -450, 2952, -542, 2942, -594, 2962, -652, 3020, -708, 3074, -834, 3116, -859, 3219, -893, 3276, -923, 3374, -942, 3428, -1016, 3456, -1152, 3531, -1144, 3651, -1172, 3678, -1159, 3747, -1157, 3783, -1127, 3843, -1129, 3952, -1090, 4032, -1032, 3995, -1010, 3919, -849, 3925, -801, 3919, -770, 3947, -710, 3944, -684, 3880, -648, 3874, -591, 3920, -468, 3777, -368, 3770, -310, 3390, -95},
+		{3390, -95, 3389, 11, 3467, 118, 3504, 191, 3448, 356, 3401, 425, 3339, 379, 3269, 379, 3188, 356, 3125, 378, 3083, 351, 3077, 234, 3117, 220, 3085, 185, 3047, 158, 3009, 106, 2988, 60, 2982, -21, 2959, -59, 2958, -134, 2982, -144, 3077, -101},
+		{3093, 5204, 3062, 5182, 3056, 5132, 3016, 5142, 2925, 5137, 2899, 5160, 2862, 5143, 2824, 5157, 2745, 5159, 2634, 5183, 2533, 5191, 2455, 5189, 2401, 5162, 2353, 5158, 2403, 5071, 2392, 5042, 2343, 5031, 2252, 4948, 2278, 4903, 2256, 4909, 2228, 4883, 2209, 4842, 2264, 4815, 2271, 4788, 2314, 4810, 2376, 4799, 2440, 4798, 2487, 4774, 2521, 4789, 2595, 4799, 2620, 4822, 2662, 4822, 2686, 4837, 2752, 4847, 2826, 4816, 2867, 4812, 2912, 4785, 2905, 4751, 2942, 4735, 2956, 4693, 2991, 4667, 2984, 4653, 3002, 4642, 2976, 4635, 2917, 4638, 2907, 4652, 2886, 4644, 2893, 4626, 2849, 4560, 2823, 4549, 2868, 4530, 2915, 4546, 2960, 4529, 3038, 4603, 3075, 4658, 3168, 4671, 3174, 4633, 3330, 4608, 3359, 4585, 3263, 4552, 3245, 4533, 3355, 4503, 3333, 4456, 3388, 4436, 3524, 4494, 3633, 4511, 3653, 4547, 3551, 4541, 3502, 4565, 3496, 4627, 3582, 4665, 3676, 4670, 3743, 4702, 3822, 4710, 3826, 4755, 3877, 4783, 3974, 4790, 3990, 4823, 3967, 4878, 4008, 4931, 4007, 4960, 3859, 4993, 3801, 4992, 3739, 5038, 3663, 5023, 3536, 5058, 3538, 5077, 3502, 5121, 3422, 5126, 3414, 5157, 3439, 5177, 3375, 5234, 3272, 5224, 3241, 5229, 3216, 5206},
+		{-5787, -3102, -5814, -3204, -5813, -3304, -5835, -3326, -5843, -3391, -5782, -3446, -5714, -3443, -5622, -3486, -5567, -3475, -5494, -3495, -5381, -3440, -5337, -3377, -5365, -3320, -5321, -3273, -5379, -3205, -5560, -3085, -5597, -3088, -5698, -3011, -5763, -3022},
+		{-15522, 1924, -15481, 1951, -15522, 1999, -15586, 2027, -15592, 2017, -15585, 1998, -15607, 1970, -15591, 1934, -15594, 1906, -15569, 1892, -15554, 1908},
+		{-15600, 2076, -15661, 2101, -15671, 2093, -15641, 2057, -15608, 2064},
+		{-15725, 2122, -15733, 2110, -15679, 2107, -15676, 2118},
+		{-15794, 2165, -15803, 2172, -15829, 2158, -15813, 2131, -15771, 2126, -15765, 2132},
+		{-15937, 2221, -15960, 2224, -15980, 2207, -15946, 2188, -15935, 2198},
+		{-9516, 4938, -9516, 4900, -12284, 4900, -12250, 4818, -12234, 4736, -12259, 4710, -12312, 4804, -12457, 4838, -12469, 4818, -12440, 4772, -12408, 4686, -12390, 4552, -12414, 4371, -12453, 4277, -12421, 4200, -12418, 4114, -12440, 4031, -12387, 3977, -12373, 3895, -12295, 3811, -12251, 3778, -12255, 3755, -12171, 3616, -12074, 3516, -12062, 3461, -12037, 3445, -11944, 3435, -11908, 3408, -11852, 3403, -11841, 3374, -11794, 3362, -11730, 3305, -11713, 3254, -11472, 3272, -11482, 3253, -11102, 3133, -10824, 3134, -10824, 3175, -10651, 3175, -10614, 3140, -10504, 3064, -10446, 2957, -10394, 2927, -10311, 2897, -10248, 2976, -10166, 2978, -10096, 2938, -10011, 2811, -9952, 2754, -9930, 2684, -9902, 2637, -9753, 2584, -9714, 2587, -9733, 2621, -9738, 2669, -9737, 2738, -9714, 2783, -9659, 2831, -9560, 2874, -9469, 2948, -9385, 2971, -9323, 2978, -9250, 2955, -9163, 2968, -9088, 2915, -9015, 2912, -8978, 2931, -8941, 2916, -8922, 2929, -8943, 2949, -8941, 2989, -8959, 3016, -8918, 3032, -8842, 3038, -8753, 3027, -8640, 3040, -8577, 3015, -8529, 2969, -8511, 2964, -8410, 3009, -8371, 2994, -8293, 2910, -8265, 2855, -8286, 2789, -8271, 2750, -8171, 2587, -8133, 2564, -8117, 2520, -8068, 2508, -8038, 2521, -8013, 2582, -8006, 2688, -8053, 2804, -8054, 2847, -8098, 2918, -8131, 3004, -8149, 3073, -8134, 3144, -8086, 3203, -8030, 3251, -7920, 3316, -7906, 3349, -7855, 3386, -7805, 3393, -7740, 3451, -7636, 3481, -7573, 3555, -7597, 3690, -7626, 3697, -7630, 3792, -7699, 3824, -7633, 3808, -7654, 3872, -7635, 3915, -7623, 3832, -7572, 3794, -7603, 3726, -7594, 3722, -7506, 3840, -7507, 3878, -7532, 3896, -7553, 3950, -7520, 3925, -7498, 3920, -7491, 3894, -7418, 3971, -7396, 4043, -7426, 4047, -7395, 4075, -7398, 4063, -7335, 4063, -7194, 4093, -7224, 4112, -7371, 4093, -7288, 4122, -7186, 4132, -7112, 4149, -7064, 4148, -6997, 4164, -6988, 4192, -7019, 4215, -7008, 4178, -7050, 4181, -7083, 4234, -7081, 4287, -7012, 4368, -6803, 4433, -6696, 4481, -6714, 4514, -6779, 4570, -6779, 4707, -6823, 4735, -6891, 4719, -6924, 4745, -7000, 4669, -7031, 4592, -7066, 4546, -7141, 4526, -7151, 4501, -7487, 4500, -7532, 4482, -7650, 4402, -7682, 4363, -7872, 4363, -7917, 4347, -7901, 4327, -7894, 4286, -8025, 4237, -8128, 4221, -8244, 4168, -8269, 4168, -8303, 4183, -8314, 4198, -8312, 4208, -8243, 4298, -8214, 4357, -8255, 4535, -8359, 4582, -8347, 4599, -8362, 4612, -8389, 4612, -8409, 4628, -8414, 4651, -8434, 4641, -8460, 4644, -8454, 4654, -8478, 4664, -8488, 4690, -8838, 4830, -8927, 4802, -8960, 4801, -9083, 4827, -9164, 4814, -9261, 4845, -9433, 4867, -9464, 4884, -9482, 4939},
+		{-15214, 5759, -15256, 5790, -15323, 5797, -15467, 5746, -15452, 5699, -15401, 5673, -15301, 5712},
+		{-16567, 6029, -16647, 6038, -16746, 6021, -16619, 5975, -16558, 5991},
+		{-17179, 6341, -17155, 6332, -17067, 6338, -16953, 6298, -16877, 6319, -16869, 6330, -16968, 6343, -17049, 6369, -17111, 6359, -17173, 6378},
+		{-15658, 7136, -15812, 7082, -15904, 7089, -16093, 7045, -16191, 7033, -16293, 6986, -16317, 6937, -16443, 6892, -16620, 6888, -16676, 6836, -16539, 6804, -16443, 6762, -16372, 6712, -16249, 6674, -16168, 6612, -16379, 6608, -16365, 6658, -16447, 6658, -16671, 6609, -16811, 6567, -16685, 6509, -16643, 6469, -16496, 6445, -16355, 6456, -16276, 6434, -16245, 6456, -16139, 6478, -16078, 6479, -16152, 6440, -16096, 6422, -16077, 6377, -16153, 6346, -16226, 6354, -16307, 6306, -16375, 6322, -16456, 6315, -16492, 6263, -16573, 6207, -16612, 6150, -16535, 6107, -16535, 6051, -16466, 6027, -16382, 5980, -16252, 5999, -16187, 5963, -16205, 5927, -16197, 5867, -16136, 5867, -16036, 5907, -15998, 5857, -15971, 5893, -15906, 5842, -15852, 5879, -15819, 5862, -15704, 5892, -15755, 5833, -15772, 5757, -15846, 5722, -15868, 5702, -16007, 5642, -16056, 5601, -16180, 5589, -16287, 5535, -16385, 5504, -16494, 5457, -16479, 5440, -16307, 5469, -16029, 5564, -15960, 5557, -15843, 5599, -15812, 5646, -15656, 5698, -15631, 5742, -15423, 5815, -15329, 5886, -15402, 5935, -15258, 6006, -15190, 6073, -15062, 6128, -15035, 6103, -15141, 6073, -15186, 5974, -15172, 5916, -15061, 5937, -14973, 5971, -14802, 5998, -14822, 6067, -14711, 6088, -14593, 6046, -14396, 6000, -14257, 6008, -13987, 5954, -13780, 5850, -13663, 5821, -13408, 5812, -13354, 5718, -13225, 5637, -13197, 5550, -13109, 5518, -13054, 5480, -12998, 5528, -13001, 5592, -13171, 5655, -13336, 5841, -13495, 5927, -13548, 5979, -13648, 5946, -13745, 5891, -13904, 6000, -14001, 6028, -14100, 6031, -14099, 6971, -14359, 7015, -14492, 6999, -14569, 7012, -14761, 7021, -14972, 7053, -15074, 7043, -15227, 7060, -15221, 7083, -15390, 7089, -15434, 7070, -15507, 7115},
+		{6708, 3736, 6783, 3714, 6839, 3816, 6818, 3890, 6744, 3914, 6770, 3958, 6854, 3953, 6901, 4009, 6933, 4073, 7067, 4096, 7046, 4050, 7060, 4022, 7101, 4024, 7177, 4015, 7306, 4087, 7187, 4139, 7116, 4114, 7042, 4152, 7126, 4217, 7096, 4227, 7039, 4208, 6907, 4138, 6863, 4067, 6826, 4066, 6799, 4114, 6671, 4117, 6651, 4199, 6602, 4199, 6610, 4300, 6490, 4373, 6319, 4365, 6201, 4350, 6106, 4441, 5850, 4559, 5593, 4500, 5597, 4131, 5710, 4132, 5693, 4183, 5779, 4217, 5863, 4275, 5998, 4222, 6008, 4143, 6047, 4122, 6155, 4127, 6188, 4108, 6237, 4005, 6417, 3889, 6522, 3840, 6655, 3797, 6652, 3736},
+		{-7197, 1161, -7223, 1111, -7261, 1082, -7291, 1045, -7303, 974, -7330, 915, -7279, 909, -7266, 863, -7244, 841, -7236, 800, -7248, 763, -7244, 742, -7220, 734, -7196, 699, -7067, 709, -7009, 696, -6939, 610, -6899, 621, -6827, 615, -6770, 627, -6734, 610, -6752, 556, -6774, 522, -6782, 450, -6762, 384, -6734, 354, -6730, 332, -6781, 282, -6745, 260, -6718, 225, -6688, 125, -6633, 72, -6555, 79, -6535, 110, -6420, 149, -6408, 192, -6337, 220, -6342, 241, -6427, 250, -6441, 313, -6437, 380, -6482, 406, -6463, 415, -6389, 402, -6309, 377, -6280, 401, -6209, 416, -6097, 454, -6060, 492, -6073, 520, -6141, 596, -6114, 623, -6116, 670, -6054, 686, -6030, 704, -6064, 741, -6055, 778, -5976, 837, -6015, 860, -6067, 858, -6083, 938, -6159, 987, -6239, 995, -6273, 1042, -6188, 1072, -6432, 1064, -6433, 1039, -6489, 1008, -6566, 1020, -6623, 1065, -6730, 1055, -6819, 1055, -6823, 1089, -6888, 1144, -6958, 1146, -6994, 1216, -7029, 1185, -7016, 1138, -7140, 1097, -7135, 1021, -7104, 986, -7126, 914, -7170, 907, -7207, 987, -7163, 1045, -7162, 1097, -7195, 1142, -7136, 1154, -7133, 1178},
+		{10704, 2181, 10657, 2222, 10673, 2279, 10581, 2298, 10533, 2335, 10448, 2282, 10350, 2270, 10271, 2271, 10217, 2246, 10275, 2168, 10320, 2077, 10444, 2076, 10482, 1989, 10418, 1962, 10390, 1927, 10509, 1867, 10656, 1660, 10731, 1591, 10756, 1520, 10738, 1420, 10761, 1354, 10749, 1234, 10581, 1157, 10625, 1096, 10520, 1089, 10433, 1049, 10508, 992, 10480, 924, 10516, 860, 10641, 953, 10722, 1036, 10837, 1101, 10920, 1167, 10934, 1343, 10888, 1528, 10827, 1608, 10736, 1670, 10566, 1906, 10588, 1975, 10672, 2070, 10805, 2155},
+		{16722, -1589, 16718, -1616, 16752, -1660, 16784, -1647},
+		{16663, -1463, 16665, -1539, 16679, -1567, 16700, -1561, 16727, -1574, 16711, -1493},
+		{5278, 1735, 5200, 1900, 4912, 1862, 4818, 1817, 4747, 1712, 4700, 1695, 4675, 1728, 4637, 1723, 4540, 1733, 4522, 1743, 4406, 1741, 4379, 1732, 4338, 1758, 4312, 1709, 4322, 1667, 4278, 1635, 4282, 1591, 4270, 1572, 4281, 1526, 4260, 1521, 4289, 1480, 4309, 1406, 4325, 1377, 4322, 1322, 4348, 1264, 4418, 1259, 4449, 1272, 4499, 1270, 4514, 1295, 4541, 1303, 4563, 1329, 4672, 1340, 4735, 1359, 4794, 1401, 4824, 1395, 4868, 1400, 4957, 1471, 5117, 1518, 5217, 1560, 5219, 1594, 5239, 1638, 5311, 1665},
+		{3220, -2875, 3246, -2830, 3258, -2747, 3283, -2674, 3207, -2673, 3187, -2718, 3128, -2729, 3069, -2674, 3068, -2640, 3095, -2602, 3104, -2573, 3133, -2566, 3184, -2584, 3175, -2548, 3193, -2437, 3119, -2225, 3066, -2215, 3032, -2227, 2984, -2210, 2943, -2209, 2802, -2283, 2712, -2357, 2679, -2424, 2649, -2462, 2594, -2470, 2566, -2549, 2503, -2572, 2421, -2567, 2373, -2539, 2331, -2527, 2282, -2550, 2258, -2598, 2161, -2673, 2089, -2683, 2067, -2648, 2076, -2587, 2017, -2492, 1990, -2477, 1989, -2846, 1900, -2897, 1846, -2905, 1739, -2878, 1722, -2836, 1682, -2808, 1634, -2858, 1757, -3073, 1822, -3166, 1825, -3243, 1793, -3261, 1825, -3328, 1824, -3387, 1838, -3414, 1842, -3400, 1886, -3444, 1919, -3446, 1962, -3482, 2007, -3480, 2069, -3442, 2154, -3426, 2257, -3386, 2299, -3392, 2359, -3379, 2468, -3399, 2517, -3380, 2578, -3394, 2591, -3367, 2642, -3361, 2746, -3323, 2822, -3277, 3006, -3114, 3133, -2940},
+		{2933, -2926, 2885, -3007, 2829, -3023, 2811, -3055, 2775, -3065, 2700, -2988, 2753, -2924, 2807, -2885, 2854, -2865},
+		{3219, -893, 3156, -876, 3074, -834, 3035, -824, 2900, -841, 2873, -853, 2845, -916, 2867, -961, 2837, -1179, 2934, -1236, 2962, -1218, 2970, -1326, 2893, -1325, 2816, -1227, 2739, -1213, 2716, -1161, 2655, -1192, 2575, -1178, 2542, -1133, 2478, -1124, 2431, -1126, 2426, -1095, 2391, -1093, 2402, -1124, 2390, -1172, 2408, -1219, 2393, -1257, 2402, -1291, 2193, -1290, 2189, -1608, 2256, -1690, 2322, -1752, 2403, -1730, 2468, -1735, 2526, -1774, 2638, -1785, 2671, -1796, 2704, -1794, 2760, -1729, 2847, -1647, 2883, -1639, 2895, -1604, 2952, -1564, 3027, -1551, 3018, -1480, 3321, -1397, 3269, -1371, 3299, -1278, 3331, -1244, 3311, -1161, 3332, -1080, 3349, -1053, 3323, -968, 3276, -923},
+		{3224, -2112, 3251, -2040, 3266, -2030, 3277, -1972, 3261, -1942, 3265, -1867, 3285, -1798, 3285, -1671, 3233, -1639, 3185, -1632, 3164, -1607, 3117, -1586, 3034, -1588, 3027, -1551, 2952, -1564, 2895, -1604, 2883, -1639, 2847, -1647, 2760, -1729, 2704, -1794, 2671, -1796, 2638, -1785, 2526, -1774, 2565, -1854, 2585, -1871, 2616, -1929, 2730, -2039, 2772, -2050, 2773, -2085, 2802, -2149, 2879, -2164, 2943, -2209, 2984, -2210, 3032, -2227, 3066, -2215, 3119, -2225},
+	}},
+	{Tolerance: 0.1, Polygons: [][]int16{
+		{6080, 3440, 6053, 3368, 6096, 3353, 6054, 3298, 6094, 3155, 6170, 3138, 6178, 3074, 6087, 2983, 6255, 2932, 6355, 2947, 6415, 2934, 6435, 2956, 6505, 2947, 6635, 2989, 6638, 3074, 6694, 3130, 6768, 3130, 6779, 3158, 6856, 3171, 6893, 3162, 6932, 3190, 6926, 3250, 6969, 3311, 7032, 3336, 6993, 3402, 7088, 3399, 7116, 3435, 7112, 3473, 7161, 3515, 7126, 3607, 7185, 3651, 7516, 3713, 7498, 3742, 7326, 3750, 7264, 3705, 7184, 3674, 7145, 3707, 7154, 3791, 7124, 3795, 7135, 3826, 7081, 3849, 7038, 3814, 7012, 3759, 6952, 3761, 6920, 3715, 6886, 3734, 6814, 3702, 6708, 3736, 6622, 3739, 6575, 3766, 6559, 3731, 6475, 3711, 6455, 3631, 6398, 3601, 6319, 3586, 6298, 3540, 6223, 3527, 6121, 3565},
+		{1338, -586, 1232, -610, 1223, -629, 1273, -693, 1324, -856, 1288, -917, 1339, -1037, 1369, -1073, 1363, -1204, 1250, -1355, 1178, -1579, 1164, -1667, 1173, -1730, 1281, -1694, 1346, -1697, 1406, -1742, 1826, -1731, 1896, -1779, 2138, -1793, 2322, -1752, 2189, -1608, 2193, -1290, 2402, -1291, 2393, -1257, 2408, -1219, 2390, -1172, 2402, -1124, 2391, -1093, 2346, -1087, 2216, -1108, 2221, -989, 2188, -952, 2180, -891, 2195, -831, 2175, -792, 2173, -729, 2051, -730, 2060, -694, 2009, -694, 2004, -712, 1942, -716, 1902, -799, 1846, -785, 1747, -807, 1686, -722, 1633, -588},
+		{1247, -525, 1300, -478, 1262, -444, 1191, -504, 1218, -579, 1244, -568},
+		{2052, 4222, 2007, 4259, 1980, 4250, 1974, 4269, 1930, 4220, 1954, 4172, 1932, 4073, 1941, 4025, 2015, 3962, 2062, 4011, 2067, 4043, 2100, 4058, 2102, 4084, 2061, 4109, 2046, 4152},
+		{5162, 2401, 5200, 2300, 5501, 2250, 5553, 2352, 5553, 2393, 5598, 2413, 5580, 2427, 5589, 2492, 5640, 2492, 5626, 2571, 5607, 2606, 5401, 2412, 5258, 2418, 5179, 2402, 5176, 2429, 5158, 2425},
+		{-6505, -5470, -6645, -5445, -6775, -5385, -6863, -5264, -6863, -5487, -6696, -5490, -6645, -5525, -6550, -5520},
+		{-6627, -2183, -6711, -2274, -6699, -2299, -6733, -2403, -6842, -2452, -6839, -2619, -6859, -2651, -6830, -2690, -6900, -2752, -6966, -2846, -7001, -2937, -6992, -3034, -7054, -3137, -7007, -3309, -6981, -3327, -6982, -3419, -7039, -3517, -7036, -3601, -7112, -3666, -7112, -3758, -7081, -3855, -7141, -3892, -7192, -4083, -7175, -4205, -7215, -4225, -7192, -4341, -7146, -4379, -7179, -4421, -7133, -4441, -7122, -4478, -7166, -4497, -7155, -4556, -7192, -4688, -7245, -4774, -7233, -4824, -7265, -4888, -7342, -4932, -7333, -5038, -7298, -5074, -7231, -5068, -7233, -5143, -7191, -5201, -6815, -5235, -6882, -5177, -6914, -5073, -6873, -5026, -6782, -4987, -6717, -4870, -6599, -4813, -6564, -4724, -6660, -4703, -6758, -4630, -6729, -4555, -6651, -4504, -6557, -4504, -6533, -4450, -6518, -4350, -6438, -4287, -6346, -4256, -6376, -4204, -6430, -4236, -6498, -4206, -6512, -4106, -6473, -4080, -6377, -4117, -6275, -4103, -6215, -4068, -6233, -4017, -6213, -3942, -6234, -3883, -6124, -3893, -5923, -3872, -5775, -3818, -5679, -3690, -5674, -3641, -5736, -3598, -5723, -3529, -5850, -3443, -5835, -3326, -5813, -3304, -5814, -3204, -5763, -3022, -5516, -2788, -5365, -2692, -5363, -2612, -5413, -2555, -5463, -2574, -5479, -2662, -5570, -2739, -5649, -2755, -5862, -2712, -5763, -2560, -5778, -2516, -5881, -2477, -6003, -2403, -6085, -2388, -6285, -2203, -6399, -2199, -6438, -2280, -6496, -2208},
+		{4375, 4074, 4366, 4025, 4500, 3974, 4530, 3947, 4574, 3947, 4614, 3874, 4651, 3877, 4648, 3946, 4561, 3990, 4589, 4022, 4536, 4056, 4556, 4081, 4497, 4125, 4358, 4109},
+		{-6061, -7963, -6188, -8039, -6629, -8026, -6574, -8059, -6449, -8092, -6016, -8100, -5957, -8004},
+		{-15948, -7905, -16125, -7838, -16311, -7822, -16371, -7860, -16244, -7928, -16113, -7963, -15921, -7950},
+		{-4666, -7783, -4866, -7805, -5099, -7961, -5185, -7995, -5399, -8022, -5416, -8063, -5285, -8097, -5048, -8103, -4651, -8059, -4333, -8003, -4349, -7909, -4392, -7848, -4515, -7805},
+		{-12241, -7332, -12262, -7366, -12162, -7401, -12023, -7409, -11929, -7383, -11872, -7348, -11992, -7366},
+		{-12656, -7325, -12728, -7346, -12591, -7374, -12403, -7387},
+		{-10043, -7185, -10170, -7172, -10233, -7189, -10180, -7231, -10078, -7250, -9620, -7252, -9679, -7195, -9788, -7207},
+		{-6873, -7051, -6972, -6925, -7025, -6888, -7117, -6904, -7174, -6951, -7178, -7068, -7207, -7119, -7392, -7127, -7501, -7166, -7495, -7207, -7419, -7237, -7190, -7209, -7239, -7248, -7108, -7250, -6878, -7217, -6833, -7141},
+		{-5760, -6386, -5722, -6353, -5781, -6327, -5859, -6339, -5989, -6396, -6141, -6427, -6204, -6458, -6300, -6464, -6418, -6517, -6457, -6560, -6725, -6688, -6774, -6733, -6743, -6815, -6758, -6854, -6845, -6933, -6854, -6972, -6849, -7011, -6725, -7164, -6713, -7205, -6737, -7248, -6796, -7279, -6894, -7301, -7283, -7340, -7489, -7387, -7622, -7397, -7691, -7364, -7793, -7342, -7930, -7352, -8030, -7313, -8147, -7385, -8519, -7348, -8601, -7309, -8727, -7319, -8842, -7301, -8923, -7256, -9009, -7332, -9142, -7340, -9244, -7317, -9634, -7362, -9769, -7356, -9812, -7321, -9914, -7291, -10368, -7262, -10311, -7373, -10255, -7411, -10125, -7419, -10012, -7487, -10065, -7530, -10488, -7495, -10756, -7518, -11007, -7479, -11126, -7442, -11230, -7471, -11394, -7371, -11622, -7424, -11747, -7403, -11970, -7448, -12107, -7452, -12540, -7452, -12824, -7432, -12955, -7446, -13226, -7430, -13375, -7444, -13521, -7430, -13886, -7497, -14164, -7509, -14432, -7554, -14491, -7520, -14620, -7538, -14650, -7573, -14614, -7611, -14610, -7648, -14761, -7658, -15133, -7740, -15292, -7750, -15374, -7707, -15697, -7730, -15837, -7689, -15805, -7803, -15598, -7869, -15533, -7906, -14953, -7936, -14677, -7993, -14642, -8034, -14722, -8067, -15065, -8134, -15210, -8100, -15441, -8116, -15684, -8110, -15529, -8142, -15453, -8177, -15286, -8204, -15267, -8245, -15341, -8324, -15359, -8369, -15090, -8390, -15006, -8430, -14683, -8453, -14289, -8457, -14311, -8504, -14853, -8561, -15094, -8530, -15519, -8510, -15807, -8537, -16193, -8514, -16702, -8457, -16995, -8388, -17289, -8406, -17438, -8453, -17583, -8412, -17726, -8445, -17906, -8414, -18000, -8471, -18000, -9000, 18000, -9000, 18000, -8471, 17599, -8416, 17322, -8441, 17228, -8404, 16940, -8383, 16890, -8334, 16660, -8302, 16371, -8240, 16163, -8169, 16112, -8128, 15979, -8095, 16075, -8020, 16092, -7973, 16177, -7916, 16367, -7912, 16700, -7875, 16660, -7832, 16474, -7818, 16349, -7707, 16357, -7624, 16423, -7546, 16564, -7477, 16609, -7438, 16739, -7417, 16798, -7381, 16929, -7366, 17109, -7209, 17121, -7170, 17050, -7140, 16731, -7083, 16269, -7074, 16157, -7058, 16081, -7023, 15967, -6999, 15918, -6960, 15681, -6938, 15428, -6856, 15364, -6889, 15250, -6887, 14884, -6839, 14665, -6790, 14600, -6760, 14620, -6723, 14549, -6692, 14306, -6680, 13746, -6695, 13662, -6678, 13587, -6603, 13570, -6558, 13507, -6531, 13476, -6621, 13078, -6643, 12880, -6676, 12700, -6656, 12516, -6672, 12322, -6648, 12232, -6656, 12087, -6719, 11983, -6727, 11858, -6717, 11670, -6666, 11560, -6670, 11439, -6607, 11360, -6588, 11286, -6609, 11174, -6613, 11024, -6670, 10808, -6695, 10618, -6693, 10424, -6597, 10283, -6556, 9972, -6725, 9868, -6711, 9578, -6739, 9418, -6711, 8967, -6715, 8883, -6695, 8799, -6621, 8748, -6688, 8675, -6715, 8566, -6709, 8378, -6731, 8278, -6721, 8148, -6754, 8094, -6788, 7911, -6833, 7843, -6870, 7764, -6946, 7386, -6987, 7308, -7072, 7191, -7132, 7102, -7209, 6987, -7226, 6871, -7217, 6795, -7185, 6893, -7107, 6907, -7068, 6795, -7070, 6781, -7031, 6956, -6968, 6967, -6923, 6971, -6897, 6889, -6793, 6691, -6786, 6405, -6741, 6239, -6801, 6143, -6795, 5994, -6741, 5874, -6729, 5726, -6668, 5716, -6625, 5636, -6597, 5453, -6582, 5179, -6625, 5095, -6652, 5075, -6688, 4993, -6711, 4899, -6709, 4744, -6772, 4650, -6760, 4411, -6827, 4196, -6860, 4002, -6911, 3967, -6954, 3865, -6978, 3720, -6917, 3616, -6925, 3530, -6901, 3491, -6866, 3387, -6850, 3275, -6938, 3199, -6966, 2709, -7046, 2367, -7052, 2257, -7070, 2145, -7007, 1926, -6989, 1595, -7003, 1513, -7040, 1473, -7003, 1342, -6997, 1240, -7025, 1195, -7064, 1082, -7083, 953, -7001, 849, -7015, 774, -6989, 714, -7025, 627, -7046, 87, -7130, -23, -7164, -66, -7123, -180, -7117, -434, -7146, -554, -7140, -579, -7103, -687, -7093, -738, -7132, -742, -7170, -861, -7166, -910, -7132, -1030, -7127, -1102, -7154, -1151, -7201, -1229, -7240, -1545, -7315, -1611, -7346, -1647, -7387, -1541, -7411, -1570, -7450, -1752, -7513, -2246, -7611, -2888, -7667, -2978, -7707, -3221, -7765, -3533, -7812, -3578, -7834, -3591, -7908, -3564, -7946, -2969, -7926, -2969, -7963, -2855, -8034, -3824, -8134, -4077, -8136, -4216, -8165, -4281, -8208, -4727, -8171, -4976, -8173, -5362, -8226, -5822, -8322, -5969, -8238, -6326, -8175, -6819, -8132, -7324, -8042, -7536, -8026, -7663, -7989, -7685, -7951, -7802, -7918, -7793, -7838, -7650, -7812, -7477, -7822, -7366, -7791, -7428, -7756, -7540, -7728, -7693, -7710, -7724, -7671, -7060, -7663, -6980, -7622, -6586, -7564, -6435, -7526, -6330, -7458, -6196, -7444, -6138, -7411, -6083, -7370, -6069, -7317, -6138, -7201, -6151, -7109, -6320, -6923, -6478, -6868, -6567, -6795, -6551, -6758, -6375, -6650, -6281, -6643, -6212, -6619, -6259, -6586, -6265, -6548, -6251, -6509, -6202, -6480, -6061, -6431, -5979, -6421, -5905, -6437},
+		{6887, -4883, 6875, -4978, 7028, -4971, 7056, -4926, 7053, -4907, 6958, -4894, 6894, -4863},
+		{14474, -4070, 14472, -4116, 14530, -4203, 14543, -4269, 14605, -4355, 14687, -4363, 14756, -4294, 14791, -4321, 14802, -4241, 14836, -4206, 14829, -4088, 14769, -4081, 14636, -4114},
+		{14360, -1340, 14352, -1283, 14316, -1233, 14312, -1191, 14287, -1178, 14280, -1116, 14252, -1067, 14214, -1104, 14169, -1241, 14184, -1274, 14165, -1294, 14152, -1370, 14170, -1504, 14127, -1639, 14088, -1737, 14022, -1771, 13926, -1737, 13911, -1706, 13830, -1681, 13707, -1587, 13550, -1500, 13543, -1472, 13608, -1372, 13596, -1332, 13631, -1329, 13695, -1235, 13649, -1186, 13626, -1205, 13588, -1196, 13530, -1225, 13468, -1194, 13439, -1204, 13355, -1179, 13302, -1138, 13236, -1113, 13182, -1127, 13256, -1160, 13258, -1211, 13174, -1230, 13122, -1218, 13062, -1254, 13018, -1311, 13034, -1336, 12989, -1362, 12941, -1442, 12962, -1497, 12836, -1487, 12780, -1428, 12707, -1382, 12614, -1410, 12613, -1435, 12569, -1423, 12567, -1451, 12517, -1468, 12438, -1557, 12426, -1633, 12382, -1611, 12350, -1660, 12386, -1707, 12343, -1727, 12301, -1641, 12231, -1725, 12224, -1820, 12086, -1968, 11981, -1998, 11925, -1995, 11884, -2026, 11744, -2075, 11717, -2062, 11671, -2070, 11465, -2183, 11423, -2252, 11415, -2176, 11374, -2248, 11384, -2306, 11339, -2438, 11422, -2579, 11423, -2630, 11394, -2591, 11344, -2562, 11378, -2655, 11334, -2612, 11405, -2733, 11417, -2812, 11462, -2852, 11504, -2946, 11500, -3003, 11516, -3060, 11569, -3161, 11580, -3221, 11571, -3326, 11555, -3349, 11505, -3362, 11503, -3420, 11663, -3503, 11802, -3506, 11901, -3446, 11930, -3451, 11989, -3398, 12130, -3382, 12218, -3400, 12366, -3389, 12403, -3348, 12422, -3296, 12615, -3222, 12710, -3228, 12954, -3159, 13133, -3150, 13229, -3198, 13299, -3201, 13427, -3262, 13409, -3285, 13524, -3395, 13521, -3448, 13599, -3489, 13637, -3409, 13700, -3375, 13781, -3290, 13789, -3364, 13750, -3413, 13735, -3471, 13683, -3526, 13772, -3508, 13821, -3438, 13845, -3513, 13812, -3561, 13908, -3573, 13957, -3614, 13999, -3740, 14064, -3802, 14361, -3881, 14449, -3809, 14503, -3790, 14488, -3842, 14632, -3904, 14738, -3822, 14830, -3781, 14942, -3777, 15000, -3743, 15008, -3642, 15033, -3567, 15071, -3517, 15171, -3304, 15245, -3255, 15289, -3164, 15307, -3035, 15351, -2900, 15357, -2811, 15309, -2726, 15314, -2607, 15286, -2527, 15090, -2346, 15073, -2240, 15048, -2256, 15008, -2212, 14968, -2234, 14929, -2126, 14872, -2063, 14885, -2039, 14639, -1896, 14606, -1828, 14616, -1776, 14589, -1691, 14564, -1678, 14549, -1629, 14527, -1543, 14537, -1498, 14456, -1417, 14392, -1455, 14356, -1376},
+		{1688, 4847, 1696, 4860, 1650, 4879, 1603, 4873, 1525, 4904, 1434, 4856, 1360, 4888, 1324, 4842, 1288, 4829, 1303, 4764, 1293, 4747, 1262, 4767, 1214, 4770, 1143, 4752, 1054, 4757, 1040, 4730, 990, 4758, 959, 4753, 948, 4710, 1105, 4675, 1116, 4694, 1215, 4712, 1238, 4677, 1463, 4643, 1514, 4666, 1601, 4668, 1653, 4750, 1634, 4771, 1690, 4771},
+		{4479, 3971, 4495, 3934, 4546, 3887, 4614, 3874, 4574, 3947, 4530, 3947, 4500, 3974},
+		{4669, 4183, 4615, 4172, 4664, 4118, 4650, 4106, 4522, 4141, 4497, 4125, 4556, 4081, 4536, 4056, 4589, 4022, 4561, 3990, 4648, 3946, 4651, 3877, 4769, 3951, 4806, 3958, 4836, 3929, 4801, 3879, 4863, 3827, 4888, 3832, 4886, 3882, 4922, 3905, 4957, 4018, 5039, 4026, 5008, 4053, 4962, 4057, 4858, 4181, 4782, 4115, 4737, 4122},
+		{2975, -445, 3075, -336, 3047, -241, 2994, -235, 2963, -292, 2902, -284, 2928, -329, 2934, -450},
+		{251, 5115, 266, 5080, 312, 5078, 429, 4991, 480, 4999, 567, 4953, 578, 5009, 604, 5013, 616, 5080, 497, 5148},
+		{275, 787, 272, 851, 291, 914, 371, 1006, 360, 1033, 380, 1073, 357, 1133, 361, 1166, 285, 1224, 249, 1223, 194, 1164, 145, 1155, 124, 1111, 90, 1100, 77, 1047, 143, 983, 146, 933, 166, 913, 162, 683, 187, 614, 269, 626},
+		{-296, 1040, -294, 1096, -76, 1094, -44, 1110, 90, 1100, 124, 1111, 145, 1155, 194, 1164, 215, 1194, 218, 1263, 102, 1285, 99, 1334, 43, 1399, 30, 1444, 37, 1493, -27, 1492, -52, 1512, -107, 1497, -200, 1456, -219, 1425, -297, 1380, -310, 1354, -352, 1334, -401, 1347, -428, 1323, -443, 1254, -522, 1171, -520, 1138, -547, 1095, -540, 1037, -433, 961, -398, 986, -351, 990, -283, 964},
+		{9215, 2363, 9187, 2362, 9171, 2299, 9116, 2350, 9147, 2407, 9192, 2413, 9238, 2498, 9180, 2515, 8992, 2527, 8983, 2597, 8936, 2601, 8856, 2645, 8821, 2577, 8893, 2524, 8831, 2487, 8808, 2450, 8870, 2423, 8853, 2363, 8888, 2288, 8903, 2206, 8970, 2186, 8985, 2204, 9027, 2184, 9059, 2239, 9050, 2281, 9142, 2277, 9183, 2218, 9237, 2067, 9230, 2148, 9265, 2132, 9267, 2204},
+		{2241, 4401, 2250, 4364, 2299, 4321, 2260, 4290, 2244, 4258, 2255, 4246, 2238, 4232, 2288, 4200, 2295, 4134, 2369, 4131, 2449, 4158, 2520, 4123, 2611, 4133, 2612, 4183, 2714, 4214, 2800, 4201, 2767, 4258, 2804, 4329, 2856, 4371, 2724, 4418, 2607, 4394, 2557, 4369, 2294, 4382, 2266, 4423},
+		{-7754, 2434, -7789, 2517, -7819, 2521, -7841, 2458, -7803, 2429, -7778, 2371, -7753, 2376},
+		{-7785, 2684, -7898, 2679, -7891, 2642, -7782, 2658},
+		{-7779, 2704, -7734, 2653, -7736, 2601, -7717, 2588, -7700, 2659},
+		{1855, 4508, 1700, 4523, 1653, 4521, 1632, 4500, 1596, 4523, 1575, 4482, 1767, 4303, 1856, 4265, 1871, 4320, 1945, 4357, 1960, 4404, 1912, 4442, 1937, 4486},
+		{2353, 5347, 2380, 5309, 2380, 5269, 2320, 5249, 2351, 5202, 2353, 5158, 2401, 5162, 2455, 5189, 2533, 5191, 2862, 5143, 2899, 5160, 2925, 5137, 3056, 5132, 3062, 5182, 3093, 5204, 3179, 5210, 3131, 5307, 3230, 5313, 3269, 5335, 3241, 5362, 3173, 5379, 3179, 5397, 3138, 5416, 3076, 5481, 3097, 5508, 3087, 5555, 2990, 5579, 2937, 5567, 2923, 5592, 2818, 5617, 2649, 5562, 2659, 5517, 2577, 5485, 2554, 5428, 2445, 5391, 2348, 5391},
+		{-8915, 1702, -8923, 1589, -8893, 1589, -8836, 1653, -8811, 1835, -8830, 1835, -8830, 1850, -8849, 1849, -8885, 1788, -8915, 1796},
+		{-6269, -2225, -6229, -2105, -6227, -2051, -6179, -1963, -6004, -1934, -5912, -1936, -5818, -1987, -5817, -2018, -5785, -1997, -5795, -1940, -5768, -1896, -5750, -1817, -5773, -1755, -5828, -1727, -5839, -1688, -5824, -1630, -6016, -1626, -6054, -1509, -6025, -1508, -6050, -1378, -6108, -1348, -6171, -1349, -6280, -1300, -6320, -1263, -6432, -1246, -6540, -1157, -6534, -976, -6665, -993, -6805, -1071, -6827, -1101, -6953, -1095, -6867, -1256, -6888, -1290, -6895, -1445, -6934, -1495, -6916, -1532, -6939, -1566, -6896, -1650, -6959, -1758, -6910, -1826, -6897, -1898, -6844, -1941, -6876, -2037, -6822, -2149, -6783, -2287, -6711, -2274, -6627, -2183, -6496, -2208, -6438, -2280, -6399, -2199, -6285, -2203},
+		{-5698, -3011, -5597, -3088, -5560, -3085, -5379, -3205, -5321, -3273, -5365, -3320, -5337, -3377, -5271, -3320, -5226, -3225, -5070, -3098, -4959, -2922, -4889, -2867, -4847, -2718, -4864, -2662, -4850, -2588, -4765, -2489, -4647, -2409, -4535, -2380, -4465, -2335, -4307, -2297, -4199, -2297, -4175, -2237, -4094, -2194, -4077, -2090, -3976, -1960, -3958, -1826, -3927, -1787, -3888, -1567, -3895, -1379, -3867, -1306, -3842, -1304, -3768, -1217, -3705, -1104, -3513, -900, -3473, -734, -3524, -546, -3560, -515, -3645, -511, -3722, -482, -3850, -370, -3998, -287, -4147, -291, -4342, -238, -4458, -269, -4442, -214, -4491, -155, -4782, -58, -4858, -124, -4862, -24, -5039, -8, -5070, 22, -4995, 105, -4997, 174, -5051, 190, -5132, 420, -5166, 416, -5256, 250, -5294, 212, -5342, 205, -5355, 233, -5378, 238, -5409, 211, -5510, 252, -5597, 251, -5600, 182, -5734, 195, -5766, 168, -5843, 146, -5854, 127, -5903, 132, -5965, 179, -5997, 276, -5982, 361, -5954, 396, -5977, 442, -6011, 457, -5998, 501, -6021, 524, -6073, 520, -6060, 492, -6097, 454, -6280, 401, -6309, 377, -6463, 415, -6482, 406, -6437, 380, -6427, 250, -6342, 241, -6337, 220, -6408, 192, -6420, 149, -6535, 110, -6555, 79, -6633, 72, -6688, 125, -6707, 113, -6754, 204, -6787, 169, -6982, 171, -6980, 109, -6922, 99, -6925, 60, -6945, 71, -7002, 54, -7002, -19, -6958, -55, -6942, -112, -6989, -430, -7079, -425, -7175, -459, -7289, -527, -7296, -574, -7322, -609, -7312, -663, -7372, -692, -7372, -734, -7399, -752, -7357, -842, -7302, -903, -7323, -946, -7256, -952, -7218, -1005, -7130, -1008, -7048, -949, -7055, -1101, -7009, -1112, -6953, -1095, -6827, -1101, -6805, -1071, -6665, -993, -6534, -976, -6540, -1157, -6432, -1246, -6320, -1263, -6280, -1300, -6171, -1349, -6108, -1348, -6050, -1378, -6025, -1508, -6054, -1509, -6016, -1626, -5824, -1630, -5839, -1688, -5828, -1727, -5773, -1755, -5750, -1817, -5768, -1896, -5795, -1940, -5785, -1997, -5817, -2018, -5787, -2073, -5794, -2209, -5688, -2228, -5647, -2209, -5580, -2236, -5561, -2266, -5540, -2396, -5503, -2400, -5465, -2384, -5429, -2402, -5429, -2457, -5463, -2574, -5413, -2555, -5363, -2612, -5365, -2692, -5516, -2788, -5763, -3022},
+		{11466, 401, 11487, 435, 11535, 432, 11545, 545, 11420, 453},
+		{9126, 2804, 9002, 2830, 8948, 2804, 8881, 2730, 8884, 2710, 8974, 2672, 9037, 2688, 9203, 2684, 9210, 2745},
+		{2526, -1774, 2422, -1789, 2358, -1828, 2320, -1787, 2091, -1825, 2088, -2181, 1990, -2185, 1990, -2477, 2017, -2492, 2076, -2587, 2067, -2648, 2089, -2683, 2161, -2673, 2258, -2598, 2282, -2550, 2331, -2527, 2421, -2567, 2503, -2572, 2566, -2549, 2594, -2470, 2649, -2462, 2712, -2357, 2802, -2283, 2943, -2209, 2879, -2164, 2802, -2149, 2772, -2050, 2730, -2039, 2616, -1929},
+		{1478, 641, 1454, 623, 1448, 473, 1495, 421, 1541, 334, 1586, 301, 1601, 227, 1654, 320, 1713, 373, 1845, 350, 1854, 420, 1893, 471, 1947, 503, 2093, 432, 2241, 403, 2284, 471, 2330, 461, 2441, 511, 2481, 490, 2565, 526, 2704, 513, 2737, 523, 2721, 555, 2647, 595, 2621, 655, 2512, 750, 2511, 783, 2346, 895, 2355, 1009, 2298, 1071, 2286, 1114, 2223, 1097, 2172, 1057, 2100, 948, 2006, 901, 1881, 898, 1891, 863, 1796, 789, 1671, 751, 1629, 775, 1611, 750, 1528, 742},
+		{-6401, 4704, -6439, 4673, -6414, 4639, -6287, 4597, -6250, 4603, -6201, 4644, -6366, 4655},
+		{-6184, 4929, -6286, 4971, -6417, 4996, -6452, 4987, -6359, 4940, -6229, 4909, -6181, 4911},
+		{-12392, 4906, -12492, 4948, -12576, 5030, -12836, 5077, -12844, 5054, -12806, 4999, -12703, 4981, -12685, 4953, -12595, 4918, -12566, 4883, -12401, 4837, -12351, 4851},
+		{-5560, 5132, -5541, 5159, -5587, 5163, -5674, 5129, -5736, 5072, -5839, 4913, -5923, 4852, -5880, 4825, -5942, 4790, -5927, 4760, -5625, 4763, -5529, 4739, -5600, 4692, -5540, 4688, -5424, 4775, -5396, 4763, -5418, 4681, -5307, 4666, -5265, 4754, -5309, 4869, -5379, 4852, -5348, 4925, -5447, 4956, -5494, 4931, -5582, 4959, -5547, 4994, -5614, 5015, -5680, 4981},
+		{-13324, 5385, -13305, 5341, -13255, 5310, -13218, 5264, -13158, 5218, -13118, 5218, -13205, 5298, -13175, 5412, -13271, 5404, -13318, 5417},
+		{-7952, 6236, -7993, 6239, -8036, 6202, -8010, 6172, -7966, 6163, -7927, 6216},
+		{-8188, 6290, -8325, 6291, -8399, 6245, -8377, 6218, -8307, 6216, -8190, 6271},
+		{-8588, 6574, -8635, 6404, -8722, 6354, -8587, 6364, -8552, 6305, -8311, 6410, -8255, 6365, -8099, 6341, -8010, 6373, -8082, 6406, -8155, 6398, -8164, 6446, -8446, 6537, -8498, 6522, -8516, 6566},
+		{-7522, 6744, -7511, 6801, -7590, 6829, -7681, 6815, -7724, 6759, -7699, 6710, -7587, 6715},
+		{-9626, 6949, -9822, 7014, -9980, 6940, -9843, 6895, -9762, 6906, -9627, 6876, -9565, 6911},
+		{-9241, 6970, -9152, 7019, -9288, 7132, -9389, 7176, -9521, 7192, -9639, 7119, -9647, 7009, -9530, 6969, -9423, 6907, -9469, 6806, -9549, 6809, -9613, 6729, -9612, 6824, -9767, 6858, -9856, 6840, -9844, 6778, -10145, 6765, -10322, 6810, -10434, 6802, -10534, 6856, -10615, 6880, -10817, 6865, -10881, 6831, -10779, 6789, -10888, 6738, -10995, 6798, -11080, 6781, -11350, 6769, -11530, 6790, -11390, 6840, -11525, 6891, -11623, 6884, -11760, 6901, -11994, 6938, -12147, 6980, -12268, 6986, -12306, 6956, -12429, 6940, -12442, 7016, -12576, 6948, -12745, 7038, -12814, 7048, -12836, 7001, -12911, 6978, -12979, 7019, -13143, 6994, -13293, 6951, -13441, 6963, -13563, 6932, -13650, 6890, -14099, 6971, -14100, 6031, -14001, 6028, -13904, 6000, -13745, 5891, -13648, 5946, -13548, 5979, -13495, 5927, -13336, 5841, -13171, 5655, -13001, 5592, -12998, 5529, -13054, 5480, -13051, 5429, -12931, 5356, -12913, 5276, -12785, 5233, -12799, 5172, -12744, 5083, -12562, 5042, -12297, 4900, -9516, 4900, -9516, 4938, -9482, 4939, -9464, 4884, -9433, 4867, -9261, 4845, -9164, 4814, -9083, 4827, -8927, 4802, -8838, 4830, -8488, 4690, -8478, 4664, -8454, 4654, -8460, 4644, -8414, 4651, -8389, 4612, -8347, 4599, -8359, 4582, -8255, 4535, -8214, 4357, -8312, 4208, -8303, 4183, -8269, 4168, -7894, 4286, -7917, 4347, -7872, 4363, -7682, 4363, -7650, 4402, -7487, 4500, -7151, 4501, -7141, 4526, -7066, 4546, -7000, 4669, -6924, 4745, -6891, 4719, -6823, 4735, -6779, 4707, -6779, 4570, -6714, 4514, -6443, 4529, -6616, 4447, -6612, 4362, -6536, 4355, -6425, 4427, -6104, 4527, -5980, 4592, -6045, 4628, -6052, 4701, -6152, 4588, -6317, 4574, -6447, 4624, -6512, 4807, -6417, 4874, -6506, 4923, -6655, 4913, -6865, 4830, -7026, 4699, -7110, 4682, -6851, 4907, -6724, 4951, -6640, 5023, -6386, 5029, -6172, 5008, -6003, 5024, -5877, 5106, -5713, 5142, -5568, 5215, -5576, 5327, -5616, 5365, -5694, 5378, -5733, 5463, -5798, 5495, -5957, 5520, -6047, 5578, -6180, 5634, -6140, 5697, -6458, 6034, -6525, 5987, -6620, 5877, -6765, 5821, -6837, 5880, -6929, 5896, -6962, 6022, -6959, 6106, -7137, 6114, -7168, 6153, -7384, 6244, -7467, 6218, -7741, 6255, -7811, 6232, -7777, 6076, -7734, 5985, -7852, 5880, -7730, 5805, -7662, 5720, -7654, 5653, -7710, 5584, -7823, 5514, -7983, 5467, -7912, 5414, -7860, 5256, -7914, 5153, -7991, 5121, -8140, 5216, -8213, 5328, -8244, 5428, -8227, 5515, -8501, 5530, -8732, 5600, -8804, 5647, -8904, 5685, -9090, 5728, -9230, 5709, -9322, 5878, -9468, 5895, -9463, 6011, -9424, 6090, -9316, 6202, -9193, 6284, -9077, 6296, -9070, 6361, -8991, 6403, -8848, 6410, -8732, 6478, -8607, 6606, -8577, 6656, -8474, 6626, -8334, 6641, -8139, 6711, -8126, 6760, -8196, 6813, -8122, 6867, -8128, 6916, -8262, 6966, -8552, 6988, -8558, 6878, -8631, 6792, -8735, 6720, -8832, 6787, -8802, 6862, -8922, 6926, -9055, 6847, -9055, 6950},
+		{-11519, 7331, -11787, 7271, -11940, 7156, -11766, 7130, -11611, 7131, -11843, 7091, -11790, 7054, -11435, 7060, -11242, 7037, -11734, 6996, -11611, 6917, -11522, 6928, -11385, 6901, -11331, 6854, -10900, 6878, -10596, 6918, -10243, 6875, -10209, 6912, -10273, 6950, -10109, 6958, -10098, 7002, -10446, 7099, -10540, 7267, -10652, 7308, -10752, 7324, -10840, 7309, -10769, 7207, -10819, 7165, -10901, 7263, -10992, 7296, -11105, 7245, -11244, 7296, -11467, 7265, -11417, 7312},
+		{-10526, 7364, -10660, 7360, -10694, 7346, -10538, 7276, -10450, 7342},
+		{-7806, 7365, -8035, 7376, -8083, 7369, -8088, 7333, -7949, 7274, -7839, 7288, -7625, 7283, -7634, 7310},
+		{-8583, 7380, -8841, 7354, -8944, 7313, -9021, 7224, -8989, 7122, -8847, 7122, -8951, 7076, -8868, 7041, -8494, 6997, -8131, 6974, -7949, 6987, -7896, 7017, -7817, 6983, -7729, 6977, -7623, 6915, -7687, 6889, -7484, 6855, -7331, 6807, -7293, 6773, -7265, 6728, -7394, 6631, -7429, 6581, -7396, 6545, -7790, 6531, -7856, 6457, -7771, 6423, -7482, 6439, -7483, 6468, -7189, 6368, -7224, 6340, -7102, 6291, -6888, 6233, -6617, 6193, -6633, 6228, -6878, 6375, -6501, 6267, -6467, 6339, -6532, 6438, -6814, 6569, -6802, 6626, -6672, 6639, -6515, 6543, -6392, 6500, -6216, 6616, -6185, 6686, -6342, 6693, -6486, 6785, -6645, 6807, -6881, 6872, -6697, 6919, -6791, 7012, -6879, 7053, -7120, 7092, -7224, 7156, -7410, 7133, -7423, 7177, -7782, 7275, -7877, 7235, -8075, 7206, -8060, 7272, -8232, 7375, -8485, 7334, -8577, 7253, -8656, 7316},
+		{-10154, 7336, -10044, 7271, -10248, 7283, -10250, 7251, -10001, 7174, -9932, 7136, -9836, 7127, -9672, 7166, -9654, 7256, -9805, 7299, -9712, 7347, -9738, 7376, -9916, 7363, -10036, 7384},
+		{-9200, 7297, -9051, 7386, -9242, 7410, -9450, 7413, -9550, 7386, -9602, 7344, -9603, 7294, -9541, 7206, -9427, 7202, -9320, 7277},
+		{-12046, 7182, -11922, 7252, -11551, 7348, -11756, 7419, -12011, 7424, -12154, 7445, -12492, 7429, -12394, 7368, -12593, 7187, -12362, 7134, -12309, 7090, -12046, 7138},
+		{-9398, 7530, -9485, 7565, -9629, 7538, -9682, 7493, -9561, 7467, -9416, 7459, -9361, 7498},
+		{-9858, 7659, -9998, 7665, -10149, 7631, -10257, 7634, -10250, 7556, -10086, 7564, -10088, 7506, -9981, 7490, -9816, 7500, -9770, 7574, -9774, 7626, -9850, 7672},
+		{-10855, 7668, -10958, 7679, -11050, 7643, -10907, 7547, -11081, 7555, -11259, 7614, -11540, 7648, -11635, 7620, -11771, 7522, -11631, 7504, -11179, 7516, -11387, 7472, -11374, 7439, -11222, 7442, -10970, 7485, -10631, 7501, -10570, 7548, -10588, 7597, -10693, 7601, -10782, 7585},
+		{-9675, 7716, -9712, 7675, -9596, 7644, -9389, 7632, -9289, 7588, -9242, 7484, -8815, 7439, -8323, 7456, -8195, 7444, -8046, 7466, -7983, 7492, -8006, 7534, -8113, 7571, -8479, 7570, -8638, 7548, -8919, 7561, -9097, 7607, -9074, 7645, -9161, 7678, -9357, 7678, -9468, 7710},
+		{-11757, 7750, -11910, 7751, -12116, 7686, -12285, 7612, -12150, 7590, -11990, 7605, -11804, 7648, -11711, 7653, -11634, 7688, -11620, 7765},
+		{-9372, 7763, -9442, 7782, -9644, 7783, -9617, 7756, -9430, 7749},
+		{-10985, 7800, -11126, 7815, -11272, 7805, -11353, 7773, -11205, 7741, -11019, 7770},
+		{-11096, 7880, -11150, 7885, -11253, 7855, -11254, 7841, -11088, 7841, -10966, 7860},
+		{-9556, 7842, -9675, 7877, -9863, 7887, -9855, 7846, -9812, 7808, -9731, 7785, -9583, 7806},
+		{-10083, 7880, -10549, 7930, -10542, 7892, -10421, 7868, -10518, 7838, -10295, 7834, -10130, 7802, -9967, 7791},
+		{-8781, 8032, -9113, 8072, -9241, 8126, -9474, 8121, -9430, 8098, -9532, 8091, -9671, 8016, -9608, 7971, -9497, 7937, -9315, 7938, -9394, 7911, -9395, 7875, -9288, 7834, -8904, 7829, -8719, 7904, -8581, 7934, -8702, 7966},
+		{-7067, 8317, -7931, 8313, -8242, 8286, -8318, 8232, -8426, 8260, -8550, 8265, -8697, 8228, -9159, 8189, -9137, 8155, -9020, 8126, -8937, 8086, -8760, 8052, -8185, 8046, -8341, 8010, -8693, 8025, -8651, 7974, -8509, 7935, -8538, 7900, -8715, 7876, -8796, 7837, -8634, 7818, -8498, 7754, -8765, 7797, -8826, 7790, -8777, 7718, -8962, 7695, -8949, 7647, -8611, 7630, -8317, 7645, -8056, 7618, -7789, 7678, -7791, 7702, -7962, 7698, -7976, 7721, -7836, 7751, -7789, 7790, -7634, 7818, -7539, 7853, -7622, 7902, -7553, 7920, -7691, 7932, -7388, 7943, -7324, 7963, -7118, 7980, -6947, 8062, -6548, 8151, -6766, 8150, -6189, 8236, -6185, 8263, -6368, 8290},
+		{852, 4783, 832, 4761, 747, 4762, 719, 4745, 674, 4754, 677, 4729, 604, 4673, 602, 4627, 650, 4643, 684, 4599, 727, 4578, 776, 4582, 832, 4616, 849, 4601, 897, 4604, 918, 4644, 992, 4631, 1036, 4648, 1044, 4689, 948, 4710, 959, 4753},
+		{-6935, -5252, -7027, -5293, -7059, -5362, -7111, -5407, -7466, -5284, -7329, -5396, -7101, -5505, -6864, -5558, -6815, -5561, -6729, -5530, -6696, -5490, -6863, -5487, -6863, -5264},
+		{-6876, -2037, -6844, -1941, -6897, -1898, -6910, -1826, -6959, -1758, -6986, -1809, -7037, -1835, -7009, -2139, -7091, -2764, -7149, -2886, -7137, -3010, -7167, -3092, -7144, -3242, -7186, -3391, -7317, -3712, -7359, -3716, -7351, -3828, -7322, -3926, -7368, -3994, -7433, -4322, -7370, -4337, -7339, -4212, -7272, -4238, -7324, -4445, -7435, -4410, -7469, -4576, -7564, -4665, -7413, -4694, -7518, -4771, -7561, -4867, -7548, -5038, -7498, -5104, -7526, -5163, -7495, -5226, -7256, -5353, -7143, -5386, -7101, -5383, -7085, -5290, -6946, -5229, -6857, -5230, -7191, -5201, -7233, -5143, -7231, -5068, -7298, -5074, -7333, -5038, -7342, -4932, -7265, -4888, -7233, -4824, -7245, -4774, -7192, -4688, -7155, -4556, -7166, -4497, -7122, -4478, -7133, -4441, -7179, -4421, -7146, -4379, -7192, -4341, -7215, -4225, -7175, -4205, -7192, -4083, -7141, -3892, -7081, -3855, -7112, -3758, -7112, -3666, -7036, -3601, -7039, -3517, -6982, -3419, -6981, -3327, -7007, -3309, -7054, -3137, -6992, -3034, -7001, -2937, -6966, -2846, -6900, -2752, -6830, -2690, -6859, -2651, -6839, -2619, -6842, -2452, -6733, -2403, -6699, -2299, -6711, -2274, -6783, -2287, -6822, -2149},
+		{11057, 1926, 11101, 1970, 11079, 2008, 11021, 2010, 10912, 1982, 10863, 1937, 10866, 1851, 10948, 1820, 11034, 1868},
+		{12729, 5074, 12595, 5279, 12507, 5316, 12357, 5346, 12100, 5325, 12018, 5275, 12073, 5252, 12074, 5196, 12018, 5164, 11928, 5058, 11929, 5014, 11788, 4951, 11668, 4989, 11549, 4814, 11574, 4773, 11631, 4785, 11730, 4770, 11806, 4807, 11887, 4775, 11977, 4705, 11966, 4669, 11887, 4681, 11742, 4667, 11672, 4639, 11599, 4573, 11446, 4534, 11346, 4481, 11187, 4510, 11135, 4446, 11183, 4374, 11041, 4287, 10924, 4252, 10774, 4248, 10613, 4213, 10496, 4160, 10452, 4191, 10331, 4191, 10183, 4251, 10085, 4266, 9952, 4252, 9635, 4273, 9576, 4332, 9531, 4424, 9469, 4435, 9348, 4498, 9095, 4529, 9059, 4572, 9097, 4689, 9028, 4769, 8885, 4807, 8801, 4860, 8775, 4930, 8736, 4921, 8660, 4855, 8577, 4846, 8572, 4745, 8516, 4700, 8318, 4733, 8246, 4554, 7997, 4492, 8087, 4318, 8018, 4292, 8026, 4235, 8012, 4212, 7854, 4158, 7819, 4119, 7690, 4107, 7653, 4043, 7547, 4056, 7478, 4037, 7382, 3989, 7396, 3966, 7368, 3943, 7393, 3851, 7426, 3861, 7486, 3838, 7498, 3742, 7516, 3713, 7590, 3667, 7619, 3590, 7784, 3549, 7891, 3432, 7881, 3351, 7921, 3299, 7918, 3248, 7846, 3262, 7874, 3152, 7972, 3088, 8111, 3018, 8153, 3042, 8233, 3012, 8334, 2946, 8390, 2932, 8423, 2884, 8501, 2864, 8582, 2820, 8812, 2788, 8873, 2809, 8881, 2730, 8948, 2804, 9002, 2830, 9126, 2804, 9170, 2777, 9250, 2790, 9341, 2864, 9457, 2928, 9540, 2903, 9612, 2945, 9659, 2883, 9625, 2841, 9733, 2826, 9791, 2834, 9825, 2775, 9868, 2751, 9867, 2592, 9772, 2508, 9760, 2390, 9866, 2406, 9890, 2314, 9953, 2295, 9924, 2212, 10042, 2156, 10115, 2185, 10127, 2120, 10180, 2117, 10165, 2232, 10271, 2271, 10448, 2282, 10533, 2335, 10581, 2298, 10673, 2279, 10657, 2222, 10704, 2181, 10805, 2155, 10852, 2172, 10986, 2140, 10963, 2101, 10989, 2028, 11044, 2034, 11079, 2140, 11184, 2155, 11324, 2205, 11381, 2255, 11415, 2222, 11476, 2267, 11589, 2278, 11866, 2455, 12113, 2814, 12168, 2823, 12209, 2983, 12150, 3014, 12126, 3068, 12189, 3095, 12191, 3169, 12062, 3338, 12023, 3436, 11915, 3491, 11966, 3561, 12064, 3611, 12110, 3665, 12252, 3693, 12236, 3745, 12171, 3748, 12082, 3787, 11970, 3716, 11891, 3745, 11888, 3790, 11806, 3806, 11753, 3874, 11804, 3920, 11902, 3925, 11964, 3990, 12164, 4095, 12217, 4042, 12138, 3975, 12159, 3936, 12105, 3890, 12213, 3917, 12287, 3964, 12427, 3993, 12508, 4057, 12618, 4111, 12687, 4182, 12734, 4150, 12821, 4147, 12805, 4199, 12960, 4242, 12999, 4299, 13064, 4240, 13063, 4290, 13114, 4293, 13129, 4411, 13103, 4497, 13188, 4532, 13310, 4514, 13377, 4612, 13411, 4721, 13450, 4758, 13503, 4848, 13337, 4818, 13251, 4779, 13099, 4779, 13058, 4873, 12940, 4944, 12766, 4976},
+		{-281, 539, -324, 625, -298, 738, -256, 822, -283, 964, -351, 990, -398, 986, -433, 961, -540, 1037, -605, 1010, -621, 1052, -667, 1043, -685, 1014, -762, 1015, -790, 1030, -823, 1013, -831, 979, -783, 858, -830, 832, -828, 769, -844, 769, -849, 740, -839, 691, -860, 647, -757, 571, -771, 436, -583, 499, -465, 517, -286, 499},
+		{1434, 223, 1594, 173, 1601, 227, 1586, 301, 1541, 334, 1495, 421, 1448, 473, 1454, 623, 1478, 641, 1544, 769, 1498, 880, 1454, 897, 1395, 955, 1417, 1002, 1547, 998, 1492, 1089, 1489, 1222, 1450, 1286, 1421, 1280, 1418, 1248, 1458, 1209, 1442, 1157, 1357, 1080, 1275, 872, 1222, 831, 1175, 698, 1106, 664, 1050, 706, 1012, 704, 952, 645, 923, 644, 876, 548, 849, 450, 874, 435, 895, 390, 940, 373, 980, 307, 965, 228},
+		{2995, 417, 2972, 460, 2843, 429, 2798, 441, 2737, 523, 2704, 513, 2565, 526, 2481, 490, 2441, 511, 2330, 461, 2284, 471, 2241, 403, 2093, 432, 1947, 503, 1893, 471, 1854, 420, 1839, 290, 1790, 174, 1783, 29, 1764, -42, 1752, -74, 1641, -174, 1597, -271, 1601, -354, 1458, -497, 1421, -479, 1414, -451, 1360, -450, 1326, -488, 1300, -478, 1263, -499, 1244, -568, 1218, -579, 1232, -610, 1338, -586, 1633, -588, 1686, -722, 1747, -807, 1846, -785, 1902, -799, 1942, -716, 2004, -712, 2009, -694, 2060, -694, 2051, -730, 2173, -729, 2175, -792, 2195, -831, 2180, -891, 2188, -952, 2221, -989, 2216, -1108, 2346, -1087, 2426, -1095, 2431, -1126, 2542, -1133, 2575, -1178, 2655, -1192, 2716, -1161, 2739, -1213, 2816, -1227, 2893, -1325, 2970, -1326, 2962, -1218, 2934, -1236, 2837, -1179, 2867, -961, 2845, -916, 2873, -853, 3035, -824, 3074, -834, 3020, -708, 2962, -652, 2942, -594, 2952, -542, 2928, -329, 2902, -284, 2929, -162, 2958, -134, 2959, -59, 2982, -21, 2988, 60, 3047, 158, 3117, 220, 3077, 234, 3083, 351},
+		{1326, -488, 1360, -450, 1414, -451, 1421, -479, 1458, -497, 1601, -354, 1597, -271, 1641, -174, 1752, -74, 1764, -42, 1783, 29, 1790, 174, 1839, 290, 1845, 350, 1713, 373, 1654, 320, 1601, 227, 1594, 173, 1434, 223, 1308, 227, 1300, 183, 1328, 131, 1403, 140, 1428, 120, 1384, 4, 1432, -55, 1443, -133, 1430, -200, 1399, -247, 1311, -243, 1258, -195, 1250, -239, 1182, -251, 1148, -277, 1186, -343, 1109, -398, 1191, -504, 1262, -444},
+		{-7511, -6, -7366, -126, -7307, -231, -7233, -243, -7177, -217, -7141, -234, -7081, -226, -7005, -273, -7069, -374, -7039, -377, -6989, -430, -6942, -112, -6958, -55, -7002, -19, -7002, 54, -6945, 71, -6925, 60, -6922, 99, -6980, 109, -6982, 171, -6787, 169, -6754, 204, -6707, 113, -6688, 125, -6718, 225, -6781, 282, -6730, 332, -6782, 450, -6774, 522, -6734, 610, -6770, 627, -6939, 610, -7009, 696, -7067, 709, -7196, 699, -7220, 734, -7244, 742, -7244, 841, -7279, 909, -7330, 915, -7291, 1045, -7223, 1111, -7197, 1161, -7133, 1178, -7114, 1211, -7140, 1238, -7175, 1244, -7224, 1196, -7341, 1123, -7420, 1131, -7428, 1110, -7491, 1108, -7548, 1062, -7567, 944, -7609, 934, -7684, 864, -7735, 867, -7747, 852, -7724, 794, -7743, 764, -7775, 771, -7788, 722, -7748, 669, -7732, 585, -7753, 558, -7731, 467, -7750, 409, -7713, 385, -7793, 270, -7843, 263, -7866, 227, -7862, 177, -7899, 169, -7886, 138, -7767, 83, -7742, 40, -7658, 26, -7629, 42, -7537, -15},
+		{-8291, 842, -8272, 893, -8293, 907, -8293, 948, -8255, 957, -8340, 1040, -8366, 1094, -8390, 1073, -8436, 1100, -8467, 1108, -8490, 1095, -8556, 1122, -8594, 1090, -8566, 1075, -8580, 1013, -8566, 993, -8511, 956, -8491, 980, -8498, 1009, -8471, 991, -8465, 962, -8363, 905, -8371, 866, -8351, 845, -8297, 823},
+		{-8251, 2308, -8378, 2279, -8497, 2190, -8455, 2180, -8405, 2191, -8391, 2215, -8349, 2217, -8278, 2269, -8180, 2264, -8217, 2239, -8182, 2219, -8052, 2204, -7928, 2156, -7872, 2160, -7848, 2103, -7814, 2074, -7749, 2067, -7709, 2041, -7776, 1986, -7496, 1992, -7430, 2005, -7418, 2028, -7493, 2069, -7567, 2074, -7560, 2102, -7652, 2121, -7835, 2251, -7928, 2240, -7968, 2277, -8062, 2311, -8227, 2319},
+		{3292, 3509, 3397, 3506, 3390, 3525, 3458, 3567, 3367, 3537, 3295, 3539, 3273, 3514},
+		{3387, 3509, 3226, 3510, 3249, 3470, 3298, 3457, 3400, 3498},
+		{1710, 4882, 1789, 4890, 1817, 4927, 1885, 4950, 1839, 4999, 1765, 5005, 1755, 5036, 1687, 5047, 1672, 5022, 1618, 5042, 1624, 5070, 1549, 5078, 1502, 5111, 1457, 5100, 1431, 5112, 1224, 5027, 1252, 4955, 1434, 4856, 1525, 4904, 1603, 4873, 1650, 4879, 1696, 4860},
+		{928, 5483, 853, 5496, 857, 5440, 880, 5402, 812, 5353, 794, 5375, 710, 5369, 691, 5348, 709, 5314, 684, 5223, 659, 5185, 599, 5185, 619, 4946, 666, 4920, 810, 4902, 759, 4833, 747, 4762, 832, 4761, 852, 4783, 959, 4753, 990, 4758, 1040, 4730, 1054, 4757, 1143, 4752, 1214, 4770, 1262, 4767, 1293, 4747, 1303, 4764, 1288, 4829, 1324, 4842, 1360, 4888, 1252, 4955, 1224, 5027, 1431, 5112, 1457, 5100, 1502, 5111, 1461, 5175, 1469, 5209, 1444, 5262, 1407, 5298, 1435, 5325, 1412, 5376, 1252, 5447, 1196, 5420, 1094, 5401, 1095, 5436, 994, 5460, 992, 5498},
+		{4278, 1246, 4235, 1254, 4200, 1210, 4166, 1163, 4176, 1105, 4255, 1111, 4278, 1093, 4315, 1146, 4272, 1174, 4329, 1197, 4332, 1239, 4308, 1270},
+		{1237, 5611, 1090, 5578, 1104, 5536, 1209, 5480, 1269, 5561},
+		{1037, 5661, 1025, 5689, 1055, 5722, 1058, 5773, 942, 5717, 854, 5711, 809, 5654, 812, 5552, 853, 5496, 928, 5483, 992, 5498, 965, 5547, 1037, 5619, 1067, 5608, 1091, 5646},
+		{-7162, 1917, -7170, 1879, -7195, 1862, -7169, 1832, -7166, 1776, -7140, 1760, -7100, 1828, -7067, 1843, -7052, 1818, -6995, 1843, -6916, 1842, -6869, 1821, -6832, 1861, -6881, 1898, -6925, 1902, -6922, 1931, -6977, 1929, -6995, 1965, -7081, 1988, -7159, 1988, -7171, 1971},
+		{1156, 2410, 1077, 2456, 1030, 2438, 995, 2494, 991, 2537, 932, 2609, 972, 2651, 963, 2714, 981, 2942, 906, 3210, 761, 3334, 752, 3410, 814, 3466, 838, 3548, 822, 3643, 842, 3695, 774, 3689, 733, 3712, 626, 3711, 532, 3672, 482, 3687, 147, 3661, 50, 3630, -13, 3589, -121, 3571, -217, 3517, -179, 3453, -139, 3286, -112, 3265, -131, 3226, -262, 3209, -307, 3172, -365, 3164, -369, 3090, -486, 3050, -524, 3000, -706, 2958, -867, 2884, -868, 2740, 182, 2061, 206, 2014, 315, 1969, 316, 1906, 427, 1916, 568, 1960, 857, 2157, 1200, 2347},
+		{-8018, -382, -8047, -406, -8044, -443, -7962, -445, -7921, -496, -7864, -455, -7845, -387, -7784, -300, -7664, -261, -7554, -156, -7523, -91, -7537, -15, -7629, 42, -7658, 26, -7742, 40, -7767, 83, -7886, 138, -8009, 77, -8002, 36, -8058, -91, -8093, -106, -8076, -197, -8097, -225, -8037, -269, -7999, -222, -7977, -266, -8030, -340},
+		{3427, 3122, 3377, 3097, 3219, 3126, 3196, 3093, 3169, 3143, 3098, 3156, 3010, 3147, 2891, 3087, 2650, 3159, 2516, 3157, 2480, 3109, 2496, 3066, 2470, 3004, 2500, 2924, 2500, 2200, 3687, 2200, 3553, 2310, 3549, 2375, 3569, 2393, 3410, 2614, 3232, 2976, 3242, 2985, 3314, 2842, 3392, 2765, 3415, 2782, 3492, 2950},
+		{4278, 1246, 4308, 1270, 4259, 1300, 4118, 1449, 3927, 1592, 3841, 1800, 3790, 1743, 3717, 1726, 3685, 1696, 3632, 1482, 3643, 1442, 3759, 1421, 3791, 1496, 3851, 1451, 3910, 1474, 3934, 1453, 4003, 1452, 4090, 1412, 4235, 1254},
+		{-867, 4213, -826, 4228, -801, 4179, -667, 4188, -639, 4138, -685, 4111, -686, 4033, -703, 4018, -707, 3971, -750, 3963, -710, 3903, -737, 3837, -703, 3808, -754, 3743, -745, 3710, -652, 3694, -624, 3637, -587, 3603, -538, 3595, -437, 3668, -215, 3667, -144, 3744, -68, 3764, -47, 3829, 11, 3874, -28, 3931, 11, 4012, 72, 4068, 81, 4101, 209, 4123, 304, 4189, 299, 4247, 183, 4234, 70, 4280, 34, 4258, -150, 4303, -190, 4342, -435, 4340, -798, 4375, -939, 4303, -898, 4259, -903, 4188},
+		{2516, 5797, 2646, 5748, 2729, 5747, 2772, 5779, 2742, 5872, 2813, 5930, 2798, 5948, 2586, 5961, 2334, 5919, 2343, 5861, 2406, 5826, 2443, 5838, 2431, 5779},
+		{3759, 1421, 3643, 1442, 3586, 1258, 3526, 1208, 3473, 1091, 3426, 1063, 3383, 838, 3329, 835, 3295, 778, 3357, 771, 3408, 723, 3425, 683, 3471, 659, 3530, 551, 3582, 534, 3582, 478, 3616, 445, 3686, 445, 3812, 360, 3956, 342, 3985, 384, 4077, 426, 4117, 392, 4186, 392, 4213, 423, 4277, 425, 4366, 496, 4496, 500, 4779, 800, 4695, 800, 4368, 918, 4256, 1057, 4278, 1093, 4255, 1111, 4176, 1105, 4166, 1163, 4235, 1254, 4090, 1412, 4003, 1452, 3934, 1453, 3910, 1474, 3851, 1451, 3791, 1496},
+		{2902, 6977, 2773, 7016, 2618, 6983, 2569, 6909, 2474, 6865, 2366, 6889, 2236, 6884, 2124, 6937, 2065, 6911, 2354, 6794, 2357, 6640, 2390, 6601, 2529, 6553, 2540, 6511, 2244, 6382, 2154, 6319, 2106, 6261, 2154, 6171, 2132, 6072, 2229, 6039, 2287, 5985, 2626, 6042, 2807, 6050, 3114, 6236, 3152, 6287, 3004, 6355, 3044, 6420, 2954, 6495, 3022, 6581, 2905, 6694, 2998, 6770, 2845, 6836, 2859, 6906},
+		{17813, -1750, 17767, -1738, 17729, -1772, 17738, -1816, 17793, -1829, 17855, -1815, 17872, -1763, 17837, -1734},
+		{18000, -1656, 18000, -1607, 17860, -1664, 17873, -1701},
+		{-17979, -1602, -18000, -1607, -18000, -1656},
+		{-6070, -5230, -5985, -5185, -5940, -5220, -5805, -5190, -5775, -5155, -5855, -5110, -5915, -5150, -6000, -5125, -6120, -5185},
+		{-5225, 324, -5166, 416, -5182, 457, -5288, 541, -5396, 576, -5448, 490, -5440, 421, -5401, 362, -5452, 231, -5409, 211, -5378, 238, -5355, 233, -5342, 205, -5294, 212, -5256, 250},
+		{939, 4301, 875, 4263, 854, 4226, 878, 4158, 923, 4138, 956, 4215},
+		{312, 5078, 266, 5080, 251, 5115, 164, 5095, 134, 5013, -99, 4935, -193, 4978, -162, 4864, -330, 4890, -459, 4868, -449, 4795, -296, 4757, -223, 4706, -119, 4601, -138, 4402, -190, 4342, -150, 4303, 34, 4258, 70, 4280, 183, 4234, 299, 4247, 310, 4308, 456, 4340, 653, 4313, 744, 4369, 755, 4413, 701, 4425, 675, 4503, 710, 4533, 680, 4571, 684, 4599, 650, 4643, 602, 4627, 604, 4673, 677, 4729, 674, 4754, 719, 4745, 747, 4762, 759, 4833, 810, 4902, 666, 4920, 480, 4999, 429, 4991},
+		{1186, -343, 1148, -277, 1182, -251, 1250, -239, 1258, -195, 1311, -243, 1399, -247, 1430, -200, 1443, -133, 1432, -55, 1384, 4, 1428, 120, 1403, 140, 1328, 131, 1300, 183, 1308, 227, 1295, 232, 1236, 219, 1128, 226, 1129, 106, 949, 101, 883, -78, 880, -111, 941, -214, 1109, -398},
+		{-673, 5517, -757, 5513, -737, 5460, -757, 5406, -620, 5387, -566, 5455},
+		{-421, 5855, -501, 5863, -579, 5782, -615, 5679, -564, 5628, -559, 5531, -505, 5578, -472, 5551, -508, 5506, -484, 5479, -363, 5462, -295, 5398, -309, 5340, -458, 5350, -477, 5284, -422, 5230, -527, 5199, -498, 5159, -341, 5143, -431, 5121, -578, 5016, -525, 4996, -454, 5034, -362, 5023, -296, 5070, -249, 5050, -79, 5077, 55, 5077, 145, 5129, 105, 5181, 156, 5210, 168, 5274, 47, 5293, -43, 5446, -111, 5462, -209, 5591, -312, 5597, -222, 5687, -196, 5768, -407, 5755, -301, 5864},
+		{4262, 4158, 4358, 4109, 4497, 4125, 4522, 4141, 4596, 4112, 4650, 4106, 4664, 4118, 4615, 4172, 4640, 4186, 4578, 4209, 4547, 4250, 4454, 4271, 4393, 4255, 4376, 4274, 4239, 4322, 4008, 4355, 3996, 4343, 4032, 4313, 4088, 4301, 4145, 4265, 4170, 4196, 4155, 4154},
+		{84, 628, 49, 741, 71, 831, 46, 868, 37, 1019, -5, 1071, 2, 1102, -294, 1096, -296, 1040, -256, 822, -298, 738, -324, 625, -281, 539, -286, 499, -196, 471, -106, 500, 106, 593},
+		{-828, 769, -830, 832, -783, 858, -831, 979, -823, 1013, -803, 1021, -834, 1049, -828, 1079, -841, 1091, -862, 1081, -838, 1139, -913, 1231, -933, 1233, -1017, 1184, -1104, 1221, -1146, 1208, -1151, 1244, -1250, 1233, -1370, 1259, -1383, 1214, -1374, 1181, -1438, 1151, -1469, 1153, -1513, 1104, -1484, 1088, -1458, 1021, -1407, 989, -1325, 890, -1271, 934, -1243, 984, -1192, 1005, -1112, 1005, -1062, 927, -1051, 835, -976, 854, -934, 793, -940, 753, -921, 731, -893, 731, -872, 771},
+		{-1593, 1313, -1514, 1351, -1428, 1328, -1384, 1351, -1405, 1379, -1469, 1363, -1508, 1388, -1540, 1386, -1562, 1362, -1671, 1359, -1684, 1315},
+		{-1469, 1153, -1438, 1151, -1374, 1181, -1383, 1214, -1370, 1259, -1555, 1263, -1668, 1238, -1609, 1152, -1566, 1146, -1513, 1104},
+		{983, 107, 1129, 106, 1128, 226, 965, 228, 931, 116, 949, 101},
+		{2351, 3528, 2474, 3508, 2472, 3492, 2616, 3500, 2629, 3530, 2575, 3518, 2577, 3535, 2425, 3537, 2370, 3571},
+		{2612, 4183, 2611, 4133, 2520, 4123, 2449, 4158, 2369, 4131, 2276, 4130, 2260, 4113, 2206, 4115, 2167, 4093, 2102, 4084, 2100, 4058, 2067, 4043, 2062, 4011, 2015, 3962, 2022, 3934, 2112, 3831, 2167, 3684, 2249, 3641, 2315, 3642, 2277, 3731, 2341, 3741, 2312, 3792, 2404, 3766, 2403, 3822, 2297, 3897, 2335, 3919, 2285, 3966, 2263, 4026, 2281, 4048, 2334, 3996, 2441, 4012, 2371, 4069, 2493, 4095, 2606, 4082, 2629, 4094, 2660, 4156},
+		{-4690, 8220, -4452, 8166, -5039, 8244, -5304, 8189, -5413, 8220, -5721, 8219, -6265, 8177, -6223, 8132, -6369, 8121, -6715, 8052, -6802, 8012, -6532, 7976, -6571, 7939, -7316, 7843, -7330, 7804, -7104, 7764, -6676, 7738, -6878, 7732, -7140, 7701, -6850, 7606, -6127, 7610, -5859, 7552, -5860, 7510, -5732, 7471, -5472, 7259, -5583, 7165, -5500, 7141, -5400, 7155, -5139, 7057, -5436, 7082, -5475, 7029, -5468, 6961, -5346, 6928, -5087, 6993, -5108, 6915, -5148, 6873, -5298, 6836, -5397, 6719, -5330, 6684, -5366, 6610, -5228, 6518, -5214, 6428, -5163, 6363, -4990, 6238, -4923, 6141, -4826, 6086, -4626, 6085, -4479, 6004, -4338, 6010, -4242, 6190, -4282, 6268, -4119, 6348, -4068, 6414, -4067, 6484, -3981, 6546, -3635, 6598, -3420, 6668, -3281, 6774, -3178, 6812, -2775, 6847, -2235, 7013, -2636, 7023, -2520, 7075, -2554, 7143, -2354, 7047, -2175, 7066, -2213, 7147, -2344, 7208, -2479, 7233, -2428, 7260, -2230, 7218, -2231, 7263, -2357, 7331, -2076, 7346, -2043, 7382, -2159, 7422, -1937, 7430, -2067, 7516, -1960, 7525, -1983, 7610, -2168, 7663, -2004, 7694, -1847, 7699, -1967, 7764, -1970, 7875, -1773, 8013, -2005, 8018, -1685, 8035, -1629, 8058, -1221, 8129, -1277, 8172, -1577, 8191, -2062, 8152, -2317, 8115, -2207, 8173, -2290, 8209, -2484, 8179, -2786, 8213, -3140, 8202, -3190, 8220, -2269, 8234, -2085, 8273, -2710, 8352, -3509, 8365, -3862, 8355, -3990, 8318, -4341, 8323, -4676, 8263},
+		{-9006, 1388, -8915, 1468, -8915, 1507, -8823, 1573, -8923, 1589, -8914, 1781, -9100, 1782, -9100, 1725, -9145, 1725, -9044, 1641, -9046, 1607, -9175, 1607, -9223, 1525, -9209, 1506, -9223, 1454, -9123, 1393, -9010, 1374},
+		{-6055, 778, -6064, 741, -6030, 704, -6116, 670, -6114, 623, -6141, 596, -6073, 520, -6021, 524, -5998, 501, -6011, 457, -5977, 442, -5954, 396, -5982, 361, -5997, 276, -5965, 179, -5903, 132, -5854, 127, -5843, 146, -5766, 168, -5734, 195, -5654, 190, -5715, 277, -5728, 333, -5760, 333, -5804, 406, -5791, 481, -5731, 507, -5715, 597, -5808, 681, -5845, 683, -5848, 735, -5910, 800, -5976, 837},
+		{-8701, 1303, -8673, 1326, -8676, 1375, -8631, 1377, -8610, 1404, -8580, 1384, -8517, 1435, -8492, 1479, -8445, 1462, -8349, 1502, -8315, 1500, -8437, 1584, -8498, 1600, -8544, 1589, -8600, 1601, -8690, 1576, -8790, 1586, -8915, 1507, -8915, 1468, -8935, 1442, -8850, 1385, -8786, 1389, -8772, 1379, -8779, 1338, -8749, 1330, -8732, 1298},
+		{1846, 4576, 1763, 4595, 1656, 4650, 1577, 4624, 1567, 4583, 1532, 4573, 1533, 4545, 1460, 4563, 1441, 4547, 1372, 4550, 1366, 4514, 1395, 4480, 1426, 4523, 1490, 4508, 1492, 4474, 1538, 4432, 1517, 4424, 1602, 4351, 1845, 4248, 1856, 4265, 1767, 4303, 1575, 4482, 1596, 4523, 1632, 4500, 1653, 4521, 1700, 4523, 1855, 4508, 1901, 4486, 1939, 4524, 1883, 4591},
+		{-7342, 1964, -7278, 1948, -7279, 1910, -7233, 1867, -7269, 1845, -7437, 1866, -7446, 1834, -7392, 1803, -7345, 1822, -7237, 1821, -7171, 1804, -7169, 1832, -7195, 1862, -7170, 1879, -7171, 1971, -7319, 1992},
+		{1637, 4684, 1656, 4650, 1763, 4595, 1846, 4576, 1960, 4617, 2022, 4613, 2102, 4632, 2210, 4767, 2271, 4788, 2264, 4815, 2209, 4842, 2187, 4832, 2080, 4862, 2024, 4833, 1878, 4808, 1870, 4788, 1786, 4776, 1698, 4812, 1690, 4771, 1634, 4771, 1653, 4750, 1620, 4685},
+		{12078, -997, 11990, -936, 11897, -956, 12030, -1026, 12072, -1024},
+		{12509, -939, 12497, -889, 12398, -929, 12346, -1024, 12358, -1036, 12444, -1014},
+		{11763, -845, 11708, -846, 11674, -903, 11913, -871, 11888, -828, 11826, -836, 11790, -810},
+		{12201, -846, 12134, -854, 12072, -824, 11992, -844, 11992, -881, 12125, -893, 12276, -865, 12290, -809},
+		{10849, -642, 10727, -595, 10605, -590, 10537, -685, 10628, -692, 10645, -735, 10828, -777, 10869, -764, 11152, -830, 11346, -835, 11456, -875, 11571, -837, 11448, -778, 11298, -759, 11261, -695, 11076, -647, 11054, -688, 10862, -678},
+		{13473, -574, 13450, -545, 13411, -614, 13421, -690, 13472, -621},
+		{12700, -313, 12599, -318, 12618, -361, 12687, -379, 12725, -346},
+		{12937, -280, 12814, -284, 12790, -339, 12999, -345, 13083, -386, 13047, -309},
+		{13399, -78, 13238, -37, 13187, -70, 13052, -94, 13094, -143, 13184, -162, 13223, -221, 13370, -221, 13378, -248, 13307, -246, 13199, -282, 13275, -331, 13276, -375, 13298, -411, 13337, -402, 13366, -354, 13516, -446, 13599, -455, 13793, -539, 13841, -623, 13867, -732, 13804, -760, 13761, -841, 13888, -838, 13913, -810, 14014, -830, 14103, -912, 14100, -260, 13993, -241, 13833, -170, 13744, -170, 13629, -231, 13546, -337, 13442, -277},
+		{12507, 164, 12408, 92, 12167, 101, 12089, 131, 12004, 57, 11932, -135, 11918, -215, 11877, -280, 11908, -349, 11950, -349, 11965, -446, 11937, -538, 11980, -567, 12043, -553, 12031, -293, 12097, -263, 12090, -360, 12162, -419, 12149, -457, 12174, -485, 12272, -446, 12224, -528, 12263, -563, 12316, -534, 12317, -468, 12227, -353, 12245, -319, 12151, -190, 12239, -152, 12282, -93, 12326, -108, 12334, -62, 12148, -96, 12094, -141, 12004, -52, 12018, 24, 12272, 43, 12369, 24, 12444, 43, 12524, 142},
+		{12859, 154, 12800, 163, 12793, 217, 12760, 181, 12740, 101, 12770, -27, 12810, -90, 12838, -78, 12797, -25, 12812, 36, 12864, 26},
+		{11805, 229, 11731, 323, 11788, 414, 11587, 431, 11552, 317, 11513, 282, 11462, 143, 11381, 122, 11286, 150, 11238, 141, 11180, 90, 11116, 98, 11051, 77, 10983, 134, 10966, 201, 10907, 134, 10895, 42, 10909, -46, 10957, -131, 11007, -159, 11022, -293, 11170, -299, 11207, -348, 11326, -312, 11376, -344, 11447, -350, 11486, -411, 11600, -366, 11615, -401, 11653, -248, 11656, -149, 11752, -80, 11748, 10, 11781, 78, 11900, 90, 11788, 183},
+		{10586, -431, 10611, -306, 10562, -243, 10489, -234, 10454, -178, 10437, -108, 10401, -106, 10344, -71, 10384, 10, 10308, 56, 10250, 140, 10166, 208, 10064, 210, 9748, 525, 9529, 548, 9538, 497, 9642, 387, 9718, 331, 9770, 245, 9860, 182, 9926, 18, 10014, -65, 10140, -280, 10258, -422, 10387, -504, 10471, -587, 10582, -585},
+		{7687, 3465, 7576, 3450, 7424, 3475, 7375, 3432, 7445, 3276, 7526, 3227, 7441, 3169, 7442, 3098, 7345, 2998, 7282, 2896, 7178, 2791, 7062, 2799, 6951, 2694, 7017, 2649, 7028, 2572, 7084, 2522, 7104, 2436, 6884, 2436, 6818, 2369, 6935, 2284, 6964, 2245, 6916, 2209, 7047, 2088, 7118, 2076, 7263, 2136, 7282, 2042, 7282, 1921, 7353, 1599, 7444, 1462, 7486, 1274, 7575, 1131, 7659, 890, 7754, 797, 7794, 825, 7828, 893, 7919, 922, 7889, 955, 7934, 1031, 7986, 1036, 7986, 1206, 8029, 1301, 8003, 1514, 8032, 1590, 8079, 1595, 8219, 1656, 8219, 1702, 8394, 1830, 8506, 1948, 8650, 2015, 8703, 2074, 8698, 2150, 8889, 2169, 8903, 2206, 8888, 2288, 8853, 2363, 8870, 2423, 8808, 2450, 8831, 2487, 8893, 2524, 8821, 2577, 8856, 2645, 8936, 2601, 8983, 2597, 8992, 2527, 9180, 2515, 9238, 2498, 9192, 2413, 9147, 2407, 9116, 2350, 9171, 2299, 9187, 2362, 9215, 2363, 9267, 2204, 9317, 2228, 9306, 2270, 9329, 2304, 9333, 2408, 9411, 2385, 9455, 2468, 9460, 2516, 9516, 2600, 9512, 2657, 9642, 2726, 9713, 2708, 9705, 2770, 9740, 2788, 9733, 2826, 9625, 2841, 9659, 2883, 9612, 2945, 9540, 2903, 9457, 2928, 9341, 2864, 9250, 2790, 9170, 2777, 9210, 2745, 9203, 2684, 9037, 2688, 8974, 2672, 8884, 2710, 8873, 2809, 8812, 2788, 8806, 2641, 8723, 2640, 8525, 2673, 8468, 2723, 8330, 2736, 8009, 2879, 8048, 2973, 8111, 3018, 7874, 3152, 7846, 3262, 7918, 3248, 7921, 3299, 7881, 3351, 7891, 3432, 7784, 3549},
+		{-695, 5407, -757, 5406, -737, 5460, -757, 5513, -969, 5388, -917, 5286, -998, 5182, -856, 5167, -679, 5226, -603, 5315, -620, 5387},
+		{5383, 3697, 5226, 3670, 5084, 3687, 5015, 3737, 4920, 3758, 4888, 3832, 4863, 3827, 4801, 3879, 4836, 3929, 4806, 3958, 4769, 3951, 4651, 3877, 4614, 3874, 4546, 3887, 4495, 3934, 4479, 3971, 4411, 3943, 4442, 3828, 4423, 3797, 4542, 3598, 4608, 3568, 4615, 3509, 4565, 3475, 4542, 3397, 4611, 3302, 4733, 3247, 4785, 3171, 4769, 3098, 4800, 3099, 4801, 3045, 4857, 2993, 4894, 3032, 4958, 2999, 5012, 3015, 5152, 2787, 5248, 2758, 5349, 2681, 5472, 2648, 5572, 2696, 5649, 2714, 5697, 2697, 5740, 2574, 6150, 2508, 6187, 2624, 6332, 2676, 6323, 2722, 6276, 2738, 6273, 2826, 6177, 2870, 6087, 2983, 6178, 3074, 6170, 3138, 6094, 3155, 6054, 3298, 6096, 3353, 6053, 3368, 6121, 3565, 6112, 3649, 6038, 3653, 5923, 3741, 5844, 3752, 5733, 3803, 5662, 3812, 5618, 3794, 5551, 3796, 5480, 3739, 5392, 3720},
+		{4477, 3717, 4429, 3700, 4394, 3726, 4278, 3739, 4235, 3723, 4184, 3661, 4129, 3636, 4138, 3563, 4101, 3442, 3879, 3338, 3920, 3216, 4040, 3189, 4189, 3119, 4471, 2918, 4657, 2910, 4730, 3006, 4857, 2993, 4801, 3045, 4800, 3099, 4769, 3098, 4785, 3171, 4733, 3247, 4611, 3302, 4542, 3397, 4565, 3475, 4615, 3509, 4608, 3568, 4542, 3598},
+		{-1617, 6653, -1780, 6599, -1906, 6628, -2058, 6573, -2213, 6641, -2365, 6626, -2433, 6561, -2223, 6538, -2218, 6508, -2396, 6489, -2178, 6440, -2276, 6396, -1866, 6350, -1491, 6436, -1361, 6513, -1474, 6581, -1451, 6646},
+		{3570, 3272, 3584, 3287, 3582, 3328, 3510, 3308, 3456, 3155, 3427, 3122, 3492, 2950, 3542, 3110, 3540, 3149, 3493, 3135, 3497, 3162, 3523, 3175, 3497, 3187, 3518, 3253, 3555, 3239},
+		{1476, 3814, 1374, 3803, 1257, 3813, 1243, 3761, 1510, 3662, 1531, 3713, 1516, 3744, 1552, 3823},
+		{871, 4090, 816, 4095, 839, 4038, 843, 3917, 881, 3891, 921, 3924, 967, 3918, 981, 4050, 921, 4121},
+		{1215, 4712, 1116, 4694, 1105, 4675, 1044, 4689, 1036, 4648, 992, 4631, 918, 4644, 897, 4604, 849, 4601, 832, 4616, 776, 4582, 727, 4578, 684, 4599, 680, 4571, 710, 4533, 675, 4503, 701, 4425, 755, 4413, 744, 4369, 785, 4377, 843, 4423, 889, 4437, 1020, 4392, 1051, 4293, 1119, 4236, 1289, 4125, 1363, 4119, 1406, 4079, 1470, 4060, 1500, 4017, 1541, 4005, 1611, 3896, 1589, 3875, 1568, 3791, 1610, 3799, 1664, 3884, 1705, 3890, 1717, 3942, 1645, 3980, 1687, 4044, 1774, 4028, 1829, 3981, 1848, 4017, 1752, 4088, 1589, 4154, 1617, 4174, 1593, 4196, 1514, 4196, 1403, 4276, 1353, 4359, 1259, 4409, 1226, 4460, 1233, 4538, 1314, 4574, 1394, 4559, 1370, 4602, 1381, 4651, 1238, 4677},
+		{-7780, 1852, -7822, 1845, -7834, 1823, -7721, 1770, -7690, 1787, -7620, 1789, -7637, 1816, -7690, 1840},
+		{3555, 3178, 3492, 2950, 3496, 2936, 3607, 2920, 3674, 2987, 3750, 3000, 3767, 3034, 3800, 3051, 3700, 3151, 3920, 3216, 3879, 3338, 3683, 3231, 3572, 3271, 3555, 3239},
+		{13390, 3436, 13349, 3394, 13292, 3406, 13237, 3346, 13236, 3299, 13301, 3270, 13328, 3329, 13379, 3352, 13420, 3320, 13477, 3381, 13464, 3415},
+		{14096, 3817, 14188, 3918, 14191, 3999, 14137, 4138, 14031, 4120, 13988, 4056, 14005, 3944, 13943, 3822, 13739, 3683, 13672, 3730, 13568, 3553, 13461, 3573, 13262, 3543, 13188, 3475, 13088, 3423, 13035, 3360, 12941, 3330, 12981, 3261, 13045, 3232, 13020, 3142, 13069, 3103, 13133, 3145, 13200, 3315, 13099, 3389, 13216, 3390, 13334, 3438, 13508, 3460, 13512, 3385, 13579, 3346, 13722, 3461, 13898, 3467, 14025, 3514, 14077, 3584, 14060, 3634, 14098, 3714},
+		{14314, 4451, 14197, 4555, 14138, 4339, 14031, 4333, 13982, 4256, 13996, 4157, 14107, 4158, 14161, 4268, 14318, 4200, 14406, 4299, 14554, 4326, 14532, 4438, 14461, 4396},
+		{7119, 4270, 7184, 4285, 7349, 4250, 7365, 4309, 7421, 4330, 7564, 4288, 7600, 4299, 7914, 4286, 7964, 4250, 8026, 4235, 8018, 4292, 8087, 4318, 7997, 4492, 8246, 4554, 8318, 4733, 8516, 4700, 8572, 4745, 8577, 4846, 8660, 4855, 8736, 4921, 8683, 4983, 8554, 4969, 8512, 5012, 8442, 5031, 8394, 5089, 8338, 5107, 8195, 5081, 8057, 5139, 8004, 5086, 7780, 5340, 7653, 5418, 7689, 5449, 7438, 5355, 7343, 5349, 7351, 5404, 7222, 5438, 7118, 5413, 7087, 5517, 6907, 5539, 6817, 5497, 6567, 5460, 6518, 5435, 6144, 5401, 6098, 5366, 6170, 5298, 6074, 5272, 6093, 5245, 5997, 5196, 6159, 5127, 6134, 5080, 5993, 5084, 5964, 5055, 5836, 5106, 5678, 5104, 5572, 5062, 5233, 5172, 5077, 5169, 4870, 5061, 4858, 4987, 4755, 5045, 4675, 4936, 4704, 4915, 4647, 4839, 4732, 4772, 4806, 4774, 4869, 4708, 4859, 4656, 4910, 4640, 5119, 4705, 5204, 4680, 5304, 4685, 5322, 4623, 5304, 4526, 5217, 4541, 5132, 4525, 5128, 4451, 5031, 4461, 5034, 4428, 5089, 4403, 5134, 4313, 5250, 4279, 5269, 4244, 5245, 4203, 5250, 4178, 5294, 4212, 5408, 4232, 5476, 4204, 5546, 4126, 5597, 4131, 5593, 4500, 5850, 4559, 6106, 4441, 6201, 4350, 6490, 4373, 6610, 4300, 6602, 4199, 6651, 4199, 6671, 4117, 6799, 4114, 6826, 4066, 6863, 4067, 6907, 4138, 7096, 4227},
+		{4098, 278, 4186, 392, 4117, 392, 4077, 426, 3985, 384, 3956, 342, 3812, 360, 3686, 445, 3616, 445, 3582, 478, 3582, 534, 3530, 551, 3401, 425, 3448, 356, 3504, 191, 3389, 11, 3390, -95, 3770, -310, 3777, -368, 3920, -468, 3960, -435, 3980, -368, 4012, -328, 4026, -257, 4064, -250, 4088, -208, 4159, -168, 4099, -86},
+		{7126, 4217, 7042, 4152, 7116, 4114, 7187, 4139, 7306, 4087, 7177, 4015, 7101, 4024, 7065, 3994, 6956, 4010, 6946, 3953, 7055, 3960, 7178, 3928, 7368, 3943, 7396, 3966, 7382, 3989, 7478, 4037, 7547, 4056, 7653, 4043, 7690, 4107, 7819, 4119, 7854, 4158, 8012, 4212, 8026, 4235, 7964, 4250, 7914, 4286, 7600, 4299, 7564, 4288, 7421, 4330, 7365, 4309, 7349, 4250, 7184, 4285, 7119, 4270, 7096, 4227},
+		{10433, 1049, 10520, 1089, 10625, 1096, 10581, 1157, 10749, 1234, 10761, 1354, 10738, 1420, 10650, 1457, 10604, 1388, 10522, 1427, 10428, 1442, 10299, 1423, 10235, 1339, 10258, 1219, 10350, 1063},
+		{12821, 3837, 12707, 3826, 12668, 3780, 12617, 3775, 12686, 3689, 12612, 3673, 12656, 3568, 12637, 3493, 12649, 3439, 12739, 3448, 12819, 3489, 12909, 3508, 12947, 3563, 12946, 3678, 12921, 3743, 12835, 3861},
+		{2135, 4221, 2158, 4225, 2178, 4268, 2081, 4327, 2007, 4259, 2052, 4222, 2059, 4186, 2072, 4185, 2076, 4205},
+		{4730, 3006, 4657, 2910, 4746, 2900, 4771, 2853, 4842, 2855, 4797, 2998},
+		{10604, 1388, 10650, 1457, 10738, 1420, 10756, 1520, 10731, 1591, 10656, 1660, 10509, 1867, 10390, 1927, 10418, 1962, 10482, 1989, 10444, 2076, 10320, 2077, 10217, 2246, 10165, 2232, 10180, 2117, 10127, 2120, 10118, 2144, 10033, 2079, 10012, 2042, 10055, 2011, 10061, 1951, 10128, 1946, 10104, 1841, 10106, 1751, 10211, 1811, 10241, 1793, 10300, 1796, 10320, 1831, 10396, 1824, 10472, 1743, 10478, 1644, 10559, 1557, 10554, 1472, 10522, 1427},
+		{3607, 3382, 3661, 3420, 3645, 3459, 3600, 3464, 3513, 3309, 3582, 3328},
+		{-764, 519, -757, 571, -860, 647, -839, 691, -844, 769, -872, 771, -893, 731, -921, 731, -940, 753, -934, 793, -976, 854, -1023, 841, -1115, 740, -1144, 679, -900, 483, -771, 436},
+		{1586, 2341, 2384, 1958, 2385, 2000, 2500, 2000, 2500, 2924, 2470, 3004, 2496, 3066, 2480, 3109, 2516, 3157, 2492, 3190, 2324, 3219, 2290, 3264, 2154, 3284, 2085, 3271, 2013, 3224, 1982, 3175, 2005, 3099, 1909, 3027, 1802, 3076, 1571, 3138, 1525, 3227, 1392, 3271, 1308, 3288, 1266, 3279, 1149, 3314, 1143, 3237, 995, 3138, 997, 3054, 948, 3031, 986, 2896, 968, 2814, 972, 2651, 932, 2609, 991, 2537, 995, 2494, 1030, 2438, 1077, 2456, 1156, 2410, 1200, 2347, 1358, 2304, 1414, 2249},
+		{8130, 856, 8084, 927, 8015, 982, 7970, 820, 7987, 676, 8035, 597, 8122, 620, 8164, 648, 8179, 752},
+		{2854, -2865, 2753, -2924, 2700, -2988, 2775, -3065, 2811, -3055, 2829, -3023, 2885, -3007, 2933, -2926},
+		{2324, 5422, 2348, 5391, 2445, 5391, 2554, 5428, 2577, 5485, 2659, 5517, 2649, 5562, 2553, 5610, 2500, 5616, 2486, 5637, 2220, 5634, 2106, 5603, 2127, 5519, 2276, 5486, 2273, 5433},
+		{578, 5009, 567, 4953, 619, 4946, 624, 4990, 604, 5013},
+		{2220, 5634, 2486, 5637, 2500, 5616, 2553, 5610, 2649, 5562, 2818, 5617, 2777, 5724, 2729, 5747, 2646, 5748, 2516, 5797, 2431, 5779, 2412, 5703, 2332, 5701, 2252, 5775, 2158, 5741, 2109, 5678, 2106, 5603},
+		{-593, 3576, -691, 3411, -866, 3324, -930, 3256, -981, 3118, -956, 2993, -1040, 2910, -1169, 2815, -1262, 2804, -1314, 2764, -1377, 2662, -1444, 2625, -1480, 2564, -1482, 2510, -1509, 2452, -1543, 2436, -1598, 2372, -1633, 2302, -1626, 2268, -1659, 2216, -1697, 2189, -1702, 2142, -1475, 2150, -1422, 2231, -1389, 2369, -1250, 2477, -1203, 2603, -1172, 2610, -1139, 2688, -1055, 2699, -974, 2686, -941, 2709, -879, 2712, -867, 2884, -706, 2958, -524, 3000, -486, 3050, -369, 3090, -365, 3164, -307, 3172, -262, 3209, -131, 3226, -112, 3265, -139, 3286, -179, 3453, -217, 3517, -364, 3540, -459, 3533, -519, 3576},
+		{2692, 4812, 2813, 4681, 2805, 4594, 2823, 4549, 2849, 4560, 2893, 4626, 2886, 4644, 3002, 4642, 2956, 4693, 2942, 4735, 2905, 4751, 2912, 4785, 2867, 4812, 2752, 4847, 2662, 4822},
+		{4919, -1204, 4886, -1249, 4885, -1309, 4829, -1378, 4787, -1366, 4801, -1409, 4771, -1459, 4631, -1578, 4445, -1622, 4431, -1685, 4396, -1741, 4404, -1833, 4446, -1944, 4437, -2007, 4390, -2083, 4389, -2116, 4343, -2134, 4325, -2206, 4335, -2278, 4370, -2357, 4376, -2446, 4404, -2499, 4541, -2560, 4710, -2494, 4944, -1795, 4950, -1711, 4977, -1688, 4986, -1645, 4967, -1571, 4986, -1541, 5020, -1600, 5038, -1571, 5048, -1523, 5022, -1476, 5006, -1356, 4981, -1290},
+		{-9753, 2584, -9902, 2637, -9952, 2754, -10011, 2811, -10096, 2938, -10166, 2978, -10248, 2976, -10311, 2897, -10394, 2927, -10446, 2957, -10504, 3064, -10651, 3175, -10824, 3175, -10824, 3134, -11102, 3133, -11482, 3253, -11472, 3272, -11713, 3254, -11552, 2956, -11493, 2928, -11416, 2857, -11420, 2812, -11457, 2774, -11506, 2772, -11447, 2714, -11360, 2664, -11346, 2677, -11230, 2601, -11215, 2547, -11218, 2474, -11030, 2343, -11003, 2282, -10985, 2282, -10941, 2336, -11017, 2427, -11066, 2430, -11071, 2483, -11128, 2573, -11162, 2666, -11276, 2778, -11296, 2843, -11314, 2841, -11327, 2875, -11467, 3016, -11494, 3139, -11478, 3180, -11421, 3152, -11387, 3157, -11315, 3117, -11316, 3079, -11227, 2927, -11223, 2895, -11118, 2794, -11064, 2786, -11039, 2716, -10929, 2644, -10944, 2582, -10926, 2558, -10840, 2517, -10603, 2277, -10527, 2142, -10527, 2108, -10550, 2082, -10540, 2053, -10573, 2043, -10499, 1932, -10392, 1875, -10350, 1829, -10192, 1792, -10083, 1717, -9656, 1565, -9469, 1620, -9388, 1594, -9223, 1454, -9209, 1506, -9223, 1525, -9175, 1607, -9046, 1607, -9044, 1641, -9145, 1725, -9100, 1725, -9100, 1782, -8914, 1781, -8903, 1800, -8885, 1788, -8849, 1849, -8809, 1852, -8784, 1826, -8744, 1947, -8762, 1965, -8738, 2026, -8685, 2085, -8681, 2133, -8705, 2154, -8854, 2149, -9028, 2100, -9077, 1928, -9141, 1888, -9443, 1814, -9484, 1856, -9590, 1883, -9653, 1989, -9719, 2064, -9739, 2141, -9770, 2190, -9787, 2244, -9770, 2427, -9714, 2587},
+		{2046, 4152, 2061, 4109, 2102, 4084, 2167, 4093, 2206, 4115, 2260, 4113, 2295, 4134, 2288, 4200, 2238, 4232, 2076, 4205},
+		{-1212, 1399, -1193, 1342, -1155, 1314, -1146, 1208, -1104, 1221, -1017, 1184, -933, 1233, -913, 1231, -838, 1139, -862, 1081, -841, 1091, -828, 1079, -834, 1049, -803, 1021, -685, 1014, -667, 1043, -621, 1052, -605, 1010, -540, 1037, -547, 1095, -520, 1138, -522, 1171, -443, 1254, -428, 1323, -401, 1347, -352, 1334, -310, 1354, -297, 1380, -219, 1425, -200, 1456, -107, 1497, -52, 1512, -27, 1492, 102, 1497, 139, 1532, 364, 1557, 372, 1618, 427, 1685, 427, 1916, 316, 1906, 315, 1969, 206, 2014, 182, 2061, -492, 2497, -645, 2496, -549, 1633, -532, 1620, -554, 1550, -955, 1549, -970, 1526, -1009, 1533, -1065, 1513, -1167, 1539, -1183, 1480, -1217, 1462},
+		{10012, 2042, 10033, 2079, 10118, 2144, 10115, 2185, 10042, 2156, 9924, 2212, 9953, 2295, 9890, 2314, 9866, 2406, 9760, 2390, 9772, 2508, 9867, 2592, 9868, 2751, 9825, 2775, 9791, 2834, 9733, 2826, 9740, 2788, 9705, 2770, 9713, 2708, 9642, 2726, 9512, 2657, 9516, 2600, 9460, 2516, 9455, 2468, 9411, 2385, 9333, 2408, 9329, 2304, 9306, 2270, 9317, 2228, 9267, 2204, 9265, 2132, 9230, 2148, 9237, 2067, 9308, 1986, 9366, 1973, 9354, 1937, 9432, 1821, 9453, 1728, 9419, 1604, 9537, 1571, 9716, 1693, 9760, 1610, 9810, 1364, 9851, 1312, 9843, 1203, 9876, 1144, 9846, 1068, 9855, 993, 9959, 1189, 9920, 1280, 9910, 1383, 9819, 1512, 9854, 1531, 9890, 1618, 9738, 1845, 9780, 1863, 9825, 1971, 9896, 1975},
+		{2007, 4259, 2034, 4290, 1922, 4352, 1871, 4320, 1845, 4248, 1937, 4188, 1930, 4220, 1974, 4269, 1980, 4250},
+		{8801, 4860, 8885, 4807, 9028, 4769, 9097, 4689, 9059, 4572, 9095, 4529, 9348, 4498, 9469, 4435, 9531, 4424, 9576, 4332, 9635, 4273, 9952, 4252, 10085, 4266, 10183, 4251, 10331, 4191, 10452, 4191, 10496, 4160, 10613, 4213, 10774, 4248, 10924, 4252, 11041, 4287, 11183, 4374, 11135, 4446, 11187, 4510, 11346, 4481, 11446, 4534, 11599, 4573, 11672, 4639, 11742, 4667, 11887, 4681, 11966, 4669, 11977, 4705, 11887, 4775, 11806, 4807, 11730, 4770, 11631, 4785, 11574, 4773, 11549, 4814, 11668, 4989, 11549, 4981, 11496, 5014, 11436, 5025, 11290, 4954, 11066, 4913, 10848, 4928, 10787, 4979, 10689, 5027, 10589, 5041, 10368, 5009, 10226, 5051, 10207, 5126, 9998, 5163, 9886, 5205, 9783, 5101, 9823, 5042, 9726, 4973, 9482, 5001, 9415, 5048, 9310, 5050, 9223, 5080, 9071, 5033, 8881, 4947, 8775, 4930},
+		{3428, -1228, 3456, -1358, 3491, -1357, 3527, -1389, 3569, -1461, 3577, -1590, 3534, -1611, 3503, -1680, 3438, -1618, 3431, -1548, 3452, -1501, 3446, -1461, 3406, -1436, 3379, -1445, 3321, -1397, 3018, -1480, 3034, -1588, 3117, -1586, 3185, -1632, 3233, -1639, 3285, -1671, 3266, -2030, 3224, -2112, 3119, -2225, 3193, -2437, 3175, -2548, 3207, -2673, 3283, -2674, 3292, -2622, 3266, -2615, 3257, -2573, 3301, -2536, 3504, -2448, 3546, -2412, 3561, -2371, 3537, -2354, 3556, -2209, 3539, -2214, 3518, -2125, 3470, -2050, 3479, -1978, 3741, -1759, 3945, -1672, 4009, -1610, 4078, -1469, 4060, -1420, 4048, -1077, 4032, -1032, 3952, -1090, 3843, -1129, 3783, -1127, 3747, -1157, 3651, -1172, 3531, -1144, 3456, -1152},
+		{-1183, 1480, -1167, 1539, -1065, 1513, -1009, 1533, -970, 1526, -955, 1549, -554, 1550, -532, 1620, -549, 1633, -645, 2496, -492, 2497, -868, 2740, -869, 2588, -1197, 2593, -1194, 2337, -1287, 2328, -1312, 2277, -1293, 2133, -1685, 2133, -1706, 2100, -1654, 2057, -1628, 2009, -1638, 1959, -1615, 1811, -1627, 1717, -1655, 1667, -1646, 1614, -1612, 1646, -1562, 1637, -1514, 1659, -1458, 1660, -1344, 1604, -1217, 1462},
+		{3428, -1016, 3374, -942, 3276, -923, 3323, -968, 3349, -1053, 3311, -1161, 3331, -1244, 3299, -1278, 3269, -1371, 3379, -1445, 3406, -1436, 3446, -1461, 3452, -1501, 3431, -1548, 3438, -1618, 3503, -1680, 3534, -1611, 3577, -1590, 3569, -1461, 3527, -1389, 3491, -1357, 3456, -1358, 3428, -1228, 3456, -1152},
+		{10026, 664, 10009, 646, 10031, 604, 10020, 531, 10056, 477, 10070, 394, 10127, 327, 10139, 276, 10352, 123, 10423, 129, 10425, 163, 10385, 252, 10350, 279, 10333, 373, 10338, 486, 10296, 552, 10214, 622, 10181, 581, 10115, 569, 10108, 620},
+		{11844, 497, 11911, 502, 11918, 541, 11769, 599, 11764, 642, 11713, 693, 11673, 692, 11622, 614, 11545, 545, 11535, 432, 11487, 435, 11466, 401, 11420, 453, 11300, 310, 11137, 270, 11117, 185, 11040, 166, 10966, 201, 10983, 134, 11051, 77, 11116, 98, 11180, 90, 11238, 141, 11286, 150, 11381, 122, 11462, 143, 11513, 282, 11552, 317, 11587, 431, 11788, 414, 11862, 448},
+		{1682, -2808, 1722, -2836, 1739, -2878, 1846, -2905, 1900, -2897, 1989, -2846, 1990, -2185, 2088, -2181, 2091, -1825, 2320, -1787, 2358, -1828, 2508, -1758, 2468, -1735, 2403, -1730, 2138, -1793, 1896, -1779, 1826, -1731, 1406, -1742, 1346, -1697, 1281, -1694, 1173, -1730, 1179, -1807, 1261, -1905, 1335, -2087, 1426, -2211, 1441, -2385, 1521, -2709, 1560, -2782, 1634, -2858},
+		{16546, -2080, 16446, -2012, 16403, -2011, 16417, -2044, 16547, -2168, 16674, -2240, 16712, -2216},
+		{249, 1223, 285, 1224, 361, 1166, 368, 1255, 397, 1296, 411, 1353, 437, 1375, 544, 1387, 645, 1349, 682, 1312, 733, 1310, 780, 1334, 901, 1283, 952, 1285, 1011, 1328, 1070, 1325, 1099, 1339, 1230, 1304, 1308, 1360, 1332, 1356, 1400, 1246, 1418, 1248, 1421, 1280, 1450, 1286, 1460, 1333, 1395, 1335, 1396, 1400, 1354, 1437, 1397, 1568, 1525, 1663, 1530, 1793, 1569, 1996, 1590, 2039, 1549, 2073, 1547, 2105, 1510, 2131, 1485, 2286, 1414, 2249, 1358, 2304, 1200, 2347, 857, 2157, 568, 1960, 427, 1916, 427, 1685, 372, 1618, 364, 1557, 139, 1532, 102, 1497, 37, 1493, 30, 1444, 43, 1399, 99, 1334, 102, 1285, 218, 1263, 215, 1194},
+		{876, 548, 923, 644, 952, 645, 1012, 704, 1050, 706, 1106, 664, 1175, 698, 1222, 831, 1275, 872, 1357, 1080, 1442, 1157, 1458, 1209, 1418, 1248, 1400, 1246, 1332, 1356, 1308, 1360, 1230, 1304, 1099, 1339, 1070, 1325, 1011, 1328, 952, 1285, 901, 1283, 780, 1334, 733, 1310, 682, 1312, 645, 1349, 544, 1387, 437, 1375, 411, 1353, 397, 1296, 368, 1255, 357, 1133, 380, 1073, 360, 1033, 371, 1006, 291, 914, 272, 851, 269, 626, 433, 627, 503, 561, 536, 489, 590, 426, 670, 424, 708, 446, 746, 441, 850, 477},
+		{-8556, 1122, -8490, 1095, -8467, 1108, -8436, 1100, -8390, 1073, -8366, 1094, -8386, 1137, -8365, 1163, -8363, 1232, -8347, 1242, -8352, 1357, -8318, 1431, -8328, 1468, -8315, 1500, -8349, 1502, -8445, 1462, -8492, 1479, -8517, 1435, -8580, 1384, -8610, 1404, -8631, 1377, -8676, 1375, -8673, 1326, -8701, 1303, -8739, 1291, -8756, 1306, -8767, 1291, -8571, 1109},
+		{471, 5309, 383, 5162, 331, 5135, 405, 5127, 497, 5148, 616, 5080, 599, 5185, 659, 5185, 684, 5223, 709, 5314, 691, 5348, 607, 5351},
+		{2637, 7099, 2455, 7103, 2302, 7020, 2138, 7026, 1918, 6982, 1476, 6781, 1053, 6449, 855, 6345, 591, 6261, 499, 6197, 531, 5966, 567, 5859, 705, 5808, 838, 5831, 1036, 5947, 1103, 5886, 1230, 6012, 1263, 6129, 1199, 6180, 1193, 6313, 1258, 6407, 1357, 6405, 1392, 6445, 1356, 6479, 1511, 6619, 1677, 6801, 1773, 6801, 1799, 6857, 1988, 6841, 2003, 6907, 2065, 6911, 2124, 6937, 2236, 6884, 2366, 6889, 2474, 6865, 2569, 6909, 2618, 6983, 2773, 7016, 2902, 6977, 2859, 6906, 2940, 6916, 3110, 6956, 3001, 7019, 3129, 7045, 2817, 7119},
+		{2328, 7808, 2288, 7845, 2081, 7825, 2142, 7794, 2073, 7768, 2249, 7744, 2472, 7785},
+		{1699, 8005, 1552, 8002, 1514, 7967, 1372, 7966, 1317, 8001, 1044, 7965, 1122, 7887, 1317, 7802, 1467, 7774, 1376, 7738, 1591, 7677, 1712, 7681, 1759, 7764, 1847, 7783, 1903, 7856, 2154, 7896},
+		{2292, 8066, 2191, 8036, 2046, 8060, 1737, 8032, 1846, 7986, 1990, 7984, 2008, 7957, 2302, 7940, 2592, 7952, 2741, 8006},
+		{8695, 2797, 8582, 2820, 8501, 2864, 8423, 2884, 8390, 2932, 8334, 2946, 8233, 3012, 8153, 3042, 8048, 2973, 8009, 2879, 8330, 2736, 8468, 2723, 8525, 2673, 8723, 2640, 8806, 2641, 8812, 2788},
+		{17280, -4049, 17210, -4096, 17195, -4151, 17157, -4177, 17113, -4251, 17052, -4303, 16895, -4394, 16830, -4412, 16705, -4511, 16651, -4585, 16668, -4622, 16776, -4629, 16841, -4662, 16933, -4664, 17062, -4591, 17145, -4424, 17231, -4387, 17308, -4385, 17271, -4337, 17425, -4177, 17425, -4135, 17396, -4093, 17325, -4133},
+		{17433, -3527, 17355, -3501, 17301, -3445, 17264, -3453, 17305, -3524, 17432, -3653, 17470, -3738, 17474, -3803, 17457, -3880, 17385, -3915, 17382, -3951, 17490, -3991, 17523, -4046, 17465, -4128, 17507, -4143, 17524, -4169, 17601, -4129, 17703, -3988, 17694, -3945, 17721, -3915, 17797, -3917, 17852, -3770, 17801, -3758, 17744, -3796, 17676, -3788, 17596, -3756, 17581, -3680, 17536, -3653, 17534, -3721, 17461, -3616},
+		{5928, 2143, 5981, 2253, 5945, 2266, 5873, 2357, 5740, 2388, 5685, 2424, 5640, 2492, 5589, 2492, 5580, 2427, 5598, 2413, 5553, 2393, 5553, 2352, 5523, 2311, 5521, 2271, 5567, 2200, 5500, 2000, 5200, 1900, 5311, 1665, 5424, 1704, 5479, 1695, 5527, 1723, 5527, 1763, 5566, 1788, 5628, 1788, 5651, 1809, 5661, 1857, 5723, 1895, 5769, 1894, 5767, 1974, 5783, 2024, 5803, 2048, 5849, 2043, 5886, 2111},
+		{5649, 2631, 5636, 2640, 5607, 2606, 5626, 2571},
+		{7458, 3702, 7185, 3651, 7126, 3607, 7161, 3515, 7112, 3473, 7116, 3435, 7088, 3399, 6993, 3402, 7032, 3336, 6969, 3311, 6926, 3250, 6932, 3190, 6893, 3162, 6856, 3171, 6779, 3158, 6768, 3130, 6694, 3130, 6638, 3074, 6635, 2989, 6505, 2947, 6435, 2956, 6415, 2934, 6355, 2947, 6255, 2932, 6087, 2983, 6177, 2870, 6273, 2826, 6276, 2738, 6323, 2722, 6332, 2676, 6187, 2624, 6150, 2508, 6637, 2543, 6715, 2466, 6744, 2394, 6818, 2369, 6884, 2436, 7104, 2436, 7084, 2522, 7028, 2572, 7017, 2649, 6951, 2694, 7062, 2799, 7178, 2791, 7282, 2896, 7345, 2998, 7442, 3098, 7441, 3169, 7526, 3227, 7445, 3276, 7375, 3432, 7424, 3475, 7576, 3450, 7687, 3465, 7784, 3549, 7619, 3590, 7590, 3667, 7516, 3713},
+		{-7775, 771, -7743, 764, -7724, 794, -7747, 852, -7735, 867, -7850, 942, -7957, 961, -7991, 931, -8144, 879, -8171, 903, -8221, 900, -8219, 921, -8255, 957, -8293, 948, -8293, 907, -8272, 893, -8287, 881, -8297, 823, -8285, 807, -8282, 829, -8172, 811, -8152, 771, -8119, 765, -8106, 782, -8089, 722, -8042, 727, -8000, 755, -8048, 809, -8038, 830, -7976, 858, -7956, 893, -7912, 900, -7818, 832, -7843, 805, -7821, 751, -7788, 722},
+		{-6896, -1650, -6939, -1566, -6916, -1532, -6934, -1495, -6895, -1445, -6888, -1290, -6867, -1256, -6953, -1095, -7009, -1112, -7055, -1101, -7048, -949, -7130, -1008, -7218, -1005, -7256, -952, -7323, -946, -7302, -903, -7357, -842, -7399, -752, -7372, -734, -7372, -692, -7312, -663, -7322, -609, -7296, -574, -7289, -527, -7175, -459, -7079, -425, -6989, -430, -7039, -377, -7069, -374, -7005, -273, -7081, -226, -7141, -234, -7177, -217, -7233, -243, -7307, -231, -7366, -126, -7511, -6, -7537, -15, -7523, -91, -7554, -156, -7664, -261, -7784, -300, -7845, -387, -7864, -455, -7921, -496, -7962, -445, -8044, -443, -8047, -406, -8018, -382, -8030, -340, -8110, -404, -8141, -474, -8093, -569, -8125, -614, -7976, -719, -7711, -1222, -7626, -1354, -7642, -1382, -7601, -1465, -7344, -1636, -7146, -1736, -7138, -1777, -7037, -1835, -6986, -1809},
+		{12631, 878, 12622, 929, 12541, 976, 12547, 899, 12476, 896, 12460, 851, 12384, 824, 12349, 869, 12231, 803, 12192, 719, 12209, 690, 12283, 746, 12330, 742, 12361, 783, 12424, 736, 12394, 689, 12422, 616, 12540, 558, 12568, 605, 12536, 679, 12583, 729, 12620, 627, 12654, 719},
+		{12408, 1123, 12334, 1027, 12350, 1094, 12295, 1088, 12284, 1026, 12238, 971, 12300, 902, 12398, 1028},
+		{11903, 1000, 11969, 1055, 11951, 1137, 11899, 1038, 11766, 907, 11717, 837, 11850, 932},
+		{12204, 1142, 12200, 1044, 12310, 1117, 12312, 1158, 12248, 1158, 12188, 1189},
+		{12523, 1254, 12427, 1256, 12488, 1179, 12489, 1142, 12430, 1150, 12446, 1089, 12476, 1084, 12480, 1013, 12528, 1036, 12501, 1131, 12578, 1105, 12550, 1216},
+		{12118, 1343, 12032, 1347, 12126, 1221, 12153, 1307},
+		{12072, 1851, 12039, 1760, 12029, 1603, 11988, 1636, 12007, 1497, 12056, 1440, 12069, 1476, 12099, 1453, 12068, 1427, 12063, 1386, 12113, 1364, 12203, 1378, 12267, 1319, 12293, 1355, 12330, 1303, 12408, 1254, 12418, 1300, 12386, 1324, 12395, 1378, 12270, 1434, 12226, 1422, 12173, 1433, 12151, 1512, 12166, 1593, 12225, 1626, 12252, 1709, 12217, 1781, 12234, 1822, 12225, 1848, 12194, 1822, 12132, 1850},
+		{15602, -654, 15476, -534, 15465, -504, 15451, -514, 15473, -590, 15517, -654, 15560, -692, 15588, -682},
+		{15232, -487, 15234, -431, 15214, -415, 15154, -417, 15165, -476, 15081, -546, 15024, -553, 15014, -500, 15000, -503, 14985, -551, 14930, -558, 14840, -544, 14832, -575, 14971, -632, 15024, -632, 15130, -584, 15146, -556, 15198, -548},
+		{14697, -672, 14789, -661, 14765, -608, 14598, -547, 14583, -488, 14458, -386, 14100, -260, 14103, -912, 14263, -933, 14341, -898, 14329, -825, 14474, -763, 14605, -807, 14657, -894, 14791, -1013, 14978, -1039, 15003, -1065, 15069, -1058, 15080, -1029, 14974, -987, 15004, -968, 14927, -951, 14931, -907, 14873, -910, 14808, -804, 14719, -739},
+		{15302, -398, 15224, -324, 15148, -278, 15094, -250, 15066, -274, 15138, -304, 15241, -379, 15283, -477, 15314, -450},
+		{1549, 5078, 1624, 5070, 1618, 5042, 1672, 5022, 1687, 5047, 1755, 5036, 1765, 5005, 1839, 4999, 1891, 4944, 1932, 4957, 1983, 4922, 2042, 4943, 2089, 4933, 2161, 4947, 2278, 4903, 2252, 4948, 2343, 5031, 2392, 5042, 2403, 5071, 2320, 5249, 2380, 5269, 2380, 5309, 2324, 5422, 2273, 5433, 1870, 5444, 1862, 5468, 1762, 5485, 1636, 5451, 1412, 5376, 1435, 5325, 1407, 5298, 1469, 5209, 1461, 5175, 1502, 5111},
+		{-6710, 1852, -6724, 1837, -6718, 1795, -6585, 1798, -6559, 1823, -6577, 1843},
+		{12999, 4299, 12960, 4242, 12805, 4199, 12821, 4147, 12734, 4150, 12687, 4182, 12618, 4111, 12508, 4057, 12427, 3993, 12532, 3955, 12539, 3939, 12513, 3885, 12522, 3867, 12471, 3811, 12524, 3786, 12528, 3767, 12569, 3794, 12617, 3775, 12668, 3780, 12707, 3826, 12821, 3837, 12835, 3861, 12739, 3921, 12753, 3976, 12863, 4019, 12971, 4088, 12967, 4160, 13040, 4228, 13078, 4222},
+		{-899, 4154, -877, 4076, -905, 3976, -945, 3939, -953, 3874, -929, 3836, -884, 3827, -875, 3765, -890, 3687, -838, 3698, -786, 3684, -745, 3710, -754, 3743, -703, 3808, -737, 3837, -710, 3903, -750, 3963, -707, 3971, -703, 4018, -686, 4033, -685, 4111, -639, 4138, -667, 4188, -801, 4179, -826, 4228, -903, 4188},
+		{-6085, -2388, -6003, -2403, -5881, -2477, -5778, -2516, -5763, -2560, -5862, -2712, -5649, -2755, -5570, -2739, -5479, -2662, -5429, -2457, -5429, -2402, -5465, -2384, -5503, -2400, -5540, -2396, -5561, -2266, -5580, -2236, -5647, -2209, -5688, -2228, -5794, -2209, -5787, -2073, -5818, -1987, -5912, -1936, -6004, -1934, -6179, -1963, -6227, -2051, -6229, -2105, -6269, -2225},
+		{3518, 3253, 3497, 3187, 3523, 3175, 3497, 3162, 3493, 3135, 3540, 3149, 3555, 3178, 3555, 3239},
+		{5111, 2456, 5139, 2463, 5161, 2522, 5159, 2580, 5129, 2611, 5101, 2601, 5074, 2548, 5081, 2475},
+		{2210, 4767, 2102, 4632, 2022, 4613, 2076, 4573, 2087, 4542, 2148, 4518, 2156, 4477, 2215, 4448, 2246, 4470, 2271, 4458, 2247, 4441, 2294, 4382, 2557, 4369, 2607, 4394, 2724, 4418, 2856, 4371, 2884, 4491, 2914, 4482, 2963, 4504, 2960, 4529, 2915, 4546, 2868, 4530, 2823, 4549, 2805, 4594, 2813, 4681, 2692, 4812, 2620, 4822, 2595, 4799, 2487, 4774, 2440, 4798, 2314, 4810},
+		{14324, 5176, 14326, 5274, 14265, 5437, 14221, 5423, 14261, 5376, 14168, 5330, 14159, 5194, 14218, 5095, 14214, 4962, 14190, 4886, 14202, 4778, 14191, 4681, 14209, 4597, 14275, 4674, 14351, 4614, 14353, 4684, 14256, 4786, 14317, 4931, 14465, 4898},
+		{2265, 5458, 2276, 5486, 2127, 5519, 1989, 5487, 1966, 5443, 2273, 5433},
+		{-17493, 6721, -18000, 6896, -18000, 6498, -17943, 6540, -17988, 6587, -17869, 6611, -17890, 6574, -17836, 6539, -17722, 6552, -17621, 6536, -17598, 6492, -17465, 6463, -17389, 6428, -17296, 6425, -17256, 6446, -17253, 6544, -17089, 6554, -16990, 6598, -17186, 6691, -17457, 6706, -17434, 6634, -17501, 6658},
+		{18000, 7152, 17873, 7110, 17890, 7078, 18000, 7083},
+		{-17766, 7113, -17758, 7127, -17902, 7156, -18000, 7152, -18000, 7083, -17869, 7089},
+		{14348, 7348, 14206, 7386, 14081, 7377, 13986, 7337, 14360, 7321},
+		{14822, 7535, 14636, 7550, 14612, 7517, 14798, 7478, 14958, 7469, 15073, 7508},
+		{14147, 7609, 13883, 7614, 13751, 7595, 13697, 7526, 13896, 7461, 14061, 7485, 14430, 7482, 14509, 7556},
+		{5562, 7154, 5542, 7237, 5848, 7431, 6158, 7526, 6818, 7623, 6885, 7654, 6816, 7694, 6621, 7681, 6450, 7644, 6117, 7625, 5563, 7508, 5590, 7463, 5351, 7375, 5443, 7363, 5244, 7277, 5248, 7223, 5146, 7201, 5160, 7147, 5341, 7121, 5368, 7076, 5754, 7072},
+		{10471, 7713, 10607, 7737, 10435, 7770, 10199, 7729, 10104, 7686, 10076, 7643, 9892, 7645, 9668, 7592, 9586, 7614, 9323, 7605, 9290, 7577, 9026, 7564, 8832, 7514, 8717, 7512, 8601, 7446, 8682, 7394, 8225, 7385, 8051, 7365, 8061, 7258, 8150, 7175, 7965, 7232, 7758, 7227, 7590, 7187, 7636, 7115, 7529, 7134, 7568, 7230, 7516, 7285, 7466, 7283, 7489, 7212, 7310, 7145, 7440, 7063, 7360, 6963, 7384, 6907, 7494, 6899, 7447, 6833, 7505, 6776, 7419, 6728, 7392, 6679, 7282, 6653, 7242, 6617, 7128, 6632, 7324, 6774, 7367, 6841, 7256, 6902, 7279, 7039, 7247, 7109, 7185, 7141, 7280, 7222, 7259, 7278, 6994, 7304, 6920, 7284, 6854, 7193, 6669, 7103, 6672, 7071, 6726, 6993, 6693, 6945, 6814, 6936, 6816, 6914, 6918, 6862, 6851, 6809, 6350, 6955, 6055, 6985, 6003, 6952, 6108, 6894, 5994, 6828, 5880, 6888, 5732, 6847, 5544, 6844, 5473, 6810, 5349, 6820, 5447, 6881, 5372, 6886, 4814, 6752, 4789, 6688, 4635, 6667, 4556, 6701, 4556, 6757, 4682, 6769, 4625, 6825, 4345, 6857, 4419, 6795, 4370, 6735, 4453, 6676, 4395, 6607, 4302, 6642, 4209, 6648, 3976, 6550, 4044, 6476, 3959, 6452, 3718, 6514, 3654, 6476, 3714, 6433, 3701, 6385, 3494, 6441, 3481, 6590, 3318, 6663, 3392, 6676, 3838, 6600, 4002, 6627, 4113, 6679, 4106, 6746, 4029, 6793, 3651, 6906, 3378, 6930, 3213, 6991, 2859, 6906, 2845, 6836, 2998, 6770, 2905, 6694, 3022, 6581, 2954, 6495, 3044, 6420, 3004, 6355, 3152, 6287, 3114, 6236, 2807, 6050, 2912, 6003, 2798, 5948, 2813, 5930, 2742, 5872, 2772, 5779, 2729, 5747, 2777, 5724, 2818, 5617, 2923, 5592, 2937, 5567, 2990, 5579, 3087, 5555, 3097, 5508, 3076, 5481, 3138, 5416, 3179, 5397, 3173, 5379, 3241, 5362, 3269, 5335, 3230, 5313, 3131, 5307, 3179, 5210, 3216, 5206, 3241, 5229, 3375, 5234, 3439, 5177, 3414, 5157, 3422, 5126, 3502, 5121, 3538, 5077, 3536, 5058, 3663, 5023, 3739, 5038, 3801, 4992, 4007, 4960, 4008, 4931, 3967, 4878, 3990, 4823, 3974, 4790, 3877, 4783, 3826, 4755, 3822, 4710, 3912, 4726, 3915, 4704, 3767, 4664, 3823, 4624, 3740, 4540, 3668, 4524, 3754, 4466, 3868, 4428, 3996, 4343, 4008, 4355, 4239, 4322, 4376, 4274, 4393, 4255, 4454, 4271, 4547, 4250, 4578, 4209, 4669, 4183, 4737, 4122, 4782, 4115, 4858, 4181, 4749, 4299, 4759, 4366, 4668, 4461, 4768, 4564, 4865, 4581, 4910, 4640, 4859, 4656, 4869, 4708, 4806, 4774, 4732, 4772, 4647, 4839, 4704, 4915, 4675, 4936, 4755, 5045, 4858, 4987, 4870, 5061, 5077, 5169, 5233, 5172, 5572, 5062, 5678, 5104, 5836, 5106, 5964, 5055, 5993, 5084, 6134, 5080, 6159, 5127, 5997, 5196, 6093, 5245, 6074, 5272, 6170, 5298, 6098, 5366, 6144, 5401, 6518, 5435, 6567, 5460, 6817, 5497, 6907, 5539, 7087, 5517, 7118, 5413, 7222, 5438, 7351, 5404, 7343, 5349, 7438, 5355, 7689, 5449, 7653, 5418, 7780, 5340, 8004, 5086, 8057, 5139, 8195, 5081, 8338, 5107, 8394, 5089, 8442, 5031, 8512, 5012, 8554, 4969, 8683, 4983, 8736, 4921, 8881, 4947, 9071, 5033, 9223, 5080, 9310, 5050, 9415, 5048, 9482, 5001, 9726, 4973, 9823, 5042, 9783, 5101, 9886, 5205, 9998, 5163, 10207, 5126, 10226, 5051, 10368, 5009, 10589, 5041, 10689, 5027, 10787, 4979, 10848, 4928, 11066, 4913, 11290, 4954, 11436, 5025, 11496, 5014, 11549, 4981, 11668, 4989, 11788, 4951, 11929, 5014, 11928, 5058, 12018, 5164, 12074, 5196, 12073, 5252, 12018, 5275, 12100, 5325, 12357, 5346, 12507, 5316, 12595, 5279, 12729, 5074, 12766, 4976, 12940, 4944, 13058, 4873, 13099, 4779, 13251, 4779, 13337, 4818, 13503, 4848, 13450, 4758, 13411, 4721, 13377, 4612, 13310, 4514, 13188, 4532, 13103, 4497, 13129, 4411, 13114, 4293, 13063, 4290, 13064, 4240, 13078, 4222, 13094, 4255, 13228, 4328, 13291, 4280, 13354, 4281, 13487, 4340, 13822, 4631, 13855, 4700, 14006, 4845, 14051, 5005, 14060, 5124, 14138, 5224, 14135, 5309, 13990, 5419, 13880, 5425, 13816, 5376, 13719, 5398, 13670, 5460, 13513, 5473, 14220, 5904, 14549, 5934, 14854, 5916, 14978, 5966, 15134, 5950, 15127, 5878, 15504, 5914, 15422, 5976, 15672, 6143, 15930, 6177, 16012, 6054, 16266, 6164, 16326, 6247, 16447, 6255, 16367, 6114, 16187, 6034, 15836, 5806, 15681, 5783, 15676, 5736, 15591, 5677, 15543, 5538, 15642, 5170, 15679, 5101, 15823, 5194, 15853, 5296, 16002, 5320, 16037, 5434, 16212, 5486, 16170, 5529, 16213, 5612, 16306, 5616, 16319, 5762, 16205, 5784, 16202, 5824, 16322, 5921, 16354, 5987, 16488, 5973, 16584, 6016, 16629, 5979, 16890, 6057, 17033, 5988, 17070, 6034, 17368, 6165, 17736, 6252, 17923, 6230, 17949, 6257, 17937, 6298, 17891, 6325, 17831, 6408, 17741, 6461, 17871, 6453, 18000, 6498, 18000, 6896, 17860, 6940, 17572, 6988, 17364, 6982, 17045, 7010, 17001, 6965, 17082, 6901, 16958, 6869, 16784, 6958, 16594, 6947, 16405, 6967, 16228, 6964, 16094, 6944, 15971, 6972, 15983, 7045, 15900, 7087, 15701, 7103, 15297, 7084, 15035, 7161, 14950, 7220, 14047, 7285, 13915, 7242, 13987, 7149, 13823, 7163, 13750, 7135, 13556, 7166, 13386, 7139, 13225, 7184, 13129, 7079, 12972, 7119, 12846, 7198, 12905, 7240, 12859, 7304, 12698, 7357, 12326, 7374, 12320, 7297, 11902, 7312, 11878, 7359, 11557, 7375, 11397, 7359, 11353, 7334, 11302, 7398, 11212, 7379, 10940, 7418, 11015, 7448, 11389, 7533, 11413, 7585, 11333, 7622, 11108, 7671, 10815, 7672, 10724, 7648, 10697, 7697},
+		{10537, 7871, 10209, 7935, 10126, 7923, 9944, 7792, 10508, 7831},
+		{5152, 8070, 5004, 8092, 4852, 8051, 4832, 8078, 4485, 8059, 4707, 8056, 4650, 8025, 4759, 8001},
+		{10019, 7978, 9788, 8075, 9594, 8125, 9378, 8102, 9118, 8034, 9255, 8014, 9331, 7943, 9497, 7904, 9776, 7876, 9994, 7888},
+		{2982, -144, 2958, -134, 2929, -162, 2902, -284, 2963, -292, 2994, -235, 3047, -241, 3076, -229, 3082, -170, 3042, -113},
+		{-941, 2709, -974, 2686, -1055, 2699, -1139, 2688, -1172, 2610, -1203, 2603, -1250, 2477, -1389, 2369, -1422, 2231, -1475, 2150, -1702, 2142, -1706, 2100, -1685, 2133, -1293, 2133, -1312, 2277, -1287, 2328, -1194, 2337, -1197, 2593, -869, 2588, -867, 2766, -882, 2766, -879, 2712},
+		{4322, 1667, 4312, 1709, 4338, 1758, 4379, 1732, 4522, 1743, 4675, 1728, 4700, 1695, 4747, 1712, 4818, 1817, 4912, 1862, 5200, 1900, 5500, 2000, 5567, 2200, 5521, 2271, 5501, 2250, 5200, 2300, 5139, 2463, 5111, 2456, 5081, 2475, 5024, 2561, 5015, 2669, 4947, 2711, 4930, 2746, 4881, 2769, 4842, 2855, 4771, 2853, 4746, 2900, 4471, 2918, 4189, 3119, 4040, 3189, 3920, 3216, 3700, 3151, 3800, 3051, 3767, 3034, 3750, 3000, 3674, 2987, 3607, 2920, 3496, 2936, 3463, 2806, 3513, 2806, 3664, 2583, 3693, 2560, 3748, 2429, 3849, 2369, 3907, 2258, 3914, 2129, 3980, 2034, 4025, 2017, 4094, 1949, 4122, 1867, 4175, 1783, 4227, 1747, 4278, 1635},
+		{3396, 958, 3426, 1063, 3473, 1091, 3526, 1208, 3586, 1258, 3627, 1356, 3643, 1442, 3632, 1482, 3685, 1696, 3717, 1726, 3790, 1743, 3841, 1800, 3748, 1861, 3711, 1981, 3697, 2084, 3719, 2102, 3687, 2200, 2500, 2200, 2500, 2000, 2385, 2000, 2389, 1561, 2302, 1568, 2230, 1433, 2251, 1409, 2218, 1379, 2230, 1337, 2194, 1259, 2229, 1265, 2250, 1226, 2251, 1168, 2288, 1138, 2298, 1071, 2355, 1009, 2346, 895, 2389, 862, 2454, 892, 2507, 1027, 2579, 1041, 2648, 955, 2675, 947, 2711, 964, 2783, 960, 2797, 940, 2897, 940, 2900, 960, 2952, 979, 2962, 1008, 3000, 1029, 3084, 971, 3135, 981, 3240, 1108, 3231, 1168, 3207, 1197, 3267, 1202, 3274, 1225, 3321, 1218, 3309, 1144, 3321, 1072, 3372, 1033, 3382, 948},
+		{3382, 948, 3372, 1033, 3321, 1072, 3309, 1144, 3321, 1218, 3274, 1225, 3267, 1202, 3207, 1197, 3231, 1168, 3240, 1108, 3135, 981, 3084, 971, 3000, 1029, 2962, 1008, 2952, 979, 2900, 960, 2897, 940, 2797, 940, 2783, 960, 2711, 964, 2675, 947, 2648, 955, 2579, 1041, 2507, 1027, 2454, 892, 2389, 862, 2511, 783, 2512, 750, 2621, 655, 2647, 595, 2721, 555, 2798, 441, 2843, 429, 2972, 460, 2995, 417, 3083, 351, 3125, 378, 3188, 356, 3269, 379, 3339, 379, 3530, 551, 3471, 659, 3425, 683, 3408, 723, 3357, 771, 3295, 778, 3329, 835, 3383, 838, 3397, 868, 3396, 946},
+		{-1562, 1362, -1540, 1386, -1508, 1388, -1469, 1363, -1405, 1379, -1384, 1351, -1428, 1328, -1514, 1351, -1593, 1313, -1684, 1315, -1668, 1238, -1555, 1263, -1322, 1258, -1250, 1233, -1151, 1244, -1155, 1314, -1193, 1342, -1217, 1462, -1344, 1604, -1458, 1660, -1514, 1659, -1562, 1637, -1612, 1646, -1719, 1492, -1763, 1473, -1713, 1437, -1671, 1359},
+		{16192, -1045, 16132, -1020, 16170, -1082, 16240, -1083},
+		{16069, -961, 15970, -924, 15964, -964, 15985, -979, 16085, -987},
+		{16128, -912, 16092, -832, 16058, -832, 16079, -892, 16153, -978, 16168, -960},
+		{15964, -802, 15836, -732, 15821, -742, 15992, -854},
+		{15714, -702, 15654, -660, 15649, -677, 15690, -718, 15734, -740, 15754, -735},
+		{-1120, 711, -1115, 740, -1023, 841, -1051, 835, -1062, 927, -1112, 1005, -1192, 1005, -1243, 984, -1271, 934, -1325, 890, -1312, 816, -1243, 726, -1171, 686, -1144, 679},
+		{-8772, 1379, -8807, 1396, -8850, 1385, -8935, 1442, -8959, 1436, -8953, 1424, -9010, 1374, -8981, 1352, -8884, 1326, -8790, 1315},
+		{4894, 997, 4895, 1141, 4838, 1138, 4665, 1082, 4412, 1045, 4347, 1128, 4315, 1146, 4256, 1057, 4368, 918, 4695, 800, 4779, 800, 4894, 945},
+		{4927, 1143, 4894, 1139, 4894, 945, 4779, 800, 4496, 500, 4366, 496, 4277, 425, 4213, 423, 4098, 278, 4099, -86, 4159, -168, 4204, -92, 4314, 29, 4656, 286, 4859, 534, 4945, 680, 5055, 920, 5105, 1064, 5111, 1202, 5073, 1202, 5026, 1168},
+		{2076, 4573, 2022, 4613, 1960, 4617, 1883, 4591, 1939, 4524, 1901, 4486, 1937, 4486, 1912, 4442, 1960, 4404, 1945, 4357, 1922, 4352, 2034, 4290, 2026, 4281, 2081, 4327, 2178, 4268, 2158, 4225, 2238, 4232, 2255, 4246, 2244, 4258, 2260, 4290, 2299, 4321, 2250, 4364, 2241, 4401, 2266, 4423, 2247, 4441, 2271, 4458, 2246, 4470, 2215, 4448, 2156, 4477, 2148, 4518, 2087, 4542},
+		{-5731, 507, -5791, 481, -5804, 406, -5760, 333, -5728, 333, -5715, 277, -5654, 190, -5600, 182, -5597, 251, -5510, 252, -5452, 231, -5401, 362, -5440, 421, -5448, 490, -5396, 576, -5503, 603, -5584, 595, -5595, 577, -5715, 597},
+		{1855, 4950, 1789, 4890, 1710, 4882, 1688, 4847, 1698, 4812, 1786, 4776, 1870, 4788, 1878, 4808, 2024, 4833, 2080, 4862, 2187, 4832, 2256, 4909, 2161, 4947, 2089, 4933, 2042, 4943, 1983, 4922, 1932, 4957},
+		{1370, 4602, 1394, 4559, 1372, 4550, 1441, 4547, 1460, 4563, 1533, 4545, 1532, 4573, 1567, 4583, 1577, 4624, 1656, 4650, 1637, 4684, 1620, 4685, 1601, 4668, 1514, 4666, 1463, 4643, 1381, 4651},
+		{2390, 6601, 2357, 6640, 2354, 6794, 2065, 6911, 2003, 6907, 1988, 6841, 1799, 6857, 1773, 6801, 1677, 6801, 1511, 6619, 1356, 6479, 1392, 6445, 1357, 6405, 1258, 6407, 1193, 6313, 1199, 6180, 1263, 6129, 1230, 6012, 1103, 5886, 1179, 5744, 1263, 5631, 1294, 5536, 1410, 5541, 1467, 5620, 1588, 5610, 1645, 5704, 1683, 5872, 1787, 5895, 1879, 6008, 1783, 6064, 1712, 6134, 1785, 6275, 2137, 6441, 2121, 6503, 2218, 6572},
+		{3199, -2629, 3184, -2584, 3133, -2566, 3104, -2573, 3068, -2640, 3069, -2674, 3128, -2729, 3187, -2718, 3207, -2673},
+		{4101, 3442, 4138, 3563, 4129, 3636, 4184, 3661, 4235, 3723, 4067, 3709, 3952, 3672, 3870, 3671, 3817, 3690, 3707, 3662, 3674, 3682, 3669, 3626, 3591, 3541, 3600, 3464, 3645, 3459, 3661, 3420, 3607, 3382, 3570, 3272, 3683, 3231},
+		{1489, 1222, 1492, 1089, 1547, 998, 1417, 1002, 1395, 955, 1454, 897, 1498, 880, 1544, 769, 1528, 742, 1611, 750, 1629, 775, 1671, 751, 1796, 789, 1891, 863, 1881, 898, 2006, 901, 2100, 948, 2172, 1057, 2223, 1097, 2286, 1114, 2288, 1138, 2251, 1168, 2250, 1226, 2229, 1265, 2194, 1259, 2230, 1337, 2218, 1379, 2251, 1409, 2230, 1433, 2302, 1568, 2389, 1561, 2384, 1958, 1586, 2341, 1485, 2286, 1510, 2131, 1547, 2105, 1549, 2073, 1590, 2039, 1569, 1996, 1530, 1793, 1525, 1663, 1397, 1568, 1354, 1437, 1396, 1400, 1395, 1335, 1460, 1333, 1450, 1286},
+		{162, 683, 166, 913, 146, 933, 143, 983, 77, 1047, 90, 1100, 2, 1102, -5, 1071, 37, 1019, 46, 868, 71, 831, 49, 741, 57, 691, 106, 593, 187, 614},
+		{10235, 1339, 10299, 1423, 10428, 1442, 10522, 1427, 10554, 1472, 10559, 1557, 10478, 1644, 10472, 1743, 10396, 1824, 10320, 1831, 10300, 1796, 10241, 1793, 10211, 1811, 10106, 1751, 10104, 1841, 10128, 1946, 10061, 1951, 10055, 2011, 10012, 2042, 9896, 1975, 9825, 1971, 9780, 1863, 9738, 1845, 9890, 1618, 9854, 1531, 9819, 1512, 9910, 1383, 9920, 1280, 9959, 1189, 9855, 993, 9815, 835, 9834, 779, 9850, 838, 9952, 734, 9969, 685, 10009, 646, 10026, 664, 10108, 620, 10115, 569, 10181, 581, 10214, 622, 10162, 674, 10102, 686, 10046, 743, 10028, 830, 9987, 921, 9922, 924, 9915, 996, 10002, 1231, 10010, 1341, 10098, 1341, 10083, 1263, 10169, 1265, 10258, 1219},
+		{7060, 4022, 7046, 4050, 7067, 4096, 6933, 4073, 6854, 3953, 6770, 3958, 6744, 3914, 6818, 3890, 6839, 3816, 6783, 3714, 6814, 3702, 6886, 3734, 6920, 3715, 6952, 3761, 7012, 3759, 7038, 3814, 7081, 3849, 7135, 3826, 7124, 3795, 7154, 3791, 7145, 3707, 7184, 3674, 7264, 3705, 7326, 3750, 7498, 3742, 7486, 3838, 7426, 3861, 7393, 3851, 7368, 3943, 7178, 3928, 7055, 3960, 6946, 3953, 6956, 4010, 7065, 3994, 7101, 4024},
+		{6223, 3527, 6298, 3540, 6319, 3586, 6398, 3601, 6455, 3631, 6475, 3711, 6559, 3731, 6575, 3766, 6652, 3736, 6655, 3797, 6417, 3889, 6237, 4005, 6188, 4108, 6155, 4127, 6047, 4122, 6008, 4143, 5998, 4222, 5863, 4275, 5779, 4217, 5693, 4183, 5710, 4132, 5546, 4126, 5476, 4204, 5408, 4232, 5294, 4212, 5250, 4178, 5281, 4114, 5292, 4187, 5372, 4212, 5401, 4155, 5474, 4095, 5386, 4063, 5292, 4088, 5269, 4003, 5336, 3998, 5310, 3929, 5388, 3895, 5374, 3791, 5392, 3720, 5480, 3739, 5551, 3796, 5618, 3794, 5662, 3812, 5733, 3803, 5844, 3752, 5923, 3741, 6038, 3653, 6112, 3649, 6121, 3565},
+		{12507, -909, 12509, -939, 12734, -840, 12696, -827, 12509, -866, 12497, -889},
+		{-6166, 1037, -6195, 1009, -6094, 1011, -6090, 1086, -6168, 1076},
+		{997, 3054, 995, 3138, 1143, 3237, 1149, 3314, 1111, 3329, 1086, 3377, 1034, 3379, 1015, 3433, 1081, 3483, 1094, 3570, 1059, 3595, 1060, 3641, 1110, 3690, 1103, 3709, 1018, 3672, 1021, 3723, 951, 3735, 842, 3695, 822, 3643, 838, 3548, 814, 3466, 752, 3410, 761, 3334, 906, 3210, 948, 3031},
+		{3517, 4204, 3351, 4202, 3235, 4174, 3115, 4109, 2924, 4122, 2882, 4046, 2728, 4042, 2617, 3946, 2680, 3899, 2632, 3821, 2705, 3765, 2764, 3666, 2873, 3668, 2970, 3614, 3039, 3626, 3062, 3668, 3170, 3664, 3251, 3611, 3403, 3622, 3471, 3680, 3555, 3657, 3616, 3665, 3578, 3627, 3615, 3582, 3669, 3626, 3674, 3682, 3707, 3662, 3817, 3690, 3870, 3671, 3952, 3672, 4067, 3709, 4278, 3739, 4394, 3726, 4429, 3700, 4477, 3717, 4423, 3797, 4442, 3828, 4411, 3943, 4479, 3971, 4366, 4025, 4375, 4074, 4358, 4109, 4262, 4158, 4155, 4154, 4037, 4101, 3951, 4110, 3835, 4095},
+		{2762, 4100, 2881, 4105, 2899, 4130, 2812, 4162, 2800, 4201, 2714, 4214, 2612, 4183, 2660, 4156, 2629, 4094, 2606, 4082, 2636, 4015},
+		{12195, 2500, 12150, 2530, 12069, 2454, 12011, 2356, 12022, 2281, 12075, 2197},
+		{3187, -103, 3042, -113, 3082, -170, 3076, -229, 3047, -241, 3075, -336, 2975, -445, 2934, -450, 2952, -542, 2942, -594, 2962, -652, 3020, -708, 3074, -834, 3276, -923, 3374, -942, 3428, -1016, 3456, -1152, 3531, -1144, 3651, -1172, 3747, -1157, 3783, -1127, 3843, -1129, 3952, -1090, 4032, -1032, 3995, -1010, 3919, -849, 3919, -770, 3944, -684, 3880, -648, 3874, -591, 3920, -468, 3777, -368, 3770, -310, 3390, -95},
+		{3390, -95, 3389, 11, 3504, 191, 3448, 356, 3401, 425, 3339, 379, 3269, 379, 3188, 356, 3125, 378, 3083, 351, 3077, 234, 3117, 220, 3047, 158, 2988, 60, 2982, -21, 2959, -59, 2958, -134, 2982, -144, 3077, -101},
+		{3093, 5204, 3062, 5182, 3056, 5132, 2925, 5137, 2899, 5160, 2862, 5143, 2533, 5191, 2455, 5189, 2401, 5162, 2353, 5158, 2403, 5071, 2392, 5042, 2343, 5031, 2252, 4948, 2278, 4903, 2256, 4909, 2209, 4842, 2264, 4815, 2271, 4788, 2314, 4810, 2440, 4798, 2487, 4774, 2595, 4799, 2620, 4822, 2752, 4847, 2867, 4812, 2912, 4785, 2905, 4751, 2942, 4735, 2956, 4693, 3002, 4642, 2886, 4644, 2893, 4626, 2849, 4560, 2823, 4549, 2868, 4530, 2915, 4546, 2960, 4529, 3075, 4658, 3168, 4671, 3174, 4633, 3330, 4608, 3359, 4585, 3245, 4533, 3355, 4503, 3333, 4456, 3388, 4436, 3524, 4494, 3633, 4511, 3653, 4547, 3551, 4541, 3502, 4565, 3496, 4627, 3582, 4665, 3676, 4670, 3743, 4702, 3822, 4710, 3826, 4755, 3877, 4783, 3974, 4790, 3990, 4823, 3967, 4878, 4008, 4931, 4007, 4960, 3801, 4992, 3739, 5038, 3663, 5023, 3536, 5058, 3538, 5077, 3502, 5121, 3422, 5126, 3414, 5157, 3439, 5177, 3375, 5234, 3241, 5229, 3216, 5206},
+		{-5787, -3102, -5814, -3204, -5813, -3304, -5835, -3326, -5843, -3391, -5782, -3446, -5714, -3443, -5622, -3486, -5567, -3475, -5494, -3495, -5381, -3440, -5337, -3377, -5365, -3320, -5321, -3273, -5379, -3205, -5560, -3085, -5597, -3088, -5698, -3011, -5763, -3022},
+		{-15522, 1924, -15481, 1951, -15522, 1999, -15586, 2027, -15585, 1998, -15607, 1970, -15594, 1906, -15569, 1892},
+		{-15600, 2076, -15661, 2101, -15671, 2093, -15641, 2057},
+		{-15725, 2122, -15733, 2110, -15679, 2107, -15676, 2118},
+		{-15794, 2165, -15829, 2158, -15813, 2131, -15771, 2126},
+		{-15937, 2221, -15980, 2207, -15946, 2188},
+		{-9516, 4938, -9516, 4900, -12284, 4900, -12234, 4736, -12259, 4710, -12312, 4804, -12457, 4838, -12469, 4818, -12408, 4686, -12390, 4552, -12414, 4371, -12453, 4277, -12421, 4200, -12418, 4114, -12440, 4031, -12387, 3977, -12373, 3895, -12251, 3778, -12255, 3755, -12171, 3616, -12074, 3516, -12062, 3461, -12037, 3445, -11944, 3435, -11908, 3408, -11852, 3403, -11841, 3374, -11794, 3362, -11730, 3305, -11713, 3254, -11472, 3272, -11482, 3253, -11102, 3133, -10824, 3134, -10824, 3175, -10651, 3175, -10504, 3064, -10446, 2957, -10394, 2927, -10311, 2897, -10248, 2976, -10166, 2978, -10096, 2938, -10011, 2811, -9952, 2754, -9902, 2637, -9753, 2584, -9714, 2587, -9738, 2669, -9737, 2738, -9714, 2783, -9659, 2831, -9560, 2874, -9469, 2948, -9323, 2978, -9250, 2955, -9163, 2968, -9088, 2915, -9015, 2912, -8978, 2931, -8941, 2916, -8922, 2929, -8943, 2949, -8941, 2989, -8959, 3016, -8918, 3032, -8640, 3040, -8577, 3015, -8511, 2964, -8410, 3009, -8371, 2994, -8293, 2910, -8265, 2855, -8286, 2789, -8271, 2750, -8171, 2587, -8133, 2564, -8117, 2520, -8068, 2508, -8038, 2521, -8013, 2582, -8006, 2688, -8053, 2804, -8054, 2847, -8131, 3004, -8149, 3073, -8134, 3144, -8030, 3251, -7920, 3316, -7906, 3349, -7855, 3386, -7805, 3393, -7740, 3451, -7636, 3481, -7573, 3555, -7597, 3690, -7626, 3697, -7630, 3792, -7699, 3824, -7633, 3808, -7654, 3872, -7635, 3915, -7623, 3832, -7572, 3794, -7594, 3722, -7506, 3840, -7507, 3878, -7532, 3896, -7553, 3950, -7498, 3920, -7491, 3894, -7418, 3971, -7396, 4043, -7426, 4047, -7395, 4075, -7398, 4063, -7335, 4063, -7194, 4093, -7224, 4112, -7371, 4093, -7288, 4122, -6997, 4164, -6988, 4192, -7019, 4215, -7008, 4178, -7050, 4181, -7083, 4234, -7081, 4287, -7012, 4368, -6696, 4481, -6779, 4570, -6779, 4707, -6823, 4735, -6891, 4719, -6924, 4745, -7000, 4669, -7066, 4546, -7141, 4526, -7151, 4501, -7487, 4500, -7650, 4402, -7682, 4363, -7872, 4363, -7917, 4347, -7894, 4286, -8269, 4168, -8303, 4183, -8312, 4208, -8214, 4357, -8255, 4535, -8359, 4582, -8347, 4599, -8389, 4612, -8414, 4651, -8460, 4644, -8454, 4654, -8478, 4664, -8488, 4690, -8838, 4830, -8927, 4802, -9083, 4827, -9164, 4814, -9261, 4845, -9433, 4867, -9464, 4884, -9482, 4939},
+		{-15214, 5759, -15256, 5790, -15323, 5797, -15467, 5746, -15452, 5699, -15401, 5673},
+		{-16567, 6029, -16647, 6038, -16746, 6021, -16619, 5975, -16558, 5991},
+		{-17179, 6341, -17067, 6338, -16953, 6298, -16869, 6330, -17049, 6369, -17111, 6359, -17173, 6378},
+		{-15658, 7136, -15812, 7082, -15904, 7089, -16191, 7033, -16293, 6986, -16317, 6937, -16443, 6892, -16620, 6888, -16676, 6836, -16539, 6804, -16372, 6712, -16249, 6674, -16168, 6612, -16379, 6608, -16365, 6658, -16447, 6658, -16811, 6567, -16685, 6509, -16643, 6469, -16496, 6445, -16355, 6456, -16276, 6434, -16245, 6456, -16078, 6479, -16152, 6440, -16096, 6422, -16077, 6377, -16153, 6346, -16226, 6354, -16307, 6306, -16375, 6322, -16456, 6315, -16492, 6263, -16573, 6207, -16612, 6150, -16535, 6107, -16535, 6051, -16382, 5980, -16252, 5999, -16187, 5963, -16205, 5927, -16197, 5867, -16136, 5867, -16036, 5907, -15998, 5857, -15971, 5893, -15906, 5842, -15852, 5879, -15819, 5862, -15704, 5892, -15755, 5833, -15772, 5757, -16007, 5642, -16056, 5601, -16180, 5589, -16494, 5457, -16479, 5440, -16307, 5469, -16029, 5564, -15960, 5557, -15843, 5599, -15812, 5646, -15656, 5698, -15631, 5742, -15423, 5815, -15329, 5886, -15402, 5935, -15258, 6006, -15190, 6073, -15062, 6128, -15035, 6103, -15141, 6073, -15186, 5974, -15172, 5916, -14973, 5971, -14802, 5998, -14822, 6067, -14711, 6088, -14396, 6000, -14257, 6008, -13987, 5954, -13780, 5850, -13663, 5821, -13408, 5812, -13354, 5718, -13225, 5637, -13197, 5550, -13054, 5480, -12998, 5528, -13001, 5592, -13171, 5655, -13336, 5841, -13495, 5927, -13548, 5979, -13648, 5946, -13745, 5891, -13904, 6000, -14001, 6028, -14100, 6031, -14099, 6971, -14359, 7015, -14492, 6999, -14972, 7053, -15074, 7043, -15227, 7060, -15221, 7083, -15390, 7089, -15434, 7070, -15507, 7115},
+		{6708, 3736, 6783, 3714, 6839, 3816, 6818, 3890, 6744, 3914, 6770, 3958, 6854, 3953, 6933, 4073, 7067, 4096, 7046, 4050, 7060, 4022, 7177, 4015, 7306, 4087, 7187, 4139, 7116, 4114, 7042, 4152, 7126, 4217, 7096, 4227, 6907, 4138, 6863, 4067, 6826, 4066, 6799, 4114, 6671, 4117, 6651, 4199, 6602, 4199, 6610, 4300, 6490, 4373, 6201, 4350, 6106, 4441, 5850, 4559, 5593, 4500, 5597, 4131, 5710, 4132, 5693, 4183, 5779, 4217, 5863, 4275, 5998, 4222, 6008, 4143, 6047, 4122, 6155, 4127, 6188, 4108, 6237, 4005, 6417, 3889, 6655, 3797, 6652, 3736},
+		{-7197, 1161, -7223, 1111, -7291, 1045, -7330, 915, -7279, 909, -7244, 841, -7244, 742, -7220, 734, -7196, 699, -7067, 709, -7009, 696, -6939, 610, -6770, 627, -6734, 610, -6774, 522, -6782, 450, -6730, 332, -6781, 282, -6718, 225, -6688, 125, -6633, 72, -6555, 79, -6535, 110, -6420, 149, -6408, 192, -6337, 220, -6342, 241, -6427, 250, -6437, 380, -6482, 406, -6463, 415, -6309, 377, -6280, 401, -6097, 454, -6060, 492, -6141, 596, -6114, 623, -6116, 670, -6030, 704, -6064, 741, -6055, 778, -5976, 837, -6015, 860, -6067, 858, -6083, 938, -6159, 987, -6239, 995, -6273, 1042, -6188, 1072, -6432, 1064, -6433, 1039, -6489, 1008, -6566, 1020, -6623, 1065, -6819, 1055, -6823, 1089, -6888, 1144, -6958, 1146, -6994, 1216, -7029, 1185, -7016, 1138, -7140, 1097, -7135, 1021, -7104, 986, -7126, 914, -7170, 907, -7207, 987, -7163, 1045, -7162, 1097, -7195, 1142, -7136, 1154, -7133, 1178},
+		{10704, 2181, 10657, 2222, 10673, 2279, 10581, 2298, 10533, 2335, 10448, 2282, 10271, 2271, 10217, 2246, 10320, 2077, 10444, 2076, 10482, 1989, 10418, 1962, 10390, 1927, 10509, 1867, 10656, 1660, 10731, 1591, 10756, 1520, 10738, 1420, 10761, 1354, 10749, 1234, 10581, 1157, 10625, 1096, 10520, 1089, 10433, 1049, 10508, 992, 10480, 924, 10516, 860, 10641, 953, 10722, 1036, 10920, 1167, 10934, 1343, 10888, 1528, 10827, 1608, 10736, 1670, 10566, 1906, 10588, 1975, 10672, 2070, 10805, 2155},
+		{16722, -1589, 16718, -1616, 16752, -1660, 16784, -1647},
+		{16663, -1463, 16679, -1567, 16727, -1574, 16711, -1493},
+		{5278, 1735, 5200, 1900, 4912, 1862, 4818, 1817, 4747, 1712, 4700, 1695, 4675, 1728, 4522, 1743, 4379, 1732, 4338, 1758, 4312, 1709, 4322, 1667, 4278, 1635, 4281, 1526, 4260, 1521, 4325, 1377, 4322, 1322, 4348, 1264, 4499, 1270, 4563, 1329, 4735, 1359, 4794, 1401, 4868, 1400, 4957, 1471, 5217, 1560, 5239, 1638, 5311, 1665},
+		{3220, -2875, 3246, -2830, 3283, -2674, 3207, -2673, 3187, -2718, 3128, -2729, 3069, -2674, 3068, -2640, 3104, -2573, 3133, -2566, 3184, -2584, 3175, -2548, 3193, -2437, 3119, -2225, 2943, -2209, 2802, -2283, 2712, -2357, 2649, -2462, 2594, -2470, 2566, -2549, 2503, -2572, 2421, -2567, 2331, -2527, 2282, -2550, 2258, -2598, 2161, -2673, 2089, -2683, 2067, -2648, 2076, -2587, 2017, -2492, 1990, -2477, 1989, -2846, 1900, -2897, 1846, -2905, 1739, -2878, 1722, -2836, 1682, -2808, 1634, -2858, 1822, -3166, 1825, -3243, 1793, -3261, 1825, -3328, 1838, -3414, 1842, -3400, 1886, -3444, 1919, -3446, 1962, -3482, 2007, -3480, 2069, -3442, 2257, -3386, 2359, -3379, 2468, -3399, 2517, -3380, 2578, -3394, 2591, -3367, 2746, -3323, 2822, -3277, 3006, -3114, 3133, -2940},
+		{2933, -2926, 2885, -3007, 2829, -3023, 2811, -3055, 2775, -3065, 2700, -2988, 2753, -2924, 2854, -2865},
+		{3219, -893, 3035, -824, 2873, -853, 2845, -916, 2867, -961, 2837, -1179, 2934, -1236, 2962, -1218, 2970, -1326, 2893, -1325, 2816, -1227, 2739, -1213, 2716, -1161, 2655, -1192, 2575, -1178, 2542, -1133, 2431, -1126, 2426, -1095, 2391, -1093, 2402, -1124, 2390, -1172, 2408, -1219, 2393, -1257, 2402, -1291, 2193, -1290, 2189, -1608, 2322, -1752, 2403, -1730, 2468, -1735, 2526, -1774, 2704, -1794, 2847, -1647, 2883, -1639, 2895, -1604, 2952, -1564, 3027, -1551, 3018, -1480, 3321, -1397, 3269, -1371, 3299, -1278, 3331, -1244, 3311, -1161, 3349, -1053, 3323, -968},
+		{3224, -2112, 3266, -2030, 3277, -1972, 3261, -1942, 3265, -1867, 3285, -1798, 3285, -1671, 3233, -1639, 3185, -1632, 3117, -1586, 3034, -1588, 3027, -1551, 2952, -1564, 2895, -1604, 2883, -1639, 2847, -1647, 2704, -1794, 2526, -1774, 2616, -1929, 2730, -2039, 2772, -2050, 2802, -2149, 2879, -2164, 2943, -2209, 3119, -2225},
+	}},
+	{Tolerance: 0.25, Polygons: [][]int16{
+		{6080, 3440, 6053, 3368, 6096, 3353, 6054, 3298, 6094, 3155, 6170, 3138, 6178, 3074, 6087, 2983, 6255, 2932, 6505, 2947, 6635, 2989, 6638, 3074, 6694, 3130, 6932, 3190, 6926, 3250, 7032, 3336, 6993, 3402, 7088, 3399, 7161, 3515, 7126, 3607, 7185, 3651, 7516, 3713, 7326, 3750, 7184, 3674, 7145, 3707, 7135, 3826, 7081, 3849, 7012, 3759, 6952, 3761, 6920, 3715, 6814, 3702, 6575, 3766, 6475, 3711, 6455, 3631, 6319, 3586, 6298, 3540, 6121, 3565},
+		{1338, -586, 1223, -629, 1324, -856, 1288, -917, 1369, -1073, 1363, -1204, 1250, -1355, 1178, -1579, 1173, -1730, 1346, -1697, 1406, -1742, 1826, -1731, 1896, -1779, 2138, -1793, 2322, -1752, 2189, -1608, 2193, -1290, 2402, -1291, 2402, -1124, 2346, -1087, 2216, -1108, 2173, -729, 2051, -730, 2060, -694, 2009, -694, 1942, -716, 1902, -799, 1747, -807, 1633, -588},
+		{1247, -525, 1300, -478, 1262, -444, 1191, -504, 1218, -579},
+		{2052, 4222, 1974, 4269, 1930, 4220, 1941, 4025, 2015, 3962, 2100, 4058, 2046, 4152},
+		{5162, 2401, 5200, 2300, 5501, 2250, 5553, 2393, 5598, 2413, 5589, 2492, 5640, 2492, 5626, 2571, 5607, 2606, 5401, 2412, 5179, 2402, 5176, 2429},
+		{-6505, -5470, -6775, -5385, -6863, -5264, -6863, -5487, -6696, -5490, -6645, -5525, -6550, -5520},
+		{-6627, -2183, -6711, -2274, -6733, -2403, -6842, -2452, -6859, -2651, -6830, -2690, -6966, -2846, -6992, -3034, -7054, -3137, -6982, -3419, -7039, -3517, -7036, -3601, -7112, -3666, -7081, -3855, -7141, -3892, -7192, -4083, -7175, -4205, -7215, -4225, -7192, -4341, -7146, -4379, -7179, -4421, -7122, -4478, -7166, -4497, -7233, -4824, -7342, -4932, -7333, -5038, -7231, -5068, -7191, -5201, -6815, -5235, -6882, -5177, -6914, -5073, -6782, -4987, -6717, -4870, -6599, -4813, -6564, -4724, -6758, -4630, -6729, -4555, -6651, -4504, -6557, -4504, -6518, -4350, -6346, -4256, -6376, -4204, -6430, -4236, -6498, -4206, -6512, -4106, -6473, -4080, -6377, -4117, -6275, -4103, -6215, -4068, -6234, -3883, -5923, -3872, -5775, -3818, -5679, -3690, -5674, -3641, -5736, -3598, -5723, -3529, -5850, -3443, -5763, -3022, -5365, -2692, -5363, -2612, -5413, -2555, -5463, -2574, -5479, -2662, -5570, -2739, -5862, -2712, -5763, -2560, -5778, -2516, -6085, -2388, -6285, -2203, -6399, -2199, -6438, -2280, -6496, -2208},
+		{4375, 4074, 4366, 4025, 4574, 3947, 4614, 3874, 4651, 3877, 4648, 3946, 4561, 3990, 4589, 4022, 4497, 4125, 4358, 4109},
+		{-6061, -7963, -6188, -8039, -6629, -8026, -6449, -8092, -6016, -8100, -5957, -8004},
+		{-15948, -7905, -16125, -7838, -16311, -7822, -16371, -7860, -16113, -7963, -15921, -7950},
+		{-4666, -7783, -4866, -7805, -5099, -7961, -5399, -8022, -5416, -8063, -5048, -8103, -4333, -8003, -4392, -7848},
+		{-12241, -7332, -12262, -7366, -12023, -7409, -11872, -7348},
+		{-12656, -7325, -12728, -7346, -12403, -7387},
+		{-10043, -7185, -10233, -7189, -10078, -7250, -9620, -7252, -9679, -7195},
+		{-6873, -7051, -7025, -6888, -7117, -6904, -7174, -6951, -7207, -7119, -7392, -7127, -7501, -7166, -7495, -7207, -7419, -7237, -7190, -7209, -7239, -7248, -6878, -7217, -6833, -7141},
+		{-5760, -6386, -5722, -6353, -5781, -6327, -6300, -6464, -6725, -6688, -6774, -6733, -6758, -6854, -6854, -6972, -6725, -7164, -6737, -7248, -7489, -7387, -7622, -7397, -8030, -7313, -8147, -7385, -8842, -7301, -8923, -7256, -9009, -7332, -9244, -7317, -9634, -7362, -9769, -7356, -9914, -7291, -10368, -7262, -10255, -7411, -10125, -7419, -10012, -7487, -10065, -7530, -10488, -7495, -10756, -7518, -11126, -7442, -11230, -7471, -11394, -7371, -11622, -7424, -11747, -7403, -12107, -7452, -13521, -7430, -14432, -7554, -14491, -7520, -14620, -7538, -14650, -7573, -14610, -7648, -15133, -7740, -15292, -7750, -15374, -7707, -15697, -7730, -15837, -7689, -15805, -7803, -15533, -7906, -14953, -7936, -14677, -7993, -14642, -8034, -15065, -8134, -15210, -8100, -15684, -8110, -15286, -8204, -15267, -8245, -15359, -8369, -15006, -8430, -14289, -8457, -14311, -8504, -14853, -8561, -15519, -8510, -15807, -8537, -16702, -8457, -16995, -8388, -17289, -8406, -17438, -8453, -17583, -8412, -17726, -8445, -17906, -8414, -18000, -8471, -18000, -9000, 18000, -9000, 18000, -8471, 17599, -8416, 17322, -8441, 16940, -8383, 16890, -8334, 16371, -8240, 15979, -8095, 16177, -7916, 16700, -7875, 16660, -7832, 16474, -7818, 16349, -7707, 16357, -7624, 16609, -7438, 16929, -7366, 17121, -7170, 16731, -7083, 16157, -7058, 15918, -6960, 15681, -6938, 15428, -6856, 15250, -6887, 14884, -6839, 14665, -6790, 14549, -6692, 13746, -6695, 13662, -6678, 13507, -6531, 13476, -6621, 12880, -6676, 12322, -6648, 11983, -6727, 11560, -6670, 11360, -6588, 11024, -6670, 10618, -6693, 10283, -6556, 9972, -6725, 9578, -6739, 8967, -6715, 8799, -6621, 8675, -6715, 8278, -6721, 7911, -6833, 7764, -6946, 7386, -6987, 7102, -7209, 6987, -7226, 6795, -7185, 6907, -7068, 6795, -7070, 6781, -7031, 6956, -6968, 6967, -6923, 6889, -6793, 6405, -6741, 6239, -6801, 6143, -6795, 5874, -6729, 5636, -6597, 5453, -6582, 5179, -6625, 5075, -6688, 4744, -6772, 4650, -6760, 4196, -6860, 3865, -6978, 3387, -6850, 3199, -6966, 2709, -7046, 2257, -7070, 2145, -7007, 1926, -6989, 1595, -7003, 1513, -7040, 1473, -7003, 1342, -6997, 1082, -7083, 953, -7001, 774, -6989, -23, -7164, -66, -7123, -434, -7146, -687, -7093, -742, -7170, -1030, -7127, -1229, -7240, -1545, -7315, -1647, -7387, -1541, -7411, -1570, -7450, -1752, -7513, -2246, -7611, -2888, -7667, -3578, -7834, -3564, -7946, -2969, -7926, -2969, -7963, -2855, -8034, -4077, -8136, -4281, -8208, -4976, -8173, -5822, -8322, -5969, -8238, -7536, -8026, -7802, -7918, -7793, -7838, -7366, -7791, -7693, -7710, -7724, -7671, -7060, -7663, -6435, -7526, -6330, -7458, -6138, -7411, -6069, -7317, -6151, -7109, -6320, -6923, -6567, -6795, -6375, -6650, -6212, -6619, -6265, -6548, -6202, -6480},
+		{6887, -4883, 6875, -4978, 7028, -4971, 7056, -4926},
+		{14474, -4070, 14605, -4355, 14687, -4363, 14756, -4294, 14791, -4321, 14836, -4206, 14829, -4088, 14636, -4114},
+		{14360, -1340, 14252, -1067, 14169, -1241, 14170, -1504, 14127, -1639, 14088, -1737, 14022, -1771, 13550, -1500, 13596, -1332, 13695, -1235, 13649, -1186, 13530, -1225, 13236, -1113, 13182, -1127, 13256, -1160, 13258, -1211, 13062, -1254, 12941, -1442, 12962, -1497, 12836, -1487, 12707, -1382, 12569, -1423, 12438, -1557, 12426, -1633, 12382, -1611, 12350, -1660, 12386, -1707, 12343, -1727, 12301, -1641, 12231, -1725, 12224, -1820, 12086, -1968, 11671, -2070, 11465, -2183, 11423, -2252, 11415, -2176, 11339, -2438, 11423, -2630, 11344, -2562, 11378, -2655, 11334, -2612, 11569, -3161, 11571, -3326, 11505, -3362, 11503, -3420, 11663, -3503, 11802, -3506, 11989, -3398, 12366, -3389, 12422, -3296, 12615, -3222, 13133, -3150, 13427, -3262, 13409, -3285, 13524, -3395, 13521, -3448, 13599, -3489, 13637, -3409, 13781, -3290, 13789, -3364, 13683, -3526, 13772, -3508, 13821, -3438, 13845, -3513, 13812, -3561, 13957, -3614, 13999, -3740, 14064, -3802, 14361, -3881, 14503, -3790, 14488, -3842, 14632, -3904, 14830, -3781, 15000, -3743, 15033, -3567, 15289, -3164, 15357, -2811, 15309, -2726, 15314, -2607, 15286, -2527, 15090, -2346, 15073, -2240, 14968, -2234, 14885, -2039, 14639, -1896, 14537, -1498, 14456, -1417, 14392, -1455},
+		{1688, 4847, 1525, 4904, 1434, 4856, 1360, 4888, 1288, 4829, 1293, 4747, 1214, 4770, 1040, 4730, 990, 4758, 948, 4710, 1105, 4675, 1215, 4712, 1238, 4677, 1463, 4643, 1601, 4668, 1653, 4750, 1634, 4771, 1690, 4771},
+		{4479, 3971, 4546, 3887, 4614, 3874, 4574, 3947},
+		{4669, 4183, 4615, 4172, 4650, 4106, 4497, 4125, 4589, 4022, 4561, 3990, 4648, 3946, 4651, 3877, 4806, 3958, 4836, 3929, 4801, 3879, 4888, 3832, 4957, 4018, 5039, 4026, 4962, 4057, 4858, 4181, 4782, 4115},
+		{2975, -445, 3075, -336, 3047, -241, 2902, -284, 2934, -450},
+		{251, 5115, 429, 4991, 567, 4953, 604, 5013, 616, 5080, 497, 5148},
+		{275, 787, 291, 914, 380, 1073, 361, 1166, 249, 1223, 90, 1100, 77, 1047, 166, 913, 187, 614, 269, 626},
+		{-296, 1040, -294, 1096, 90, 1100, 194, 1164, 218, 1263, 102, 1285, 37, 1493, -107, 1497, -310, 1354, -428, 1323, -522, 1171, -540, 1037, -433, 961, -351, 990, -283, 964},
+		{9215, 2363, 9171, 2299, 9116, 2350, 9238, 2498, 8992, 2527, 8983, 2597, 8856, 2645, 8821, 2577, 8893, 2524, 8808, 2450, 8870, 2423, 8853, 2363, 8903, 2206, 9027, 2184, 9050, 2281, 9142, 2277, 9237, 2067, 9267, 2204},
+		{2241, 4401, 2299, 4321, 2238, 4232, 2288, 4200, 2295, 4134, 2449, 4158, 2611, 4133, 2612, 4183, 2800, 4201, 2767, 4258, 2856, 4371, 2724, 4418, 2557, 4369, 2294, 4382, 2266, 4423},
+		{-7754, 2434, -7819, 2521, -7841, 2458, -7778, 2371},
+		{-7785, 2684, -7898, 2679, -7891, 2642, -7782, 2658},
+		{-7779, 2704, -7717, 2588, -7700, 2659},
+		{1855, 4508, 1596, 4523, 1575, 4482, 1856, 4265, 1960, 4404, 1912, 4442, 1937, 4486},
+		{2353, 5347, 2380, 5269, 2320, 5249, 2353, 5158, 2533, 5191, 3056, 5132, 3093, 5204, 3179, 5210, 3131, 5307, 3269, 5335, 3076, 5481, 3087, 5555, 2818, 5617, 2649, 5562, 2659, 5517, 2577, 5485, 2554, 5428, 2348, 5391},
+		{-8915, 1702, -8923, 1589, -8893, 1589, -8836, 1653, -8830, 1850, -8915, 1796},
+		{-6269, -2225, -6179, -1963, -5912, -1936, -5817, -2018, -5785, -1997, -5750, -1817, -5828, -1727, -5824, -1630, -6016, -1626, -6050, -1378, -6432, -1246, -6540, -1157, -6534, -976, -6665, -993, -6827, -1101, -6953, -1095, -6867, -1256, -6934, -1495, -6896, -1650, -6959, -1758, -6844, -1941, -6876, -2037, -6783, -2287, -6711, -2274, -6627, -2183, -6496, -2208, -6438, -2280, -6399, -2199},
+		{-5698, -3011, -5379, -3205, -5321, -3273, -5365, -3320, -5337, -3377, -4889, -2867, -4850, -2588, -4765, -2489, -4465, -2335, -4199, -2297, -4175, -2237, -4094, -2194, -3927, -1787, -3867, -1306, -3513, -900, -3473, -734, -3560, -515, -3722, -482, -3998, -287, -4342, -238, -4458, -269, -4442, -214, -4491, -155, -4782, -58, -4858, -124, -4862, -24, -5039, -8, -5070, 22, -4995, 105, -4997, 174, -5051, 190, -5132, 420, -5294, 212, -5597, 251, -5600, 182, -5734, 195, -5903, 132, -5965, 179, -5997, 276, -5954, 396, -6011, 457, -5998, 501, -6073, 520, -6097, 454, -6309, 377, -6482, 406, -6437, 380, -6427, 250, -6337, 220, -6555, 79, -6707, 113, -6754, 204, -6787, 169, -6982, 171, -6980, 109, -6922, 99, -6925, 60, -7002, 54, -6942, -112, -6989, -430, -7079, -425, -7289, -527, -7312, -663, -7399, -752, -7302, -903, -7323, -946, -7256, -952, -7218, -1005, -7130, -1008, -7048, -949, -7055, -1101, -6827, -1101, -6665, -993, -6534, -976, -6540, -1157, -6432, -1246, -6050, -1378, -6016, -1626, -5824, -1630, -5828, -1727, -5750, -1817, -5817, -2018, -5794, -2209, -5580, -2236, -5540, -2396, -5429, -2402, -5463, -2574, -5413, -2555, -5363, -2612, -5365, -2692, -5763, -3022},
+		{11466, 401, 11535, 432, 11545, 545, 11420, 453},
+		{9126, 2804, 9002, 2830, 8884, 2710, 8974, 2672, 9203, 2684, 9210, 2745},
+		{2526, -1774, 2358, -1828, 2320, -1787, 2091, -1825, 2088, -2181, 1990, -2185, 1990, -2477, 2076, -2587, 2089, -2683, 2161, -2673, 2331, -2527, 2421, -2567, 2566, -2549, 2594, -2470, 2649, -2462, 2712, -2357, 2943, -2209, 2802, -2149, 2772, -2050, 2616, -1929},
+		{1478, 641, 1448, 473, 1586, 301, 1601, 227, 1713, 373, 1845, 350, 1854, 420, 1947, 503, 2241, 403, 2284, 471, 2441, 511, 2737, 523, 2511, 783, 2346, 895, 2355, 1009, 2286, 1114, 2172, 1057, 2100, 948, 1881, 898, 1891, 863, 1796, 789, 1528, 742},
+		{-6401, 4704, -6439, 4673, -6414, 4639, -6287, 4597, -6201, 4644, -6366, 4655},
+		{-6184, 4929, -6452, 4987, -6229, 4909},
+		{-12392, 4906, -12576, 5030, -12844, 5054, -12806, 4999, -12703, 4981, -12566, 4883, -12351, 4851},
+		{-5560, 5132, -5541, 5159, -5587, 5163, -5736, 5072, -5923, 4852, -5880, 4825, -5942, 4790, -5927, 4760, -5625, 4763, -5529, 4739, -5600, 4692, -5540, 4688, -5424, 4775, -5396, 4763, -5418, 4681, -5307, 4666, -5265, 4754, -5309, 4869, -5379, 4852, -5348, 4925, -5582, 4959, -5547, 4994, -5614, 5015, -5680, 4981},
+		{-13324, 5385, -13218, 5264, -13118, 5218, -13205, 5298, -13175, 5412, -13318, 5417},
+		{-7952, 6236, -8036, 6202, -7966, 6163, -7927, 6216},
+		{-8188, 6290, -8325, 6291, -8399, 6245, -8307, 6216},
+		{-8588, 6574, -8635, 6404, -8722, 6354, -8587, 6364, -8552, 6305, -8311, 6410, -8255, 6365, -8099, 6341, -8010, 6373},
+		{-7522, 6744, -7511, 6801, -7590, 6829, -7681, 6815, -7724, 6759, -7699, 6710},
+		{-9626, 6949, -9822, 7014, -9980, 6940, -9627, 6876, -9565, 6911},
+		{-9241, 6970, -9152, 7019, -9389, 7176, -9521, 7192, -9639, 7119, -9647, 7009, -9423, 6907, -9469, 6806, -9549, 6809, -9613, 6729, -9612, 6824, -9767, 6858, -9856, 6840, -9844, 6778, -10145, 6765, -10434, 6802, -10615, 6880, -10817, 6865, -10881, 6831, -10779, 6789, -10888, 6738, -10995, 6798, -11350, 6769, -11530, 6790, -11390, 6840, -11525, 6891, -12147, 6980, -12429, 6940, -12442, 7016, -12576, 6948, -12814, 7048, -12911, 6978, -12979, 7019, -13293, 6951, -13441, 6963, -13650, 6890, -14099, 6971, -14100, 6031, -13904, 6000, -13745, 5891, -13548, 5979, -13336, 5841, -13171, 5655, -13001, 5592, -13051, 5429, -12931, 5356, -12913, 5276, -12785, 5233, -12799, 5172, -12744, 5083, -12562, 5042, -12297, 4900, -9516, 4900, -9516, 4938, -9482, 4939, -9433, 4867, -9164, 4814, -8838, 4830, -8488, 4690, -8255, 4535, -8214, 4357, -8312, 4208, -8269, 4168, -7894, 4286, -7917, 4347, -7872, 4363, -7682, 4363, -7487, 4500, -7151, 4501, -7066, 4546, -6924, 4745, -6779, 4707, -6779, 4570, -6714, 4514, -6443, 4529, -6616, 4447, -6612, 4362, -6536, 4355, -5980, 4592, -6045, 4628, -6052, 4701, -6152, 4588, -6317, 4574, -6447, 4624, -6512, 4807, -6417, 4874, -6506, 4923, -6655, 4913, -6865, 4830, -7026, 4699, -7110, 4682, -6851, 4907, -6640, 5023, -6003, 5024, -5568, 5215, -5576, 5327, -5694, 5378, -5733, 5463, -5957, 5520, -6180, 5634, -6140, 5697, -6458, 6034, -6620, 5877, -6765, 5821, -6929, 5896, -6959, 6106, -7137, 6114, -7384, 6244, -7811, 6232, -7734, 5985, -7852, 5880, -7730, 5805, -7654, 5653, -7823, 5514, -7983, 5467, -7912, 5414, -7860, 5256, -7914, 5153, -7991, 5121, -8140, 5216, -8213, 5328, -8227, 5515, -8501, 5530, -9090, 5728, -9230, 5709, -9322, 5878, -9468, 5895, -9424, 6090, -9193, 6284, -9077, 6296, -9070, 6361, -8848, 6410, -8732, 6478, -8577, 6656, -8334, 6641, -8139, 6711, -8126, 6760, -8196, 6813, -8122, 6867, -8128, 6916, -8262, 6966, -8552, 6988, -8558, 6878, -8735, 6720, -8832, 6787, -8802, 6862, -8922, 6926, -9055, 6847, -9055, 6950},
+		{-11519, 7331, -11787, 7271, -11940, 7156, -11611, 7131, -11843, 7091, -11790, 7054, -11242, 7037, -11734, 6996, -11611, 6917, -11385, 6901, -11331, 6854, -10596, 6918, -10243, 6875, -10209, 6912, -10273, 6950, -10109, 6958, -10098, 7002, -10446, 7099, -10540, 7267, -10652, 7308, -10840, 7309, -10769, 7207, -10819, 7165, -10901, 7263, -10992, 7296, -11105, 7245, -11244, 7296, -11467, 7265, -11417, 7312},
+		{-10526, 7364, -10694, 7346, -10538, 7276, -10450, 7342},
+		{-7806, 7365, -8035, 7376, -8083, 7369, -8088, 7333, -7949, 7274, -7625, 7283},
+		{-8583, 7380, -8944, 7313, -9021, 7224, -8989, 7122, -8847, 7122, -8951, 7076, -8868, 7041, -8494, 6997, -8131, 6974, -7896, 7017, -7623, 6915, -7687, 6889, -7293, 6773, -7265, 6728, -7429, 6581, -7396, 6545, -7790, 6531, -7856, 6457, -7771, 6423, -7482, 6439, -7483, 6468, -7189, 6368, -7224, 6340, -7102, 6291, -6617, 6193, -6878, 6375, -6501, 6267, -6467, 6339, -6532, 6438, -6814, 6569, -6802, 6626, -6672, 6639, -6392, 6500, -6216, 6616, -6185, 6686, -6342, 6693, -6486, 6785, -6881, 6872, -6697, 6919, -6879, 7053, -7120, 7092, -7224, 7156, -7410, 7133, -7423, 7177, -7782, 7275, -8075, 7206, -8060, 7272, -8232, 7375, -8485, 7334, -8577, 7253, -8656, 7316},
+		{-10154, 7336, -10044, 7271, -10248, 7283, -10250, 7251, -9932, 7136, -9672, 7166, -9654, 7256, -9805, 7299, -9712, 7347, -9738, 7376, -10036, 7384},
+		{-9200, 7297, -9051, 7386, -9450, 7413, -9602, 7344, -9541, 7206, -9427, 7202, -9320, 7277},
+		{-12046, 7182, -11922, 7252, -11551, 7348, -11756, 7419, -12492, 7429, -12394, 7368, -12593, 7187, -12309, 7090, -12046, 7138},
+		{-9398, 7530, -9485, 7565, -9682, 7493, -9416, 7459, -9361, 7498},
+		{-9858, 7659, -10257, 7634, -10250, 7556, -10086, 7564, -10088, 7506, -9981, 7490, -9816, 7500, -9774, 7626},
+		{-10855, 7668, -11050, 7643, -10907, 7547, -11540, 7648, -11771, 7522, -11179, 7516, -11387, 7472, -11374, 7439, -11222, 7442, -10631, 7501, -10570, 7548, -10588, 7597, -10782, 7585},
+		{-9675, 7716, -9712, 7675, -9289, 7588, -9242, 7484, -8815, 7439, -8195, 7444, -7983, 7492, -8113, 7571, -8919, 7561, -9097, 7607, -9074, 7645, -9161, 7678},
+		{-11757, 7750, -11910, 7751, -12285, 7612, -11990, 7605, -11634, 7688, -11620, 7765},
+		{-9372, 7763, -9644, 7783, -9617, 7756},
+		{-10985, 7800, -11272, 7805, -11353, 7773, -11205, 7741},
+		{-11096, 7880, -11254, 7841, -10966, 7860},
+		{-9556, 7842, -9863, 7887, -9812, 7808, -9731, 7785, -9583, 7806},
+		{-10083, 7880, -10549, 7930, -10542, 7892, -10421, 7868, -10518, 7838, -9967, 7791},
+		{-8781, 8032, -9113, 8072, -9241, 8126, -9474, 8121, -9430, 8098, -9532, 8091, -9671, 8016, -9497, 7937, -9315, 7938, -9394, 7911, -9395, 7875, -9288, 7834, -8904, 7829, -8581, 7934},
+		{-7067, 8317, -7931, 8313, -8242, 8286, -8318, 8232, -8550, 8265, -9159, 8189, -8937, 8086, -8760, 8052, -8185, 8046, -8341, 8010, -8693, 8025, -8651, 7974, -8509, 7935, -8796, 7837, -8634, 7818, -8498, 7754, -8826, 7790, -8777, 7718, -8962, 7695, -8949, 7647, -8056, 7618, -7789, 7678, -7976, 7721, -7539, 7853, -7622, 7902, -7553, 7920, -7691, 7932, -7118, 7980, -6947, 8062, -6548, 8151, -6766, 8150, -6189, 8236, -6185, 8263},
+		{852, 4783, 674, 4754, 602, 4627, 650, 4643, 727, 4578, 1036, 4648, 1044, 4689, 948, 4710, 959, 4753},
+		{-6935, -5252, -7027, -5293, -7111, -5407, -7466, -5284, -7101, -5505, -6864, -5558, -6729, -5530, -6696, -5490, -6863, -5487, -6863, -5264},
+		{-6876, -2037, -6844, -1941, -6959, -1758, -7037, -1835, -7009, -2139, -7091, -2764, -7149, -2886, -7144, -3242, -7317, -3712, -7359, -3716, -7322, -3926, -7368, -3994, -7433, -4322, -7370, -4337, -7339, -4212, -7272, -4238, -7324, -4445, -7435, -4410, -7469, -4576, -7564, -4665, -7413, -4694, -7518, -4771, -7561, -4867, -7495, -5226, -7143, -5386, -7101, -5383, -7085, -5290, -6857, -5230, -7191, -5201, -7231, -5068, -7333, -5038, -7342, -4932, -7233, -4824, -7166, -4497, -7122, -4478, -7179, -4421, -7146, -4379, -7192, -4341, -7215, -4225, -7175, -4205, -7192, -4083, -7141, -3892, -7081, -3855, -7112, -3666, -7036, -3601, -7039, -3517, -6982, -3419, -7054, -3137, -6992, -3034, -6966, -2846, -6830, -2690, -6859, -2651, -6842, -2452, -6733, -2403, -6699, -2299, -6783, -2287},
+		{11057, 1926, 11101, 1970, 11079, 2008, 10912, 1982, 10863, 1937, 10866, 1851, 10948, 1820, 11034, 1868},
+		{12729, 5074, 12595, 5279, 12507, 5316, 12357, 5346, 12100, 5325, 12018, 5275, 12073, 5252, 12074, 5196, 11928, 5058, 11929, 5014, 11788, 4951, 11668, 4989, 11549, 4814, 11574, 4773, 11730, 4770, 11806, 4807, 11977, 4705, 11966, 4669, 11742, 4667, 11346, 4481, 11187, 4510, 11135, 4446, 11183, 4374, 11041, 4287, 10613, 4213, 10496, 4160, 10085, 4266, 9635, 4273, 9531, 4424, 9348, 4498, 9095, 4529, 9059, 4572, 9097, 4689, 9028, 4769, 8885, 4807, 8801, 4860, 8775, 4930, 8736, 4921, 8660, 4855, 8577, 4846, 8572, 4745, 8516, 4700, 8318, 4733, 8246, 4554, 7997, 4492, 8087, 4318, 8018, 4292, 8012, 4212, 7819, 4119, 7690, 4107, 7653, 4043, 7478, 4037, 7382, 3989, 7368, 3943, 7393, 3851, 7486, 3838, 7498, 3742, 7619, 3590, 7784, 3549, 7891, 3432, 7918, 3248, 7846, 3262, 7874, 3152, 7972, 3088, 8111, 3018, 8153, 3042, 8233, 3012, 8582, 2820, 8873, 2809, 8881, 2730, 9002, 2830, 9170, 2777, 9250, 2790, 9457, 2928, 9540, 2903, 9612, 2945, 9659, 2883, 9625, 2841, 9791, 2834, 9868, 2751, 9867, 2592, 9772, 2508, 9760, 2390, 9866, 2406, 9890, 2314, 9953, 2295, 9924, 2212, 10042, 2156, 10115, 2185, 10127, 2120, 10180, 2117, 10165, 2232, 10448, 2282, 10533, 2335, 10673, 2279, 10657, 2222, 10704, 2181, 10986, 2140, 10989, 2028, 11044, 2034, 11079, 2140, 11324, 2205, 11381, 2255, 11415, 2222, 11589, 2278, 11866, 2455, 12113, 2814, 12168, 2823, 12209, 2983, 12126, 3068, 12189, 3095, 12191, 3169, 12023, 3436, 11915, 3491, 12110, 3665, 12252, 3693, 12236, 3745, 12082, 3787, 11970, 3716, 11753, 3874, 11804, 3920, 11902, 3925, 12164, 4095, 12217, 4042, 12105, 3890, 12427, 3993, 12687, 4182, 12821, 4147, 12805, 4199, 12960, 4242, 12999, 4299, 13064, 4240, 13063, 4290, 13114, 4293, 13103, 4497, 13188, 4532, 13310, 4514, 13503, 4848, 13251, 4779, 13099, 4779, 13058, 4873, 12940, 4944, 12766, 4976},
+		{-281, 539, -324, 625, -256, 822, -283, 964, -351, 990, -433, 961, -540, 1037, -605, 1010, -621, 1052, -685, 1014, -823, 1013, -783, 858, -830, 832, -860, 647, -757, 571, -771, 436, -465, 517, -286, 499},
+		{1434, 223, 1594, 173, 1586, 301, 1448, 473, 1454, 623, 1544, 769, 1498, 880, 1395, 955, 1417, 1002, 1547, 998, 1450, 1286, 1418, 1248, 1458, 1209, 1442, 1157, 1357, 1080, 1175, 698, 1106, 664, 1012, 704, 923, 644, 849, 450, 980, 307, 965, 228},
+		{2995, 417, 2972, 460, 2798, 441, 2737, 523, 2441, 511, 2284, 471, 2241, 403, 1947, 503, 1854, 420, 1764, -42, 1641, -174, 1601, -354, 1458, -497, 1360, -450, 1263, -499, 1232, -610, 1633, -588, 1747, -807, 1902, -799, 1942, -716, 2009, -694, 2060, -694, 2051, -730, 2173, -729, 2216, -1108, 2426, -1095, 2431, -1126, 2655, -1192, 2716, -1161, 2893, -1325, 2970, -1326, 2962, -1218, 2934, -1236, 2837, -1179, 2873, -853, 3074, -834, 2942, -594, 2902, -284, 2988, 60, 3117, 220, 3077, 234, 3083, 351},
+		{1326, -488, 1414, -451, 1458, -497, 1601, -354, 1641, -174, 1764, -42, 1845, 350, 1713, 373, 1594, 173, 1308, 227, 1328, 131, 1428, 120, 1384, 4, 1432, -55, 1430, -200, 1399, -247, 1311, -243, 1258, -195, 1250, -239, 1148, -277, 1186, -343, 1109, -398, 1191, -504, 1262, -444},
+		{-7511, -6, -7366, -126, -7307, -231, -7081, -226, -7005, -273, -7069, -374, -6989, -430, -6942, -112, -7002, 54, -6925, 60, -6922, 99, -6980, 109, -6982, 171, -6787, 169, -6754, 204, -6688, 125, -6781, 282, -6730, 332, -6782, 450, -6734, 610, -6939, 610, -7009, 696, -7196, 699, -7244, 742, -7279, 909, -7330, 915, -7291, 1045, -7197, 1161, -7133, 1178, -7140, 1238, -7341, 1123, -7491, 1108, -7548, 1062, -7567, 944, -7735, 867, -7724, 794, -7775, 771, -7732, 585, -7750, 409, -7713, 385, -7899, 169, -7742, 40},
+		{-8291, 842, -8293, 948, -8255, 957, -8366, 1094, -8556, 1122, -8594, 1090, -8566, 993, -8511, 956, -8498, 1009, -8363, 905, -8351, 845},
+		{-8251, 2308, -8378, 2279, -8497, 2190, -8405, 2191, -8278, 2269, -8180, 2264, -8217, 2239, -7872, 2160, -7814, 2074, -7709, 2041, -7776, 1986, -7496, 1992, -7418, 2028, -7567, 2074, -7560, 2102, -7652, 2121, -7835, 2251, -7928, 2240, -8062, 2311},
+		{3292, 3509, 3397, 3506, 3458, 3567, 3295, 3539},
+		{3387, 3509, 3226, 3510, 3298, 3457},
+		{1710, 4882, 1885, 4950, 1755, 5036, 1672, 5022, 1502, 5111, 1224, 5027, 1252, 4955, 1434, 4856, 1525, 4904},
+		{928, 5483, 853, 5496, 880, 5402, 812, 5353, 691, 5348, 684, 5223, 599, 5185, 619, 4946, 810, 4902, 747, 4762, 1293, 4747, 1288, 4829, 1360, 4888, 1252, 4955, 1224, 5027, 1502, 5111, 1407, 5298, 1435, 5325, 1412, 5376, 1252, 5447, 1094, 5401, 1095, 5436, 994, 5460, 992, 5498},
+		{4278, 1246, 4235, 1254, 4200, 1210, 4176, 1105, 4278, 1093, 4315, 1146, 4272, 1174, 4329, 1197, 4332, 1239, 4308, 1270},
+		{1237, 5611, 1090, 5578, 1104, 5536, 1209, 5480, 1269, 5561},
+		{1037, 5661, 1058, 5773, 854, 5711, 809, 5654, 812, 5552, 853, 5496, 992, 5498, 965, 5547, 1091, 5646},
+		{-7162, 1917, -7195, 1862, -7140, 1760, -7067, 1843, -6869, 1821, -6832, 1861, -7081, 1988, -7159, 1988},
+		{1156, 2410, 1077, 2456, 1030, 2438, 932, 2609, 972, 2651, 981, 2942, 906, 3210, 761, 3334, 752, 3410, 814, 3466, 842, 3695, 626, 3711, 532, 3672, 147, 3661, -121, 3571, -217, 3517, -112, 3265, -131, 3226, -365, 3164, -369, 3090, -524, 3000, -867, 2884, -868, 2740, 315, 1969, 316, 1906, 568, 1960, 1200, 2347},
+		{-8018, -382, -8044, -443, -7962, -445, -7921, -496, -7864, -455, -7784, -300, -7664, -261, -7554, -156, -7537, -15, -7629, 42, -7742, 40, -7886, 138, -8009, 77, -8058, -91, -8093, -106, -8097, -225, -8037, -269, -7999, -222, -7977, -266, -8030, -340},
+		{3427, 3122, 3377, 3097, 3219, 3126, 3196, 3093, 3169, 3143, 3098, 3156, 2891, 3087, 2650, 3159, 2516, 3157, 2470, 3004, 2500, 2924, 2500, 2200, 3687, 2200, 3553, 2310, 3569, 2393, 3410, 2614, 3232, 2976, 3314, 2842, 3392, 2765, 3415, 2782, 3492, 2950},
+		{4278, 1246, 4308, 1270, 3927, 1592, 3841, 1800, 3685, 1696, 3643, 1442, 3759, 1421, 3791, 1496, 3851, 1451, 4003, 1452},
+		{-867, 4213, -826, 4228, -801, 4179, -667, 4188, -639, 4138, -685, 4111, -707, 3971, -750, 3963, -703, 3808, -754, 3743, -538, 3595, -437, 3668, -215, 3667, -144, 3744, -68, 3764, 11, 3874, -28, 3931, 81, 4101, 209, 4123, 304, 4189, 299, 4247, 34, 4258, -190, 4342, -798, 4375, -939, 4303, -898, 4259, -903, 4188},
+		{2516, 5797, 2729, 5747, 2772, 5779, 2742, 5872, 2813, 5930, 2586, 5961, 2334, 5919, 2343, 5861, 2443, 5838, 2431, 5779},
+		{3759, 1421, 3643, 1442, 3586, 1258, 3426, 1063, 3383, 838, 3329, 835, 3295, 778, 3357, 771, 3471, 659, 3616, 445, 3956, 342, 4077, 426, 4186, 392, 4366, 496, 4496, 500, 4779, 800, 4368, 918, 4256, 1057, 4278, 1093, 4176, 1105, 4166, 1163, 4235, 1254, 4090, 1412, 3910, 1474, 3851, 1451, 3791, 1496},
+		{2902, 6977, 2773, 7016, 2618, 6983, 2569, 6909, 2474, 6865, 2236, 6884, 2124, 6937, 2065, 6911, 2354, 6794, 2357, 6640, 2529, 6553, 2540, 6511, 2154, 6319, 2106, 6261, 2154, 6171, 2132, 6072, 2287, 5985, 2807, 6050, 3114, 6236, 3152, 6287, 3004, 6355, 3044, 6420, 2954, 6495, 3022, 6581, 2905, 6694, 2998, 6770, 2845, 6836},
+		{17813, -1750, 17767, -1738, 17738, -1816, 17855, -1815, 17872, -1763},
+		{18000, -1656, 18000, -1607, 17860, -1664, 17873, -1701},
+		{-17979, -1602, -18000, -1607, -18000, -1656, -17992, -1650},
+		{-6070, -5230, -5985, -5185, -5940, -5220, -5805, -5190, -5775, -5155, -5855, -5110, -5915, -5150, -6000, -5125, -6120, -5185},
+		{-5225, 324, -5166, 416, -5182, 457, -5396, 576, -5448, 490, -5401, 362, -5452, 231, -5294, 212},
+		{939, 4301, 854, 4226, 923, 4138, 956, 4215},
+		{312, 5078, 251, 5115, 164, 5095, 134, 5013, -99, 4935, -193, 4978, -162, 4864, -459, 4868, -449, 4795, -296, 4757, -119, 4601, -138, 4402, -190, 4342, -150, 4303, 183, 4234, 299, 4247, 310, 4308, 456, 4340, 653, 4313, 744, 4369, 755, 4413, 701, 4425, 675, 4503, 710, 4533, 684, 4599, 650, 4643, 602, 4627, 604, 4673, 674, 4754, 747, 4762, 810, 4902, 429, 4991},
+		{1186, -343, 1148, -277, 1250, -239, 1258, -195, 1311, -243, 1399, -247, 1430, -200, 1432, -55, 1384, 4, 1428, 120, 1328, 131, 1295, 232, 1128, 226, 1129, 106, 949, 101, 883, -78, 941, -214, 1109, -398},
+		{-673, 5517, -757, 5513, -757, 5406, -620, 5387, -566, 5455},
+		{-421, 5855, -501, 5863, -615, 5679, -564, 5628, -559, 5531, -505, 5578, -472, 5551, -508, 5506, -484, 5479, -363, 5462, -295, 5398, -309, 5340, -458, 5350, -477, 5284, -422, 5230, -527, 5199, -498, 5159, -341, 5143, -578, 5016, -362, 5023, -296, 5070, 55, 5077, 145, 5129, 105, 5181, 156, 5210, 168, 5274, 47, 5293, -43, 5446, -111, 5462, -209, 5591, -312, 5597, -196, 5768, -407, 5755, -301, 5864},
+		{4262, 4158, 4358, 4109, 4522, 4141, 4664, 4118, 4615, 4172, 4640, 4186, 4547, 4250, 3996, 4343, 4145, 4265, 4155, 4154},
+		{84, 628, 2, 1102, -294, 1096, -256, 822, -324, 625, -286, 499, -196, 471, -106, 500, 106, 593},
+		{-828, 769, -830, 832, -783, 858, -831, 979, -803, 1021, -913, 1231, -1017, 1184, -1146, 1208, -1151, 1244, -1370, 1259, -1374, 1181, -1513, 1104, -1325, 890, -1243, 984, -1112, 1005, -1051, 835, -976, 854, -921, 731},
+		{-1593, 1313, -1384, 1351, -1540, 1386, -1671, 1359, -1684, 1315},
+		{-1469, 1153, -1374, 1181, -1370, 1259, -1668, 1238, -1609, 1152, -1513, 1104},
+		{983, 107, 1129, 106, 1128, 226, 965, 228, 931, 116},
+		{2351, 3528, 2472, 3492, 2616, 3500, 2629, 3530, 2370, 3571},
+		{2612, 4183, 2611, 4133, 2449, 4158, 2102, 4084, 2015, 3962, 2167, 3684, 2315, 3642, 2277, 3731, 2341, 3741, 2312, 3792, 2404, 3766, 2403, 3822, 2297, 3897, 2335, 3919, 2285, 3966, 2281, 4048, 2334, 3996, 2441, 4012, 2371, 4069, 2629, 4094, 2660, 4156},
+		{-4690, 8220, -4452, 8166, -5039, 8244, -5304, 8189, -5721, 8219, -6265, 8177, -6223, 8132, -6802, 8012, -6532, 7976, -6571, 7939, -7316, 7843, -7330, 7804, -6676, 7738, -7140, 7701, -6850, 7606, -6127, 7610, -5859, 7552, -5860, 7510, -5732, 7471, -5472, 7259, -5583, 7165, -5400, 7155, -5139, 7057, -5436, 7082, -5468, 6961, -5346, 6928, -5087, 6993, -5148, 6873, -5298, 6836, -5397, 6719, -5330, 6684, -5366, 6610, -5228, 6518, -5163, 6363, -4826, 6086, -4626, 6085, -4479, 6004, -4338, 6010, -4242, 6190, -4282, 6268, -4119, 6348, -4067, 6484, -3981, 6546, -3420, 6668, -3178, 6812, -2775, 6847, -2235, 7013, -2636, 7023, -2520, 7075, -2554, 7143, -2354, 7047, -2175, 7066, -2213, 7147, -2479, 7233, -2428, 7260, -2230, 7218, -2231, 7263, -2357, 7331, -2076, 7346, -2043, 7382, -2159, 7422, -1937, 7430, -2067, 7516, -1960, 7525, -1983, 7610, -2168, 7663, -1847, 7699, -1967, 7764, -1970, 7875, -1773, 8013, -2005, 8018, -1685, 8035, -1221, 8129, -1277, 8172, -1577, 8191, -2317, 8115, -2207, 8173, -2290, 8209, -2484, 8179, -3190, 8220, -2269, 8234, -2085, 8273, -2710, 8352, -3862, 8355, -3990, 8318, -4341, 8323, -4676, 8263},
+		{-9006, 1388, -8823, 1573, -8923, 1589, -8914, 1781, -9100, 1782, -9100, 1725, -9145, 1725, -9046, 1607, -9175, 1607, -9223, 1454, -9123, 1393},
+		{-6055, 778, -6030, 704, -6116, 670, -6141, 596, -6073, 520, -5998, 501, -6011, 457, -5954, 396, -5997, 276, -5965, 179, -5854, 127, -5734, 195, -5654, 190, -5804, 406, -5715, 597, -5976, 837},
+		{-8701, 1303, -8676, 1375, -8580, 1384, -8492, 1479, -8315, 1500, -8498, 1600, -8790, 1586, -8915, 1507, -8935, 1442, -8850, 1385, -8786, 1389, -8779, 1338},
+		{1846, 4576, 1656, 4650, 1577, 4624, 1533, 4545, 1372, 4550, 1366, 4514, 1395, 4480, 1426, 4523, 1490, 4508, 1517, 4424, 1602, 4351, 1845, 4248, 1575, 4482, 1596, 4523, 1901, 4486, 1939, 4524, 1883, 4591},
+		{-7342, 1964, -7278, 1948, -7233, 1867, -7437, 1866, -7446, 1834, -7392, 1803, -7171, 1804, -7171, 1971, -7319, 1992},
+		{1637, 4684, 1846, 4576, 2102, 4632, 2210, 4767, 2271, 4788, 2209, 4842, 2080, 4862, 1786, 4776, 1698, 4812, 1690, 4771, 1634, 4771},
+		{12078, -997, 11990, -936, 11897, -956, 12030, -1026},
+		{12509, -939, 12497, -889, 12398, -929, 12346, -1024, 12444, -1014},
+		{11763, -845, 11708, -846, 11674, -903, 11913, -871, 11888, -828, 11790, -810},
+		{12201, -846, 12072, -824, 11992, -844, 11992, -881, 12276, -865, 12290, -809},
+		{10849, -642, 10605, -590, 10537, -685, 10828, -777, 11456, -875, 11571, -837, 11298, -759, 11261, -695, 11076, -647, 11054, -688, 10862, -678},
+		{13473, -574, 13450, -545, 13411, -614, 13421, -690},
+		{12700, -313, 12599, -318, 12687, -379, 12725, -346},
+		{12937, -280, 12814, -284, 12790, -339, 12999, -345, 13083, -386, 13047, -309},
+		{13399, -78, 13238, -37, 13052, -94, 13223, -221, 13370, -221, 13378, -248, 13199, -282, 13275, -331, 13298, -411, 13366, -354, 13793, -539, 13867, -732, 13804, -760, 13761, -841, 14014, -830, 14103, -912, 14100, -260, 13744, -170, 13629, -231, 13546, -337, 13442, -277},
+		{12507, 164, 12408, 92, 12089, 131, 12004, 57, 11877, -280, 11908, -349, 11950, -349, 11937, -538, 12043, -553, 12031, -293, 12097, -263, 12090, -360, 12162, -419, 12149, -457, 12174, -485, 12272, -446, 12224, -528, 12263, -563, 12316, -534, 12317, -468, 12227, -353, 12245, -319, 12151, -190, 12282, -93, 12326, -108, 12334, -62, 12148, -96, 12094, -141, 12004, -52, 12018, 24, 12444, 43, 12524, 142},
+		{12859, 154, 12800, 163, 12793, 217, 12740, 101, 12810, -90, 12838, -78, 12797, -25, 12812, 36, 12864, 26},
+		{11805, 229, 11731, 323, 11788, 414, 11587, 431, 11462, 143, 11286, 150, 11180, 90, 11051, 77, 10966, 201, 10907, 134, 10895, 42, 10909, -46, 11007, -159, 11022, -293, 11170, -299, 11207, -348, 11326, -312, 11447, -350, 11486, -411, 11600, -366, 11615, -401, 11656, -149, 11752, -80, 11781, 78, 11900, 90, 11788, 183},
+		{10586, -431, 10611, -306, 10562, -243, 10489, -234, 10437, -108, 10344, -71, 10384, 10, 10250, 140, 10166, 208, 10064, 210, 9748, 525, 9529, 548, 9538, 497, 9860, 182, 9926, 18, 10258, -422, 10471, -587, 10582, -585},
+		{7687, 3465, 7424, 3475, 7375, 3432, 7445, 3276, 7526, 3227, 7441, 3169, 7442, 3098, 7178, 2791, 7062, 2799, 6951, 2694, 7017, 2649, 7104, 2436, 6884, 2436, 6818, 2369, 6935, 2284, 6964, 2245, 6916, 2209, 7047, 2088, 7118, 2076, 7263, 2136, 7353, 1599, 7444, 1462, 7486, 1274, 7659, 890, 7754, 797, 7828, 893, 7919, 922, 7889, 955, 7934, 1031, 7986, 1036, 8032, 1590, 8219, 1656, 8219, 1702, 8506, 1948, 8650, 2015, 8703, 2074, 8698, 2150, 8889, 2169, 8870, 2423, 8808, 2450, 8893, 2524, 8821, 2577, 8856, 2645, 8983, 2597, 8992, 2527, 9238, 2498, 9116, 2350, 9171, 2299, 9215, 2363, 9267, 2204, 9317, 2228, 9333, 2408, 9411, 2385, 9512, 2657, 9642, 2726, 9713, 2708, 9705, 2770, 9740, 2788, 9733, 2826, 9625, 2841, 9659, 2883, 9612, 2945, 9540, 2903, 9457, 2928, 9250, 2790, 9170, 2777, 9210, 2745, 9203, 2684, 8974, 2672, 8884, 2710, 8873, 2809, 8812, 2788, 8806, 2641, 8330, 2736, 8009, 2879, 8111, 3018, 7874, 3152, 7846, 3262, 7918, 3248, 7921, 3299, 7881, 3351, 7891, 3432, 7784, 3549},
+		{-695, 5407, -757, 5406, -757, 5513, -969, 5388, -917, 5286, -998, 5182, -856, 5167, -679, 5226, -603, 5315, -620, 5387},
+		{5383, 3697, 5084, 3687, 4920, 3758, 4888, 3832, 4801, 3879, 4836, 3929, 4806, 3958, 4614, 3874, 4546, 3887, 4479, 3971, 4411, 3943, 4423, 3797, 4542, 3598, 4608, 3568, 4615, 3509, 4542, 3397, 4611, 3302, 4733, 3247, 4785, 3171, 4769, 3098, 4857, 2993, 4894, 3032, 5012, 3015, 5152, 2787, 5349, 2681, 5472, 2648, 5649, 2714, 5697, 2697, 5740, 2574, 6150, 2508, 6187, 2624, 6332, 2676, 6276, 2738, 6273, 2826, 6177, 2870, 6087, 2983, 6178, 3074, 6170, 3138, 6094, 3155, 6054, 3298, 6096, 3353, 6053, 3368, 6121, 3565, 6112, 3649, 5662, 3812, 5551, 3796},
+		{4477, 3717, 4235, 3723, 4129, 3636, 4101, 3442, 3879, 3338, 3920, 3216, 4189, 3119, 4471, 2918, 4657, 2910, 4730, 3006, 4857, 2993, 4769, 3098, 4785, 3171, 4733, 3247, 4611, 3302, 4542, 3397, 4615, 3509, 4608, 3568, 4542, 3598},
+		{-1617, 6653, -1780, 6599, -1906, 6628, -2058, 6573, -2213, 6641, -2365, 6626, -2433, 6561, -2223, 6538, -2218, 6508, -2396, 6489, -2178, 6440, -2276, 6396, -1866, 6350, -1491, 6436, -1361, 6513, -1474, 6581, -1451, 6646},
+		{3570, 3272, 3582, 3328, 3510, 3308, 3427, 3122, 3492, 2950, 3542, 3110, 3540, 3149, 3493, 3135, 3523, 3175, 3497, 3187, 3518, 3253, 3555, 3239},
+		{1476, 3814, 1257, 3813, 1243, 3761, 1510, 3662, 1552, 3823},
+		{871, 4090, 816, 4095, 843, 3917, 881, 3891, 967, 3918, 981, 4050, 921, 4121},
+		{1215, 4712, 1044, 4689, 1036, 4648, 918, 4644, 897, 4604, 684, 4599, 701, 4425, 755, 4413, 744, 4369, 889, 4437, 1020, 4392, 1051, 4293, 1119, 4236, 1541, 4005, 1611, 3896, 1568, 3791, 1610, 3799, 1705, 3890, 1717, 3942, 1645, 3980, 1687, 4044, 1829, 3981, 1848, 4017, 1589, 4154, 1617, 4174, 1593, 4196, 1514, 4196, 1259, 4409, 1233, 4538, 1394, 4559, 1381, 4651},
+		{-7780, 1852, -7834, 1823, -7721, 1770, -7620, 1789},
+		{3555, 3178, 3492, 2950, 3607, 2920, 3800, 3051, 3700, 3151, 3920, 3216, 3879, 3338, 3683, 3231, 3572, 3271},
+		{13390, 3436, 13349, 3394, 13292, 3406, 13236, 3299, 13301, 3270, 13379, 3352, 13420, 3320, 13477, 3381},
+		{14096, 3817, 14188, 3918, 14137, 4138, 14031, 4120, 13988, 4056, 14005, 3944, 13943, 3822, 13739, 3683, 13672, 3730, 13568, 3553, 13262, 3543, 13035, 3360, 12941, 3330, 13045, 3232, 13020, 3142, 13069, 3103, 13133, 3145, 13200, 3315, 13099, 3389, 13508, 3460, 13512, 3385, 13579, 3346, 13722, 3461, 14025, 3514, 14077, 3584},
+		{14314, 4451, 14197, 4555, 14138, 4339, 14031, 4333, 13982, 4256, 13996, 4157, 14107, 4158, 14161, 4268, 14318, 4200, 14406, 4299, 14554, 4326, 14532, 4438, 14461, 4396},
+		{7119, 4270, 7349, 4250, 7365, 4309, 7421, 4330, 7564, 4288, 7914, 4286, 8026, 4235, 8018, 4292, 8087, 4318, 7997, 4492, 8246, 4554, 8318, 4733, 8516, 4700, 8572, 4745, 8577, 4846, 8660, 4855, 8736, 4921, 8683, 4983, 8554, 4969, 8338, 5107, 8195, 5081, 8057, 5139, 8004, 5086, 7780, 5340, 7653, 5418, 7689, 5449, 7343, 5349, 7351, 5404, 7222, 5438, 7118, 5413, 7087, 5517, 6907, 5539, 6518, 5435, 6144, 5401, 6098, 5366, 6170, 5298, 6074, 5272, 6093, 5245, 5997, 5196, 6159, 5127, 6134, 5080, 5993, 5084, 5964, 5055, 5836, 5106, 5678, 5104, 5572, 5062, 5233, 5172, 5077, 5169, 4870, 5061, 4858, 4987, 4755, 5045, 4647, 4839, 4732, 4772, 4806, 4774, 4869, 4708, 4859, 4656, 4910, 4640, 5119, 4705, 5304, 4685, 5304, 4526, 5132, 4525, 5128, 4451, 5031, 4461, 5134, 4313, 5250, 4279, 5250, 4178, 5408, 4232, 5546, 4126, 5597, 4131, 5593, 4500, 5850, 4559, 6106, 4441, 6201, 4350, 6490, 4373, 6610, 4300, 6602, 4199, 6651, 4199, 6671, 4117, 6799, 4114, 6826, 4066},
+		{4098, 278, 4186, 392, 4077, 426, 3956, 342, 3812, 360, 3686, 445, 3616, 445, 3582, 534, 3530, 551, 3401, 425, 3504, 191, 3389, 11, 3390, -95, 3770, -310, 3777, -368, 3920, -468, 4026, -257, 4159, -168, 4099, -86},
+		{7126, 4217, 7042, 4152, 7306, 4087, 7177, 4015, 6956, 4010, 6946, 3953, 7368, 3943, 7382, 3989, 7478, 4037, 7653, 4043, 7690, 4107, 7819, 4119, 8026, 4235, 7914, 4286, 7564, 4288, 7421, 4330, 7365, 4309, 7349, 4250, 7119, 4270, 7096, 4227},
+		{10433, 1049, 10625, 1096, 10581, 1157, 10749, 1234, 10738, 1420, 10650, 1457, 10604, 1388, 10428, 1442, 10299, 1423, 10235, 1339, 10258, 1219, 10350, 1063},
+		{12821, 3837, 12617, 3775, 12686, 3689, 12612, 3673, 12656, 3568, 12649, 3439, 12909, 3508, 12947, 3563, 12921, 3743, 12835, 3861},
+		{2135, 4221, 2178, 4268, 2081, 4327, 2007, 4259, 2059, 4186},
+		{4730, 3006, 4657, 2910, 4842, 2855, 4797, 2998},
+		{10604, 1388, 10650, 1457, 10738, 1420, 10731, 1591, 10509, 1867, 10390, 1927, 10482, 1989, 10444, 2076, 10320, 2077, 10217, 2246, 10165, 2232, 10180, 2117, 10118, 2144, 10012, 2042, 10061, 1951, 10128, 1946, 10106, 1751, 10211, 1811, 10396, 1824, 10559, 1557, 10522, 1427},
+		{3607, 3382, 3661, 3420, 3645, 3459, 3600, 3464, 3513, 3309, 3582, 3328},
+		{-764, 519, -757, 571, -860, 647, -844, 769, -921, 731, -976, 854, -1115, 740, -1144, 679, -900, 483, -771, 436},
+		{1586, 2341, 2384, 1958, 2385, 2000, 2500, 2000, 2492, 3190, 2324, 3219, 2290, 3264, 2085, 3271, 1982, 3175, 2005, 3099, 1909, 3027, 1571, 3138, 1525, 3227, 1149, 3314, 1143, 3237, 995, 3138, 997, 3054, 948, 3031, 986, 2896, 972, 2651, 932, 2609, 1030, 2438, 1077, 2456, 1200, 2347, 1358, 2304, 1414, 2249},
+		{8130, 856, 8015, 982, 7970, 820, 8035, 597, 8164, 648, 8179, 752},
+		{2854, -2865, 2700, -2988, 2775, -3065, 2885, -3007, 2933, -2926},
+		{2324, 5422, 2445, 5391, 2554, 5428, 2577, 5485, 2659, 5517, 2649, 5562, 2486, 5637, 2106, 5603, 2127, 5519, 2276, 5486, 2273, 5433},
+		{578, 5009, 567, 4953, 619, 4946, 624, 4990},
+		{2220, 5634, 2486, 5637, 2649, 5562, 2818, 5617, 2777, 5724, 2516, 5797, 2431, 5779, 2412, 5703, 2332, 5701, 2252, 5775, 2158, 5741, 2106, 5603},
+		{-593, 3576, -691, 3411, -930, 3256, -981, 3118, -956, 2993, -1169, 2815, -1262, 2804, -1444, 2625, -1702, 2142, -1475, 2150, -1389, 2369, -1250, 2477, -1139, 2688, -879, 2712, -867, 2884, -524, 3000, -369, 3090, -365, 3164, -131, 3226, -112, 3265, -217, 3517},
+		{2692, 4812, 2813, 4681, 2823, 4549, 2886, 4644, 3002, 4642, 2867, 4812, 2752, 4847, 2662, 4822},
+		{4919, -1204, 4829, -1378, 4787, -1366, 4771, -1459, 4631, -1578, 4445, -1622, 4396, -1741, 4446, -1944, 4389, -2116, 4343, -2134, 4335, -2278, 4404, -2499, 4541, -2560, 4710, -2494, 4977, -1688, 4967, -1571, 4986, -1541, 5020, -1600, 5038, -1571, 5006, -1356},
+		{-9753, 2584, -9902, 2637, -10096, 2938, -10248, 2976, -10311, 2897, -10394, 2927, -10651, 3175, -10824, 3175, -10824, 3134, -11102, 3133, -11472, 3272, -11713, 3254, -11552, 2956, -11416, 2857, -11457, 2774, -11506, 2772, -11230, 2601, -11218, 2474, -11030, 2343, -11003, 2282, -10941, 2336, -11017, 2427, -11066, 2430, -11162, 2666, -11467, 3016, -11478, 3180, -11315, 3117, -11223, 2895, -11064, 2786, -11039, 2716, -10929, 2644, -10926, 2558, -10840, 2517, -10603, 2277, -10527, 2142, -10540, 2053, -10573, 2043, -10499, 1932, -10350, 1829, -9656, 1565, -9469, 1620, -9388, 1594, -9223, 1454, -9175, 1607, -9046, 1607, -9145, 1725, -9100, 1725, -9100, 1782, -8885, 1788, -8849, 1849, -8784, 1826, -8681, 2133, -8854, 2149, -9028, 2100, -9077, 1928, -9443, 1814, -9590, 1883, -9770, 2190, -9770, 2427, -9714, 2587},
+		{2046, 4152, 2102, 4084, 2295, 4134, 2288, 4200, 2238, 4232, 2076, 4205},
+		{-1212, 1399, -1155, 1314, -1146, 1208, -1017, 1184, -913, 1231, -803, 1021, -685, 1014, -621, 1052, -605, 1010, -540, 1037, -522, 1171, -401, 1347, -310, 1354, -107, 1497, 102, 1497, 139, 1532, 364, 1557, 427, 1685, 427, 1916, 316, 1906, 315, 1969, -492, 2497, -645, 2496, -532, 1620, -554, 1550, -955, 1549, -1065, 1513, -1167, 1539},
+		{10012, 2042, 10118, 2144, 10115, 2185, 10042, 2156, 9924, 2212, 9953, 2295, 9890, 2314, 9866, 2406, 9760, 2390, 9772, 2508, 9867, 2592, 9868, 2751, 9791, 2834, 9733, 2826, 9713, 2708, 9642, 2726, 9512, 2657, 9411, 2385, 9333, 2408, 9317, 2228, 9267, 2204, 9265, 2132, 9230, 2148, 9237, 2067, 9366, 1973, 9354, 1937, 9432, 1821, 9453, 1728, 9419, 1604, 9537, 1571, 9716, 1693, 9851, 1312, 9855, 993, 9959, 1189, 9910, 1383, 9819, 1512, 9890, 1618, 9738, 1845, 9825, 1971},
+		{2007, 4259, 2034, 4290, 1922, 4352, 1845, 4248, 1937, 4188, 1974, 4269},
+		{8801, 4860, 9028, 4769, 9097, 4689, 9059, 4572, 9095, 4529, 9348, 4498, 9531, 4424, 9635, 4273, 10085, 4266, 10496, 4160, 10613, 4213, 11041, 4287, 11183, 4374, 11135, 4446, 11187, 4510, 11346, 4481, 11742, 4667, 11966, 4669, 11977, 4705, 11806, 4807, 11730, 4770, 11574, 4773, 11549, 4814, 11668, 4989, 11549, 4981, 11436, 5025, 11290, 4954, 11066, 4913, 10848, 4928, 10689, 5027, 10368, 5009, 10226, 5051, 10207, 5126, 9886, 5205, 9783, 5101, 9823, 5042, 9726, 4973, 9223, 5080, 8775, 4930},
+		{3428, -1228, 3456, -1358, 3527, -1389, 3569, -1461, 3577, -1590, 3503, -1680, 3438, -1618, 3446, -1461, 3321, -1397, 3018, -1480, 3034, -1588, 3117, -1586, 3285, -1671, 3266, -2030, 3119, -2225, 3193, -2437, 3207, -2673, 3283, -2674, 3257, -2573, 3301, -2536, 3546, -2412, 3556, -2209, 3470, -2050, 3479, -1978, 3741, -1759, 3945, -1672, 4078, -1469, 4032, -1032, 3747, -1157, 3456, -1152},
+		{-1183, 1480, -1167, 1539, -1065, 1513, -955, 1549, -554, 1550, -532, 1620, -645, 2496, -492, 2497, -868, 2740, -869, 2588, -1197, 2593, -1194, 2337, -1287, 2328, -1293, 2133, -1685, 2133, -1706, 2100, -1628, 2009, -1646, 1614, -1458, 1660, -1344, 1604, -1217, 1462},
+		{3428, -1016, 3374, -942, 3276, -923, 3349, -1053, 3311, -1161, 3331, -1244, 3269, -1371, 3446, -1461, 3438, -1618, 3503, -1680, 3577, -1590, 3569, -1461, 3527, -1389, 3456, -1358},
+		{10026, 664, 10020, 531, 10139, 276, 10352, 123, 10423, 129, 10350, 279, 10338, 486, 10296, 552, 10214, 622, 10115, 569, 10108, 620},
+		{11844, 497, 11911, 502, 11918, 541, 11769, 599, 11713, 693, 11673, 692, 11545, 545, 11535, 432, 11466, 401, 11420, 453, 11300, 310, 11137, 270, 11117, 185, 11040, 166, 10966, 201, 10983, 134, 11051, 77, 11180, 90, 11286, 150, 11462, 143, 11587, 431, 11788, 414, 11862, 448},
+		{1682, -2808, 1739, -2878, 1846, -2905, 1989, -2846, 1990, -2185, 2088, -2181, 2091, -1825, 2320, -1787, 2358, -1828, 2508, -1758, 2403, -1730, 2138, -1793, 1896, -1779, 1826, -1731, 1406, -1742, 1346, -1697, 1173, -1730, 1179, -1807, 1426, -2211, 1521, -2709, 1634, -2858},
+		{16546, -2080, 16403, -2011, 16547, -2168, 16674, -2240, 16712, -2216},
+		{249, 1223, 361, 1166, 411, 1353, 544, 1387, 682, 1312, 780, 1334, 901, 1283, 1099, 1339, 1230, 1304, 1308, 1360, 1418, 1248, 1460, 1333, 1395, 1335, 1354, 1437, 1397, 1568, 1525, 1663, 1590, 2039, 1510, 2131, 1485, 2286, 1414, 2249, 1358, 2304, 1200, 2347, 568, 1960, 427, 1916, 427, 1685, 364, 1557, 37, 1493, 102, 1285, 218, 1263, 215, 1194},
+		{876, 548, 923, 644, 1012, 704, 1106, 664, 1175, 698, 1357, 1080, 1458, 1209, 1308, 1360, 1230, 1304, 1099, 1339, 901, 1283, 780, 1334, 682, 1312, 544, 1387, 437, 1375, 368, 1255, 371, 1006, 272, 851, 269, 626, 433, 627, 590, 426, 850, 477},
+		{-8556, 1122, -8366, 1094, -8386, 1137, -8315, 1500, -8492, 1479, -8580, 1384, -8676, 1375, -8673, 1326, -8767, 1291},
+		{471, 5309, 331, 5135, 497, 5148, 616, 5080, 599, 5185, 684, 5223, 691, 5348},
+		{2637, 7099, 2455, 7103, 2302, 7020, 1918, 6982, 1476, 6781, 1053, 6449, 591, 6261, 499, 6197, 567, 5859, 705, 5808, 838, 5831, 1036, 5947, 1103, 5886, 1230, 6012, 1263, 6129, 1199, 6180, 1193, 6313, 1258, 6407, 1357, 6405, 1392, 6445, 1356, 6479, 1677, 6801, 1773, 6801, 1799, 6857, 1988, 6841, 2003, 6907, 2124, 6937, 2236, 6884, 2474, 6865, 2569, 6909, 2618, 6983, 2773, 7016, 2902, 6977, 2859, 6906, 2940, 6916, 3110, 6956, 3001, 7019, 3129, 7045, 2817, 7119},
+		{2328, 7808, 2288, 7845, 2081, 7825, 2142, 7794, 2073, 7768, 2249, 7744, 2472, 7785},
+		{1699, 8005, 1552, 8002, 1514, 7967, 1372, 7966, 1317, 8001, 1044, 7965, 1122, 7887, 1467, 7774, 1376, 7738, 1591, 7677, 1712, 7681, 1759, 7764, 1847, 7783, 1903, 7856, 2154, 7896},
+		{2292, 8066, 1737, 8032, 2008, 7957, 2302, 7940, 2592, 7952, 2741, 8006},
+		{8695, 2797, 8423, 2884, 8153, 3042, 8048, 2973, 8009, 2879, 8330, 2736, 8468, 2723, 8525, 2673, 8806, 2641, 8812, 2788},
+		{17280, -4049, 17052, -4303, 16705, -4511, 16651, -4585, 16668, -4622, 16933, -4664, 17062, -4591, 17145, -4424, 17308, -4385, 17271, -4337, 17425, -4177, 17425, -4135, 17396, -4093, 17325, -4133},
+		{17433, -3527, 17264, -3453, 17470, -3738, 17457, -3880, 17382, -3951, 17490, -3991, 17523, -4046, 17465, -4128, 17524, -4169, 17601, -4129, 17721, -3915, 17797, -3917, 17852, -3770, 17676, -3788, 17596, -3756, 17581, -3680, 17536, -3653, 17534, -3721},
+		{5928, 2143, 5981, 2253, 5873, 2357, 5740, 2388, 5640, 2492, 5589, 2492, 5598, 2413, 5523, 2311, 5567, 2200, 5500, 2000, 5200, 1900, 5311, 1665, 5479, 1695, 5566, 1788, 5628, 1788, 5661, 1857, 5769, 1894, 5783, 2024, 5849, 2043},
+		{5649, 2631, 5607, 2606, 5626, 2571},
+		{7458, 3702, 7185, 3651, 7126, 3607, 7161, 3515, 7088, 3399, 6993, 3402, 7032, 3336, 6926, 3250, 6932, 3190, 6694, 3130, 6638, 3074, 6635, 2989, 6255, 2932, 6087, 2983, 6177, 2870, 6273, 2826, 6276, 2738, 6332, 2676, 6187, 2624, 6150, 2508, 6637, 2543, 6744, 2394, 6818, 2369, 6884, 2436, 7104, 2436, 7017, 2649, 6951, 2694, 7062, 2799, 7178, 2791, 7442, 3098, 7441, 3169, 7526, 3227, 7445, 3276, 7375, 3432, 7424, 3475, 7687, 3465, 7784, 3549, 7619, 3590, 7590, 3667, 7516, 3713},
+		{-7775, 771, -7724, 794, -7735, 867, -7850, 942, -7957, 961, -8144, 879, -8293, 948, -8285, 807, -8172, 811, -8152, 771, -8106, 782, -8089, 722, -8000, 755, -8048, 809, -7956, 893, -7818, 832, -7843, 805, -7788, 722},
+		{-6896, -1650, -6934, -1495, -6867, -1256, -6953, -1095, -7055, -1101, -7048, -949, -7130, -1008, -7323, -946, -7302, -903, -7399, -752, -7312, -663, -7289, -527, -7079, -425, -6989, -430, -7069, -374, -7005, -273, -7081, -226, -7307, -231, -7366, -126, -7537, -15, -7554, -156, -7664, -261, -7784, -300, -7921, -496, -7962, -445, -8044, -443, -8030, -340, -8110, -404, -8141, -474, -8093, -569, -8125, -614, -7976, -719, -7626, -1354, -7601, -1465, -7037, -1835, -6986, -1809},
+		{12631, 878, 12622, 929, 12541, 976, 12547, 899, 12476, 896, 12460, 851, 12384, 824, 12349, 869, 12231, 803, 12192, 719, 12209, 690, 12361, 783, 12424, 736, 12394, 689, 12422, 616, 12540, 558, 12568, 605, 12536, 679, 12583, 729, 12620, 627, 12654, 719},
+		{12408, 1123, 12334, 1027, 12350, 1094, 12295, 1088, 12238, 971, 12300, 902, 12398, 1028},
+		{11903, 1000, 11969, 1055, 11951, 1137, 11717, 837},
+		{12204, 1142, 12200, 1044, 12310, 1117, 12312, 1158, 12188, 1189},
+		{12523, 1254, 12427, 1256, 12488, 1179, 12489, 1142, 12430, 1150, 12480, 1013, 12528, 1036, 12501, 1131, 12578, 1105},
+		{12118, 1343, 12032, 1347, 12126, 1221, 12153, 1307},
+		{12072, 1851, 12029, 1603, 11988, 1636, 12007, 1497, 12056, 1440, 12069, 1476, 12099, 1453, 12063, 1386, 12203, 1378, 12267, 1319, 12293, 1355, 12408, 1254, 12395, 1378, 12173, 1433, 12166, 1593, 12225, 1626, 12252, 1709, 12225, 1848, 12194, 1822},
+		{15602, -654, 15451, -514, 15517, -654, 15560, -692},
+		{15232, -487, 15234, -431, 15154, -417, 15165, -476, 15081, -546, 15024, -553, 15014, -500, 14985, -551, 14840, -544, 14832, -575, 15024, -632, 15198, -548},
+		{14697, -672, 14789, -661, 14765, -608, 14598, -547, 14583, -488, 14458, -386, 14100, -260, 14103, -912, 14263, -933, 14341, -898, 14329, -825, 14474, -763, 14605, -807, 14791, -1013, 15069, -1058, 15080, -1029, 14974, -987, 15004, -968, 14927, -951, 14931, -907, 14873, -910},
+		{15302, -398, 15148, -278, 15094, -250, 15066, -274, 15241, -379, 15283, -477},
+		{1549, 5078, 1672, 5022, 1755, 5036, 1891, 4944, 2161, 4947, 2278, 4903, 2252, 4948, 2403, 5071, 2320, 5249, 2380, 5269, 2380, 5309, 2324, 5422, 2273, 5433, 1870, 5444, 1762, 5485, 1636, 5451, 1412, 5376, 1461, 5175},
+		{-6710, 1852, -6718, 1795, -6559, 1823},
+		{12999, 4299, 12960, 4242, 12805, 4199, 12821, 4147, 12687, 4182, 12427, 3993, 12539, 3939, 12471, 3811, 12528, 3767, 12821, 3837, 12835, 3861, 12739, 3921, 12753, 3976, 12971, 4088, 12967, 4160, 13078, 4222},
+		{-899, 4154, -877, 4076, -953, 3874, -884, 3827, -890, 3687, -786, 3684, -703, 3808, -750, 3963, -707, 3971, -685, 4111, -639, 4138, -667, 4188, -801, 4179, -826, 4228, -903, 4188},
+		{-6085, -2388, -5778, -2516, -5763, -2560, -5862, -2712, -5649, -2755, -5479, -2662, -5429, -2402, -5540, -2396, -5580, -2236, -5794, -2209, -5818, -1987, -5912, -1936, -6179, -1963, -6227, -2051, -6269, -2225},
+		{3518, 3253, 3493, 3135, 3555, 3178, 3555, 3239},
+		{5111, 2456, 5161, 2522, 5129, 2611, 5074, 2548},
+		{2210, 4767, 2102, 4632, 2022, 4613, 2156, 4477, 2271, 4458, 2247, 4441, 2294, 4382, 2557, 4369, 2724, 4418, 2856, 4371, 2884, 4491, 2963, 4504, 2915, 4546, 2823, 4549, 2813, 4681, 2692, 4812, 2487, 4774, 2314, 4810},
+		{14324, 5176, 14265, 5437, 14221, 5423, 14261, 5376, 14168, 5330, 14159, 5194, 14218, 5095, 14209, 4597, 14275, 4674, 14351, 4614, 14353, 4684, 14256, 4786, 14317, 4931, 14465, 4898},
+		{2265, 5458, 2276, 5486, 2127, 5519, 1989, 5487, 1966, 5443, 2273, 5433},
+		{-17493, 6721, -18000, 6896, -18000, 6498, -17943, 6540, -17988, 6587, -17869, 6611, -17890, 6574, -17836, 6539, -17621, 6536, -17598, 6492, -17296, 6425, -17256, 6446, -17253, 6544, -16990, 6598, -17186, 6691, -17457, 6706, -17434, 6634, -17501, 6658},
+		{18000, 7152, 17873, 7110, 17890, 7078, 18000, 7083},
+		{-17766, 7113, -18000, 7152, -18000, 7083},
+		{14348, 7348, 14206, 7386, 13986, 7337, 14360, 7321},
+		{14822, 7535, 14636, 7550, 14612, 7517, 14958, 7469, 15073, 7508},
+		{14147, 7609, 13751, 7595, 13697, 7526, 13896, 7461, 14430, 7482, 14509, 7556},
+		{5562, 7154, 5542, 7237, 5848, 7431, 6158, 7526, 6885, 7654, 6816, 7694, 6117, 7625, 5563, 7508, 5590, 7463, 5351, 7375, 5443, 7363, 5244, 7277, 5248, 7223, 5146, 7201, 5160, 7147, 5341, 7121, 5368, 7076, 5754, 7072},
+		{10471, 7713, 10607, 7737, 10435, 7770, 10199, 7729, 10076, 7643, 9668, 7592, 9323, 7605, 8717, 7512, 8601, 7446, 8682, 7394, 8051, 7365, 8061, 7258, 8150, 7175, 7965, 7232, 7758, 7227, 7590, 7187, 7636, 7115, 7529, 7134, 7568, 7230, 7466, 7283, 7489, 7212, 7310, 7145, 7440, 7063, 7360, 6963, 7384, 6907, 7494, 6899, 7447, 6833, 7505, 6776, 7242, 6617, 7128, 6632, 7367, 6841, 7256, 6902, 7279, 7039, 7185, 7141, 7280, 7222, 7259, 7278, 6994, 7304, 6669, 7103, 6726, 6993, 6693, 6945, 6814, 6936, 6918, 6862, 6851, 6809, 6350, 6955, 6055, 6985, 6003, 6952, 6108, 6894, 5994, 6828, 5880, 6888, 5473, 6810, 5349, 6820, 5447, 6881, 5372, 6886, 4814, 6752, 4789, 6688, 4635, 6667, 4556, 6701, 4556, 6757, 4682, 6769, 4625, 6825, 4345, 6857, 4419, 6795, 4370, 6735, 4453, 6676, 4395, 6607, 4209, 6648, 3976, 6550, 4044, 6476, 3959, 6452, 3718, 6514, 3654, 6476, 3714, 6433, 3701, 6385, 3494, 6441, 3481, 6590, 3318, 6663, 3838, 6600, 4113, 6679, 4106, 6746, 3651, 6906, 3378, 6930, 3213, 6991, 2859, 6906, 2845, 6836, 2998, 6770, 2905, 6694, 3022, 6581, 2954, 6495, 3044, 6420, 3004, 6355, 3152, 6287, 2807, 6050, 2912, 6003, 2798, 5948, 2742, 5872, 2772, 5779, 2729, 5747, 2777, 5724, 2818, 5617, 3087, 5555, 3076, 5481, 3269, 5335, 3131, 5307, 3179, 5210, 3375, 5234, 3439, 5177, 3422, 5126, 3502, 5121, 3536, 5058, 4007, 4960, 3974, 4790, 3826, 4755, 3822, 4710, 3915, 4704, 3767, 4664, 3823, 4624, 3740, 4540, 3668, 4524, 3996, 4343, 4547, 4250, 4782, 4115, 4858, 4181, 4668, 4461, 4910, 4640, 4859, 4656, 4869, 4708, 4806, 4774, 4732, 4772, 4647, 4839, 4755, 5045, 4858, 4987, 4870, 5061, 5077, 5169, 5233, 5172, 5572, 5062, 5678, 5104, 5836, 5106, 5964, 5055, 5993, 5084, 6134, 5080, 6159, 5127, 5997, 5196, 6093, 5245, 6074, 5272, 6170, 5298, 6098, 5366, 6144, 5401, 6518, 5435, 6907, 5539, 7087, 5517, 7118, 5413, 7222, 5438, 7351, 5404, 7343, 5349, 7689, 5449, 7653, 5418, 7780, 5340, 8004, 5086, 8057, 5139, 8195, 5081, 8338, 5107, 8554, 4969, 8683, 4983, 8736, 4921, 9223, 5080, 9726, 4973, 9823, 5042, 9783, 5101, 9886, 5205, 10207, 5126, 10226, 5051, 10368, 5009, 10689, 5027, 10848, 4928, 11066, 4913, 11290, 4954, 11436, 5025, 11788, 4951, 11929, 5014, 11928, 5058, 12074, 5196, 12073, 5252, 12018, 5275, 12100, 5325, 12357, 5346, 12507, 5316, 12595, 5279, 12766, 4976, 12940, 4944, 13058, 4873, 13099, 4779, 13251, 4779, 13503, 4848, 13310, 4514, 13188, 4532, 13103, 4497, 13129, 4411, 13114, 4293, 13063, 4290, 13078, 4222, 13228, 4328, 13354, 4281, 13487, 4340, 14006, 4845, 14060, 5124, 14138, 5224, 14135, 5309, 13990, 5419, 13880, 5425, 13816, 5376, 13719, 5398, 13670, 5460, 13513, 5473, 14220, 5904, 14854, 5916, 14978, 5966, 15134, 5950, 15127, 5878, 15504, 5914, 15422, 5976, 15672, 6143, 15930, 6177, 16012, 6054, 16266, 6164, 16326, 6247, 16447, 6255, 16367, 6114, 15836, 5806, 15681, 5783, 15676, 5736, 15591, 5677, 15543, 5538, 15679, 5101, 15823, 5194, 15853, 5296, 16002, 5320, 16037, 5434, 16212, 5486, 16170, 5529, 16213, 5612, 16306, 5616, 16319, 5762, 16205, 5784, 16202, 5824, 16354, 5987, 16488, 5973, 16584, 6016, 16629, 5979, 16890, 6057, 17033, 5988, 17368, 6165, 17736, 6252, 17923, 6230, 17937, 6298, 17741, 6461, 17871, 6453, 18000, 6498, 18000, 6896, 17572, 6988, 17045, 7010, 17001, 6965, 17082, 6901, 16958, 6869, 16784, 6958, 16094, 6944, 15971, 6972, 15983, 7045, 15900, 7087, 15297, 7084, 14950, 7220, 14047, 7285, 13915, 7242, 13987, 7149, 13386, 7139, 13225, 7184, 13129, 7079, 12846, 7198, 12905, 7240, 12859, 7304, 12698, 7357, 12326, 7374, 12320, 7297, 11902, 7312, 11878, 7359, 11557, 7375, 11353, 7334, 11302, 7398, 10940, 7418, 11389, 7533, 11413, 7585, 11108, 7671, 10724, 7648, 10697, 7697},
+		{10537, 7871, 10209, 7935, 10126, 7923, 9944, 7792, 10508, 7831},
+		{5152, 8070, 4485, 8059, 4707, 8056, 4650, 8025, 4759, 8001},
+		{10019, 7978, 9594, 8125, 9118, 8034, 9255, 8014, 9331, 7943, 9776, 7876, 9994, 7888},
+		{2982, -144, 2929, -162, 2902, -284, 2963, -292, 2994, -235, 3076, -229, 3042, -113},
+		{-941, 2709, -1139, 2688, -1250, 2477, -1389, 2369, -1475, 2150, -1702, 2142, -1706, 2100, -1685, 2133, -1293, 2133, -1287, 2328, -1194, 2337, -1197, 2593, -869, 2588, -867, 2766, -879, 2712},
+		{4322, 1667, 4338, 1758, 4675, 1728, 4700, 1695, 4912, 1862, 5200, 1900, 5500, 2000, 5567, 2200, 5521, 2271, 5200, 2300, 5139, 2463, 5081, 2475, 5024, 2561, 5015, 2669, 4881, 2769, 4842, 2855, 4771, 2853, 4746, 2900, 4471, 2918, 4040, 3189, 3920, 3216, 3700, 3151, 3800, 3051, 3607, 2920, 3496, 2936, 3463, 2806, 3513, 2806, 3748, 2429, 3849, 2369, 3907, 2258, 3914, 2129, 4094, 1949, 4278, 1635},
+		{3396, 958, 3426, 1063, 3627, 1356, 3685, 1696, 3841, 1800, 3748, 1861, 3687, 2200, 2500, 2200, 2500, 2000, 2385, 2000, 2389, 1561, 2302, 1568, 2194, 1259, 2229, 1265, 2355, 1009, 2346, 895, 2389, 862, 2454, 892, 2507, 1027, 2579, 1041, 2675, 947, 2897, 940, 3000, 1029, 3084, 971, 3135, 981, 3240, 1108, 3207, 1197, 3274, 1225, 3321, 1218, 3321, 1072},
+		{3382, 948, 3372, 1033, 3321, 1072, 3321, 1218, 3274, 1225, 3207, 1197, 3240, 1108, 3135, 981, 3084, 971, 3000, 1029, 2897, 940, 2675, 947, 2579, 1041, 2507, 1027, 2454, 892, 2389, 862, 2511, 783, 2798, 441, 2972, 460, 3083, 351, 3125, 378, 3188, 356, 3339, 379, 3530, 551, 3408, 723, 3295, 778, 3329, 835, 3383, 838},
+		{-1562, 1362, -1508, 1388, -1384, 1351, -1684, 1315, -1668, 1238, -1151, 1244, -1217, 1462, -1344, 1604, -1458, 1660, -1612, 1646, -1763, 1473, -1671, 1359},
+		{16192, -1045, 16132, -1020, 16170, -1082, 16240, -1083},
+		{16069, -961, 15970, -924, 15985, -979, 16085, -987},
+		{16128, -912, 16092, -832, 16058, -832, 16153, -978},
+		{15964, -802, 15821, -742, 15992, -854},
+		{15714, -702, 15654, -660, 15754, -735},
+		{-1120, 711, -1023, 841, -1051, 835, -1112, 1005, -1192, 1005, -1325, 890, -1312, 816, -1171, 686},
+		{-8772, 1379, -8935, 1442, -9010, 1374, -8790, 1315},
+		{4894, 997, 4895, 1141, 4412, 1045, 4315, 1146, 4256, 1057, 4368, 918, 4695, 800, 4779, 800, 4894, 945},
+		{4927, 1143, 4894, 1139, 4894, 945, 4496, 500, 4366, 496, 4277, 425, 4213, 423, 4098, 278, 4099, -86, 4159, -168, 4314, 29, 4656, 286, 4859, 534, 5055, 920, 5111, 1202},
+		{2076, 4573, 2022, 4613, 1883, 4591, 1939, 4524, 1912, 4442, 1960, 4404, 1922, 4352, 2026, 4281, 2081, 4327, 2178, 4268, 2158, 4225, 2238, 4232, 2299, 4321, 2241, 4401, 2271, 4458, 2156, 4477},
+		{-5731, 507, -5791, 481, -5804, 406, -5654, 190, -5600, 182, -5597, 251, -5452, 231, -5401, 362, -5448, 490, -5396, 576, -5715, 597},
+		{1855, 4950, 1789, 4890, 1710, 4882, 1698, 4812, 1786, 4776, 2080, 4862, 2187, 4832, 2256, 4909, 2161, 4947, 1983, 4922},
+		{1370, 4602, 1372, 4550, 1533, 4545, 1577, 4624, 1656, 4650, 1620, 4685, 1381, 4651},
+		{2390, 6601, 2357, 6640, 2354, 6794, 2003, 6907, 1988, 6841, 1799, 6857, 1773, 6801, 1677, 6801, 1356, 6479, 1392, 6445, 1357, 6405, 1258, 6407, 1193, 6313, 1199, 6180, 1263, 6129, 1230, 6012, 1103, 5886, 1294, 5536, 1410, 5541, 1467, 5620, 1588, 5610, 1683, 5872, 1787, 5895, 1879, 6008, 1712, 6134, 1785, 6275, 2137, 6441, 2121, 6503, 2218, 6572},
+		{3199, -2629, 3184, -2584, 3104, -2573, 3069, -2674, 3128, -2729, 3187, -2718},
+		{4101, 3442, 4129, 3636, 4235, 3723, 3952, 3672, 3674, 3682, 3591, 3541, 3600, 3464, 3661, 3420, 3607, 3382, 3570, 3272, 3683, 3231},
+		{1489, 1222, 1492, 1089, 1547, 998, 1417, 1002, 1395, 955, 1498, 880, 1528, 742, 1796, 789, 1891, 863, 1881, 898, 2100, 948, 2172, 1057, 2286, 1114, 2229, 1265, 2194, 1259, 2302, 1568, 2389, 1561, 2384, 1958, 1586, 2341, 1485, 2286, 1510, 2131, 1590, 2039, 1525, 1663, 1397, 1568, 1354, 1437, 1395, 1335, 1460, 1333},
+		{162, 683, 143, 983, 77, 1047, 90, 1100, 2, 1102, 71, 831, 57, 691, 106, 593, 187, 614},
+		{10235, 1339, 10299, 1423, 10522, 1427, 10554, 1472, 10559, 1557, 10478, 1644, 10472, 1743, 10396, 1824, 10320, 1831, 10300, 1796, 10211, 1811, 10106, 1751, 10128, 1946, 10061, 1951, 10012, 2042, 9825, 1971, 9738, 1845, 9890, 1618, 9819, 1512, 9910, 1383, 9959, 1189, 9815, 835, 9834, 779, 9850, 838, 10009, 646, 10108, 620, 10115, 569, 10214, 622, 10046, 743, 9987, 921, 9922, 924, 9915, 996, 10010, 1341, 10098, 1341, 10083, 1263, 10258, 1219},
+		{7060, 4022, 7067, 4096, 6933, 4073, 6854, 3953, 6770, 3958, 6744, 3914, 6818, 3890, 6839, 3816, 6783, 3714, 6920, 3715, 6952, 3761, 7012, 3759, 7081, 3849, 7135, 3826, 7145, 3707, 7184, 3674, 7326, 3750, 7498, 3742, 7486, 3838, 7393, 3851, 7368, 3943, 6946, 3953, 6956, 4010, 7065, 3994, 7101, 4024},
+		{6223, 3527, 6455, 3631, 6475, 3711, 6575, 3766, 6652, 3736, 6655, 3797, 6417, 3889, 6237, 4005, 6188, 4108, 6047, 4122, 6008, 4143, 5998, 4222, 5863, 4275, 5693, 4183, 5710, 4132, 5546, 4126, 5408, 4232, 5250, 4178, 5281, 4114, 5292, 4187, 5372, 4212, 5474, 4095, 5386, 4063, 5292, 4088, 5269, 4003, 5336, 3998, 5310, 3929, 5388, 3895, 5392, 3720, 5551, 3796, 5733, 3803, 6112, 3649, 6121, 3565},
+		{12507, -909, 12509, -939, 12734, -840, 12509, -866},
+		{-6166, 1037, -6195, 1009, -6094, 1011, -6090, 1086, -6168, 1076},
+		{997, 3054, 995, 3138, 1143, 3237, 1149, 3314, 1034, 3379, 1015, 3433, 1081, 3483, 1060, 3641, 1103, 3709, 1018, 3672, 1021, 3723, 951, 3735, 842, 3695, 814, 3466, 752, 3410, 761, 3334, 906, 3210, 948, 3031},
+		{3517, 4204, 3351, 4202, 3115, 4109, 2924, 4122, 2882, 4046, 2728, 4042, 2617, 3946, 2680, 3899, 2632, 3821, 2764, 3666, 2873, 3668, 2970, 3614, 3062, 3668, 3170, 3664, 3251, 3611, 3403, 3622, 3471, 3680, 3616, 3665, 3578, 3627, 3615, 3582, 3674, 3682, 3952, 3672, 4278, 3739, 4477, 3717, 4423, 3797, 4411, 3943, 4479, 3971, 4366, 4025, 4358, 4109, 4262, 4158, 3835, 4095},
+		{2762, 4100, 2899, 4130, 2800, 4201, 2612, 4183, 2660, 4156, 2606, 4082, 2636, 4015},
+		{12195, 2500, 12150, 2530, 12011, 2356, 12075, 2197},
+		{3187, -103, 3042, -113, 3082, -170, 3047, -241, 3075, -336, 2934, -450, 2962, -652, 3074, -834, 3374, -942, 3456, -1152, 3747, -1157, 4032, -1032, 3919, -849, 3944, -684, 3880, -648, 3874, -591, 3920, -468, 3777, -368, 3770, -310, 3390, -95},
+		{3390, -95, 3389, 11, 3504, 191, 3448, 356, 3401, 425, 3339, 379, 3125, 378, 3083, 351, 3077, 234, 3117, 220, 2988, 60, 2958, -134},
+		{3093, 5204, 3056, 5132, 2533, 5191, 2353, 5158, 2392, 5042, 2252, 4948, 2278, 4903, 2209, 4842, 2271, 4788, 2487, 4774, 2752, 4847, 2867, 4812, 3002, 4642, 2886, 4644, 2823, 4549, 2960, 4529, 3075, 4658, 3168, 4671, 3174, 4633, 3359, 4585, 3245, 4533, 3355, 4503, 3333, 4456, 3388, 4436, 3633, 4511, 3653, 4547, 3502, 4565, 3496, 4627, 3822, 4710, 3826, 4755, 3990, 4823, 3967, 4878, 4007, 4960, 3536, 5058, 3502, 5121, 3422, 5126, 3439, 5177, 3375, 5234},
+		{-5787, -3102, -5843, -3391, -5782, -3446, -5494, -3495, -5381, -3440, -5337, -3377, -5365, -3320, -5321, -3273, -5379, -3205, -5698, -3011, -5763, -3022},
+		{-15522, 1924, -15481, 1951, -15586, 2027, -15594, 1906},
+		{-15600, 2076, -15671, 2093, -15641, 2057},
+		{-15725, 2122, -15733, 2110, -15679, 2107, -15676, 2118},
+		{-15794, 2165, -15829, 2158, -15771, 2126},
+		{-15937, 2221, -15980, 2207, -15946, 2188},
+		{-9516, 4938, -9516, 4900, -12284, 4900, -12234, 4736, -12259, 4710, -12312, 4804, -12469, 4818, -12390, 4552, -12453, 4277, -12421, 4200, -12440, 4031, -12062, 3461, -11852, 3403, -11713, 3254, -11472, 3272, -11102, 3133, -10824, 3134, -10824, 3175, -10651, 3175, -10394, 2927, -10311, 2897, -10248, 2976, -10096, 2938, -9902, 2637, -9753, 2584, -9714, 2587, -9714, 2783, -9469, 2948, -9323, 2978, -8941, 2916, -8959, 3016, -8918, 3032, -8640, 3040, -8511, 2964, -8410, 3009, -8293, 2910, -8271, 2750, -8117, 2520, -8068, 2508, -8013, 2582, -8006, 2688, -8131, 3004, -8134, 3144, -7906, 3349, -7636, 3481, -7573, 3555, -7630, 3792, -7699, 3824, -7633, 3808, -7635, 3915, -7623, 3832, -7572, 3794, -7594, 3722, -7506, 3840, -7553, 3950, -7491, 3894, -7418, 3971, -7395, 4075, -7194, 4093, -7371, 4093, -6997, 4164, -6988, 4192, -7019, 4215, -7008, 4178, -7050, 4181, -7083, 4234, -7012, 4368, -6696, 4481, -6779, 4570, -6779, 4707, -6924, 4745, -7066, 4546, -7151, 4501, -7487, 4500, -7682, 4363, -7872, 4363, -7917, 4347, -7894, 4286, -8269, 4168, -8312, 4208, -8214, 4357, -8255, 4535, -8414, 4651, -8838, 4830, -9164, 4814, -9433, 4867},
+		{-15214, 5759, -15323, 5797, -15467, 5746, -15401, 5673},
+		{-16567, 6029, -16746, 6021, -16619, 5975, -16558, 5991},
+		{-17179, 6341, -16953, 6298, -16869, 6330, -17173, 6378},
+		{-15658, 7136, -16191, 7033, -16317, 6937, -16620, 6888, -16676, 6836, -16539, 6804, -16168, 6612, -16379, 6608, -16365, 6658, -16447, 6658, -16811, 6567, -16643, 6469, -16496, 6445, -16276, 6434, -16078, 6479, -16152, 6440, -16077, 6377, -16307, 6306, -16456, 6315, -16612, 6150, -16535, 6107, -16535, 6051, -16382, 5980, -16252, 5999, -16187, 5963, -16197, 5867, -16036, 5907, -15998, 5857, -15971, 5893, -15906, 5842, -15704, 5892, -15772, 5757, -16056, 5601, -16180, 5589, -16479, 5440, -15843, 5599, -15631, 5742, -15423, 5815, -15329, 5886, -15402, 5935, -15062, 6128, -15035, 6103, -15141, 6073, -15186, 5974, -15172, 5916, -14802, 5998, -14822, 6067, -14711, 6088, -14396, 6000, -14257, 6008, -13987, 5954, -13663, 5821, -13408, 5812, -13354, 5718, -13225, 5637, -13197, 5550, -13054, 5480, -12998, 5528, -13001, 5592, -13171, 5655, -13336, 5841, -13548, 5979, -13745, 5891, -13904, 6000, -14100, 6031, -14099, 6971, -15074, 7043, -15221, 7083, -15434, 7070},
+		{6708, 3736, 6783, 3714, 6839, 3816, 6818, 3890, 6744, 3914, 6770, 3958, 6854, 3953, 6933, 4073, 7067, 4096, 7060, 4022, 7177, 4015, 7306, 4087, 7042, 4152, 7126, 4217, 7096, 4227, 6826, 4066, 6799, 4114, 6671, 4117, 6651, 4199, 6602, 4199, 6610, 4300, 6490, 4373, 6201, 4350, 6106, 4441, 5850, 4559, 5593, 4500, 5597, 4131, 5710, 4132, 5693, 4183, 5863, 4275, 5998, 4222, 6008, 4143, 6047, 4122, 6188, 4108, 6237, 4005, 6655, 3797, 6652, 3736},
+		{-7197, 1161, -7291, 1045, -7330, 915, -7279, 909, -7244, 841, -7244, 742, -7196, 699, -7009, 696, -6939, 610, -6734, 610, -6782, 450, -6730, 332, -6781, 282, -6633, 72, -6555, 79, -6337, 220, -6427, 250, -6437, 380, -6482, 406, -6309, 377, -6060, 492, -6141, 596, -6116, 670, -6030, 704, -6055, 778, -5976, 837, -6067, 858, -6083, 938, -6239, 995, -6273, 1042, -6188, 1072, -6432, 1064, -6489, 1008, -6623, 1065, -6819, 1055, -6888, 1144, -6958, 1146, -6994, 1216, -7029, 1185, -7016, 1138, -7140, 1097, -7104, 986, -7126, 914, -7170, 907, -7207, 987, -7163, 1045, -7195, 1142, -7133, 1178},
+		{10704, 2181, 10657, 2222, 10673, 2279, 10533, 2335, 10448, 2282, 10217, 2246, 10320, 2077, 10444, 2076, 10482, 1989, 10390, 1927, 10509, 1867, 10756, 1520, 10749, 1234, 10581, 1157, 10625, 1096, 10433, 1049, 10508, 992, 10480, 924, 10516, 860, 10920, 1167, 10934, 1343, 10888, 1528, 10566, 1906, 10672, 2070, 10805, 2155},
+		{16722, -1589, 16752, -1660, 16784, -1647},
+		{16663, -1463, 16679, -1567, 16727, -1574, 16711, -1493},
+		{5278, 1735, 5200, 1900, 4912, 1862, 4700, 1695, 4675, 1728, 4338, 1758, 4260, 1521, 4348, 1264, 4499, 1270, 4563, 1329, 4868, 1400, 4957, 1471, 5217, 1560, 5239, 1638, 5311, 1665},
+		{3220, -2875, 3283, -2674, 3207, -2673, 3128, -2729, 3069, -2674, 3104, -2573, 3184, -2584, 3193, -2437, 3119, -2225, 2943, -2209, 2712, -2357, 2649, -2462, 2594, -2470, 2566, -2549, 2421, -2567, 2331, -2527, 2161, -2673, 2089, -2683, 2076, -2587, 1990, -2477, 1989, -2846, 1846, -2905, 1739, -2878, 1682, -2808, 1634, -2858, 1822, -3166, 1825, -3243, 1793, -3261, 1838, -3414, 1962, -3482, 2257, -3386, 2578, -3394, 2746, -3323, 3006, -3114},
+		{2933, -2926, 2885, -3007, 2775, -3065, 2700, -2988, 2854, -2865},
+		{3219, -893, 3035, -824, 2873, -853, 2837, -1179, 2934, -1236, 2962, -1218, 2970, -1326, 2893, -1325, 2716, -1161, 2575, -1178, 2542, -1133, 2391, -1093, 2402, -1291, 2193, -1290, 2189, -1608, 2322, -1752, 2468, -1735, 2704, -1794, 2895, -1604, 3027, -1551, 3018, -1480, 3321, -1397, 3269, -1371, 3331, -1244, 3311, -1161, 3349, -1053, 3323, -968},
+		{3224, -2112, 3266, -2030, 3285, -1798, 3285, -1671, 3233, -1639, 3034, -1588, 3027, -1551, 2952, -1564, 2704, -1794, 2526, -1774, 2616, -1929, 2772, -2050, 2802, -2149, 2943, -2209, 3119, -2225},
+	}},
+	{Tolerance: 0.5, Polygons: [][]int16{
+		{6080, 3440, 6054, 3298, 6178, 3074, 6087, 2983, 6505, 2947, 6635, 2989, 6694, 3130, 6932, 3190, 7032, 3336, 6993, 3402, 7088, 3399, 7161, 3515, 7126, 3607, 7516, 3713, 7326, 3750, 7184, 3674, 7081, 3849, 6920, 3715, 6575, 3766, 6298, 3540, 6121, 3565},
+		{1338, -586, 1223, -629, 1363, -1204, 1250, -1355, 1173, -1730, 2322, -1752, 2189, -1608, 2193, -1290, 2402, -1291, 2402, -1124, 2216, -1108, 2173, -729, 2009, -694, 1902, -799, 1747, -807, 1633, -588},
+		{1247, -525, 1262, -444, 1218, -579},
+		{2052, 4222, 1930, 4220, 1941, 4025, 2015, 3962, 2100, 4058},
+		{5162, 2401, 5200, 2300, 5501, 2250, 5626, 2571, 5401, 2412},
+		{-6505, -5470, -6775, -5385, -6863, -5264, -6863, -5487},
+		{-6627, -2183, -6733, -2403, -6842, -2452, -6830, -2690, -6966, -2846, -7054, -3137, -6982, -3419, -7112, -3666, -7081, -3855, -7215, -4225, -7122, -4478, -7233, -4824, -7342, -4932, -7191, -5201, -6815, -5235, -6914, -5073, -6564, -4724, -6758, -4630, -6729, -4555, -6557, -4504, -6518, -4350, -6346, -4256, -6498, -4206, -6512, -4106, -6275, -4103, -6215, -4068, -6234, -3883, -5923, -3872, -5679, -3690, -5723, -3529, -5850, -3443, -5763, -3022, -5365, -2692, -5413, -2555, -5570, -2739, -5862, -2712, -5778, -2516, -6085, -2388, -6285, -2203, -6399, -2199, -6438, -2280},
+		{4375, 4074, 4651, 3877, 4497, 4125},
+		{-6061, -7963, -6188, -8039, -6629, -8026, -6016, -8100, -5957, -8004},
+		{-15948, -7905, -16371, -7860, -16113, -7963, -15921, -7950},
+		{-4666, -7783, -5416, -8063, -5048, -8103, -4333, -8003, -4392, -7848},
+		{-12241, -7332, -12023, -7409, -11872, -7348},
+		{-12656, -7325, -12728, -7346, -12403, -7387},
+		{-10043, -7185, -10233, -7189, -9620, -7252},
+		{-6873, -7051, -7025, -6888, -7117, -6904, -7207, -7119, -7495, -7207, -6878, -7217},
+		{-5760, -6386, -5781, -6327, -6300, -6464, -6725, -6688, -6854, -6972, -6737, -7248, -7489, -7387, -8030, -7313, -8147, -7385, -8923, -7256, -9009, -7332, -9634, -7362, -10368, -7262, -10255, -7411, -10012, -7487, -10756, -7518, -11230, -7471, -11394, -7371, -12107, -7452, -13521, -7430, -14620, -7538, -14610, -7648, -15133, -7740, -15837, -7689, -15805, -7803, -15533, -7906, -14953, -7936, -14642, -8034, -15065, -8134, -15684, -8110, -15286, -8204, -15359, -8369, -14289, -8457, -14311, -8504, -15807, -8537, -16995, -8388, -17438, -8453, -17906, -8414, -18000, -8471, -18000, -9000, 18000, -9000, 18000, -8471, 16940, -8383, 15979, -8095, 16177, -7916, 16700, -7875, 16474, -7818, 16357, -7624, 16609, -7438, 16929, -7366, 17121, -7170, 16157, -7058, 15428, -6856, 14884, -6839, 14549, -6692, 13746, -6695, 13507, -6531, 13476, -6621, 12322, -6648, 11983, -6727, 11360, -6588, 10618, -6693, 10283, -6556, 9972, -6725, 9578, -6739, 8967, -6715, 8799, -6621, 8675, -6715, 8278, -6721, 7764, -6946, 7386, -6987, 6987, -7226, 6795, -7185, 6907, -7068, 6781, -7031, 6967, -6923, 6889, -6793, 6405, -6741, 6143, -6795, 5453, -6582, 3865, -6978, 3387, -6850, 3199, -6966, 2709, -7046, 1342, -6997, 1082, -7083, 774, -6989, -23, -7164, -687, -7093, -742, -7170, -1030, -7127, -1545, -7315, -1647, -7387, -1570, -7450, -1752, -7513, -3578, -7834, -3564, -7946, -2969, -7926, -2855, -8034, -4281, -8208, -4976, -8173, -5822, -8322, -5969, -8238, -7536, -8026, -7802, -7918, -7793, -7838, -7366, -7791, -7724, -7671, -7060, -7663, -6138, -7411, -6069, -7317, -6151, -7109, -6567, -6795, -6212, -6619, -6265, -6548, -6202, -6480},
+		{6887, -4883, 6875, -4978, 7056, -4926},
+		{14474, -4070, 14605, -4355, 14791, -4321, 14829, -4088},
+		{14360, -1340, 14252, -1067, 14127, -1639, 14022, -1771, 13550, -1500, 13695, -1235, 13649, -1186, 13530, -1225, 13182, -1127, 13258, -1211, 13062, -1254, 12962, -1497, 12707, -1382, 12569, -1423, 12350, -1660, 12386, -1707, 12301, -1641, 12086, -1968, 11671, -2070, 11423, -2252, 11415, -2176, 11339, -2438, 11423, -2630, 11344, -2562, 11378, -2655, 11334, -2612, 11569, -3161, 11503, -3420, 11802, -3506, 11989, -3398, 12366, -3389, 12615, -3222, 13133, -3150, 13427, -3262, 13599, -3489, 13781, -3290, 13683, -3526, 13821, -3438, 13812, -3561, 13957, -3614, 14064, -3802, 14361, -3881, 14503, -3790, 14632, -3904, 15000, -3743, 15289, -3164, 15357, -2811, 15314, -2607, 15073, -2240, 14968, -2234, 14885, -2039, 14639, -1896, 14537, -1498, 14456, -1417, 14392, -1455},
+		{1688, 4847, 1360, 4888, 1293, 4747, 948, 4710, 1601, 4668},
+		{4479, 3971, 4495, 3934, 4546, 3887, 4614, 3874, 4574, 3932, 4574, 3947, 4530, 3947, 4500, 3974},
+		{4669, 4183, 4615, 4172, 4650, 4106, 4497, 4125, 4561, 3990, 4651, 3877, 4806, 3958, 4888, 3832, 4957, 4018, 5039, 4026, 4858, 4181, 4782, 4115},
+		{2975, -445, 3075, -336, 3047, -241, 2902, -284},
+		{251, 5115, 429, 4991, 567, 4953, 604, 5013, 616, 5080, 497, 5148},
+		{275, 787, 380, 1073, 249, 1223, 77, 1047, 166, 913, 187, 614, 269, 626},
+		{-296, 1040, -294, 1096, 194, 1164, 218, 1263, 102, 1285, 37, 1493, -107, 1497, -428, 1323, -540, 1037, -433, 961, -283, 964},
+		{9215, 2363, 9171, 2299, 9116, 2350, 9238, 2498, 8992, 2527, 8856, 2645, 8821, 2577, 8893, 2524, 8808, 2450, 8903, 2206, 9027, 2184, 9050, 2281, 9142, 2277, 9237, 2067},
+		{2241, 4401, 2295, 4134, 2800, 4201, 2856, 4371},
+		{-7754, 2434, -7819, 2521, -7778, 2371},
+		{-7785, 2684, -7851, 2687, -7898, 2679, -7891, 2642, -7782, 2658},
+		{-7779, 2704, -7779, 2693, -7734, 2653, -7736, 2601, -7717, 2588, -7700, 2659},
+		{1855, 4508, 1575, 4482, 1856, 4265, 1960, 4404},
+		{2353, 5347, 2353, 5158, 3056, 5132, 3179, 5210, 3131, 5307, 3269, 5335, 3076, 5481, 3087, 5555, 2818, 5617},
+		{-8915, 1702, -8893, 1589, -8830, 1850},
+		{-6269, -2225, -6179, -1963, -5912, -1936, -5785, -1997, -5750, -1817, -5824, -1630, -6016, -1626, -6050, -1378, -6540, -1157, -6534, -976, -6953, -1095, -6867, -1256, -6959, -1758, -6844, -1941, -6783, -2287, -6627, -2183, -6438, -2280, -6399, -2199},
+		{-5698, -3011, -5379, -3205, -5337, -3377, -4889, -2867, -4765, -2489, -4199, -2297, -4094, -2194, -3927, -1787, -3867, -1306, -3513, -900, -3473, -734, -3560, -515, -3998, -287, -4458, -269, -4491, -155, -4782, -58, -4858, -124, -4862, -24, -5039, -8, -4997, 174, -5132, 420, -5294, 212, -5597, 251, -5600, 182, -5903, 132, -5997, 276, -5998, 501, -6073, 520, -6309, 377, -6482, 406, -6427, 250, -6337, 220, -6555, 79, -6707, 113, -6754, 204, -6982, 171, -6922, 99, -7002, 54, -6942, -112, -6989, -430, -7289, -527, -7399, -752, -7323, -946, -7218, -1005, -7048, -949, -7055, -1101, -6827, -1101, -6534, -976, -6540, -1157, -6050, -1378, -6016, -1626, -5824, -1630, -5750, -1817, -5794, -2209, -5580, -2236, -5540, -2396, -5429, -2402, -5463, -2574, -5363, -2612, -5365, -2692, -5763, -3022},
+		{11466, 401, 11545, 545, 11420, 453},
+		{9126, 2804, 9002, 2830, 8884, 2710, 9203, 2684},
+		{2526, -1774, 2091, -1825, 2088, -2181, 1990, -2185, 1990, -2477, 2089, -2683, 2331, -2527, 2566, -2549, 2943, -2209, 2802, -2149},
+		{1478, 641, 1448, 473, 1601, 227, 1713, 373, 1845, 350, 1947, 503, 2241, 403, 2441, 511, 2737, 523, 2346, 895, 2286, 1114, 1796, 789, 1528, 742},
+		{-6401, 4704, -6414, 4639, -6287, 4597, -6201, 4644},
+		{-6184, 4929, -6286, 4971, -6417, 4996, -6452, 4987, -6359, 4940, -6229, 4909, -6181, 4911},
+		{-12392, 4906, -12576, 5030, -12844, 5054, -12566, 4883, -12351, 4851},
+		{-5560, 5132, -5736, 5072, -5927, 4760, -5625, 4763, -5540, 4688, -5424, 4775, -5418, 4681, -5307, 4666, -5265, 4754, -5309, 4869, -5379, 4852, -5348, 4925, -5680, 4981},
+		{-13324, 5385, -13118, 5218, -13205, 5298, -13175, 5412},
+		{-7952, 6236, -8036, 6202, -7966, 6163},
+		{-8188, 6290, -8325, 6291, -8399, 6245, -8377, 6218, -8307, 6216, -8190, 6271},
+		{-8588, 6574, -8722, 6354, -8552, 6305, -8311, 6410, -8010, 6373},
+		{-7522, 6744, -7590, 6829, -7724, 6759},
+		{-9626, 6949, -9822, 7014, -9980, 6940, -9627, 6876, -9565, 6911},
+		{-9241, 6970, -9152, 7019, -9389, 7176, -9639, 7119, -9647, 7009, -9423, 6907, -9613, 6729, -9612, 6824, -9767, 6858, -9856, 6840, -9844, 6778, -10145, 6765, -10615, 6880, -10817, 6865, -10881, 6831, -10779, 6789, -10888, 6738, -10995, 6798, -11350, 6769, -11530, 6790, -11390, 6840, -11525, 6891, -12147, 6980, -12429, 6940, -12442, 7016, -12576, 6948, -12814, 7048, -12911, 6978, -13650, 6890, -14099, 6971, -14100, 6031, -13745, 5891, -13548, 5979, -13001, 5592, -13051, 5429, -12785, 5233, -12744, 5083, -12297, 4900, -9482, 4939, -9164, 4814, -8838, 4830, -8255, 4535, -8269, 4168, -7487, 4500, -7151, 4501, -6924, 4745, -6779, 4707, -6714, 4514, -6443, 4529, -6616, 4447, -6612, 4362, -6536, 4355, -5980, 4592, -6052, 4701, -6152, 4588, -6447, 4624, -6512, 4807, -6417, 4874, -6506, 4923, -7110, 4682, -6640, 5023, -6003, 5024, -5568, 5215, -5576, 5327, -5733, 5463, -6180, 5634, -6140, 5697, -6458, 6034, -6765, 5821, -6929, 5896, -6959, 6106, -7384, 6244, -7811, 6232, -7734, 5985, -7852, 5880, -7654, 5653, -7983, 5467, -7860, 5256, -7991, 5121, -8213, 5328, -8227, 5515, -9230, 5709, -9322, 5878, -9468, 5895, -9424, 6090, -9070, 6361, -8732, 6478, -8577, 6656, -8139, 6711, -8196, 6813, -8122, 6867, -8262, 6966, -8552, 6988, -8558, 6878, -8735, 6720, -8922, 6926, -9055, 6847, -9055, 6950},
+		{-11519, 7331, -11940, 7156, -11611, 7131, -11843, 7091, -11790, 7054, -11242, 7037, -11734, 6996, -11331, 6854, -10243, 6875, -10273, 6950, -10109, 6958, -10446, 7099, -10540, 7267, -10652, 7308, -10840, 7309, -10769, 7207, -10819, 7165, -10992, 7296, -11467, 7265, -11417, 7312},
+		{-10526, 7364, -10694, 7346, -10538, 7276, -10450, 7342},
+		{-7806, 7365, -8088, 7333, -7625, 7283},
+		{-8583, 7380, -8944, 7313, -9021, 7224, -8989, 7122, -8494, 6997, -7896, 7017, -7293, 6773, -7396, 6545, -7790, 6531, -7856, 6457, -7771, 6423, -7483, 6468, -7102, 6291, -6617, 6193, -6878, 6375, -6501, 6267, -6467, 6339, -6814, 6569, -6802, 6626, -6672, 6639, -6392, 6500, -6185, 6686, -6881, 6872, -6697, 6919, -7224, 7156, -7410, 7133, -7782, 7275, -8075, 7206, -8060, 7272, -8232, 7375, -8577, 7253, -8656, 7316},
+		{-10154, 7336, -10044, 7271, -10250, 7251, -9932, 7136, -9672, 7166, -9654, 7256, -9805, 7299, -9738, 7376},
+		{-9200, 7297, -9051, 7386, -9450, 7413, -9602, 7344, -9541, 7206},
+		{-12046, 7182, -11551, 7348, -11756, 7419, -12492, 7429, -12394, 7368, -12593, 7187, -12309, 7090},
+		{-9398, 7530, -9682, 7493, -9416, 7459},
+		{-9858, 7659, -10257, 7634, -10250, 7556, -9816, 7500, -9774, 7626},
+		{-10855, 7668, -11050, 7643, -10907, 7547, -11540, 7648, -11771, 7522, -11179, 7516, -11387, 7472, -11222, 7442, -10570, 7548},
+		{-9675, 7716, -9712, 7675, -9289, 7588, -9242, 7484, -7983, 7492, -8113, 7571, -8919, 7561, -9161, 7678},
+		{-11757, 7750, -12285, 7612, -11634, 7688, -11620, 7765},
+		{-9372, 7763, -9442, 7782, -9644, 7783, -9617, 7756, -9430, 7749, -9384, 7752},
+		{-10985, 7800, -11126, 7815, -11272, 7805, -11353, 7773, -11205, 7741, -11019, 7770},
+		{-11096, 7880, -11254, 7841, -10966, 7860},
+		{-9556, 7842, -9863, 7887, -9731, 7785},
+		{-10083, 7880, -10549, 7930, -10421, 7868, -10518, 7838, -9967, 7791},
+		{-8781, 8032, -9241, 8126, -9474, 8121, -9671, 8016, -9315, 7938, -9395, 7875, -9288, 7834, -8904, 7829, -8581, 7934},
+		{-7067, 8317, -9159, 8189, -8760, 8052, -8185, 8046, -8693, 8025, -8509, 7935, -8796, 7837, -8498, 7754, -8826, 7790, -8777, 7718, -8949, 7647, -8056, 7618, -7789, 7678, -7976, 7721, -7539, 7853, -7622, 7902, -7553, 7920, -7691, 7932, -7118, 7980, -6548, 8151, -6766, 8150, -6185, 8263},
+		{852, 4783, 674, 4754, 602, 4627, 727, 4578, 1036, 4648},
+		{-6935, -5252, -7111, -5407, -7466, -5284, -7101, -5505, -6729, -5530, -6696, -5490, -6863, -5487, -6863, -5264},
+		{-6876, -2037, -6844, -1941, -6959, -1758, -7037, -1835, -7009, -2139, -7144, -3242, -7359, -3716, -7322, -3926, -7433, -4322, -7370, -4337, -7339, -4212, -7272, -4238, -7324, -4445, -7435, -4410, -7564, -4665, -7413, -4694, -7561, -4867, -7495, -5226, -7143, -5386, -7085, -5290, -6857, -5230, -7191, -5201, -7342, -4932, -7233, -4824, -7122, -4478, -7215, -4225, -7081, -3855, -7112, -3666, -6982, -3419, -7054, -3137, -6966, -2846, -6830, -2690, -6842, -2452, -6699, -2299, -6783, -2287},
+		{11057, 1926, 11079, 2008, 10912, 1982, 10866, 1851, 10948, 1820},
+		{12729, 5074, 12507, 5316, 12100, 5325, 11929, 5014, 11668, 4989, 11549, 4814, 11806, 4807, 11966, 4669, 11742, 4667, 11346, 4481, 11187, 4510, 11135, 4446, 11183, 4374, 11041, 4287, 10496, 4160, 10085, 4266, 9635, 4273, 9531, 4424, 9095, 4529, 9028, 4769, 8736, 4921, 8577, 4846, 8516, 4700, 8318, 4733, 8246, 4554, 7997, 4492, 8087, 4318, 8012, 4212, 7368, 3943, 7619, 3590, 7891, 3432, 7918, 3248, 7846, 3262, 7972, 3088, 8582, 2820, 8873, 2809, 8881, 2730, 9002, 2830, 9250, 2790, 9612, 2945, 9625, 2841, 9791, 2834, 9868, 2751, 9760, 2390, 9866, 2406, 9953, 2295, 9924, 2212, 10180, 2117, 10165, 2232, 10533, 2335, 10673, 2279, 10704, 2181, 10986, 2140, 10989, 2028, 11044, 2034, 11079, 2140, 11589, 2278, 11866, 2455, 12168, 2823, 12209, 2983, 12126, 3068, 12191, 3169, 11915, 3491, 12252, 3693, 12236, 3745, 12082, 3787, 11970, 3716, 11753, 3874, 12164, 4095, 12217, 4042, 12105, 3890, 12427, 3993, 12687, 4182, 12821, 4147, 12805, 4199, 12999, 4299, 13064, 4240, 13103, 4497, 13310, 4514, 13503, 4848, 13099, 4779, 12940, 4944, 12766, 4976},
+		{-281, 539, -283, 964, -621, 1052, -823, 1013, -783, 858, -860, 647, -757, 571, -771, 436},
+		{1434, 223, 1594, 173, 1448, 473, 1544, 769, 1395, 955, 1547, 998, 1450, 1286, 1175, 698, 923, 644, 849, 450, 965, 228},
+		{2995, 417, 2798, 441, 2737, 523, 2441, 511, 2241, 403, 1947, 503, 1854, 420, 1764, -42, 1601, -354, 1458, -497, 1263, -499, 1232, -610, 1633, -588, 1747, -807, 1902, -799, 2009, -694, 2173, -729, 2216, -1108, 2716, -1161, 2970, -1326, 2962, -1218, 2837, -1179, 2873, -853, 3074, -834, 2942, -594, 2902, -284, 2988, 60, 3117, 220},
+		{1326, -488, 1458, -497, 1601, -354, 1764, -42, 1845, 350, 1713, 373, 1594, 173, 1308, 227, 1328, 131, 1428, 120, 1430, -200, 1258, -195, 1109, -398, 1191, -504, 1262, -444},
+		{-7511, -6, -7307, -231, -7005, -273, -7069, -374, -6989, -430, -6942, -112, -7002, 54, -6922, 99, -6982, 171, -6754, 204, -6688, 125, -6781, 282, -6734, 610, -7196, 699, -7330, 915, -7140, 1238, -7491, 1108, -7775, 771, -7713, 385, -7899, 169, -7742, 40},
+		{-8291, 842, -8255, 957, -8366, 1094, -8594, 1090, -8566, 993},
+		{-8251, 2308, -8497, 2190, -8180, 2264, -7872, 2160, -7709, 2041, -7776, 1986, -7418, 2028, -7835, 2251},
+		{3292, 3509, 3319, 3517, 3338, 3516, 3346, 3510, 3348, 3500, 3353, 3504, 3368, 3502, 3387, 3509, 3397, 3506, 3390, 3525, 3458, 3567, 3367, 3537, 3295, 3539, 3280, 3515, 3273, 3514},
+		{3387, 3509, 3226, 3510, 3298, 3457},
+		{1710, 4882, 1885, 4950, 1502, 5111, 1224, 5027, 1434, 4856},
+		{928, 5483, 853, 5496, 880, 5402, 812, 5353, 691, 5348, 684, 5223, 599, 5185, 619, 4946, 810, 4902, 747, 4762, 1293, 4747, 1360, 4888, 1224, 5027, 1502, 5111, 1412, 5376},
+		{4278, 1246, 4176, 1105, 4278, 1093, 4332, 1239},
+		{1237, 5611, 1104, 5536, 1209, 5480},
+		{1037, 5661, 1058, 5773, 854, 5711, 812, 5552, 992, 5498, 1091, 5646},
+		{-7162, 1917, -7140, 1760, -7067, 1843, -6832, 1861, -7081, 1988, -7159, 1988},
+		{1156, 2410, 1030, 2438, 932, 2609, 981, 2942, 906, 3210, 752, 3410, 842, 3695, 147, 3661, -121, 3571, -217, 3517, -131, 3226, -867, 2884, -868, 2740, 315, 1969, 316, 1906, 568, 1960, 1200, 2347},
+		{-8018, -382, -8044, -443, -7864, -455, -7784, -300, -7554, -156, -7537, -15, -7886, 138, -8009, 77, -8097, -225, -7999, -222},
+		{3427, 3122, 3196, 3093, 3098, 3156, 2891, 3087, 2516, 3157, 2500, 2200, 3687, 2200, 3553, 2310, 3569, 2393, 3232, 2976, 3415, 2782, 3492, 2950},
+		{4278, 1246, 3927, 1592, 3841, 1800, 3685, 1696, 3643, 1442, 4003, 1452},
+		{-867, 4213, -639, 4138, -750, 3963, -754, 3743, -538, 3595, -68, 3764, 81, 4101, 304, 4189, 299, 4247, -190, 4342, -798, 4375, -939, 4303},
+		{2516, 5797, 2729, 5747, 2813, 5930, 2334, 5919, 2431, 5779},
+		{3759, 1421, 3643, 1442, 3295, 778, 3616, 445, 3956, 342, 4077, 426, 4186, 392, 4496, 500, 4779, 800, 4368, 918, 4278, 1093, 4176, 1105, 4235, 1254, 4090, 1412, 3791, 1496},
+		{2902, 6977, 2618, 6983, 2474, 6865, 2065, 6911, 2354, 6794, 2357, 6640, 2540, 6511, 2154, 6319, 2132, 6072, 2287, 5985, 2807, 6050, 3114, 6236, 3152, 6287, 3004, 6355, 3044, 6420, 2954, 6495, 3022, 6581, 2905, 6694, 2998, 6770, 2845, 6836},
+		{17813, -1750, 17738, -1816, 17855, -1815},
+		{18000, -1656, 18000, -1607, 17941, -1638, 17910, -1643, 17860, -1664, 17873, -1701, 17936, -1680},
+		{-17979, -1602, -18000, -1607, -18000, -1656, -17992, -1650},
+		{-6070, -5230, -5775, -5155, -6000, -5125, -6120, -5185},
+		{-5225, 324, -5182, 457, -5396, 576, -5452, 231, -5294, 212},
+		{939, 4301, 854, 4226, 923, 4138},
+		{312, 5078, 164, 5095, 134, 5013, -99, 4935, -193, 4978, -162, 4864, -459, 4868, -449, 4795, -119, 4601, -150, 4303, 183, 4234, 744, 4369, 604, 4673, 810, 4902},
+		{1186, -343, 1148, -277, 1258, -195, 1430, -200, 1428, 120, 1328, 131, 1295, 232, 1128, 226, 1129, 106, 949, 101, 883, -78, 1109, -398},
+		{-673, 5517, -757, 5513, -757, 5406, -620, 5387, -566, 5455},
+		{-421, 5855, -501, 5863, -615, 5679, -559, 5531, -505, 5578, -484, 5479, -295, 5398, -309, 5340, -458, 5350, -422, 5230, -527, 5199, -341, 5143, -578, 5016, 145, 5129, 168, 5274, 47, 5293, -209, 5591, -312, 5597, -196, 5768, -407, 5755, -301, 5864},
+		{4262, 4158, 4664, 4118, 4547, 4250, 3996, 4343, 4145, 4265, 4155, 4154},
+		{84, 628, 2, 1102, -294, 1096, -286, 499, -106, 500},
+		{-828, 769, -803, 1021, -913, 1231, -1017, 1184, -1370, 1259, -1513, 1104, -1325, 890, -1112, 1005, -1051, 835, -976, 854, -921, 731},
+		{-1593, 1313, -1384, 1351, -1540, 1386, -1684, 1315},
+		{-1469, 1153, -1370, 1259, -1668, 1238, -1513, 1104},
+		{983, 107, 1129, 106, 1128, 226, 965, 228, 931, 116},
+		{2351, 3528, 2474, 3508, 2472, 3492, 2616, 3500, 2629, 3530, 2575, 3518, 2577, 3535, 2503, 3542, 2425, 3537, 2370, 3571},
+		{2612, 4183, 2102, 4084, 2015, 3962, 2167, 3684, 2315, 3642, 2312, 3792, 2404, 3766, 2281, 4048, 2441, 4012, 2371, 4069, 2629, 4094},
+		{-4690, 8220, -4452, 8166, -5039, 8244, -6265, 8177, -6223, 8132, -6802, 8012, -6532, 7976, -6571, 7939, -7330, 7804, -6676, 7738, -7140, 7701, -6850, 7606, -5859, 7552, -5472, 7259, -5583, 7165, -5139, 7057, -5436, 7082, -5468, 6961, -5087, 6993, -5397, 6719, -5163, 6363, -4826, 6086, -4338, 6010, -4242, 6190, -4282, 6268, -4119, 6348, -3981, 6546, -2235, 7013, -2636, 7023, -2520, 7075, -2554, 7143, -2354, 7047, -2175, 7066, -2213, 7147, -2479, 7233, -2230, 7218, -2357, 7331, -1937, 7430, -2067, 7516, -1960, 7525, -1983, 7610, -2168, 7663, -1847, 7699, -1967, 7764, -1970, 7875, -1773, 8013, -2005, 8018, -1221, 8129, -1577, 8191, -2317, 8115, -2207, 8173, -3190, 8220, -2085, 8273, -2710, 8352, -3862, 8355},
+		{-9006, 1388, -8823, 1573, -8923, 1589, -8914, 1781, -9100, 1782, -9145, 1725, -9046, 1607, -9175, 1607, -9223, 1454},
+		{-6055, 778, -6030, 704, -6141, 596, -5998, 501, -5965, 179, -5854, 127, -5654, 190, -5804, 406, -5715, 597, -5976, 837},
+		{-8701, 1303, -8492, 1479, -8315, 1500, -8498, 1600, -8790, 1586, -8915, 1507, -8935, 1442},
+		{1846, 4576, 1656, 4650, 1366, 4514, 1490, 4508, 1602, 4351, 1845, 4248, 1575, 4482, 1939, 4524},
+		{-7342, 1964, -7233, 1867, -7446, 1834, -7171, 1804, -7171, 1971},
+		{1637, 4684, 1846, 4576, 2102, 4632, 2271, 4788, 2080, 4862, 1698, 4812},
+		{12078, -997, 12043, -967, 11990, -936, 11897, -956, 12030, -1026, 12072, -1024},
+		{12509, -939, 12398, -929, 12346, -1024},
+		{11763, -845, 11674, -903, 11913, -871},
+		{12201, -846, 11992, -881, 12276, -865, 12290, -809},
+		{10849, -642, 10605, -590, 10537, -685, 11456, -875, 11571, -837, 11261, -695},
+		{13473, -574, 13450, -545, 13429, -578, 13411, -614, 13421, -690, 13472, -621},
+		{12700, -313, 12599, -318, 12687, -379},
+		{12937, -280, 12790, -339, 13083, -386},
+		{13399, -78, 13052, -94, 13223, -221, 13370, -221, 13199, -282, 13298, -411, 13366, -354, 13793, -539, 13867, -732, 13761, -841, 14014, -830, 14103, -912, 14100, -260, 13744, -170, 13546, -337, 13442, -277},
+		{12507, 164, 12408, 92, 12089, 131, 12004, 57, 11877, -280, 11950, -349, 11937, -538, 12043, -553, 12031, -293, 12097, -263, 12149, -457, 12272, -446, 12224, -528, 12316, -534, 12151, -190, 12334, -62, 12094, -141, 12018, 24, 12444, 43},
+		{12859, 154, 12793, 217, 12740, 101, 12810, -90},
+		{11805, 229, 11731, 323, 11788, 414, 11587, 431, 11462, 143, 11051, 77, 10966, 201, 10895, 42, 11022, -293, 11615, -401, 11781, 78, 11900, 90},
+		{10586, -431, 10611, -306, 10344, -71, 10384, 10, 10250, 140, 10064, 210, 9748, 525, 9529, 548, 10258, -422, 10471, -587, 10582, -585},
+		{7687, 3465, 7375, 3432, 7526, 3227, 7178, 2791, 7062, 2799, 6951, 2694, 7104, 2436, 6818, 2369, 7047, 2088, 7263, 2136, 7353, 1599, 7754, 797, 7986, 1036, 8032, 1590, 8219, 1656, 8650, 2015, 8698, 2150, 8889, 2169, 8870, 2423, 8808, 2450, 8893, 2524, 8821, 2577, 8856, 2645, 8992, 2527, 9238, 2498, 9116, 2350, 9171, 2299, 9215, 2363, 9267, 2204, 9512, 2657, 9713, 2708, 9740, 2788, 9625, 2841, 9612, 2945, 9170, 2777, 9203, 2684, 8974, 2672, 8884, 2710, 8873, 2809, 8806, 2641, 8330, 2736, 8009, 2879, 8111, 3018, 7874, 3152, 7891, 3432, 7784, 3549},
+		{-695, 5407, -757, 5406, -757, 5513, -969, 5388, -917, 5286, -998, 5182, -679, 5226, -603, 5315},
+		{5383, 3697, 4920, 3758, 4806, 3958, 4614, 3874, 4411, 3943, 4423, 3797, 4608, 3568, 4542, 3397, 4733, 3247, 4857, 2993, 5012, 3015, 5152, 2787, 5349, 2681, 5649, 2714, 5740, 2574, 6150, 2508, 6187, 2624, 6332, 2676, 6087, 2983, 6178, 3074, 6054, 3298, 6112, 3649, 5662, 3812},
+		{4477, 3717, 4235, 3723, 4129, 3636, 4101, 3442, 3879, 3338, 3920, 3216, 4471, 2918, 4657, 2910, 4730, 3006, 4857, 2993, 4733, 3247, 4542, 3397, 4608, 3568},
+		{-1617, 6653, -2058, 6573, -2365, 6626, -2433, 6561, -2223, 6538, -2396, 6489, -2178, 6440, -2276, 6396, -1866, 6350, -1361, 6513, -1474, 6581, -1451, 6646},
+		{3570, 3272, 3582, 3328, 3510, 3308, 3427, 3122, 3492, 2950, 3497, 3187},
+		{1476, 3814, 1243, 3761, 1510, 3662, 1552, 3823},
+		{871, 4090, 816, 4095, 881, 3891, 967, 3918, 981, 4050},
+		{1215, 4712, 684, 4599, 701, 4425, 744, 4369, 889, 4437, 1020, 4392, 1119, 4236, 1541, 4005, 1610, 3799, 1705, 3890, 1687, 4044, 1848, 4017, 1259, 4409, 1233, 4538, 1394, 4559, 1381, 4651},
+		{-7780, 1852, -7834, 1823, -7620, 1789},
+		{3555, 3178, 3492, 2950, 3607, 2920, 3800, 3051, 3700, 3151, 3920, 3216, 3879, 3338, 3683, 3231, 3572, 3271},
+		{13390, 3436, 13236, 3299, 13420, 3320, 13477, 3381},
+		{14096, 3817, 14188, 3918, 14137, 4138, 13988, 4056, 13943, 3822, 13739, 3683, 13672, 3730, 13568, 3553, 13262, 3543, 12941, 3330, 13045, 3232, 13020, 3142, 13133, 3145, 13200, 3315, 13099, 3389, 13508, 3460, 13579, 3346, 13722, 3461, 14025, 3514},
+		{14314, 4451, 14197, 4555, 14138, 4339, 13982, 4256, 13996, 4157, 14107, 4158, 14161, 4268, 14318, 4200, 14554, 4326, 14532, 4438},
+		{7119, 4270, 7349, 4250, 7421, 4330, 8026, 4235, 8087, 4318, 7997, 4492, 8246, 4554, 8318, 4733, 8516, 4700, 8577, 4846, 8736, 4921, 8338, 5107, 8004, 5086, 7689, 5449, 7343, 5349, 7351, 5404, 7118, 5413, 7087, 5517, 6907, 5539, 6144, 5401, 6098, 5366, 6170, 5298, 5997, 5196, 6159, 5127, 6134, 5080, 5572, 5062, 5077, 5169, 4858, 4987, 4755, 5045, 4647, 4839, 4910, 4640, 5304, 4685, 5304, 4526, 5031, 4461, 5250, 4279, 5250, 4178, 5408, 4232, 5597, 4131, 5593, 4500, 5850, 4559, 6201, 4350, 6490, 4373, 6610, 4300, 6671, 4117, 6826, 4066},
+		{4098, 278, 4186, 392, 3812, 360, 3530, 551, 3401, 425, 3504, 191, 3390, -95, 3920, -468, 4159, -168},
+		{7126, 4217, 7042, 4152, 7306, 4087, 6956, 4010, 6946, 3953, 7368, 3943, 8026, 4235, 7421, 4330, 7349, 4250, 7119, 4270},
+		{10433, 1049, 10625, 1096, 10581, 1157, 10749, 1234, 10738, 1420, 10299, 1423, 10258, 1219},
+		{12821, 3837, 12617, 3775, 12686, 3689, 12612, 3673, 12649, 3439, 12947, 3563, 12921, 3743},
+		{2135, 4221, 2178, 4268, 2081, 4327, 2007, 4259, 2059, 4186},
+		{4730, 3006, 4657, 2910, 4842, 2855},
+		{10604, 1388, 10650, 1457, 10738, 1420, 10731, 1591, 10390, 1927, 10482, 1989, 10444, 2076, 10320, 2077, 10165, 2232, 10180, 2117, 10012, 2042, 10128, 1946, 10106, 1751, 10396, 1824, 10559, 1557, 10522, 1427},
+		{3607, 3382, 3645, 3459, 3513, 3309},
+		{-764, 519, -860, 647, -844, 769, -921, 731, -976, 854, -1115, 740, -1144, 679, -900, 483, -771, 436},
+		{1586, 2341, 2384, 1958, 2500, 2000, 2492, 3190, 2085, 3271, 1909, 3027, 1571, 3138, 1525, 3227, 1149, 3314, 948, 3031, 932, 2609, 1030, 2438, 1414, 2249},
+		{8130, 856, 8015, 982, 7970, 820, 8035, 597, 8164, 648},
+		{2854, -2865, 2700, -2988, 2775, -3065, 2933, -2926},
+		{2324, 5422, 2554, 5428, 2649, 5562, 2486, 5637, 2106, 5603, 2127, 5519},
+		{578, 5009, 567, 4953, 590, 4944, 619, 4946, 624, 4990, 604, 5013},
+		{2220, 5634, 2649, 5562, 2818, 5617, 2777, 5724, 2516, 5797, 2412, 5703, 2158, 5741, 2106, 5603},
+		{-593, 3576, -691, 3411, -930, 3256, -956, 2993, -1444, 2625, -1702, 2142, -1475, 2150, -1139, 2688, -879, 2712, -867, 2884, -131, 3226, -217, 3517},
+		{2692, 4812, 2823, 4549, 2886, 4644, 3002, 4642, 2867, 4812},
+		{4919, -1204, 4771, -1459, 4445, -1622, 4396, -1741, 4446, -1944, 4335, -2278, 4404, -2499, 4541, -2560, 4710, -2494, 4967, -1571, 5038, -1571},
+		{-9753, 2584, -9902, 2637, -10096, 2938, -10394, 2927, -10651, 3175, -11102, 3133, -11472, 3272, -11713, 3254, -11416, 2857, -11506, 2772, -11230, 2601, -11218, 2474, -11003, 2282, -10941, 2336, -11467, 3016, -11478, 3180, -11315, 3117, -11223, 2895, -10603, 2277, -10499, 1932, -9656, 1565, -9388, 1594, -9223, 1454, -9175, 1607, -9046, 1607, -9145, 1725, -9100, 1782, -8784, 1826, -8681, 2133, -9028, 2100, -9077, 1928, -9443, 1814, -9590, 1883, -9770, 2190},
+		{2046, 4152, 2102, 4084, 2295, 4134, 2238, 4232},
+		{-1212, 1399, -1146, 1208, -913, 1231, -803, 1021, -605, 1010, -401, 1347, -107, 1497, 364, 1557, 427, 1916, 316, 1906, 315, 1969, -492, 2497, -645, 2496, -554, 1550, -1167, 1539},
+		{10012, 2042, 10115, 2185, 9924, 2212, 9953, 2295, 9866, 2406, 9760, 2390, 9868, 2751, 9733, 2826, 9713, 2708, 9512, 2657, 9230, 2148, 9432, 1821, 9419, 1604, 9537, 1571, 9716, 1693, 9851, 1312, 9855, 993, 9959, 1189, 9819, 1512, 9890, 1618, 9738, 1845, 9825, 1971},
+		{2007, 4259, 1922, 4352, 1845, 4248, 1937, 4188},
+		{8801, 4860, 9028, 4769, 9095, 4529, 9531, 4424, 9635, 4273, 10085, 4266, 10496, 4160, 11041, 4287, 11183, 4374, 11135, 4446, 11187, 4510, 11346, 4481, 11977, 4705, 11806, 4807, 11574, 4773, 11668, 4989, 11436, 5025, 11066, 4913, 10848, 4928, 10689, 5027, 10368, 5009, 9886, 5205, 9726, 4973, 9223, 5080, 8775, 4930},
+		{3428, -1228, 3569, -1461, 3503, -1680, 3446, -1461, 3321, -1397, 3018, -1480, 3034, -1588, 3285, -1671, 3266, -2030, 3119, -2225, 3207, -2673, 3283, -2674, 3301, -2536, 3546, -2412, 3479, -1978, 3945, -1672, 4078, -1469, 4032, -1032, 3747, -1157, 3456, -1152},
+		{-1183, 1480, -1167, 1539, -554, 1550, -532, 1620, -645, 2496, -492, 2497, -868, 2740, -869, 2588, -1197, 2593, -1194, 2337, -1287, 2328, -1293, 2133, -1706, 2100, -1628, 2009, -1646, 1614, -1458, 1660},
+		{3428, -1016, 3276, -923, 3349, -1053, 3269, -1371, 3446, -1461, 3503, -1680, 3569, -1461, 3456, -1358},
+		{10026, 664, 10139, 276, 10423, 129, 10296, 552, 10214, 622, 10115, 569},
+		{11844, 497, 11918, 541, 11673, 692, 11535, 432, 11420, 453, 11300, 310, 11137, 270, 11117, 185, 10966, 201, 10983, 134, 11051, 77, 11462, 143, 11587, 431, 11788, 414},
+		{1682, -2808, 1846, -2905, 1989, -2846, 1990, -2185, 2088, -2181, 2091, -1825, 2508, -1758, 1173, -1730, 1426, -2211, 1521, -2709, 1634, -2858},
+		{16546, -2080, 16403, -2011, 16547, -2168, 16712, -2216},
+		{249, 1223, 361, 1166, 411, 1353, 544, 1387, 901, 1283, 1308, 1360, 1418, 1248, 1460, 1333, 1354, 1437, 1525, 1663, 1590, 2039, 1485, 2286, 1414, 2249, 1200, 2347, 427, 1916, 364, 1557, 37, 1493, 102, 1285},
+		{876, 548, 1012, 704, 1175, 698, 1458, 1209, 1308, 1360, 901, 1283, 437, 1375, 269, 626, 433, 627, 590, 426, 850, 477},
+		{-8556, 1122, -8366, 1094, -8315, 1500, -8492, 1479, -8767, 1291},
+		{471, 5309, 331, 5135, 616, 5080, 691, 5348},
+		{2637, 7099, 1918, 6982, 1476, 6781, 1053, 6449, 591, 6261, 499, 6197, 567, 5859, 838, 5831, 1036, 5947, 1103, 5886, 1263, 6129, 1193, 6313, 1258, 6407, 1357, 6405, 1677, 6801, 1988, 6841, 2124, 6937, 2474, 6865, 2773, 7016, 2940, 6916, 3110, 6956, 3001, 7019, 3129, 7045},
+		{2328, 7808, 2081, 7825, 2142, 7794, 2073, 7768, 2472, 7785},
+		{1699, 8005, 1044, 7965, 1591, 7677, 1712, 7681, 1903, 7856, 2154, 7896},
+		{2292, 8066, 1737, 8032, 2302, 7940, 2741, 8006},
+		{8695, 2797, 8153, 3042, 8009, 2879, 8525, 2673, 8806, 2641, 8812, 2788},
+		{17280, -4049, 17052, -4303, 16705, -4511, 16668, -4622, 16933, -4664, 17145, -4424, 17308, -4385, 17271, -4337, 17425, -4135},
+		{17433, -3527, 17264, -3453, 17470, -3738, 17382, -3951, 17490, -3991, 17465, -4128, 17524, -4169, 17721, -3915, 17797, -3917, 17852, -3770, 17676, -3788, 17536, -3653, 17534, -3721},
+		{5928, 2143, 5981, 2253, 5589, 2492, 5500, 2000, 5200, 1900, 5311, 1665, 5769, 1894, 5783, 2024},
+		{5649, 2631, 5636, 2640, 5607, 2606, 5626, 2571, 5639, 2590},
+		{7458, 3702, 7126, 3607, 7161, 3515, 7088, 3399, 6993, 3402, 7032, 3336, 6932, 3190, 6694, 3130, 6635, 2989, 6255, 2932, 6087, 2983, 6332, 2676, 6187, 2624, 6150, 2508, 6637, 2543, 6818, 2369, 7104, 2436, 6951, 2694, 7062, 2799, 7178, 2791, 7526, 3227, 7375, 3432, 7687, 3465, 7784, 3549},
+		{-7775, 771, -7735, 867, -7850, 942, -8144, 879, -8293, 948, -8285, 807, -8089, 722, -7956, 893, -7818, 832, -7788, 722},
+		{-6896, -1650, -6867, -1256, -6953, -1095, -7055, -1101, -7048, -949, -7130, -1008, -7323, -946, -7399, -752, -7289, -527, -6989, -430, -7069, -374, -7005, -273, -7307, -231, -7537, -15, -7554, -156, -7784, -300, -7921, -496, -8044, -443, -8030, -340, -8110, -404, -8125, -614, -7976, -719, -7601, -1465, -7037, -1835},
+		{12631, 878, 12541, 976, 12547, 899, 12349, 869, 12192, 719, 12361, 783, 12422, 616, 12540, 558, 12536, 679, 12583, 729, 12620, 627, 12654, 719},
+		{12408, 1123, 12334, 1027, 12295, 1088, 12238, 971, 12300, 902},
+		{11903, 1000, 11951, 1137, 11717, 837},
+		{12204, 1142, 12200, 1044, 12312, 1158, 12188, 1189},
+		{12523, 1254, 12427, 1256, 12480, 1013, 12501, 1131, 12578, 1105},
+		{12118, 1343, 12032, 1347, 12126, 1221},
+		{12072, 1851, 12029, 1603, 11988, 1636, 12007, 1497, 12099, 1453, 12063, 1386, 12293, 1355, 12408, 1254, 12395, 1378, 12173, 1433, 12225, 1848},
+		{15602, -654, 15451, -514, 15517, -654},
+		{15232, -487, 15154, -417, 15081, -546, 15014, -500, 14832, -575, 15024, -632},
+		{14697, -672, 14789, -661, 14765, -608, 14458, -386, 14100, -260, 14103, -912, 14263, -933, 14474, -763, 14791, -1013, 15069, -1058},
+		{15302, -398, 15066, -274, 15283, -477},
+		{1549, 5078, 1891, 4944, 2278, 4903, 2403, 5071, 2320, 5249, 2380, 5309, 2273, 5433, 1636, 5451, 1412, 5376},
+		{-6710, 1852, -6718, 1795, -6559, 1823},
+		{12999, 4299, 12427, 3993, 12539, 3939, 12471, 3811, 12821, 3837, 12753, 3976, 13078, 4222},
+		{-899, 4154, -953, 3874, -884, 3827, -890, 3687, -786, 3684, -703, 3808, -750, 3963, -639, 4138, -826, 4228},
+		{-6085, -2388, -5778, -2516, -5862, -2712, -5649, -2755, -5479, -2662, -5429, -2402, -5540, -2396, -5580, -2236, -5794, -2209, -5818, -1987, -5912, -1936, -6179, -1963, -6227, -2051, -6269, -2225},
+		{3518, 3253, 3493, 3135, 3555, 3178},
+		{5111, 2456, 5139, 2463, 5161, 2522, 5159, 2580, 5129, 2611, 5101, 2601, 5074, 2548, 5081, 2475},
+		{2210, 4767, 2022, 4613, 2294, 4382, 2856, 4371, 2884, 4491, 2963, 4504, 2823, 4549, 2692, 4812},
+		{14324, 5176, 14221, 5423, 14261, 5376, 14168, 5330, 14159, 5194, 14218, 5095, 14209, 4597, 14275, 4674, 14351, 4614, 14256, 4786, 14317, 4931, 14465, 4898},
+		{2265, 5458, 2127, 5519, 1966, 5443},
+		{-17493, 6721, -18000, 6896, -18000, 6498, -17943, 6540, -17988, 6587, -17869, 6611, -17836, 6539, -17296, 6425, -17253, 6544, -16990, 6598, -17186, 6691, -17457, 6706, -17434, 6634},
+		{18000, 7152, 17873, 7110, 18000, 7083},
+		{-17766, 7113, -18000, 7152, -18000, 7083},
+		{14348, 7348, 14206, 7386, 14081, 7377, 13986, 7337, 14004, 7332, 14209, 7321, 14360, 7321},
+		{14822, 7535, 14612, 7517, 15073, 7508},
+		{14147, 7609, 13751, 7595, 13697, 7526, 13896, 7461, 14430, 7482, 14509, 7556},
+		{5562, 7154, 5542, 7237, 5848, 7431, 6885, 7654, 6117, 7625, 5563, 7508, 5590, 7463, 5146, 7201, 5368, 7076, 5754, 7072},
+		{10471, 7713, 10607, 7737, 10435, 7770, 10076, 7643, 8717, 7512, 8601, 7446, 8682, 7394, 8051, 7365, 8150, 7175, 7758, 7227, 7590, 7187, 7636, 7115, 7529, 7134, 7568, 7230, 7466, 7283, 7489, 7212, 7310, 7145, 7440, 7063, 7360, 6963, 7494, 6899, 7447, 6833, 7505, 6776, 7242, 6617, 7128, 6632, 7367, 6841, 7256, 6902, 7279, 7039, 7185, 7141, 7280, 7222, 6994, 7304, 6669, 7103, 6693, 6945, 6918, 6862, 6851, 6809, 6055, 6985, 6003, 6952, 6108, 6894, 5994, 6828, 5880, 6888, 5349, 6820, 5447, 6881, 5372, 6886, 4635, 6667, 4556, 6701, 4682, 6769, 4625, 6825, 4345, 6857, 4453, 6676, 4395, 6607, 4209, 6648, 3976, 6550, 4044, 6476, 3959, 6452, 3718, 6514, 3654, 6476, 3701, 6385, 3494, 6441, 3481, 6590, 3318, 6663, 3838, 6600, 4113, 6679, 4106, 6746, 3213, 6991, 2859, 6906, 2845, 6836, 2998, 6770, 2905, 6694, 3022, 6581, 2954, 6495, 3044, 6420, 3004, 6355, 3152, 6287, 2807, 6050, 2912, 6003, 2742, 5872, 2729, 5747, 2818, 5617, 3087, 5555, 3076, 5481, 3269, 5335, 3131, 5307, 3179, 5210, 3375, 5234, 3536, 5058, 4007, 4960, 3974, 4790, 3826, 4755, 3915, 4704, 3767, 4664, 3823, 4624, 3668, 4524, 3996, 4343, 4547, 4250, 4782, 4115, 4858, 4181, 4668, 4461, 4910, 4640, 4647, 4839, 4755, 5045, 4858, 4987, 5077, 5169, 5572, 5062, 6134, 5080, 6159, 5127, 5997, 5196, 6170, 5298, 6098, 5366, 6144, 5401, 6907, 5539, 7087, 5517, 7118, 5413, 7351, 5404, 7343, 5349, 7689, 5449, 8004, 5086, 8338, 5107, 8736, 4921, 9223, 5080, 9726, 4973, 9886, 5205, 10368, 5009, 10689, 5027, 10848, 4928, 11066, 4913, 11436, 5025, 11788, 4951, 11929, 5014, 12100, 5325, 12507, 5316, 12766, 4976, 12940, 4944, 13099, 4779, 13503, 4848, 13310, 4514, 13103, 4497, 13078, 4222, 13228, 4328, 13354, 4281, 13487, 4340, 14006, 4845, 14135, 5309, 13990, 5419, 13816, 5376, 13513, 5473, 14220, 5904, 14978, 5966, 15134, 5950, 15127, 5878, 15504, 5914, 15422, 5976, 15672, 6143, 15930, 6177, 16012, 6054, 16447, 6255, 16367, 6114, 15591, 5677, 15543, 5538, 15679, 5101, 15853, 5296, 16002, 5320, 16037, 5434, 16212, 5486, 16170, 5529, 16306, 5616, 16319, 5762, 16202, 5824, 16354, 5987, 16890, 6057, 17033, 5988, 17368, 6165, 17923, 6230, 17937, 6298, 17741, 6461, 18000, 6498, 18000, 6896, 17572, 6988, 17045, 7010, 17001, 6965, 17082, 6901, 16958, 6869, 16784, 6958, 16094, 6944, 15971, 6972, 15900, 7087, 15297, 7084, 14950, 7220, 14047, 7285, 13915, 7242, 13987, 7149, 13225, 7184, 13129, 7079, 12846, 7198, 12905, 7240, 12859, 7304, 12698, 7357, 12326, 7374, 12320, 7297, 10940, 7418, 11413, 7585},
+		{10537, 7871, 10209, 7935, 9944, 7792},
+		{5152, 8070, 4485, 8059, 4759, 8001},
+		{10019, 7978, 9594, 8125, 9118, 8034, 9776, 7876, 9994, 7888},
+		{2982, -144, 2902, -284, 3076, -229, 3042, -113},
+		{-941, 2709, -1139, 2688, -1475, 2150, -1706, 2100, -1293, 2133, -1287, 2328, -1194, 2337, -1197, 2593, -869, 2588, -867, 2766},
+		{4322, 1667, 4338, 1758, 4700, 1695, 4912, 1862, 5500, 2000, 5567, 2200, 5521, 2271, 5200, 2300, 4842, 2855, 4471, 2918, 4040, 3189, 3700, 3151, 3800, 3051, 3607, 2920, 3496, 2936, 3463, 2806, 3849, 2369, 3914, 2129, 4278, 1635},
+		{3396, 958, 3627, 1356, 3685, 1696, 3841, 1800, 3748, 1861, 3687, 2200, 2500, 2200, 2500, 2000, 2385, 2000, 2389, 1561, 2302, 1568, 2194, 1259, 2355, 1009, 2346, 895, 2454, 892, 2579, 1041, 2675, 947, 2897, 940, 3000, 1029, 3135, 981, 3274, 1225},
+		{3382, 948, 3274, 1225, 3135, 981, 3000, 1029, 2897, 940, 2675, 947, 2579, 1041, 2389, 862, 2798, 441, 2972, 460, 3188, 356, 3339, 379, 3530, 551, 3295, 778, 3383, 838},
+		{-1562, 1362, -1384, 1351, -1684, 1315, -1668, 1238, -1151, 1244, -1217, 1462, -1458, 1660, -1612, 1646, -1763, 1473, -1671, 1359},
+		{16192, -1045, 16132, -1020, 16240, -1083},
+		{16069, -961, 16036, -940, 15970, -924, 15964, -964, 15985, -979, 16046, -990, 16085, -987},
+		{16128, -912, 16058, -832, 16153, -978},
+		{15964, -802, 15821, -742, 15992, -854},
+		{15714, -702, 15654, -660, 15754, -735},
+		{-1120, 711, -1023, 841, -1112, 1005, -1192, 1005, -1325, 890, -1312, 816},
+		{-8772, 1379, -8935, 1442, -9010, 1374, -8790, 1315},
+		{4894, 997, 4895, 1141, 4412, 1045, 4315, 1146, 4256, 1057, 4368, 918, 4695, 800, 4779, 800},
+		{4927, 1143, 4894, 945, 4496, 500, 4213, 423, 4098, 278, 4159, -168, 4859, 534, 5111, 1202},
+		{2076, 4573, 1883, 4591, 1922, 4352, 2238, 4232, 2271, 4458},
+		{-5731, 507, -5804, 406, -5654, 190, -5452, 231, -5396, 576, -5715, 597},
+		{1855, 4950, 1698, 4812, 2187, 4832, 2256, 4909},
+		{1370, 4602, 1533, 4545, 1656, 4650},
+		{2390, 6601, 2354, 6794, 2003, 6907, 1988, 6841, 1677, 6801, 1357, 6405, 1258, 6407, 1193, 6313, 1230, 6012, 1103, 5886, 1294, 5536, 1588, 5610, 1683, 5872, 1879, 6008, 1712, 6134, 1785, 6275, 2137, 6441, 2218, 6572},
+		{3199, -2629, 3104, -2573, 3069, -2674, 3187, -2718},
+		{4101, 3442, 4129, 3636, 4235, 3723, 3674, 3682, 3591, 3541, 3661, 3420, 3570, 3272, 3683, 3231},
+		{1489, 1222, 1547, 998, 1395, 955, 1528, 742, 2100, 948, 2286, 1114, 2194, 1259, 2302, 1568, 2389, 1561, 2384, 1958, 1586, 2341, 1485, 2286, 1590, 2039, 1525, 1663, 1354, 1437},
+		{162, 683, 143, 983, 90, 1100, 2, 1102, 106, 593, 187, 614},
+		{10235, 1339, 10299, 1423, 10554, 1472, 10472, 1743, 10320, 1831, 10106, 1751, 10128, 1946, 10012, 2042, 9825, 1971, 9738, 1845, 9890, 1618, 9819, 1512, 9959, 1189, 9815, 835, 10115, 569, 10214, 622, 10046, 743, 9915, 996, 10010, 1341, 10258, 1219},
+		{7060, 4022, 7067, 4096, 6933, 4073, 6854, 3953, 6770, 3958, 6839, 3816, 6783, 3714, 6920, 3715, 7081, 3849, 7184, 3674, 7498, 3742, 7368, 3943, 6946, 3953},
+		{6223, 3527, 6575, 3766, 6652, 3736, 6655, 3797, 5863, 4275, 5710, 4132, 5546, 4126, 5408, 4232, 5250, 4178, 5281, 4114, 5372, 4212, 5474, 4095, 5292, 4088, 5269, 4003, 5388, 3895, 5392, 3720, 5733, 3803, 6112, 3649, 6121, 3565},
+		{12507, -909, 12509, -939, 12593, -911, 12697, -867, 12734, -840, 12696, -827, 12664, -840, 12595, -843, 12509, -866, 12497, -889},
+		{-6166, 1037, -6094, 1011, -6090, 1086},
+		{997, 3054, 1149, 3314, 1015, 3433, 1103, 3709, 1018, 3672, 951, 3735, 842, 3695, 752, 3410, 948, 3031},
+		{3517, 4204, 2728, 4042, 2617, 3946, 2680, 3899, 2632, 3821, 2764, 3666, 2970, 3614, 3471, 3680, 3616, 3665, 3615, 3582, 3674, 3682, 4477, 3717, 4411, 3943, 4479, 3971, 4262, 4158, 3835, 4095},
+		{2762, 4100, 2899, 4130, 2800, 4201, 2612, 4183, 2636, 4015},
+		{12195, 2500, 12150, 2530, 12011, 2356, 12075, 2197},
+		{3187, -103, 3042, -113, 3075, -336, 2934, -450, 3074, -834, 3374, -942, 3456, -1152, 3747, -1157, 4032, -1032, 3919, -849, 3920, -468, 3390, -95},
+		{3390, -95, 3504, 191, 3448, 356, 3401, 425, 3125, 378, 2958, -134},
+		{3093, 5204, 3056, 5132, 2353, 5158, 2392, 5042, 2209, 4842, 2487, 4774, 2867, 4812, 3002, 4642, 2886, 4644, 2823, 4549, 2960, 4529, 3168, 4671, 3359, 4585, 3245, 4533, 3388, 4436, 3633, 4511, 3496, 4627, 3990, 4823, 4007, 4960, 3536, 5058, 3375, 5234},
+		{-5787, -3102, -5843, -3391, -5782, -3446, -5494, -3495, -5381, -3440, -5321, -3273, -5379, -3205, -5698, -3011},
+		{-15522, 1924, -15586, 2027, -15594, 1906},
+		{-15600, 2076, -15626, 2092, -15661, 2101, -15671, 2093, -15670, 2086, -15659, 2078, -15641, 2057, -15608, 2064},
+		{-15725, 2122, -15733, 2110, -15679, 2107, -15676, 2118},
+		{-15794, 2165, -15803, 2172, -15829, 2158, -15825, 2154, -15813, 2131, -15778, 2128, -15771, 2126, -15765, 2132},
+		{-15937, 2221, -15960, 2224, -15975, 2214, -15980, 2207, -15946, 2188, -15935, 2198},
+		{-9516, 4938, -12284, 4900, -12259, 4710, -12312, 4804, -12469, 4818, -12390, 4552, -12440, 4031, -12062, 3461, -11852, 3403, -11713, 3254, -11472, 3272, -11102, 3133, -10651, 3175, -10394, 2927, -10096, 2938, -9902, 2637, -9753, 2584, -9714, 2783, -9469, 2948, -8941, 2916, -8918, 3032, -8410, 3009, -8068, 2508, -8006, 2688, -8134, 3144, -7573, 3555, -7699, 3824, -7633, 3808, -7635, 3915, -7594, 3722, -7506, 3840, -7553, 3950, -7491, 3894, -7395, 4075, -7194, 4093, -7371, 4093, -6997, 4164, -7083, 4234, -7012, 4368, -6696, 4481, -6779, 4707, -6924, 4745, -7151, 4501, -7487, 4500, -8269, 4168, -8255, 4535, -8414, 4651, -8838, 4830, -9164, 4814},
+		{-15214, 5759, -15467, 5746, -15401, 5673},
+		{-16567, 6029, -16746, 6021, -16619, 5975},
+		{-17179, 6341, -17155, 6332, -17067, 6338, -17029, 6319, -16953, 6298, -16877, 6319, -16869, 6330, -16968, 6343, -17049, 6369, -17111, 6359, -17173, 6378},
+		{-15658, 7136, -16191, 7033, -16676, 6836, -16168, 6612, -16447, 6658, -16811, 6567, -16496, 6445, -16078, 6479, -16152, 6440, -16077, 6377, -16456, 6315, -16612, 6150, -16382, 5980, -16187, 5963, -16197, 5867, -15704, 5892, -15772, 5757, -16479, 5440, -15843, 5599, -15423, 5815, -15329, 5886, -15402, 5935, -15062, 6128, -15172, 5916, -14802, 5998, -14822, 6067, -14711, 6088, -14257, 6008, -13408, 5812, -13197, 5550, -13054, 5480, -12998, 5528, -13548, 5979, -13745, 5891, -14100, 6031, -14099, 6971},
+		{6708, 3736, 6783, 3714, 6839, 3816, 6744, 3914, 6933, 4073, 7067, 4096, 7060, 4022, 7177, 4015, 7306, 4087, 7042, 4152, 7096, 4227, 6826, 4066, 6671, 4117, 6610, 4300, 6490, 4373, 6201, 4350, 5850, 4559, 5593, 4500, 5597, 4131, 5710, 4132, 5863, 4275},
+		{-7197, 1161, -7330, 915, -7244, 742, -7009, 696, -6939, 610, -6734, 610, -6781, 282, -6633, 72, -6337, 220, -6427, 250, -6482, 406, -6309, 377, -6060, 492, -6141, 596, -5976, 837, -6273, 1042, -6188, 1072, -6489, 1008, -6819, 1055, -6994, 1216, -7140, 1097, -7104, 986, -7170, 907, -7195, 1142, -7133, 1178},
+		{10704, 2181, 10673, 2279, 10533, 2335, 10217, 2246, 10320, 2077, 10444, 2076, 10482, 1989, 10390, 1927, 10756, 1520, 10749, 1234, 10581, 1157, 10625, 1096, 10433, 1049, 10516, 860, 10920, 1167, 10888, 1528, 10566, 1906, 10805, 2155},
+		{16722, -1589, 16718, -1616, 16752, -1660, 16784, -1647},
+		{16663, -1463, 16665, -1539, 16679, -1567, 16700, -1561, 16727, -1574, 16711, -1493},
+		{5278, 1735, 5200, 1900, 4912, 1862, 4700, 1695, 4338, 1758, 4260, 1521, 4348, 1264, 5217, 1560, 5311, 1665},
+		{3220, -2875, 3283, -2674, 3128, -2729, 3069, -2674, 3104, -2573, 3184, -2584, 3119, -2225, 2943, -2209, 2566, -2549, 2331, -2527, 2161, -2673, 2089, -2683, 1990, -2477, 1989, -2846, 1846, -2905, 1682, -2808, 1634, -2858, 1822, -3166, 1838, -3414, 1962, -3482, 2746, -3323},
+		{2933, -2926, 2775, -3065, 2700, -2988, 2854, -2865},
+		{3219, -893, 2873, -853, 2837, -1179, 2962, -1218, 2970, -1326, 2716, -1161, 2391, -1093, 2402, -1291, 2193, -1290, 2189, -1608, 2322, -1752, 2704, -1794, 3027, -1551, 3018, -1480, 3321, -1397, 3269, -1371, 3349, -1053},
+		{3224, -2112, 3285, -1798, 3233, -1639, 2952, -1564, 2704, -1794, 2526, -1774, 2802, -2149, 3119, -2225},
+	}},
+	{Tolerance: 1, Polygons: [][]int16{
+		{6080, 3440, 6087, 2983, 6505, 2947, 6932, 3190, 7126, 3607, 7516, 3713, 7184, 3674, 7081, 3849, 6920, 3715, 6575, 3766},
+		{1338, -586, 1223, -629, 1363, -1204, 1173, -1730, 2322, -1752, 2193, -1290, 2402, -1291, 2402, -1124, 2216, -1108, 2173, -729, 1747, -807, 1633, -588},
+		{1247, -525, 1263, -499, 1300, -478, 1262, -444, 1232, -461, 1191, -504, 1218, -579, 1244, -568},
+		{2052, 4222, 1930, 4220, 2015, 3962},
+		{5162, 2401, 5501, 2250, 5626, 2571},
+		{-6505, -5470, -6863, -5264, -6863, -5487},
+		{-6627, -2183, -7054, -3137, -6982, -3419, -7215, -4225, -7122, -4478, -7342, -4932, -7191, -5201, -6815, -5235, -6914, -5073, -6564, -4724, -6729, -4555, -6346, -4256, -6512, -4106, -6275, -4103, -6234, -3883, -5679, -3690, -5850, -3443, -5763, -3022, -5365, -2692, -5413, -2555, -5570, -2739, -5862, -2712, -5778, -2516, -6285, -2203},
+		{4375, 4074, 4651, 3877, 4497, 4125},
+		{-6061, -7963, -6629, -8026, -6016, -8100},
+		{-15948, -7905, -16025, -7869, -16125, -7838, -16311, -7822, -16371, -7860, -16371, -7860, -16307, -7887, -16303, -7893, -16244, -7928, -16113, -7963, -15921, -7950},
+		{-4666, -7783, -5416, -8063, -4333, -8003},
+		{-12241, -7332, -12262, -7366, -12262, -7366, -12162, -7401, -12023, -7409, -11929, -7383, -11872, -7348, -11992, -7366, -12121, -7350},
+		{-12656, -7325, -12728, -7346, -12728, -7346, -12591, -7374, -12462, -7383, -12403, -7387, -12556, -7348},
+		{-10043, -7185, -10233, -7189, -9620, -7252},
+		{-6873, -7051, -7117, -6904, -7207, -7119, -7495, -7207, -6878, -7217},
+		{-5760, -6386, -6725, -6688, -6854, -6972, -6737, -7248, -7489, -7387, -10368, -7262, -10012, -7487, -13521, -7430, -14620, -7538, -14610, -7648, -15133, -7740, -15837, -7689, -15533, -7906, -14642, -8034, -15684, -8110, -15286, -8204, -15359, -8369, -14311, -8504, -17906, -8414, -18000, -9000, 18000, -9000, 18000, -8471, 16940, -8383, 15979, -8095, 16177, -7916, 16700, -7875, 16357, -7624, 17121, -7170, 14549, -6692, 13746, -6695, 13507, -6531, 11983, -6727, 11360, -6588, 10618, -6693, 10283, -6556, 9578, -6739, 8799, -6621, 7386, -6987, 6987, -7226, 6795, -7185, 6967, -6923, 6889, -6793, 6143, -6795, 5453, -6582, 3865, -6978, 3387, -6850, 2709, -7046, -687, -7093, -1545, -7315, -1752, -7513, -3578, -7834, -3564, -7946, -2855, -8034, -5822, -8322, -7802, -7918, -7366, -7791, -7724, -7671, -6138, -7411, -6151, -7109, -6567, -6795, -6202, -6480},
+		{6887, -4883, 6872, -4924, 6875, -4978, 7028, -4971, 7056, -4926, 7053, -4907, 6958, -4894, 6894, -4863},
+		{14474, -4070, 14605, -4355, 14791, -4321, 14829, -4088},
+		{14360, -1340, 14252, -1067, 14022, -1771, 13550, -1500, 13649, -1186, 13182, -1127, 13258, -1211, 13062, -1254, 12962, -1497, 12569, -1423, 12086, -1968, 11415, -2176, 11334, -2612, 11569, -3161, 11503, -3420, 11802, -3506, 13133, -3150, 13599, -3489, 13781, -3290, 13683, -3526, 13821, -3438, 14064, -3802, 14632, -3904, 15000, -3743, 15289, -3164, 15314, -2607, 14639, -1896},
+		{1688, 4847, 948, 4710, 1601, 4668},
+		{4479, 3971, 4495, 3934, 4546, 3887, 4614, 3874, 4574, 3932, 4574, 3947, 4530, 3947, 4500, 3974},
+		{4669, 4183, 4497, 4125, 4561, 3990, 4888, 3832, 5039, 4026},
+		{2975, -445, 3047, -241, 2902, -284},
+		{251, 5115, 266, 5080, 312, 5078, 359, 5038, 429, 4991, 480, 4999, 567, 4953, 578, 5009, 604, 5013, 616, 5080, 561, 5104, 497, 5148, 405, 5127, 331, 5135},
+		{275, 787, 380, 1073, 249, 1223, 77, 1047, 187, 614},
+		{-296, 1040, 194, 1164, 37, 1493, -428, 1323, -540, 1037},
+		{9215, 2363, 9238, 2498, 8856, 2645, 8903, 2206, 9142, 2277, 9237, 2067},
+		{2241, 4401, 2295, 4134, 2800, 4201, 2856, 4371},
+		{-7754, 2434, -7789, 2517, -7819, 2521, -7841, 2458, -7803, 2429, -7778, 2371, -7753, 2376},
+		{-7785, 2684, -7851, 2687, -7898, 2679, -7891, 2642, -7782, 2658},
+		{-7779, 2704, -7779, 2693, -7734, 2653, -7736, 2601, -7717, 2588, -7700, 2659},
+		{1855, 4508, 1575, 4482, 1856, 4265, 1960, 4404},
+		{2353, 5347, 2353, 5158, 3056, 5132, 3269, 5335, 2818, 5617},
+		{-8915, 1702, -8893, 1589, -8830, 1850},
+		{-6269, -2225, -6179, -1963, -5785, -1997, -5824, -1630, -6016, -1626, -6050, -1378, -6540, -1157, -6534, -976, -6953, -1095, -6783, -2287},
+		{-5698, -3011, -5337, -3377, -4889, -2867, -4765, -2489, -4094, -2194, -3867, -1306, -3473, -734, -3560, -515, -3998, -287, -4458, -269, -5039, -8, -5132, 420, -5294, 212, -5903, 132, -6073, 520, -6482, 406, -6337, 220, -6555, 79, -6982, 171, -6989, -430, -7289, -527, -7399, -752, -7218, -1005, -7048, -949, -7055, -1101, -6534, -976, -6540, -1157, -6050, -1378, -6016, -1626, -5824, -1630, -5794, -2209, -5580, -2236, -5363, -2612},
+		{11466, 401, 11487, 435, 11535, 432, 11541, 496, 11545, 545, 11460, 490, 11420, 453},
+		{9126, 2804, 8884, 2710, 9203, 2684},
+		{2526, -1774, 2091, -1825, 1990, -2185, 2089, -2683, 2566, -2549, 2943, -2209},
+		{1478, 641, 1601, 227, 1947, 503, 2241, 403, 2737, 523, 2286, 1114},
+		{-6401, 4704, -6439, 4673, -6414, 4639, -6287, 4597, -6250, 4603, -6201, 4644, -6294, 4642, -6366, 4655},
+		{-6184, 4929, -6286, 4971, -6417, 4996, -6452, 4987, -6359, 4940, -6229, 4909, -6181, 4911},
+		{-12392, 4906, -12492, 4948, -12542, 4995, -12576, 5030, -12670, 5040, -12731, 5055, -12836, 5077, -12844, 5054, -12806, 4999, -12703, 4981, -12685, 4953, -12595, 4918, -12566, 4883, -12401, 4837, -12351, 4851},
+		{-5560, 5132, -5927, 4760, -5307, 4666, -5348, 4925, -5680, 4981},
+		{-13324, 5385, -13118, 5218, -13175, 5412},
+		{-7952, 6236, -7993, 6239, -8032, 6209, -8036, 6202, -8010, 6172, -7966, 6163, -7927, 6216},
+		{-8188, 6290, -8325, 6291, -8399, 6245, -8377, 6218, -8307, 6216, -8190, 6271},
+		{-8588, 6574, -8722, 6354, -8010, 6373},
+		{-7522, 6744, -7510, 6758, -7511, 6801, -7590, 6829, -7681, 6815, -7724, 6759, -7699, 6710, -7587, 6715},
+		{-9626, 6949, -9656, 6968, -9716, 6986, -9822, 7014, -9892, 6971, -9980, 6940, -9843, 6895, -9762, 6906, -9627, 6876, -9565, 6911},
+		{-9241, 6970, -9152, 7019, -9389, 7176, -9639, 7119, -9423, 6907, -9613, 6729, -9767, 6858, -10145, 6765, -10615, 6880, -11350, 6769, -12814, 7048, -13650, 6890, -14099, 6971, -14100, 6031, -13745, 5891, -13548, 5979, -13001, 5592, -13051, 5429, -12744, 5083, -12297, 4900, -8838, 4830, -8255, 4535, -8269, 4168, -7151, 4501, -6924, 4745, -6714, 4514, -6443, 4529, -6616, 4447, -6536, 4355, -5980, 4592, -6052, 4701, -6447, 4624, -6506, 4923, -7110, 4682, -6640, 5023, -6003, 5024, -5568, 5215, -5733, 5463, -6180, 5634, -6458, 6034, -6765, 5821, -6959, 6106, -7384, 6244, -7811, 6232, -7734, 5985, -7852, 5880, -7654, 5653, -7983, 5467, -7860, 5256, -7991, 5121, -8227, 5515, -9230, 5709, -9468, 5895, -9424, 6090, -8577, 6656, -8139, 6711, -8122, 6867, -8552, 6988, -8735, 6720, -8922, 6926},
+		{-11519, 7331, -11940, 7156, -11242, 7037, -11734, 6996, -11331, 6854, -10109, 6958, -10652, 7308, -10840, 7309, -10819, 7165},
+		{-10526, 7364, -10660, 7360, -10694, 7346, -10538, 7276, -10450, 7342},
+		{-7806, 7365, -8088, 7333, -7625, 7283},
+		{-8583, 7380, -8944, 7313, -8989, 7122, -7896, 7017, -7293, 6773, -7396, 6545, -7790, 6531, -7771, 6423, -7483, 6468, -6617, 6193, -6878, 6375, -6467, 6339, -6802, 6626, -6392, 6500, -6185, 6686, -6881, 6872, -6697, 6919, -7224, 7156, -8075, 7206, -8232, 7375, -8577, 7253},
+		{-10154, 7336, -10044, 7271, -10250, 7251, -9672, 7166, -9738, 7376},
+		{-9200, 7297, -9051, 7386, -9602, 7344, -9541, 7206},
+		{-12046, 7182, -11551, 7348, -12492, 7429, -12394, 7368, -12593, 7187},
+		{-9398, 7530, -9485, 7565, -9629, 7538, -9682, 7493, -9561, 7467, -9416, 7459, -9361, 7498},
+		{-9858, 7659, -10250, 7556, -9816, 7500},
+		{-10855, 7668, -11050, 7643, -10907, 7547, -11540, 7648, -11771, 7522, -10570, 7548},
+		{-9675, 7716, -9242, 7484, -7983, 7492},
+		{-11757, 7750, -12285, 7612, -11634, 7688},
+		{-9372, 7763, -9442, 7782, -9644, 7783, -9617, 7756, -9430, 7749, -9384, 7752},
+		{-10985, 7800, -11126, 7815, -11272, 7805, -11353, 7773, -11205, 7741, -11019, 7770},
+		{-11096, 7880, -11254, 7841, -10966, 7860},
+		{-9556, 7842, -9675, 7877, -9734, 7883, -9863, 7887, -9855, 7846, -9812, 7808, -9731, 7785, -9583, 7806},
+		{-10083, 7880, -10549, 7930, -9967, 7791},
+		{-8781, 8032, -9241, 8126, -9671, 8016, -9288, 7834, -8581, 7934},
+		{-7067, 8317, -9159, 8189, -8185, 8046, -8693, 8025, -8509, 7935, -8796, 7837, -8498, 7754, -8826, 7790, -8949, 7647, -8056, 7618, -7539, 7853, -7691, 7932, -6185, 8263},
+		{852, 4783, 602, 4627, 1036, 4648},
+		{-6935, -5252, -7111, -5407, -7466, -5284, -6729, -5530},
+		{-6876, -2037, -6959, -1758, -7433, -4322, -7272, -4238, -7564, -4665, -7413, -4694, -7561, -4867, -7495, -5226, -7143, -5386, -6857, -5230, -7191, -5201, -7342, -4932, -7122, -4478, -7215, -4225, -6982, -3419, -7054, -3137, -6699, -2299},
+		{11057, 1926, 10912, 1982, 10866, 1851},
+		{12729, 5074, 12507, 5316, 12100, 5325, 11929, 5014, 11549, 4814, 11966, 4669, 11187, 4510, 11041, 4287, 10496, 4160, 9635, 4273, 9095, 4529, 9028, 4769, 8736, 4921, 7997, 4492, 8012, 4212, 7368, 3943, 7891, 3432, 7972, 3088, 8881, 2730, 9612, 2945, 9868, 2751, 9760, 2390, 9924, 2212, 10180, 2117, 10165, 2232, 10533, 2335, 11044, 2034, 11866, 2455, 12168, 2823, 12191, 3169, 11915, 3491, 12236, 3745, 11753, 3874, 12164, 4095, 12105, 3890, 13064, 4240, 13103, 4497, 13310, 4514, 13503, 4848, 13099, 4779},
+		{-281, 539, -283, 964, -823, 1013, -771, 436},
+		{1434, 223, 1594, 173, 1448, 473, 1450, 1286, 1175, 698, 923, 644, 849, 450, 965, 228},
+		{2995, 417, 1947, 503, 1601, -354, 1232, -610, 1633, -588, 1747, -807, 2173, -729, 2216, -1108, 2970, -1326, 2837, -1179, 2873, -853, 3074, -834, 2902, -284, 3117, 220},
+		{1326, -488, 1601, -354, 1845, 350, 1594, 173, 1308, 227, 1430, -200, 1109, -398},
+		{-7511, -6, -7307, -231, -7005, -273, -6989, -430, -6982, 171, -6688, 125, -6734, 610, -7196, 699, -7330, 915, -7140, 1238, -7491, 1108, -7775, 771, -7713, 385, -7899, 169},
+		{-8291, 842, -8366, 1094, -8594, 1090},
+		{-8251, 2308, -8497, 2190, -8180, 2264, -7776, 1986, -7418, 2028},
+		{3292, 3509, 3319, 3517, 3338, 3516, 3346, 3510, 3348, 3500, 3353, 3504, 3368, 3502, 3387, 3509, 3397, 3506, 3390, 3525, 3458, 3567, 3367, 3537, 3295, 3539, 3280, 3515, 3273, 3514},
+		{3387, 3509, 3368, 3502, 3353, 3504, 3348, 3500, 3346, 3510, 3338, 3516, 3319, 3517, 3292, 3509, 3273, 3514, 3226, 3510, 3249, 3470, 3298, 3457, 3400, 3498, 3397, 3506},
+		{1710, 4882, 1885, 4950, 1502, 5111, 1224, 5027, 1434, 4856},
+		{928, 5483, 691, 5348, 619, 4946, 810, 4902, 747, 4762, 1293, 4747, 1224, 5027, 1502, 5111, 1412, 5376},
+		{4278, 1246, 4235, 1254, 4200, 1210, 4166, 1163, 4174, 1136, 4176, 1105, 4231, 1103, 4255, 1111, 4278, 1093, 4315, 1146, 4272, 1174, 4329, 1197, 4332, 1239, 4308, 1270},
+		{1237, 5611, 1104, 5536, 1209, 5480},
+		{1037, 5661, 1058, 5773, 854, 5711, 812, 5552, 992, 5498},
+		{-7162, 1917, -7140, 1760, -6832, 1861},
+		{1156, 2410, 932, 2609, 981, 2942, 752, 3410, 842, 3695, -121, 3571, -131, 3226, -867, 2884, -868, 2740, 316, 1906},
+		{-8018, -382, -7864, -455, -7537, -15, -8009, 77},
+		{3427, 3122, 2516, 3157, 2500, 2200, 3687, 2200, 3232, 2976, 3415, 2782},
+		{4278, 1246, 3841, 1800, 3685, 1696, 3643, 1442, 4003, 1452},
+		{-867, 4213, -639, 4138, -754, 3743, -538, 3595, -68, 3764, 81, 4101, 304, 4189, -798, 4375, -939, 4303},
+		{2516, 5797, 2729, 5747, 2813, 5930, 2334, 5919},
+		{3759, 1421, 3643, 1442, 3295, 778, 3616, 445, 4186, 392, 4779, 800, 4368, 918, 4090, 1412},
+		{2902, 6977, 2065, 6911, 2540, 6511, 2154, 6319, 2132, 6072, 2807, 6050, 3114, 6236},
+		{17813, -1750, 17767, -1738, 17729, -1772, 17738, -1816, 17793, -1829, 17855, -1815, 17872, -1763, 17837, -1734},
+		{18000, -1656, 18000, -1607, 17941, -1638, 17910, -1643, 17860, -1664, 17873, -1701, 17936, -1680},
+		{-17979, -1602, -18000, -1607, -18000, -1656, -17992, -1650},
+		{-6070, -5230, -5985, -5185, -5940, -5220, -5805, -5190, -5775, -5155, -5855, -5110, -5915, -5150, -6000, -5125, -6120, -5185},
+		{-5225, 324, -5182, 457, -5396, 576, -5452, 231},
+		{939, 4301, 875, 4263, 854, 4226, 878, 4158, 923, 4138, 956, 4215},
+		{312, 5078, -459, 4868, -119, 4601, -150, 4303, 744, 4369, 604, 4673, 810, 4902},
+		{1186, -343, 1430, -200, 1295, 232, 949, 101, 883, -78},
+		{-673, 5517, -757, 5406, -620, 5387},
+		{-421, 5855, -615, 5679, -295, 5398, -527, 5199, -341, 5143, -578, 5016, 145, 5129, 168, 5274, -312, 5597, -196, 5768},
+		{4262, 4158, 4664, 4118, 3996, 4343},
+		{84, 628, 2, 1102, -294, 1096, -286, 499},
+		{-828, 769, -913, 1231, -1370, 1259, -1513, 1104, -1325, 890, -1112, 1005},
+		{-1593, 1313, -1569, 1327, -1551, 1328, -1514, 1351, -1471, 1330, -1428, 1328, -1384, 1351, -1405, 1379, -1438, 1363, -1469, 1363, -1508, 1388, -1540, 1386, -1562, 1362, -1671, 1359, -1684, 1315},
+		{-1469, 1153, -1370, 1259, -1668, 1238},
+		{983, 107, 1128, 226, 965, 228},
+		{2351, 3528, 2474, 3508, 2472, 3492, 2616, 3500, 2629, 3530, 2575, 3518, 2577, 3535, 2503, 3542, 2425, 3537, 2370, 3571},
+		{2612, 4183, 2102, 4084, 2015, 3962, 2167, 3684, 2404, 3766, 2281, 4048},
+		{-4690, 8220, -4452, 8166, -6265, 8177, -6802, 8012, -6571, 7939, -7330, 7804, -6676, 7738, -7140, 7701, -6850, 7606, -5859, 7552, -5472, 7259, -5583, 7165, -5139, 7057, -5436, 7082, -5468, 6961, -5087, 6993, -5397, 6719, -5163, 6363, -4826, 6086, -4338, 6010, -3981, 6546, -2235, 7013, -2636, 7023, -2554, 7143, -2175, 7066, -2479, 7233, -1937, 7430, -2168, 7663, -1847, 7699, -1970, 7875, -1773, 8013, -2005, 8018, -1221, 8129, -3190, 8220, -2085, 8273, -2710, 8352},
+		{-9006, 1388, -8823, 1573, -8914, 1781, -9100, 1782, -9046, 1607, -9223, 1454},
+		{-6055, 778, -6141, 596, -5965, 179, -5654, 190, -5804, 406, -5715, 597},
+		{-8701, 1303, -8315, 1500, -8915, 1507},
+		{1846, 4576, 1366, 4514, 1845, 4248, 1575, 4482, 1939, 4524},
+		{-7342, 1964, -7233, 1867, -7446, 1834, -7171, 1804, -7171, 1971},
+		{1637, 4684, 1846, 4576, 2271, 4788, 1698, 4812},
+		{12078, -997, 12043, -967, 11990, -936, 11897, -956, 12030, -1026, 12072, -1024},
+		{12509, -939, 12507, -909, 12497, -889, 12398, -929, 12355, -990, 12346, -1024, 12358, -1036, 12444, -1014},
+		{11763, -845, 11674, -903, 11913, -871},
+		{12201, -846, 12134, -854, 12072, -824, 11992, -844, 11992, -881, 12125, -893, 12276, -865, 12290, -809},
+		{10849, -642, 10537, -685, 11571, -837},
+		{13473, -574, 13450, -545, 13429, -578, 13411, -614, 13421, -690, 13472, -621},
+		{12700, -313, 12599, -318, 12618, -361, 12687, -379, 12725, -346},
+		{12937, -280, 12790, -339, 13083, -386},
+		{13399, -78, 13052, -94, 13370, -221, 13199, -282, 13298, -411, 13793, -539, 13761, -841, 14103, -912, 14100, -260, 13744, -170, 13546, -337},
+		{12507, 164, 12004, 57, 11937, -538, 12097, -263, 12316, -534, 12151, -190, 12334, -62, 12094, -141, 12018, 24},
+		{12859, 154, 12740, 101, 12810, -90},
+		{11805, 229, 11788, 414, 11587, 431, 11462, 143, 11051, 77, 10966, 201, 11022, -293, 11615, -401, 11900, 90},
+		{10586, -431, 10250, 140, 9529, 548, 10258, -422, 10471, -587},
+		{7687, 3465, 7375, 3432, 7526, 3227, 6951, 2694, 7104, 2436, 6818, 2369, 7047, 2088, 7263, 2136, 7754, 797, 7986, 1036, 8032, 1590, 8889, 2169, 8856, 2645, 9238, 2498, 9116, 2350, 9267, 2204, 9740, 2788, 9612, 2945, 9203, 2684, 8873, 2809, 8806, 2641, 8330, 2736, 8009, 2879, 8111, 3018, 7874, 3152, 7891, 3432},
+		{-695, 5407, -757, 5513, -969, 5388, -998, 5182, -679, 5226},
+		{5383, 3697, 4920, 3758, 4806, 3958, 4411, 3943, 4608, 3568, 4542, 3397, 4857, 2993, 5012, 3015, 5349, 2681, 6150, 2508, 6332, 2676, 6087, 2983, 6112, 3649, 5662, 3812},
+		{4477, 3717, 4235, 3723, 3879, 3338, 4657, 2910, 4857, 2993},
+		{-1617, 6653, -2433, 6561, -2223, 6538, -2396, 6489, -2178, 6440, -2276, 6396, -1866, 6350, -1361, 6513},
+		{3570, 3272, 3427, 3122, 3492, 2950},
+		{1476, 3814, 1243, 3761, 1510, 3662},
+		{871, 4090, 881, 3891, 981, 4050},
+		{1215, 4712, 684, 4599, 701, 4425, 1020, 4392, 1610, 3799, 1687, 4044, 1848, 4017, 1259, 4409, 1381, 4651},
+		{-7780, 1852, -7822, 1845, -7834, 1823, -7777, 1786, -7721, 1770, -7690, 1787, -7620, 1789, -7637, 1816, -7690, 1840, -7757, 1849},
+		{3555, 3178, 3492, 2950, 3607, 2920, 3800, 3051, 3700, 3151, 3920, 3216, 3879, 3338},
+		{13390, 3436, 13236, 3299, 13420, 3320},
+		{14096, 3817, 14137, 4138, 13568, 3553, 12941, 3330, 13020, 3142, 13200, 3315, 13099, 3389, 13508, 3460, 13579, 3346, 14025, 3514},
+		{14314, 4451, 14197, 4555, 13996, 4157, 14554, 4326},
+		{7119, 4270, 8026, 4235, 7997, 4492, 8736, 4921, 8004, 5086, 7689, 5449, 7343, 5349, 6907, 5539, 6144, 5401, 5997, 5196, 6134, 5080, 5077, 5169, 4647, 4839, 4910, 4640, 5304, 4685, 5304, 4526, 5031, 4461, 5250, 4178, 5597, 4131, 5593, 4500, 5850, 4559, 6201, 4350, 6490, 4373, 6826, 4066},
+		{4098, 278, 4186, 392, 3812, 360, 3530, 551, 3390, -95, 3920, -468, 4159, -168},
+		{7126, 4217, 7042, 4152, 7306, 4087, 6946, 3953, 7368, 3943, 8026, 4235, 7421, 4330},
+		{10433, 1049, 10749, 1234, 10738, 1420, 10299, 1423, 10258, 1219},
+		{12821, 3837, 12617, 3775, 12649, 3439, 12947, 3563},
+		{2135, 4221, 2158, 4225, 2154, 4232, 2166, 4244, 2178, 4268, 2163, 4268, 2144, 4286, 2127, 4291, 2114, 4307, 2096, 4313, 2081, 4327, 2064, 4322, 2050, 4288, 2026, 4281, 2007, 4259, 2028, 4232, 2052, 4222, 2059, 4186, 2072, 4185, 2076, 4205},
+		{4730, 3006, 4657, 2910, 4842, 2855},
+		{10604, 1388, 10731, 1591, 10390, 1927, 10444, 2076, 10165, 2232, 10012, 2042, 10106, 1751, 10396, 1824},
+		{3607, 3382, 3661, 3420, 3645, 3459, 3600, 3464, 3598, 3461, 3548, 3391, 3513, 3309, 3546, 3309, 3555, 3326, 3582, 3328},
+		{-764, 519, -976, 854, -1115, 740, -900, 483},
+		{1586, 2341, 2500, 2000, 2492, 3190, 2085, 3271, 1909, 3027, 1149, 3314, 948, 3031, 932, 2609, 1414, 2249},
+		{8130, 856, 8015, 982, 8035, 597, 8164, 648},
+		{2854, -2865, 2807, -2885, 2753, -2924, 2700, -2988, 2775, -3065, 2811, -3055, 2829, -3023, 2885, -3007, 2902, -2974, 2933, -2926, 2898, -2896},
+		{2324, 5422, 2649, 5562, 2106, 5603},
+		{578, 5009, 567, 4953, 590, 4944, 619, 4946, 624, 4990, 604, 5013},
+		{2220, 5634, 2818, 5617, 2516, 5797, 2158, 5741, 2106, 5603},
+		{-593, 3576, -1702, 2142, -1475, 2150, -1139, 2688, -879, 2712, -867, 2884, -131, 3226, -217, 3517},
+		{2692, 4812, 2823, 4549, 3002, 4642},
+		{4919, -1204, 4396, -1741, 4335, -2278, 4541, -2560, 4710, -2494, 5038, -1571},
+		{-9753, 2584, -10096, 2938, -10394, 2927, -10651, 3175, -11713, 3254, -11416, 2857, -11506, 2772, -11003, 2282, -11478, 3180, -10603, 2277, -10499, 1932, -9223, 1454, -9046, 1607, -9100, 1782, -8784, 1826, -8681, 2133, -9028, 2100, -9077, 1928, -9443, 1814, -9770, 2190},
+		{2046, 4152, 2061, 4109, 2102, 4084, 2167, 4093, 2206, 4115, 2260, 4113, 2276, 4130, 2295, 4134, 2288, 4200, 2238, 4232, 2192, 4230, 2158, 4225, 2135, 4221, 2076, 4205, 2072, 4185, 2059, 4186},
+		{-1212, 1399, -1146, 1208, -913, 1231, -803, 1021, -605, 1010, -401, 1347, 364, 1557, 427, 1916, -645, 2496, -554, 1550, -1167, 1539},
+		{10012, 2042, 10115, 2185, 9760, 2390, 9868, 2751, 9733, 2826, 9230, 2148, 9419, 1604, 9716, 1693, 9855, 993, 9959, 1189, 9738, 1845},
+		{2007, 4259, 2026, 4281, 2034, 4290, 1996, 4311, 1963, 4321, 1948, 4335, 1922, 4352, 1903, 4343, 1871, 4320, 1856, 4265, 1845, 4248, 1888, 4228, 1916, 4196, 1937, 4188, 1930, 4220, 1974, 4269, 1980, 4250},
+		{8801, 4860, 9028, 4769, 9095, 4529, 9635, 4273, 10496, 4160, 11977, 4705, 11574, 4773, 11668, 4989, 10848, 4928, 9886, 5205, 9726, 4973, 9223, 5080},
+		{3428, -1228, 3569, -1461, 3503, -1680, 3321, -1397, 3018, -1480, 3285, -1671, 3119, -2225, 3207, -2673, 3546, -2412, 3479, -1978, 4078, -1469, 4032, -1032},
+		{-1183, 1480, -532, 1620, -645, 2496, -492, 2497, -868, 2740, -869, 2588, -1197, 2593, -1293, 2133, -1706, 2100, -1646, 1614},
+		{3428, -1016, 3276, -923, 3269, -1371, 3503, -1680},
+		{10026, 664, 10139, 276, 10423, 129, 10296, 552},
+		{11844, 497, 11673, 692, 11535, 432, 10983, 134, 11462, 143, 11587, 431},
+		{1682, -2808, 1989, -2846, 2091, -1825, 2508, -1758, 1173, -1730, 1521, -2709},
+		{16546, -2080, 16403, -2011, 16712, -2216},
+		{249, 1223, 361, 1166, 544, 1387, 1418, 1248, 1354, 1437, 1590, 2039, 1485, 2286, 1200, 2347, 427, 1916, 364, 1557, 37, 1493},
+		{876, 548, 1175, 698, 1458, 1209, 1308, 1360, 437, 1375, 269, 626, 590, 426},
+		{-8556, 1122, -8366, 1094, -8315, 1500, -8767, 1291},
+		{471, 5309, 331, 5135, 616, 5080, 691, 5348},
+		{2637, 7099, 1918, 6982, 591, 6261, 567, 5859, 1103, 5886, 1263, 6129, 1193, 6313, 1677, 6801, 3129, 7045},
+		{2328, 7808, 2073, 7768, 2472, 7785},
+		{1699, 8005, 1044, 7965, 1591, 7677, 2154, 7896},
+		{2292, 8066, 1737, 8032, 2741, 8006},
+		{8695, 2797, 8153, 3042, 8009, 2879, 8806, 2641},
+		{17280, -4049, 16668, -4622, 16933, -4664, 17308, -4385, 17425, -4135},
+		{17433, -3527, 17264, -3453, 17524, -4169, 17852, -3770, 17534, -3721},
+		{5928, 2143, 5981, 2253, 5589, 2492, 5500, 2000, 5200, 1900, 5311, 1665},
+		{5649, 2631, 5636, 2640, 5607, 2606, 5626, 2571, 5639, 2590},
+		{7458, 3702, 7126, 3607, 6932, 3190, 6635, 2989, 6087, 2983, 6332, 2676, 6150, 2508, 7104, 2436, 6951, 2694, 7526, 3227, 7375, 3432, 7784, 3549},
+		{-7775, 771, -7850, 942, -8293, 948, -8089, 722, -7956, 893},
+		{-6896, -1650, -6953, -1095, -7323, -946, -7399, -752, -7289, -527, -6989, -430, -7005, -273, -7537, -15, -7921, -496, -8110, -404, -8125, -614, -7601, -1465, -7037, -1835},
+		{12631, 878, 12541, 976, 12192, 719, 12361, 783, 12540, 558},
+		{12408, 1123, 12334, 1027, 12350, 1094, 12295, 1088, 12284, 1026, 12259, 998, 12238, 971, 12300, 902, 12331, 932, 12362, 995, 12398, 1028},
+		{11903, 1000, 11951, 1137, 11717, 837},
+		{12204, 1142, 12197, 1091, 12200, 1044, 12264, 1074, 12310, 1117, 12312, 1158, 12248, 1158, 12188, 1189},
+		{12523, 1254, 12427, 1256, 12488, 1179, 12489, 1142, 12430, 1150, 12446, 1089, 12476, 1084, 12480, 1013, 12528, 1036, 12503, 1098, 12501, 1131, 12578, 1105, 12550, 1216},
+		{12118, 1343, 12032, 1347, 12083, 1270, 12126, 1221, 12153, 1307},
+		{12072, 1851, 12063, 1386, 12408, 1254, 12173, 1433, 12225, 1848},
+		{15602, -654, 15555, -620, 15506, -557, 15476, -534, 15465, -504, 15451, -514, 15473, -590, 15517, -654, 15560, -692, 15588, -682},
+		{15232, -487, 15234, -431, 15214, -415, 15154, -417, 15165, -476, 15109, -511, 15081, -546, 15024, -553, 15014, -500, 15000, -503, 14985, -551, 14930, -558, 14840, -544, 14832, -575, 14889, -603, 14971, -632, 15024, -632, 15075, -608, 15130, -584, 15146, -556, 15198, -548},
+		{14697, -672, 14100, -260, 14103, -912, 14474, -763, 15069, -1058},
+		{15302, -398, 15264, -366, 15224, -324, 15182, -300, 15148, -278, 15094, -250, 15066, -274, 15138, -304, 15195, -346, 15241, -379, 15264, -418, 15283, -477, 15314, -450},
+		{1549, 5078, 2278, 4903, 2380, 5309, 2273, 5433, 1636, 5451, 1412, 5376},
+		{-6710, 1852, -6724, 1837, -6718, 1795, -6660, 1798, -6585, 1798, -6559, 1823, -6577, 1843, -6628, 1851},
+		{12999, 4299, 12427, 3993, 12471, 3811, 12821, 3837, 12753, 3976, 13078, 4222},
+		{-899, 4154, -953, 3874, -786, 3684, -639, 4138},
+		{-6085, -2388, -5778, -2516, -5862, -2712, -5479, -2662, -5429, -2402, -5794, -2209, -5912, -1936, -6227, -2051, -6269, -2225},
+		{3518, 3253, 3497, 3187, 3523, 3175, 3497, 3162, 3493, 3135, 3540, 3149, 3555, 3178, 3555, 3239},
+		{5111, 2456, 5139, 2463, 5161, 2522, 5159, 2580, 5129, 2611, 5101, 2601, 5074, 2548, 5081, 2475},
+		{2210, 4767, 2022, 4613, 2294, 4382, 2856, 4371, 2963, 4504, 2692, 4812},
+		{14324, 5176, 14221, 5423, 14209, 4597, 14351, 4614, 14317, 4931, 14465, 4898},
+		{2265, 5458, 2276, 5486, 2232, 5502, 2127, 5519, 1989, 5487, 1966, 5443, 2089, 5431, 2273, 5433},
+		{-17493, 6721, -18000, 6896, -18000, 6498, -17869, 6611, -17296, 6425, -16990, 6598},
+		{18000, 7152, 17873, 7110, 17890, 7078, 18000, 7083},
+		{-17766, 7113, -17758, 7127, -17902, 7156, -17987, 7156, -18000, 7152, -18000, 7083, -17869, 7089},
+		{14348, 7348, 14206, 7386, 14081, 7377, 13986, 7337, 14004, 7332, 14209, 7321, 14360, 7321},
+		{14822, 7535, 14612, 7517, 15073, 7508},
+		{14147, 7609, 13697, 7526, 14509, 7556},
+		{5562, 7154, 5848, 7431, 6885, 7654, 5563, 7508, 5146, 7201, 5754, 7072},
+		{10471, 7713, 10607, 7737, 8051, 7365, 8150, 7175, 7636, 7115, 7466, 7283, 7310, 7145, 7505, 6776, 7242, 6617, 7128, 6632, 7367, 6841, 7185, 7141, 7280, 7222, 6994, 7304, 6669, 7103, 6851, 6809, 6055, 6985, 5994, 6828, 5372, 6886, 4635, 6667, 4625, 6825, 4345, 6857, 4395, 6607, 3718, 6514, 3701, 6385, 3318, 6663, 3838, 6600, 4106, 6746, 3213, 6991, 2859, 6906, 3004, 6355, 3152, 6287, 2807, 6050, 2912, 6003, 2729, 5747, 3087, 5555, 3269, 5335, 3179, 5210, 4007, 4960, 3668, 4524, 4782, 4115, 4668, 4461, 4910, 4640, 4647, 4839, 4755, 5045, 5077, 5169, 6134, 5080, 5997, 5196, 6144, 5401, 6907, 5539, 7343, 5349, 7689, 5449, 8004, 5086, 8736, 4921, 9223, 5080, 9726, 4973, 9886, 5205, 10848, 4928, 11788, 4951, 12100, 5325, 12507, 5316, 13099, 4779, 13503, 4848, 13310, 4514, 13103, 4497, 13078, 4222, 13487, 4340, 14006, 4845, 14135, 5309, 13513, 5473, 14220, 5904, 15504, 5914, 15422, 5976, 15672, 6143, 16012, 6054, 16447, 6255, 15591, 5677, 15679, 5101, 16212, 5486, 16319, 5762, 16202, 5824, 16354, 5987, 17033, 5988, 17923, 6230, 17741, 6461, 18000, 6498, 18000, 6896, 17572, 6988, 17045, 7010, 16958, 6869, 14047, 7285, 13915, 7242, 13987, 7149, 13129, 7079, 12698, 7357, 10940, 7418, 11413, 7585},
+		{10537, 7871, 10209, 7935, 9944, 7792},
+		{5152, 8070, 5004, 8092, 4910, 8075, 4852, 8051, 4832, 8078, 4680, 8077, 4485, 8059, 4707, 8056, 4650, 8025, 4759, 8001, 4875, 8018, 4889, 8034, 4979, 8042, 5114, 8055},
+		{10019, 7978, 9594, 8125, 9118, 8034, 9776, 7876},
+		{2982, -144, 2902, -284, 3076, -229},
+		{-941, 2709, -1139, 2688, -1475, 2150, -1706, 2100, -1293, 2133, -1197, 2593, -869, 2588},
+		{4322, 1667, 5500, 2000, 5567, 2200, 5200, 2300, 4842, 2855, 4040, 3189, 3700, 3151, 3800, 3051, 3463, 2806},
+		{3396, 958, 3841, 1800, 3687, 2200, 2500, 2200, 2194, 1259, 2346, 895, 2579, 1041, 3135, 981, 3274, 1225},
+		{3382, 948, 3274, 1225, 3135, 981, 2579, 1041, 2389, 862, 2798, 441, 3188, 356, 3530, 551, 3295, 778},
+		{-1562, 1362, -1384, 1351, -1668, 1238, -1151, 1244, -1458, 1660, -1763, 1473},
+		{16192, -1045, 16132, -1020, 16170, -1082, 16240, -1083, 16212, -1048},
+		{16069, -961, 16036, -940, 15970, -924, 15964, -964, 15985, -979, 16046, -990, 16085, -987},
+		{16128, -912, 16092, -832, 16058, -832, 16079, -892, 16153, -978, 16168, -960},
+		{15964, -802, 15882, -756, 15836, -732, 15821, -742, 15859, -775, 15913, -811, 15992, -854, 15988, -834},
+		{15714, -702, 15654, -660, 15649, -677, 15690, -718, 15734, -740, 15754, -735},
+		{-1120, 711, -1023, 841, -1192, 1005, -1312, 816},
+		{-8772, 1379, -8786, 1389, -8807, 1396, -8850, 1385, -8854, 1398, -8884, 1414, -8906, 1434, -8935, 1442, -8959, 1436, -8953, 1424, -8972, 1413, -9006, 1388, -9010, 1374, -8981, 1352, -8926, 1346, -8884, 1326, -8848, 1316, -8790, 1315, -8779, 1338},
+		{4894, 997, 4895, 1141, 4256, 1057, 4695, 800},
+		{4927, 1143, 4496, 500, 4098, 278, 4159, -168, 4859, 534, 5111, 1202},
+		{2076, 4573, 1883, 4591, 1922, 4352, 2238, 4232, 2271, 4458},
+		{-5731, 507, -5654, 190, -5452, 231, -5396, 576},
+		{1855, 4950, 1698, 4812, 2256, 4909},
+		{1370, 4602, 1394, 4559, 1372, 4550, 1441, 4547, 1460, 4563, 1494, 4547, 1533, 4545, 1532, 4573, 1567, 4583, 1577, 4624, 1656, 4650, 1637, 4684, 1620, 4685, 1601, 4668, 1514, 4666, 1463, 4643, 1381, 4651},
+		{2390, 6601, 2354, 6794, 2003, 6907, 1258, 6407, 1103, 5886, 1294, 5536, 1588, 5610, 1879, 6008, 1712, 6134, 1785, 6275},
+		{3199, -2629, 3184, -2584, 3133, -2566, 3104, -2573, 3095, -2602, 3068, -2640, 3069, -2674, 3128, -2729, 3187, -2718, 3207, -2673},
+		{4101, 3442, 4235, 3723, 3674, 3682, 3570, 3272},
+		{1489, 1222, 1547, 998, 1395, 955, 1528, 742, 2100, 948, 2286, 1114, 2194, 1259, 2389, 1561, 2384, 1958, 1485, 2286, 1590, 2039, 1354, 1437},
+		{162, 683, 2, 1102, 106, 593},
+		{10235, 1339, 10554, 1472, 10472, 1743, 10106, 1751, 10012, 2042, 9825, 1971, 9738, 1845, 9959, 1189, 9815, 835, 10115, 569, 10214, 622, 9915, 996, 10010, 1341, 10258, 1219},
+		{7060, 4022, 6770, 3958, 6783, 3714, 7081, 3849, 7184, 3674, 7498, 3742, 7368, 3943, 6946, 3953},
+		{6223, 3527, 6655, 3797, 5863, 4275, 5710, 4132, 5250, 4178, 5474, 4095, 5269, 4003, 5392, 3720, 5733, 3803},
+		{12507, -909, 12509, -939, 12593, -911, 12697, -867, 12734, -840, 12696, -827, 12664, -840, 12595, -843, 12509, -866, 12497, -889},
+		{-6166, 1037, -6195, 1009, -6177, 1000, -6094, 1011, -6090, 1086, -6111, 1089, -6168, 1076},
+		{997, 3054, 1149, 3314, 1015, 3433, 1103, 3709, 951, 3735, 752, 3410},
+		{3517, 4204, 2728, 4042, 2632, 3821, 2970, 3614, 4477, 3717, 4479, 3971, 4262, 4158},
+		{2762, 4100, 2899, 4130, 2612, 4183, 2636, 4015},
+		{12195, 2500, 12011, 2356, 12075, 2197},
+		{3187, -103, 3042, -113, 2934, -450, 3074, -834, 3374, -942, 3456, -1152, 4032, -1032, 3920, -468},
+		{3390, -95, 3448, 356, 3125, 378, 2958, -134},
+		{3093, 5204, 2353, 5158, 2209, 4842, 2867, 4812, 3002, 4642, 2823, 4549, 3168, 4671, 3388, 4436, 3633, 4511, 3496, 4627, 3990, 4823, 4007, 4960, 3375, 5234},
+		{-5787, -3102, -5782, -3446, -5381, -3440, -5379, -3205, -5698, -3011},
+		{-15522, 1924, -15483, 1945, -15481, 1951, -15506, 1986, -15522, 1999, -15540, 2008, -15579, 2025, -15586, 2027, -15592, 2017, -15585, 1998, -15602, 1981, -15607, 1970, -15591, 1934, -15594, 1906, -15569, 1892, -15554, 1908},
+		{-15600, 2076, -15626, 2092, -15661, 2101, -15671, 2093, -15670, 2086, -15659, 2078, -15641, 2057, -15608, 2064},
+		{-15725, 2122, -15733, 2110, -15679, 2107, -15676, 2118},
+		{-15794, 2165, -15803, 2172, -15829, 2158, -15825, 2154, -15813, 2131, -15778, 2128, -15771, 2126, -15765, 2132},
+		{-15937, 2221, -15960, 2224, -15975, 2214, -15980, 2207, -15946, 2188, -15935, 2198},
+		{-9516, 4938, -12284, 4900, -12259, 4710, -12469, 4818, -12440, 4031, -12062, 3461, -11713, 3254, -10651, 3175, -10394, 2927, -10096, 2938, -9753, 2584, -9469, 2948, -8410, 3009, -8068, 2508, -8134, 3144, -7573, 3555, -7699, 3824, -7635, 3915, -7594, 3722, -7553, 3950, -6997, 4164, -7012, 4368, -6696, 4481, -6924, 4745, -7151, 4501, -8269, 4168, -8255, 4535, -8414, 4651},
+		{-15214, 5759, -15256, 5790, -15323, 5797, -15376, 5782, -15467, 5746, -15452, 5699, -15401, 5673, -15301, 5712},
+		{-16567, 6029, -16647, 6038, -16746, 6021, -16685, 5994, -16619, 5975, -16558, 5991},
+		{-17179, 6341, -17155, 6332, -17067, 6338, -17029, 6319, -16953, 6298, -16877, 6319, -16869, 6330, -16968, 6343, -17049, 6369, -17111, 6359, -17173, 6378},
+		{-15658, 7136, -16676, 6836, -16168, 6612, -16811, 6567, -16078, 6479, -16612, 6150, -16197, 5867, -15704, 5892, -16479, 5440, -15843, 5599, -15062, 6128, -15172, 5916, -14711, 6088, -14257, 6008, -13408, 5812, -12998, 5528, -13548, 5979, -13745, 5891, -14100, 6031, -14099, 6971},
+		{6708, 3736, 6933, 4073, 7306, 4087, 7096, 4227, 6826, 4066, 6490, 4373, 5593, 4500, 5597, 4131, 5863, 4275},
+		{-7197, 1161, -7330, 915, -7244, 742, -6734, 610, -6781, 282, -6633, 72, -6337, 220, -6482, 406, -6060, 492, -6141, 596, -5976, 837, -6188, 1072, -6819, 1055, -6994, 1216, -7170, 907},
+		{10704, 2181, 10533, 2335, 10217, 2246, 10756, 1520, 10749, 1234, 10433, 1049, 10516, 860, 10920, 1167, 10888, 1528, 10566, 1906, 10805, 2155},
+		{16722, -1589, 16718, -1616, 16752, -1660, 16784, -1647},
+		{16663, -1463, 16665, -1539, 16679, -1567, 16700, -1561, 16727, -1574, 16711, -1493},
+		{5278, 1735, 5200, 1900, 4700, 1695, 4338, 1758, 4348, 1264, 5217, 1560},
+		{3220, -2875, 3283, -2674, 3069, -2674, 3184, -2584, 3119, -2225, 2943, -2209, 2566, -2549, 2161, -2673, 1990, -2477, 1989, -2846, 1634, -2858, 1962, -3482, 2746, -3323},
+		{2933, -2926, 2902, -2974, 2885, -3007, 2829, -3023, 2811, -3055, 2775, -3065, 2700, -2988, 2753, -2924, 2807, -2885, 2854, -2865, 2898, -2896},
+		{3219, -893, 2873, -853, 2837, -1179, 2970, -1326, 2391, -1093, 2402, -1291, 2193, -1290, 2189, -1608, 2704, -1794, 3321, -1397},
+		{3224, -2112, 3233, -1639, 2952, -1564, 2526, -1774, 2802, -2149, 3119, -2225},
+	}},
+	{Tolerance: 2, Polygons: [][]int16{
+		{6080, 3440, 6087, 2983, 6505, 2947, 7516, 3713, 6575, 3766},
+		{1338, -586, 1173, -1730, 2322, -1752, 2173, -729},
+		{1247, -525, 1263, -499, 1300, -478, 1262, -444, 1232, -461, 1191, -504, 1218, -579, 1244, -568},
+		{2052, 4222, 2028, 4232, 2007, 4259, 1980, 4250, 1974, 4269, 1930, 4220, 1937, 4188, 1954, 4172, 1940, 4141, 1932, 4073, 1941, 4025, 1996, 3992, 1998, 3969, 2015, 3962, 2062, 4011, 2067, 4043, 2100, 4058, 2102, 4084, 2061, 4109, 2046, 4152, 2059, 4186},
+		{5162, 2401, 5501, 2250, 5626, 2571},
+		{-6505, -5470, -6645, -5445, -6775, -5385, -6825, -5310, -6863, -5264, -6863, -5487, -6756, -5487, -6696, -5490, -6645, -5525, -6550, -5520},
+		{-6627, -2183, -7054, -3137, -7191, -5201, -6815, -5235, -6512, -4106, -5679, -3690, -5763, -3022, -5365, -2692, -5862, -2712, -5778, -2516},
+		{4375, 4074, 4366, 4025, 4440, 4001, 4479, 3971, 4500, 3974, 4530, 3947, 4574, 3947, 4574, 3932, 4614, 3874, 4651, 3877, 4648, 3946, 4603, 3963, 4561, 3990, 4589, 4022, 4536, 4056, 4556, 4081, 4518, 4099, 4497, 4125, 4358, 4109},
+		{-6061, -7963, -6114, -7998, -6188, -8039, -6404, -8029, -6629, -8026, -6574, -8055, -6574, -8059, -6449, -8092, -6226, -8086, -6016, -8100, -5987, -8055, -5957, -8004},
+		{-15948, -7905, -16025, -7869, -16125, -7838, -16311, -7822, -16371, -7860, -16371, -7860, -16307, -7887, -16303, -7893, -16244, -7928, -16113, -7963, -15921, -7950},
+		{-4666, -7783, -5416, -8063, -4333, -8003},
+		{-12241, -7332, -12262, -7366, -12262, -7366, -12162, -7401, -12023, -7409, -11929, -7383, -11872, -7348, -11992, -7366, -12121, -7350},
+		{-12656, -7325, -12728, -7346, -12728, -7346, -12591, -7374, -12462, -7383, -12403, -7387, -12556, -7348},
+		{-10043, -7185, -10170, -7172, -10233, -7189, -10233, -7189, -10180, -7231, -10078, -7250, -9943, -7244, -9820, -7248, -9698, -7244, -9620, -7252, -9679, -7195, -9788, -7207, -9898, -7193},
+		{-6873, -7051, -7117, -6904, -7495, -7207},
+		{-5760, -6386, -6725, -6688, -6737, -7248, -7489, -7387, -10368, -7262, -10012, -7487, -15837, -7689, -14642, -8034, -15684, -8110, -15359, -8369, -14311, -8504, -17906, -8414, -18000, -9000, 18000, -9000, 18000, -8471, 15979, -8095, 16700, -7875, 16357, -7624, 17121, -7170, 13507, -6531, 8799, -6621, 6987, -7226, 6889, -6793, 5453, -6582, 3865, -6978, -687, -7093, -3578, -7834, -2855, -8034, -5822, -8322, -7802, -7918, -7366, -7791, -7724, -7671, -6138, -7411, -6567, -6795},
+		{6887, -4883, 6872, -4924, 6875, -4978, 7028, -4971, 7056, -4926, 7053, -4907, 6958, -4894, 6894, -4863},
+		{14474, -4070, 14791, -4321, 14829, -4088},
+		{14360, -1340, 14252, -1067, 14022, -1771, 13550, -1500, 13649, -1186, 13182, -1127, 12962, -1497, 12569, -1423, 11415, -2176, 11503, -3420, 13133, -3150, 13599, -3489, 13781, -3290, 13683, -3526, 14064, -3802, 15000, -3743, 15314, -2607},
+		{1688, 4847, 1696, 4860, 1650, 4879, 1603, 4873, 1525, 4904, 1490, 4896, 1434, 4856, 1360, 4888, 1324, 4842, 1288, 4829, 1303, 4764, 1293, 4747, 1262, 4767, 1214, 4770, 1143, 4752, 1054, 4757, 1040, 4730, 990, 4758, 959, 4753, 963, 4735, 948, 4710, 993, 4692, 1044, 4689, 1105, 4675, 1116, 4694, 1215, 4712, 1238, 4677, 1381, 4651, 1463, 4643, 1514, 4666, 1601, 4668, 1620, 4685, 1653, 4750, 1634, 4771, 1690, 4771, 1698, 4812},
+		{4479, 3971, 4495, 3934, 4546, 3887, 4614, 3874, 4574, 3932, 4574, 3947, 4530, 3947, 4500, 3974},
+		{4669, 4183, 4888, 3832, 5039, 4026},
+		{2975, -445, 3012, -409, 3051, -357, 3075, -336, 3074, -303, 3053, -281, 3047, -241, 2994, -235, 2963, -292, 2902, -284, 2928, -329, 2934, -450},
+		{251, 5115, 266, 5080, 312, 5078, 359, 5038, 429, 4991, 480, 4999, 567, 4953, 578, 5009, 604, 5013, 616, 5080, 561, 5104, 497, 5148, 405, 5127, 331, 5135},
+		{275, 787, 272, 851, 291, 914, 322, 944, 371, 1006, 360, 1033, 380, 1073, 357, 1133, 361, 1166, 285, 1224, 249, 1223, 215, 1194, 194, 1164, 145, 1155, 124, 1111, 90, 1100, 77, 1047, 108, 1018, 143, 983, 146, 933, 166, 913, 162, 683, 187, 614, 269, 626},
+		{-296, 1040, 194, 1164, 37, 1493, -428, 1323, -540, 1037},
+		{9215, 2363, 8856, 2645, 8903, 2206, 9237, 2067},
+		{2241, 4401, 2295, 4134, 2856, 4371},
+		{-7754, 2434, -7789, 2517, -7819, 2521, -7841, 2458, -7803, 2429, -7778, 2371, -7753, 2376},
+		{-7785, 2684, -7851, 2687, -7898, 2679, -7891, 2642, -7782, 2658},
+		{-7779, 2704, -7779, 2693, -7734, 2653, -7736, 2601, -7717, 2588, -7700, 2659},
+		{1855, 4508, 1575, 4482, 1856, 4265},
+		{2353, 5347, 3056, 5132, 3269, 5335, 2818, 5617},
+		{-8915, 1702, -8923, 1589, -8893, 1589, -8873, 1623, -8855, 1627, -8836, 1653, -8824, 1704, -8830, 1713, -8820, 1749, -8829, 1764, -8812, 1808, -8811, 1835, -8830, 1835, -8830, 1850, -8849, 1849, -8885, 1788, -8903, 1800, -8915, 1796, -8914, 1781},
+		{-6269, -2225, -5785, -1997, -5824, -1630, -6534, -976, -6953, -1095, -6783, -2287},
+		{-5698, -3011, -5337, -3377, -4765, -2489, -4094, -2194, -3473, -734, -5039, -8, -5132, 420, -5903, 132, -6073, 520, -6555, 79, -6982, 171, -6989, -430, -7399, -752, -7055, -1101, -6534, -976, -5824, -1630, -5794, -2209, -5363, -2612},
+		{11466, 401, 11487, 435, 11535, 432, 11541, 496, 11545, 545, 11460, 490, 11420, 453},
+		{9126, 2804, 9073, 2806, 9002, 2830, 8948, 2804, 8881, 2730, 8884, 2710, 8974, 2672, 9037, 2688, 9122, 2681, 9203, 2684, 9210, 2745, 9170, 2777},
+		{2526, -1774, 2091, -1825, 2089, -2683, 2943, -2209},
+		{1478, 641, 1601, 227, 2737, 523, 2286, 1114},
+		{-6401, 4704, -6439, 4673, -6414, 4639, -6287, 4597, -6250, 4603, -6201, 4644, -6294, 4642, -6366, 4655},
+		{-6184, 4929, -6286, 4971, -6417, 4996, -6452, 4987, -6359, 4940, -6229, 4909, -6181, 4911},
+		{-12392, 4906, -12492, 4948, -12542, 4995, -12576, 5030, -12670, 5040, -12731, 5055, -12836, 5077, -12844, 5054, -12806, 4999, -12703, 4981, -12685, 4953, -12595, 4918, -12566, 4883, -12401, 4837, -12351, 4851},
+		{-5560, 5132, -5927, 4760, -5307, 4666},
+		{-13324, 5385, -13305, 5341, -13255, 5310, -13218, 5264, -13158, 5218, -13118, 5218, -13205, 5298, -13175, 5412, -13271, 5404, -13318, 5417},
+		{-7952, 6236, -7993, 6239, -8032, 6209, -8036, 6202, -8010, 6172, -7966, 6163, -7927, 6216},
+		{-8188, 6290, -8325, 6291, -8399, 6245, -8377, 6218, -8307, 6216, -8190, 6271},
+		{-8588, 6574, -8722, 6354, -8010, 6373},
+		{-7522, 6744, -7510, 6758, -7511, 6801, -7590, 6829, -7681, 6815, -7724, 6759, -7699, 6710, -7587, 6715},
+		{-9626, 6949, -9656, 6968, -9716, 6986, -9822, 7014, -9892, 6971, -9980, 6940, -9843, 6895, -9762, 6906, -9627, 6876, -9565, 6911},
+		{-9241, 6970, -9639, 7119, -9423, 6907, -9613, 6729, -14099, 6971, -14100, 6031, -13548, 5979, -12297, 4900, -8838, 4830, -8255, 4535, -8269, 4168, -6924, 4745, -6536, 4355, -5980, 4592, -6447, 4624, -6506, 4923, -7110, 4682, -5568, 5215, -6458, 6034, -6765, 5821, -7384, 6244, -7811, 6232, -7654, 5653, -7991, 5121, -8227, 5515, -9230, 5709, -9424, 6090, -8122, 6867, -8552, 6988, -8735, 6720},
+		{-11519, 7331, -11940, 7156, -11242, 7037, -11734, 6996, -11331, 6854, -10109, 6958},
+		{-10526, 7364, -10660, 7360, -10694, 7346, -10538, 7276, -10450, 7342},
+		{-7806, 7365, -8035, 7376, -8083, 7369, -8088, 7333, -7978, 7280, -7949, 7274, -7839, 7288, -7731, 7286, -7625, 7283, -7634, 7310},
+		{-8583, 7380, -8989, 7122, -7293, 6773, -7771, 6423, -6617, 6193, -6802, 6626, -6185, 6686},
+		{-10154, 7336, -10044, 7271, -10248, 7283, -10250, 7251, -10001, 7174, -9932, 7136, -9836, 7127, -9672, 7166, -9654, 7256, -9805, 7299, -9712, 7347, -9738, 7376, -9916, 7363, -10036, 7384},
+		{-9200, 7297, -9051, 7386, -9242, 7410, -9450, 7413, -9550, 7386, -9602, 7344, -9603, 7294, -9541, 7206, -9427, 7202, -9320, 7277},
+		{-12046, 7182, -11551, 7348, -12492, 7429, -12593, 7187},
+		{-9398, 7530, -9485, 7565, -9629, 7538, -9682, 7493, -9561, 7467, -9416, 7459, -9361, 7498},
+		{-9858, 7659, -9998, 7665, -10149, 7631, -10257, 7634, -10250, 7556, -10086, 7564, -10088, 7506, -9981, 7490, -9816, 7500, -9770, 7574, -9774, 7626, -9850, 7672},
+		{-10855, 7668, -11771, 7522, -10570, 7548},
+		{-9675, 7716, -9712, 7675, -9596, 7644, -9389, 7632, -9289, 7588, -9277, 7539, -9242, 7484, -8976, 7452, -8815, 7439, -8610, 7441, -8323, 7456, -8195, 7444, -8046, 7466, -7983, 7492, -8006, 7534, -8113, 7571, -8275, 7578, -8479, 7570, -8638, 7548, -8784, 7557, -8919, 7561, -8982, 7585, -9097, 7607, -9074, 7645, -9161, 7678, -9357, 7678, -9468, 7710},
+		{-11757, 7750, -11910, 7751, -12116, 7686, -12285, 7612, -12285, 7612, -12150, 7590, -11990, 7605, -11804, 7648, -11711, 7653, -11634, 7688, -11620, 7765},
+		{-9372, 7763, -9442, 7782, -9644, 7783, -9617, 7756, -9430, 7749, -9384, 7752},
+		{-10985, 7800, -11126, 7815, -11272, 7805, -11353, 7773, -11205, 7741, -11019, 7770},
+		{-11096, 7880, -11150, 7885, -11253, 7855, -11254, 7841, -11088, 7841, -10966, 7860},
+		{-9556, 7842, -9675, 7877, -9734, 7883, -9863, 7887, -9855, 7846, -9812, 7808, -9731, 7785, -9583, 7806},
+		{-10083, 7880, -10353, 7917, -10549, 7930, -10542, 7892, -10421, 7868, -10518, 7838, -10295, 7834, -10130, 7802, -9967, 7791, -10006, 7832},
+		{-8781, 8032, -9671, 8016, -8581, 7934},
+		{-7067, 8317, -9159, 8189, -8185, 8046, -8693, 8025, -8498, 7754, -8949, 7647, -8056, 7618, -6185, 8263},
+		{852, 4783, 602, 4627, 1036, 4648},
+		{-6935, -5252, -7466, -5284, -6729, -5530},
+		{-6876, -2037, -6959, -1758, -7564, -4665, -7495, -5226, -7143, -5386, -6857, -5230, -7342, -4932, -6699, -2299},
+		{11057, 1926, 11101, 1970, 11079, 2008, 11021, 2010, 10912, 1982, 10863, 1937, 10866, 1851, 10948, 1820, 11034, 1868},
+		{12729, 5074, 12100, 5325, 11549, 4814, 11966, 4669, 10496, 4160, 9635, 4273, 8736, 4921, 7368, 3943, 7972, 3088, 8881, 2730, 9612, 2945, 9924, 2212, 10533, 2335, 11044, 2034, 12168, 2823, 11915, 3491, 12236, 3745, 11753, 3874, 13064, 4240, 13503, 4848},
+		{-281, 539, -283, 964, -823, 1013, -771, 436},
+		{1434, 223, 1450, 1286, 849, 450},
+		{2995, 417, 1947, 503, 1232, -610, 2173, -729, 2216, -1108, 2970, -1326},
+		{1326, -488, 1845, 350, 1308, 227, 1430, -200, 1109, -398},
+		{-7511, -6, -6989, -430, -6982, 171, -6688, 125, -6734, 610, -7330, 915, -7140, 1238, -7775, 771, -7899, 169},
+		{-8291, 842, -8283, 863, -8287, 881, -8272, 893, -8293, 907, -8293, 948, -8255, 957, -8302, 999, -8340, 1040, -8366, 1094, -8390, 1073, -8419, 1079, -8436, 1100, -8467, 1108, -8490, 1095, -8556, 1122, -8571, 1109, -8594, 1090, -8566, 1075, -8579, 1044, -8580, 1013, -8566, 993, -8534, 983, -8511, 956, -8491, 980, -8498, 1009, -8471, 991, -8465, 962, -8430, 949, -8391, 929, -8363, 905, -8360, 883, -8371, 866, -8351, 845, -8297, 823},
+		{-8251, 2308, -8497, 2190, -7418, 2028},
+		{3292, 3509, 3319, 3517, 3338, 3516, 3346, 3510, 3348, 3500, 3353, 3504, 3368, 3502, 3387, 3509, 3397, 3506, 3390, 3525, 3458, 3567, 3367, 3537, 3295, 3539, 3280, 3515, 3273, 3514},
+		{3387, 3509, 3368, 3502, 3353, 3504, 3348, 3500, 3346, 3510, 3338, 3516, 3319, 3517, 3292, 3509, 3273, 3514, 3226, 3510, 3249, 3470, 3298, 3457, 3400, 3498, 3397, 3506},
+		{1710, 4882, 1755, 4880, 1789, 4890, 1791, 4900, 1810, 4904, 1817, 4927, 1840, 4932, 1855, 4950, 1885, 4950, 1839, 4999, 1765, 5005, 1755, 5036, 1687, 5047, 1672, 5022, 1618, 5042, 1624, 5070, 1549, 5078, 1502, 5111, 1457, 5100, 1431, 5112, 1406, 5093, 1334, 5073, 1297, 5048, 1224, 5027, 1242, 4997, 1252, 4955, 1303, 4931, 1360, 4888, 1434, 4856, 1490, 4896, 1525, 4904, 1603, 4873, 1650, 4879, 1696, 4860},
+		{928, 5483, 619, 4946, 1293, 4747, 1412, 5376},
+		{4278, 1246, 4235, 1254, 4200, 1210, 4166, 1163, 4174, 1136, 4176, 1105, 4231, 1103, 4255, 1111, 4278, 1093, 4315, 1146, 4272, 1174, 4329, 1197, 4332, 1239, 4308, 1270},
+		{1237, 5611, 1090, 5578, 1104, 5536, 1209, 5480, 1269, 5561},
+		{1037, 5661, 1025, 5689, 1055, 5722, 1058, 5773, 978, 5745, 942, 5717, 854, 5711, 826, 5681, 809, 5654, 812, 5552, 853, 5496, 928, 5483, 992, 5498, 965, 5547, 1037, 5619, 1067, 5608, 1091, 5646},
+		{-7162, 1917, -7170, 1879, -7195, 1862, -7169, 1832, -7171, 1804, -7166, 1776, -7140, 1760, -7100, 1828, -7067, 1843, -7052, 1818, -7013, 1825, -6995, 1843, -6962, 1838, -6916, 1842, -6869, 1821, -6832, 1861, -6881, 1898, -6925, 1902, -6922, 1931, -6977, 1929, -6995, 1965, -7021, 1962, -7081, 1988, -7159, 1988, -7171, 1971},
+		{1156, 2410, 842, 3695, -121, 3571, -131, 3226, -867, 2884, 316, 1906},
+		{-8018, -382, -7537, -15, -8009, 77},
+		{3427, 3122, 2516, 3157, 2500, 2200, 3687, 2200, 3232, 2976, 3415, 2782},
+		{4278, 1246, 3685, 1696, 3643, 1442},
+		{-867, 4213, -538, 3595, 304, 4189},
+		{2516, 5797, 2813, 5930, 2334, 5919},
+		{3759, 1421, 3295, 778, 3616, 445, 4186, 392, 4779, 800},
+		{2902, 6977, 2065, 6911, 2540, 6511, 2132, 6072, 3114, 6236},
+		{17813, -1750, 17767, -1738, 17729, -1772, 17738, -1816, 17793, -1829, 17855, -1815, 17872, -1763, 17837, -1734},
+		{18000, -1656, 18000, -1607, 17941, -1638, 17910, -1643, 17860, -1664, 17873, -1701, 17936, -1680},
+		{-17979, -1602, -18000, -1607, -18000, -1656, -17992, -1650},
+		{-6070, -5230, -5985, -5185, -5940, -5220, -5805, -5190, -5775, -5155, -5855, -5110, -5915, -5150, -6000, -5125, -6120, -5185},
+		{-5225, 324, -5396, 576, -5452, 231},
+		{939, 4301, 875, 4263, 854, 4226, 878, 4158, 923, 4138, 956, 4215},
+		{312, 5078, -459, 4868, -150, 4303, 744, 4369, 810, 4902},
+		{1186, -343, 1430, -200, 1295, 232, 883, -78},
+		{-673, 5517, -757, 5513, -737, 5460, -757, 5406, -695, 5407, -620, 5387, -566, 5455},
+		{-421, 5855, -615, 5679, -341, 5143, -578, 5016, 145, 5129},
+		{4262, 4158, 4664, 4118, 3996, 4343},
+		{84, 628, 2, 1102, -294, 1096, -286, 499},
+		{-828, 769, -913, 1231, -1513, 1104},
+		{-1593, 1313, -1569, 1327, -1551, 1328, -1514, 1351, -1471, 1330, -1428, 1328, -1384, 1351, -1405, 1379, -1438, 1363, -1469, 1363, -1508, 1388, -1540, 1386, -1562, 1362, -1671, 1359, -1684, 1315},
+		{-1469, 1153, -1438, 1151, -1412, 1168, -1390, 1168, -1374, 1181, -1383, 1214, -1372, 1225, -1370, 1259, -1555, 1263, -1582, 1252, -1615, 1255, -1668, 1238, -1661, 1217, -1631, 1196, -1631, 1181, -1609, 1152, -1566, 1146, -1513, 1104},
+		{983, 107, 1129, 106, 1128, 226, 965, 228, 931, 116, 949, 101},
+		{2351, 3528, 2474, 3508, 2472, 3492, 2616, 3500, 2629, 3530, 2575, 3518, 2577, 3535, 2503, 3542, 2425, 3537, 2370, 3571},
+		{2612, 4183, 2102, 4084, 2167, 3684},
+		{-4690, 8220, -4452, 8166, -6265, 8177, -7330, 7804, -5859, 7552, -5139, 7057, -5468, 6961, -5087, 6993, -5397, 6719, -5163, 6363, -4338, 6010, -3981, 6546, -2235, 7013, -2636, 7023, -1937, 7430, -2168, 7663, -1773, 8013, -2005, 8018, -1221, 8129},
+		{-9006, 1388, -8823, 1573, -9100, 1782},
+		{-6055, 778, -5965, 179, -5654, 190},
+		{-8701, 1303, -8315, 1500, -8915, 1507},
+		{1846, 4576, 1366, 4514, 1845, 4248, 1575, 4482},
+		{-7342, 1964, -7278, 1948, -7279, 1910, -7233, 1867, -7269, 1845, -7345, 1853, -7437, 1866, -7446, 1834, -7392, 1803, -7345, 1822, -7284, 1815, -7237, 1821, -7171, 1804, -7169, 1832, -7195, 1862, -7170, 1879, -7162, 1917, -7171, 1971, -7258, 1987, -7319, 1992},
+		{1637, 4684, 1656, 4650, 1688, 4638, 1763, 4595, 1846, 4576, 1883, 4591, 1960, 4617, 2022, 4613, 2102, 4632, 2163, 4699, 2210, 4767, 2271, 4788, 2264, 4815, 2209, 4842, 2187, 4832, 2080, 4862, 2047, 4856, 2024, 4833, 1977, 4820, 1966, 4827, 1917, 4811, 1878, 4808, 1870, 4788, 1786, 4776, 1749, 4787, 1698, 4812, 1690, 4771, 1634, 4771, 1653, 4750, 1620, 4685},
+		{12078, -997, 12043, -967, 11990, -936, 11897, -956, 12030, -1026, 12072, -1024},
+		{12509, -939, 12507, -909, 12497, -889, 12398, -929, 12355, -990, 12346, -1024, 12358, -1036, 12444, -1014},
+		{11763, -845, 11708, -846, 11674, -903, 11728, -904, 11797, -891, 11913, -871, 11888, -828, 11826, -836, 11790, -810},
+		{12201, -846, 12134, -854, 12072, -824, 11992, -844, 11992, -881, 12125, -893, 12276, -865, 12290, -809},
+		{10849, -642, 10537, -685, 11571, -837},
+		{13473, -574, 13450, -545, 13429, -578, 13411, -614, 13421, -690, 13472, -621},
+		{12700, -313, 12599, -318, 12618, -361, 12687, -379, 12725, -346},
+		{12937, -280, 12814, -284, 12790, -339, 12859, -343, 12916, -336, 12999, -345, 13083, -386, 13047, -309},
+		{13399, -78, 13052, -94, 14103, -912, 14100, -260, 13546, -337},
+		{12507, 164, 12004, 57, 11937, -538, 12097, -263, 12316, -534, 12334, -62, 12018, 24},
+		{12859, 154, 12800, 163, 12793, 217, 12760, 181, 12740, 101, 12770, -27, 12810, -90, 12838, -78, 12797, -25, 12812, 36, 12864, 26, 12869, 113},
+		{11805, 229, 11587, 431, 11462, 143, 10966, 201, 11022, -293, 11615, -401},
+		{10586, -431, 9529, 548, 10258, -422},
+		{7687, 3465, 7375, 3432, 7526, 3227, 6818, 2369, 7263, 2136, 7754, 797, 8032, 1590, 8889, 2169, 8856, 2645, 9267, 2204, 9740, 2788, 8330, 2736},
+		{-695, 5407, -757, 5406, -737, 5460, -757, 5513, -833, 5466, -969, 5388, -917, 5286, -998, 5182, -856, 5167, -679, 5226, -603, 5315, -620, 5387},
+		{5383, 3697, 4411, 3943, 4857, 2993, 6150, 2508, 6112, 3649},
+		{4477, 3717, 3879, 3338, 4657, 2910, 4857, 2993},
+		{-1617, 6653, -2433, 6561, -1361, 6513},
+		{3570, 3272, 3584, 3287, 3582, 3328, 3555, 3326, 3546, 3309, 3513, 3309, 3510, 3308, 3496, 3283, 3475, 3207, 3449, 3161, 3456, 3155, 3427, 3122, 3492, 2950, 3542, 3110, 3540, 3149, 3493, 3135, 3497, 3162, 3523, 3175, 3497, 3187, 3518, 3253, 3555, 3239, 3572, 3271},
+		{1476, 3814, 1374, 3803, 1257, 3813, 1243, 3761, 1383, 3710, 1434, 3700, 1510, 3662, 1531, 3713, 1516, 3744, 1552, 3823},
+		{871, 4090, 816, 4095, 839, 4038, 843, 3917, 881, 3891, 921, 3924, 967, 3918, 981, 4050, 921, 4121},
+		{1215, 4712, 701, 4425, 1610, 3799, 1848, 4017},
+		{-7780, 1852, -7822, 1845, -7834, 1823, -7777, 1786, -7721, 1770, -7690, 1787, -7620, 1789, -7637, 1816, -7690, 1840, -7757, 1849},
+		{3555, 3178, 3607, 2920, 3920, 3216},
+		{13390, 3436, 13349, 3394, 13292, 3406, 13237, 3346, 13236, 3299, 13301, 3270, 13328, 3329, 13379, 3352, 13420, 3320, 13477, 3381, 13464, 3415},
+		{14096, 3817, 14137, 4138, 13020, 3142, 13099, 3389, 14025, 3514},
+		{14314, 4451, 13996, 4157, 14554, 4326},
+		{7119, 4270, 8026, 4235, 7997, 4492, 8736, 4921, 6907, 5539, 6144, 5401, 6134, 5080, 5077, 5169, 4647, 4839, 5304, 4685, 5031, 4461, 5250, 4178, 5597, 4131, 5850, 4559, 6826, 4066},
+		{4098, 278, 3530, 551, 3390, -95, 3920, -468},
+		{7126, 4217, 7306, 4087, 6946, 3953, 8026, 4235},
+		{10433, 1049, 10738, 1420, 10299, 1423},
+		{12821, 3837, 12649, 3439, 12947, 3563},
+		{2135, 4221, 2158, 4225, 2154, 4232, 2166, 4244, 2178, 4268, 2163, 4268, 2144, 4286, 2127, 4291, 2114, 4307, 2096, 4313, 2081, 4327, 2064, 4322, 2050, 4288, 2026, 4281, 2007, 4259, 2028, 4232, 2052, 4222, 2059, 4186, 2072, 4185, 2076, 4205},
+		{4730, 3006, 4657, 2910, 4746, 2900, 4771, 2853, 4842, 2855, 4809, 2931, 4818, 2953, 4797, 2998},
+		{10604, 1388, 10731, 1591, 10165, 2232, 10106, 1751, 10396, 1824},
+		{3607, 3382, 3661, 3420, 3645, 3459, 3600, 3464, 3598, 3461, 3548, 3391, 3513, 3309, 3546, 3309, 3555, 3326, 3582, 3328},
+		{-764, 519, -754, 531, -757, 571, -799, 613, -831, 619, -860, 647, -839, 691, -849, 740, -844, 769, -872, 771, -893, 731, -921, 731, -940, 753, -934, 793, -976, 854, -1002, 843, -1023, 841, -1070, 794, -1115, 740, -1120, 711, -1144, 679, -1077, 614, -991, 559, -900, 483, -797, 436, -771, 436},
+		{1586, 2341, 2500, 2000, 2492, 3190, 1909, 3027, 1149, 3314, 932, 2609},
+		{8130, 856, 8084, 927, 8015, 982, 7970, 820, 7987, 676, 8035, 597, 8122, 620, 8164, 648, 8179, 752},
+		{2854, -2865, 2807, -2885, 2753, -2924, 2700, -2988, 2775, -3065, 2811, -3055, 2829, -3023, 2885, -3007, 2902, -2974, 2933, -2926, 2898, -2896},
+		{2324, 5422, 2649, 5562, 2106, 5603},
+		{578, 5009, 567, 4953, 590, 4944, 619, 4946, 624, 4990, 604, 5013},
+		{2220, 5634, 2388, 5627, 2486, 5637, 2500, 5616, 2553, 5610, 2649, 5562, 2710, 5578, 2818, 5617, 2786, 5676, 2777, 5724, 2729, 5747, 2646, 5748, 2560, 5785, 2516, 5797, 2431, 5779, 2412, 5703, 2332, 5701, 2252, 5775, 2158, 5741, 2109, 5678, 2106, 5603},
+		{-593, 3576, -1702, 2142, -131, 3226},
+		{2692, 4812, 2723, 4783, 2755, 4741, 2813, 4681, 2816, 4637, 2805, 4594, 2823, 4549, 2849, 4560, 2866, 4594, 2893, 4626, 2886, 4644, 2907, 4652, 2917, 4638, 2976, 4635, 3002, 4642, 2984, 4653, 2991, 4667, 2956, 4693, 2942, 4735, 2905, 4751, 2912, 4785, 2867, 4812, 2826, 4816, 2752, 4847, 2686, 4837, 2662, 4822},
+		{4919, -1204, 4396, -1741, 4541, -2560, 5038, -1571},
+		{-9753, 2584, -10651, 3175, -11713, 3254, -11003, 2282, -11478, 3180, -10499, 1932, -9223, 1454, -8681, 2133, -9443, 1814},
+		{2046, 4152, 2061, 4109, 2102, 4084, 2167, 4093, 2206, 4115, 2260, 4113, 2276, 4130, 2295, 4134, 2288, 4200, 2238, 4232, 2192, 4230, 2158, 4225, 2135, 4221, 2076, 4205, 2072, 4185, 2059, 4186},
+		{-1212, 1399, -605, 1010, 364, 1557, 427, 1916, -645, 2496, -554, 1550},
+		{10012, 2042, 9733, 2826, 9230, 2148, 9419, 1604, 9716, 1693, 9855, 993, 9738, 1845},
+		{2007, 4259, 2026, 4281, 2034, 4290, 1996, 4311, 1963, 4321, 1948, 4335, 1922, 4352, 1903, 4343, 1871, 4320, 1856, 4265, 1845, 4248, 1888, 4228, 1916, 4196, 1937, 4188, 1930, 4220, 1974, 4269, 1980, 4250},
+		{8801, 4860, 9635, 4273, 10496, 4160, 11977, 4705, 11574, 4773, 11668, 4989, 9886, 5205},
+		{3428, -1228, 3503, -1680, 3018, -1480, 3285, -1671, 3207, -2673, 4078, -1469, 4032, -1032},
+		{-1183, 1480, -532, 1620, -492, 2497, -868, 2740, -1293, 2133, -1706, 2100, -1646, 1614},
+		{3428, -1016, 3394, -969, 3374, -942, 3276, -923, 3323, -968, 3349, -1053, 3332, -1080, 3311, -1161, 3331, -1244, 3299, -1278, 3269, -1371, 3321, -1397, 3379, -1445, 3406, -1436, 3446, -1461, 3452, -1501, 3431, -1548, 3438, -1618, 3503, -1680, 3534, -1611, 3577, -1590, 3569, -1461, 3527, -1389, 3491, -1357, 3456, -1358, 3428, -1228, 3456, -1152},
+		{10026, 664, 10009, 646, 10031, 604, 10020, 531, 10056, 477, 10070, 394, 10127, 327, 10139, 276, 10257, 197, 10352, 123, 10423, 129, 10425, 163, 10385, 252, 10350, 279, 10343, 338, 10333, 373, 10344, 418, 10338, 486, 10296, 552, 10237, 613, 10214, 622, 10181, 581, 10115, 569, 10108, 620},
+		{11844, 497, 11673, 692, 10983, 134},
+		{1682, -2808, 1989, -2846, 2091, -1825, 2508, -1758, 1173, -1730},
+		{16546, -2080, 16502, -2046, 16446, -2012, 16403, -2011, 16417, -2044, 16483, -2115, 16547, -2168, 16619, -2213, 16674, -2240, 16712, -2216, 16660, -2170, 16578, -2108},
+		{249, 1223, 1418, 1248, 1485, 2286, 427, 1916, 37, 1493},
+		{876, 548, 1458, 1209, 437, 1375, 269, 626},
+		{-8556, 1122, -8315, 1500, -8767, 1291},
+		{471, 5309, 331, 5135, 616, 5080, 691, 5348},
+		{2637, 7099, 591, 6261, 567, 5859, 1103, 5886, 1677, 6801, 3129, 7045},
+		{2328, 7808, 2288, 7845, 2081, 7825, 2142, 7794, 2073, 7768, 2249, 7744, 2472, 7785},
+		{1699, 8005, 1044, 7965, 1591, 7677, 2154, 7896},
+		{2292, 8066, 1737, 8032, 2741, 8006},
+		{8695, 2797, 8582, 2820, 8501, 2864, 8423, 2884, 8390, 2932, 8334, 2946, 8233, 3012, 8153, 3042, 8111, 3018, 8048, 2973, 8009, 2879, 8106, 2842, 8200, 2793, 8330, 2736, 8468, 2723, 8525, 2673, 8602, 2663, 8723, 2640, 8806, 2641, 8817, 2681, 8804, 2745, 8812, 2788},
+		{17280, -4049, 16668, -4622, 17308, -4385},
+		{17433, -3527, 17524, -4169, 17852, -3770},
+		{5928, 2143, 5589, 2492, 5311, 1665},
+		{5649, 2631, 5636, 2640, 5607, 2606, 5626, 2571, 5639, 2590},
+		{7458, 3702, 6635, 2989, 6087, 2983, 6332, 2676, 6150, 2508, 7104, 2436, 6951, 2694, 7526, 3227, 7375, 3432, 7784, 3549},
+		{-7775, 771, -7743, 764, -7724, 794, -7747, 852, -7735, 867, -7773, 895, -7806, 925, -7850, 942, -7906, 945, -7902, 955, -7957, 961, -7991, 931, -8052, 911, -8095, 886, -8144, 879, -8171, 903, -8181, 895, -8221, 900, -8219, 921, -8255, 957, -8293, 948, -8293, 907, -8272, 893, -8287, 881, -8283, 863, -8291, 842, -8297, 823, -8285, 807, -8282, 829, -8239, 829, -8213, 818, -8172, 811, -8152, 771, -8119, 765, -8106, 782, -8089, 722, -8042, 727, -8028, 742, -8000, 755, -8048, 809, -8038, 830, -8016, 833, -7976, 858, -7956, 893, -7912, 900, -7862, 872, -7844, 839, -7818, 832, -7843, 805, -7821, 751, -7788, 722},
+		{-6896, -1650, -6953, -1095, -7399, -752, -7005, -273, -7537, -15, -8125, -614, -7601, -1465, -7037, -1835},
+		{12631, 878, 12192, 719, 12540, 558},
+		{12408, 1123, 12334, 1027, 12350, 1094, 12295, 1088, 12284, 1026, 12259, 998, 12238, 971, 12300, 902, 12331, 932, 12362, 995, 12398, 1028},
+		{11903, 1000, 11969, 1055, 11951, 1137, 11899, 1038, 11839, 968, 11766, 907, 11717, 837, 11850, 932},
+		{12204, 1142, 12197, 1091, 12200, 1044, 12264, 1074, 12310, 1117, 12312, 1158, 12248, 1158, 12188, 1189},
+		{12523, 1254, 12427, 1256, 12488, 1179, 12489, 1142, 12430, 1150, 12446, 1089, 12476, 1084, 12480, 1013, 12528, 1036, 12503, 1098, 12501, 1131, 12578, 1105, 12550, 1216},
+		{12118, 1343, 12032, 1347, 12083, 1270, 12126, 1221, 12153, 1307},
+		{12072, 1851, 12063, 1386, 12408, 1254},
+		{15602, -654, 15555, -620, 15506, -557, 15476, -534, 15465, -504, 15451, -514, 15473, -590, 15517, -654, 15560, -692, 15588, -682},
+		{15232, -487, 15234, -431, 15214, -415, 15154, -417, 15165, -476, 15109, -511, 15081, -546, 15024, -553, 15014, -500, 15000, -503, 14985, -551, 14930, -558, 14840, -544, 14832, -575, 14889, -603, 14971, -632, 15024, -632, 15075, -608, 15130, -584, 15146, -556, 15198, -548},
+		{14697, -672, 14100, -260, 14103, -912, 14474, -763, 15069, -1058},
+		{15302, -398, 15264, -366, 15224, -324, 15182, -300, 15148, -278, 15094, -250, 15066, -274, 15138, -304, 15195, -346, 15241, -379, 15264, -418, 15283, -477, 15314, -450},
+		{1549, 5078, 2278, 4903, 2380, 5309, 1636, 5451, 1412, 5376},
+		{-6710, 1852, -6724, 1837, -6718, 1795, -6660, 1798, -6585, 1798, -6559, 1823, -6577, 1843, -6628, 1851},
+		{12999, 4299, 12471, 3811, 12821, 3837},
+		{-899, 4154, -786, 3684, -639, 4138},
+		{-6085, -2388, -5862, -2712, -5479, -2662, -5912, -1936, -6227, -2051},
+		{3518, 3253, 3497, 3187, 3523, 3175, 3497, 3162, 3493, 3135, 3540, 3149, 3555, 3178, 3555, 3239},
+		{5111, 2456, 5139, 2463, 5161, 2522, 5159, 2580, 5129, 2611, 5101, 2601, 5074, 2548, 5081, 2475},
+		{2210, 4767, 2022, 4613, 2294, 4382, 2963, 4504, 2692, 4812},
+		{14324, 5176, 14221, 5423, 14209, 4597},
+		{2265, 5458, 2276, 5486, 2232, 5502, 2127, 5519, 1989, 5487, 1966, 5443, 2089, 5431, 2273, 5433},
+		{-17493, 6721, -18000, 6896, -18000, 6498, -16990, 6598},
+		{18000, 7152, 17873, 7110, 17890, 7078, 18000, 7083},
+		{-17766, 7113, -17758, 7127, -17902, 7156, -17987, 7156, -18000, 7152, -18000, 7083, -17869, 7089},
+		{14348, 7348, 14206, 7386, 14081, 7377, 13986, 7337, 14004, 7332, 14209, 7321, 14360, 7321},
+		{14822, 7535, 14612, 7517, 15073, 7508},
+		{14147, 7609, 13697, 7526, 14509, 7556},
+		{5562, 7154, 6885, 7654, 5146, 7201, 5754, 7072},
+		{10471, 7713, 8051, 7365, 8150, 7175, 7466, 7283, 7242, 6617, 7280, 7222, 6994, 7304, 6669, 7103, 6851, 6809, 4345, 6857, 4395, 6607, 3701, 6385, 3318, 6663, 4106, 6746, 2859, 6906, 3152, 6287, 2729, 5747, 3179, 5210, 4007, 4960, 3668, 4524, 4782, 4115, 4755, 5045, 6134, 5080, 6144, 5401, 6907, 5539, 8736, 4921, 9886, 5205, 11788, 4951, 12507, 5316, 13099, 4779, 13503, 4848, 13078, 4222, 14006, 4845, 14135, 5309, 13513, 5473, 14220, 5904, 16447, 6255, 15591, 5677, 15679, 5101, 16354, 5987, 17923, 6230, 17741, 6461, 18000, 6498, 18000, 6896, 17572, 6988, 14047, 7285, 13129, 7079},
+		{10537, 7871, 10284, 7928, 10209, 7935, 10126, 7923, 9944, 7792, 10508, 7831},
+		{5152, 8070, 5004, 8092, 4910, 8075, 4852, 8051, 4832, 8078, 4680, 8077, 4485, 8059, 4707, 8056, 4650, 8025, 4759, 8001, 4875, 8018, 4889, 8034, 4979, 8042, 5114, 8055},
+		{10019, 7978, 9788, 8075, 9594, 8125, 9378, 8102, 9118, 8034, 9255, 8014, 9331, 7943, 9497, 7904, 9776, 7876, 9994, 7888},
+		{2982, -144, 2958, -134, 2929, -162, 2925, -222, 2912, -229, 2902, -284, 2963, -292, 2994, -235, 3047, -241, 3076, -229, 3082, -170, 3042, -113},
+		{-941, 2709, -1706, 2100, -1293, 2133},
+		{4322, 1667, 5567, 2200, 4842, 2855, 3700, 3151, 3463, 2806},
+		{3396, 958, 3841, 1800, 3687, 2200, 2500, 2200, 2346, 895, 3274, 1225},
+		{3382, 948, 3274, 1225, 2389, 862, 3188, 356, 3530, 551},
+		{-1562, 1362, -1151, 1244, -1458, 1660, -1763, 1473},
+		{16192, -1045, 16132, -1020, 16170, -1082, 16240, -1083, 16212, -1048},
+		{16069, -961, 16036, -940, 15970, -924, 15964, -964, 15985, -979, 16046, -990, 16085, -987},
+		{16128, -912, 16092, -832, 16058, -832, 16079, -892, 16153, -978, 16168, -960},
+		{15964, -802, 15882, -756, 15836, -732, 15821, -742, 15859, -775, 15913, -811, 15992, -854, 15988, -834},
+		{15714, -702, 15654, -660, 15649, -677, 15690, -718, 15734, -740, 15754, -735},
+		{-1120, 711, -1115, 740, -1070, 794, -1023, 841, -1051, 835, -1049, 872, -1065, 898, -1062, 927, -1084, 969, -1112, 1005, -1192, 1005, -1215, 986, -1243, 984, -1260, 962, -1271, 934, -1325, 890, -1312, 816, -1295, 780, -1243, 726, -1171, 686, -1144, 679},
+		{-8772, 1379, -8786, 1389, -8807, 1396, -8850, 1385, -8854, 1398, -8884, 1414, -8906, 1434, -8935, 1442, -8959, 1436, -8953, 1424, -8972, 1413, -9006, 1388, -9010, 1374, -8981, 1352, -8926, 1346, -8884, 1326, -8848, 1316, -8790, 1315, -8779, 1338},
+		{4894, 997, 4256, 1057, 4695, 800},
+		{4927, 1143, 4098, 278, 4159, -168, 4859, 534},
+		{2076, 4573, 1922, 4352, 2238, 4232},
+		{-5731, 507, -5452, 231, -5396, 576},
+		{1855, 4950, 1698, 4812, 2256, 4909},
+		{1370, 4602, 1394, 4559, 1372, 4550, 1441, 4547, 1460, 4563, 1494, 4547, 1533, 4545, 1532, 4573, 1567, 4583, 1577, 4624, 1656, 4650, 1637, 4684, 1620, 4685, 1601, 4668, 1514, 4666, 1463, 4643, 1381, 4651},
+		{2390, 6601, 2003, 6907, 1258, 6407, 1294, 5536},
+		{3199, -2629, 3184, -2584, 3133, -2566, 3104, -2573, 3095, -2602, 3068, -2640, 3069, -2674, 3128, -2729, 3187, -2718, 3207, -2673},
+		{4101, 3442, 4235, 3723, 3674, 3682, 3570, 3272},
+		{1489, 1222, 1528, 742, 2100, 948, 2384, 1958, 1485, 2286},
+		{162, 683, 166, 913, 146, 933, 143, 983, 108, 1018, 77, 1047, 90, 1100, 2, 1102, -5, 1071, 37, 1019, 37, 947, 46, 868, 71, 831, 49, 741, 57, 691, 84, 628, 106, 593, 187, 614},
+		{10235, 1339, 10554, 1472, 10472, 1743, 9825, 1971, 9815, 835, 10115, 569, 9915, 996},
+		{7060, 4022, 6783, 3714, 7498, 3742},
+		{6223, 3527, 6655, 3797, 5863, 4275, 5250, 4178, 5392, 3720},
+		{12507, -909, 12509, -939, 12593, -911, 12697, -867, 12734, -840, 12696, -827, 12664, -840, 12595, -843, 12509, -866, 12497, -889},
+		{-6166, 1037, -6195, 1009, -6177, 1000, -6094, 1011, -6090, 1086, -6111, 1089, -6168, 1076},
+		{997, 3054, 951, 3735, 752, 3410},
+		{3517, 4204, 2632, 3821, 4477, 3717, 4262, 4158},
+		{2762, 4100, 2881, 4105, 2899, 4130, 2812, 4162, 2800, 4201, 2714, 4214, 2612, 4183, 2660, 4156, 2629, 4094, 2606, 4082, 2604, 4062, 2636, 4015, 2719, 4069},
+		{12195, 2500, 12150, 2530, 12069, 2454, 12011, 2356, 12022, 2281, 12075, 2197, 12118, 2279, 12178, 2439},
+		{3187, -103, 3074, -834, 3456, -1152, 4032, -1032, 3920, -468},
+		{3390, -95, 3448, 356, 3125, 378, 2958, -134},
+		{3093, 5204, 2353, 5158, 2209, 4842, 3388, 4436, 3990, 4823},
+		{-5787, -3102, -5782, -3446, -5381, -3440},
+		{-15522, 1924, -15483, 1945, -15481, 1951, -15506, 1986, -15522, 1999, -15540, 2008, -15579, 2025, -15586, 2027, -15592, 2017, -15585, 1998, -15602, 1981, -15607, 1970, -15591, 1934, -15594, 1906, -15569, 1892, -15554, 1908},
+		{-15600, 2076, -15626, 2092, -15661, 2101, -15671, 2093, -15670, 2086, -15659, 2078, -15641, 2057, -15608, 2064},
+		{-15725, 2122, -15733, 2110, -15679, 2107, -15676, 2118},
+		{-15794, 2165, -15803, 2172, -15829, 2158, -15825, 2154, -15813, 2131, -15778, 2128, -15771, 2126, -15765, 2132},
+		{-15937, 2221, -15960, 2224, -15975, 2214, -15980, 2207, -15946, 2188, -15935, 2198},
+		{-9516, 4938, -12284, 4900, -12440, 4031, -11713, 3254, -10651, 3175, -9753, 2584, -9469, 2948, -8410, 3009, -8068, 2508, -8134, 3144, -7573, 3555, -7699, 3824, -6696, 4481, -6924, 4745, -8269, 4168, -8414, 4651},
+		{-15214, 5759, -15256, 5790, -15323, 5797, -15376, 5782, -15467, 5746, -15452, 5699, -15401, 5673, -15301, 5712},
+		{-16567, 6029, -16647, 6038, -16746, 6021, -16685, 5994, -16619, 5975, -16558, 5991},
+		{-17179, 6341, -17155, 6332, -17067, 6338, -17029, 6319, -16953, 6298, -16877, 6319, -16869, 6330, -16968, 6343, -17049, 6369, -17111, 6359, -17173, 6378},
+		{-15658, 7136, -16676, 6836, -16168, 6612, -16811, 6567, -16078, 6479, -16612, 6150, -15704, 5892, -16479, 5440, -15062, 6128, -15172, 5916, -14257, 6008, -12998, 5528, -14100, 6031, -14099, 6971},
+		{6708, 3736, 7306, 4087, 5593, 4500, 5597, 4131, 5863, 4275},
+		{-7197, 1161, -7244, 742, -6734, 610, -6633, 72, -6060, 492, -6188, 1072, -6994, 1216, -7170, 907},
+		{10704, 2181, 10217, 2246, 10756, 1520, 10516, 860, 10920, 1167},
+		{16722, -1589, 16718, -1616, 16752, -1660, 16784, -1647},
+		{16663, -1463, 16665, -1539, 16679, -1567, 16700, -1561, 16727, -1574, 16711, -1493},
+		{5278, 1735, 4338, 1758, 4348, 1264},
+		{3220, -2875, 2943, -2209, 1634, -2858, 1962, -3482},
+		{2933, -2926, 2902, -2974, 2885, -3007, 2829, -3023, 2811, -3055, 2775, -3065, 2700, -2988, 2753, -2924, 2807, -2885, 2854, -2865, 2898, -2896},
+		{3219, -893, 2873, -853, 2970, -1326, 2391, -1093, 2189, -1608, 2704, -1794, 3321, -1397},
+		{3224, -2112, 3233, -1639, 2526, -1774, 2802, -2149},
+	}},
+}
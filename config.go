@@ -5,12 +5,118 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // Config represents the user preferences for the localize app.
 type Config struct {
-	Cities []string `json:"cities"` // List of selected city names
-	Preset string   `json:"preset"` // Selected preset name
+	Cities         []string            `json:"cities"`                    // List of selected city names
+	Preset         string              `json:"preset"`                    // Selected preset name
+	MeetingGroups  []MeetingGroup      `json:"meeting_groups,omitempty"`  // Saved meeting participant groups
+	MapProjection  string              `json:"map_projection,omitempty"`  // Map projection key (e.g. "robinson")
+	CentreMeridian *float64            `json:"centre_meridian,omitempty"` // Centre meridian of the pacific projection
+	CustomCities   []CustomCity        `json:"custom_cities,omitempty"`   // Locations added from the map cursor
+	AddedCities    []string            `json:"added_cities,omitempty"`    // Database cities added from the command palette
+	Theme          string              `json:"theme,omitempty"`           // Colour theme name (e.g. "light")
+	ColorMode      string              `json:"color_mode,omitempty"`      // Colour depth: auto, truecolor, 256, 16 or mono
+	Themes         []ThemeConfig       `json:"themes,omitempty"`          // User-defined colour themes
+	KeyPreset      string              `json:"key_preset,omitempty"`      // Key binding preset: default, vim or emacs
+	KeyBindings    map[string][]string `json:"key_bindings,omitempty"`    // Action → key specs, replacing the defaults
+	RecentCommands []string            `json:"recent_commands,omitempty"` // Command palette history, newest first
+	TimeFormat     TimeFormatConfig    `json:"time_format,omitzero"`      // Clock and date display format
+	Locale         string              `json:"locale,omitempty"`          // UI language (e.g. "de"); default from LANG
+	Renderer       string              `json:"renderer,omitempty"`        // Map renderer: auto, braille, halfblock or ascii
+	Layout         string              `json:"layout,omitempty"`          // Dashboard layout: auto, map, panel, grid, wall, table or strip
+	Accessible     bool                `json:"accessible,omitempty"`      // Screen-reader friendly text layout instead of the map
+}
+
+// TimeFormatConfig chooses how clocks and dates are shown. Empty fields
+// keep the defaults: 24-hour clocks with seconds and text dates.
+type TimeFormatConfig struct {
+	Clock        string `json:"clock,omitempty"`         // "24h" or "12h"
+	Seconds      *bool  `json:"seconds,omitempty"`       // Seconds on the clock panels and converter
+	Date         string `json:"date,omitempty"`          // Date style: text, long, iso, us or eu
+	WeekNumbers  bool   `json:"week_numbers,omitempty"`  // ISO week numbers after full dates
+	RelativeDays bool   `json:"relative_days,omitempty"` // Today, Tomorrow or Yesterday for nearby dates
+}
+
+// ThemeConfig is a user-defined theme: a built-in base theme with some of
+// its role colours replaced.
+type ThemeConfig struct {
+	Name    string            `json:"name"`
+	Base    string            `json:"base,omitempty"`    // Built-in theme to start from (default "dark")
+	Colors  map[string]string `json:"colors"`            // Role → colour name or #RRGGBB
+	Markers []string          `json:"markers,omitempty"` // City marker palette
+}
+
+// CustomCity is a user-added location, stored alongside the built-in cities.
+type CustomCity struct {
+	Name      string  `json:"name"`
+	Timezone  string  `json:"timezone"`
+	Category  string  `json:"category"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// MeetingGroup is a named set of meeting participants with planner defaults.
+type MeetingGroup struct {
+	Name          string   `json:"name"`
+	Cities        []string `json:"cities"`
+	BusinessStart int      `json:"business_start"`         // Hour (0-23) when business hours start
+	BusinessEnd   int      `json:"business_end"`           // Hour (1-24) when business hours end
+	Duration      int      `json:"duration_minutes"`       // Default meeting length in minutes
+	WindowStart   int      `json:"window_start,omitempty"` // Preferred window start, UTC hour
+	WindowEnd     int      `json:"window_end,omitempty"`   // Preferred window end, UTC hour (0 = 24)
+}
+
+// FindMeetingGroup returns the saved group with the given name (case-insensitive).
+func (c *Config) FindMeetingGroup(name string) *MeetingGroup {
+	for i := range c.MeetingGroups {
+		if strings.EqualFold(c.MeetingGroups[i].Name, name) {
+			return &c.MeetingGroups[i]
+		}
+	}
+	return nil
+}
+
+// PutMeetingGroup adds a group, replacing any existing group with the same name.
+func (c *Config) PutMeetingGroup(group MeetingGroup) {
+	if existing := c.FindMeetingGroup(group.Name); existing != nil {
+		*existing = group
+		return
+	}
+	c.MeetingGroups = append(c.MeetingGroups, group)
+}
+
+// DeleteMeetingGroup removes the group with the given name, if present.
+func (c *Config) DeleteMeetingGroup(name string) {
+	for i := range c.MeetingGroups {
+		if strings.EqualFold(c.MeetingGroups[i].Name, name) {
+			c.MeetingGroups = append(c.MeetingGroups[:i], c.MeetingGroups[i+1:]...)
+			return
+		}
+	}
+}
+
+// PutCustomCity adds a custom city, replacing any existing one with the same name.
+func (c *Config) PutCustomCity(city CustomCity) {
+	for i := range c.CustomCities {
+		if strings.EqualFold(c.CustomCities[i].Name, city.Name) {
+			c.CustomCities[i] = city
+			return
+		}
+	}
+	c.CustomCities = append(c.CustomCities, city)
+}
+
+// PutAddedCity records a city added to the clocks, once.
+func (c *Config) PutAddedCity(name string) {
+	for _, added := range c.AddedCities {
+		if strings.EqualFold(added, name) {
+			return
+		}
+	}
+	c.AddedCities = append(c.AddedCities, name)
 }
 
 // DefaultConfigPath returns the default config file path (~/.localize/config.json).
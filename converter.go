@@ -17,8 +17,16 @@ type converterMode struct {
 	zones        []Region // All available zones for conversion
 	inputMode    bool     // True if waiting for time input
 	timeError    string   // Error message for invalid input
+	sliderRow    int      // Row of the time slider in the last render
 }
 
+// Layout of the converter's time slider: one cell per half hour.
+const (
+	converterSliderIndent = 5  // Cells before the slider track ("  00 ")
+	converterSliderCells  = 48 // Cells in the slider track
+	converterSliderStep   = 30 // Minutes per slider cell
+)
+
 // newConverterMode creates a new converter mode handler.
 func newConverterMode(app *tview.Application) *converterMode {
 	// Collect all available zones
@@ -63,42 +71,36 @@ func (c *converterMode) HandleKey(key rune) bool {
 			c.inputTime += ":"
 		}
 		return true
-	case 'c', 'C':
+	}
+	return false
+}
+
+// HandleAction runs a bound converter action.
+func (c *converterMode) HandleAction(action string) bool {
+	switch action {
+	case "converter.input":
 		// Toggle input mode
 		c.inputMode = !c.inputMode
 		if !c.inputMode {
 			c.inputTime = ""
 			c.timeError = ""
 		}
-		return true
-	case 16: // Ctrl+P or Up arrow equivalent - previous zone
+	case "converter.prev":
 		if c.selectedZone > 0 {
 			c.selectedZone--
 		}
-		return true
-	case 14: // Ctrl+N or Down arrow equivalent - next zone
-		if c.selectedZone < len(c.zones)-1 {
-			c.selectedZone++
-		}
-		return true
-	case 'j', 'J': // Down
+	case "converter.next":
 		if c.selectedZone < len(c.zones)-1 {
 			c.selectedZone++
 		}
-		return true
-	case 'k', 'K': // Up
-		if c.selectedZone > 0 {
-			c.selectedZone--
-		}
-		return true
-	case 'r', 'R':
-		// Reset input
+	case "converter.reset":
 		c.inputTime = ""
 		c.timeError = ""
 		c.inputMode = false
-		return true
+	default:
+		return false
 	}
-	return false
+	return true
 }
 
 // Render returns the rendered converter display.
@@ -106,18 +108,18 @@ func (c *converterMode) Render() string {
 	var b strings.Builder
 
 	// Header
-	b.WriteString("\n[yellow::b]━━━ TIME CONVERTER ━━━[-::-]\n\n")
+	b.WriteString("\n[" + themeColor(roleAccent) + "::b]━━━ " + T("TIME CONVERTER") + " ━━━[-::-]\n\n")
 
 	// Source time display
 	sourceTime := c.getSourceTime()
 	if sourceTime != nil {
 		zone := c.zones[c.selectedZone]
-		b.WriteString(fmt.Sprintf("  [dodgerblue::b]Source:[-::-] %s @ %s\n",
-			sourceTime.Format("15:04:05"), zone.Name))
-		b.WriteString(fmt.Sprintf("         [%s] %s\n\n",
-			sourceTime.Format("Mon, 02 Jan 2006"), zone.Timezone))
+		b.WriteString(fmt.Sprintf("  [%s::b]%s[-::-] %s @ %s\n",
+			themeColor(roleInfo), T("Source:"), strings.TrimSpace(FormatClock(*sourceTime)), LocalCityName(zone.Name)))
+		b.WriteString(fmt.Sprintf("         %s %s\n\n",
+			tview.Escape("["+FormatDate(*sourceTime)+"]"), zone.Timezone))
 	} else {
-		b.WriteString("  [dodgerblue::b]Enter source time below[white]\n\n")
+		b.WriteString("  [" + themeColor(roleInfo) + "::b]" + T("Enter source time below") + "[-]\n\n")
 	}
 
 	// Time input
@@ -126,17 +128,23 @@ func (c *converterMode) Render() string {
 		if displayTime == "" {
 			displayTime = "HH:MM"
 		}
-		b.WriteString(fmt.Sprintf("  [::b]Enter time: [yellow]%s[-]\n\n", displayTime))
+		b.WriteString(fmt.Sprintf("  [::b]%s [%s]%s[-]\n\n", T("Enter time:"), themeColor(roleAccent), displayTime))
 		if c.timeError != "" {
-			b.WriteString(fmt.Sprintf("  [red]%s[-]\n\n", c.timeError))
+			b.WriteString(fmt.Sprintf("  [%s]%s[-]\n\n", themeColor(roleBad), c.timeError))
 		}
 	} else {
-		b.WriteString("  [darkgray]Press C to enter time[white]\n\n")
+		b.WriteString("  [" + themeColor(roleMuted) + "]" + fmt.Sprintf(T("Press %s to enter time"), KeyLabel("converter.input")) + "[-]\n\n")
 	}
 
+	// Time slider, set by clicking or dragging
+	c.sliderRow = strings.Count(b.String(), "\n")
+	b.WriteString(c.renderSlider(sourceTime))
+	b.WriteString("\n\n")
+
 	// Converted times
-	b.WriteString("  [aqua::b]Converted Times:[-::-]\n")
-	b.WriteString("  [darkgray]Use ↑/↓ to select source timezone[white]\n\n")
+	b.WriteString("  [" + themeColor(roleInfo) + "::b]" + T("Converted Times:") + "[-::-]\n")
+	b.WriteString("  [" + themeColor(roleMuted) + "]" + fmt.Sprintf(T("Use %s/%s to select source timezone"),
+		KeyLabel("converter.prev"), KeyLabel("converter.next")) + "[-]\n\n")
 
 	if sourceTime != nil {
 		for i, zone := range c.zones {
@@ -149,15 +157,96 @@ func (c *converterMode) Render() string {
 				marker = "> "
 			}
 			colorTag := colorToTag(zone.Color)
-			b.WriteString(fmt.Sprintf("%s[%s::b]%-13s[-::-] %s  [%s]\n",
-				marker, colorTag, zone.Name, converted.Format("15:04:05"),
-				converted.Format("Mon, 02 Jan")))
+			b.WriteString(fmt.Sprintf("%s[%s::b]%s[-::-] %s  %s\n",
+				marker, colorTag, padTagged(LocalCityName(zone.Name), 13), FormatClock(converted),
+				tview.Escape("["+FormatShortDate(converted)+"]")))
+		}
+	}
+
+	return b.String()
+}
+
+// renderSlider returns the 24-hour slider with its knob at the source time,
+// or at the current time in the source zone (dimmed) if none is entered.
+func (c *converterMode) renderSlider(sourceTime *time.Time) string {
+	knobColor := themeColor(roleAccent)
+	t := sourceTime
+	if t == nil {
+		now := time.Now()
+		if loc, err := time.LoadLocation(c.zones[c.selectedZone].Timezone); err == nil {
+			now = now.In(loc)
 		}
+		t, knobColor = &now, themeColor(roleMuted)
 	}
+	knob := (t.Hour()*60 + t.Minute()) / converterSliderStep
 
+	var b strings.Builder
+	b.WriteString("  [" + themeColor(roleMuted) + "]00[-] ")
+	for i := 0; i < converterSliderCells; i++ {
+		if i == knob {
+			b.WriteString(fmt.Sprintf("[%s::b]●[-::-]", knobColor))
+		} else {
+			b.WriteString("[" + themeColor(roleMuted) + "]─[-]")
+		}
+	}
+	b.WriteString(" [" + themeColor(roleMuted) + "]24[-]")
 	return b.String()
 }
 
+// HandleMouse sets the source time from a click or drag on the slider.
+func (c *converterMode) HandleMouse(action mouseAction, x, y int) bool {
+	if (action != mouseClick && action != mouseDrag) || y != c.sliderRow {
+		return false
+	}
+	cell := x - converterSliderIndent
+	if cell < 0 || cell >= converterSliderCells {
+		return false
+	}
+	minutes := cell * converterSliderStep
+	c.inputTime = fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+	c.timeError = ""
+	return true
+}
+
+// SetSource sets the time to convert ("15:00", "9:30" or "15") and, if
+// cityName is not empty, the source city, which is added to the zones if
+// it is not one of them.
+func (c *converterMode) SetSource(clock, cityName string) error {
+	if !strings.Contains(clock, ":") {
+		clock += ":00"
+	}
+	if len(clock) == 4 {
+		clock = "0" + clock // Pad single digit hour
+	}
+	if _, err := time.Parse("15:04", clock); err != nil {
+		return fmt.Errorf("invalid time %q (use HH:MM)", clock)
+	}
+
+	if cityName != "" {
+		city := GetCityByName(cityName)
+		if city == nil {
+			return fmt.Errorf("unknown city %q", cityName)
+		}
+		index := -1
+		for i, zone := range c.zones {
+			if zone.Name == city.Name {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			c.zones = append(c.zones, Region{Name: city.Name, Timezone: city.Timezone, Color: city.Color})
+			index = len(c.zones) - 1
+		}
+		c.selectedZone = index
+	}
+
+	c.inputTime = clock
+	c.inputMode = false
+	c.timeError = ""
+	return nil
+}
+
 // getSourceTime parses the input time and returns it in the selected zone.
 func (c *converterMode) getSourceTime() *time.Time {
 	if len(c.inputTime) < 4 {
@@ -204,7 +293,18 @@ func (c *converterMode) convertTime(t *time.Time, targetZone string) time.Time {
 
 // GetHelpText returns the help text for converter mode.
 func (c *converterMode) GetHelpText() string {
-	return "[darkgray]Keys:[white] C=Enter Time  ↑/↓=Select Zone  R=Reset  Esc=Exit"
+	mouse := fmt.Sprintf("[%s]%s[-] %s", themeColor(roleMuted), T("Mouse:"), T("Drag the slider"))
+	return fmt.Sprintf("[%s]%s[-] %s", themeColor(roleMuted), T("Keys:"), ContextKeyHelp(keyContextConverter, T("Esc=Exit"), mouse))
+}
+
+// HelpGroups returns the keys the converter handles itself, for the help
+// overlay.
+func (c *converterMode) HelpGroups() []helpGroup {
+	return []helpGroup{{title: T("Time input"), entries: []helpEntry{
+		{keys: "0-9  :", help: T("Type the time as HH:MM, after Enter Time")},
+		{keys: "Backspace", help: T("Delete the last digit")},
+		{keys: T("Click, Drag"), help: T("Set the time on the slider")},
+	}}}
 }
 
 // HandleSpecialKeyEvent handles non-rune key events (Enter, Backspace, etc.).
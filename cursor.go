@@ -0,0 +1,280 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// mapCursorState tracks the free cursor that points at any spot of the map.
+// The spot is kept as a position on the globe and projected when drawn, so
+// the cursor stays put when the view, projection or layout changes.
+type mapCursorState struct {
+	active     bool
+	lat, lon   float64  // Position under the cursor
+	cols, rows int      // Grid size of the last render
+	vp         Viewport // Viewport of the last render
+	naming     bool     // Typing a name for a new city
+	nameInput  string
+	message    string // Result of the last action, shown in the status bar
+}
+
+// global map cursor state
+var mapCursor = &mapCursorState{}
+
+// customCityColor is the marker color of cities added from the map
+const customCityColor = tcell.ColorOrchid
+
+// kmPerDegree is the length of one degree of arc on the Earth's surface
+const kmPerDegree = 111.2
+
+// IsMapCursorActive reports whether the free map cursor is shown.
+func IsMapCursorActive() bool {
+	return mapCursor.active
+}
+
+// ToggleMapCursor shows the cursor at the centre of the map, or hides it.
+func ToggleMapCursor() {
+	mapCursor.active = !mapCursor.active
+	mapCursor.naming = false
+	mapCursor.message = ""
+	if mapCursor.active {
+		mapCursor.lat = (mapViewport.North + mapViewport.South) / 2
+		mapCursor.lon = normalizeLongitude((mapViewport.West + mapViewport.East) / 2)
+	}
+}
+
+// SetMapCursorGrid records the grid and viewport of the current render,
+// which cursor moves and clicks are measured in.
+func SetMapCursorGrid(cols, rows int, vp Viewport) {
+	mapCursor.cols, mapCursor.rows, mapCursor.vp = cols, rows, vp
+}
+
+// mapCursorCell returns the braille cell the cursor is drawn in, or ok
+// false when its position is outside the last render's viewport.
+func mapCursorCell() (col, row int, ok bool) {
+	return LatLonToBraille(mapCursor.lat, mapCursor.lon, mapCursor.cols, mapCursor.rows, mapCursor.vp)
+}
+
+// MoveMapCursor moves the cursor by whole braille cells. A cursor outside
+// the view moves from the centre of the map.
+func MoveMapCursor(dCol, dRow int) {
+	col, row, ok := mapCursorCell()
+	if !ok {
+		col, row = mapCursor.cols/2, mapCursor.rows/2
+	}
+	col = max(0, min(col+dCol, mapCursor.cols-1))
+	row = max(0, min(row+dRow, mapCursor.rows-1))
+	if lat, lon, ok := mapCursor.vp.CellLatLon(col, row, mapCursor.cols, mapCursor.rows); ok {
+		mapCursor.lat, mapCursor.lon = lat, lon
+		mapCursor.message = ""
+	}
+}
+
+// SetMapCursorCell moves the cursor to a braille cell, reporting whether
+// it moved. The name prompt keeps the cursor in place, and cells outside
+// the projected map are ignored.
+func SetMapCursorCell(col, row int) bool {
+	if mapCursor.naming {
+		return false
+	}
+	if c, r, ok := mapCursorCell(); ok && c == col && r == row {
+		return false
+	}
+	lat, lon, ok := mapCursor.vp.CellLatLon(col, row, mapCursor.cols, mapCursor.rows)
+	if !ok {
+		return false
+	}
+	mapCursor.lat, mapCursor.lon = lat, lon
+	mapCursor.message = ""
+	return true
+}
+
+// nearestCity returns the closest city in the database and its distance in km.
+func nearestCity(lat, lon float64) (*City, float64) {
+	var nearest *City
+	best := 360.0
+	for i := range AllCities {
+		c := &AllCities[i]
+		if d := angularDistance(lat, lon, c.Coordinates[0], c.Coordinates[1]); d < best {
+			best, nearest = d, c
+		}
+	}
+	return nearest, best * kmPerDegree
+}
+
+// formatLatLon formats a position as e.g. "35.7°N 139.7°E".
+func formatLatLon(lat, lon float64) string {
+	ns, ew := "N", "E"
+	if lat < 0 {
+		ns, lat = "S", -lat
+	}
+	if lon < 0 {
+		ew, lon = "W", -lon
+	}
+	return fmt.Sprintf("%.1f°%s %.1f°%s", lat, ns, lon, ew)
+}
+
+// formatCursorStatus returns the status bar text for the cursor: position,
+// zone with local time and offset, sun elevation and the nearest city.
+func formatCursorStatus(t time.Time) string {
+	if mapCursor.naming {
+		return fmt.Sprintf("[%s]%s %s[-] [white]%s_[-]  [darkgray]%s  %s[-]",
+			themeColor(roleZone), MapGlyphs().cursor, T("Name:"), mapCursor.nameInput, T("Enter=Add"), T("Esc=Cancel"))
+	}
+	lat, lon := mapCursor.lat, mapCursor.lon
+	status := fmt.Sprintf("[%s]%s[-] %s  [white]%s[-]  [yellow]%s %.0f°[-]",
+		themeColor(roleZone), MapGlyphs().cursor, formatLatLon(lat, lon), formatZone(timezoneAt(lat, lon), t),
+		MapGlyphs().sun, computeSunPosition(t).elevationAt(lat, lon))
+	if city, km := nearestCity(lat, lon); city != nil {
+		status += fmt.Sprintf("  [silver]%s %.0f km[-]", LocalCityName(city.Name), km)
+	}
+	if mapCursor.message != "" {
+		status += "  [green]" + mapCursor.message + "[-]"
+	} else {
+		status += "  [darkgray]" + KeyHelp([]string{"cursor.add", "cursor.exit"}) + "[-]"
+	}
+	return status
+}
+
+// overlayMapCursor draws the cursor on the map lines, unless it is outside
+// the viewport or a marker or label covers its cell.
+func overlayMapCursor(lines []string, taken *labelGrid) {
+	if col, row, ok := mapCursorCell(); ok {
+		overlayCell(lines, taken, col, row,
+			fmt.Sprintf("[%s::b]%s[-:-:-]", themeColor(roleZone), MapGlyphs().cursor))
+	}
+}
+
+// HandleMapCursorKey types the name of a new city at the cursor. It returns
+// false unless the name is being typed; the cursor's other keys are bound
+// actions of the cursor key context.
+func HandleMapCursorKey(event *tcell.EventKey) bool {
+	if !mapCursor.naming {
+		return false
+	}
+	switch event.Key() {
+	case tcell.KeyEnter:
+		mapCursor.naming = false
+		mapCursor.message = addCursorCity(strings.TrimSpace(mapCursor.nameInput))
+	case tcell.KeyEscape:
+		mapCursor.naming = false
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if r := []rune(mapCursor.nameInput); len(r) > 0 {
+			mapCursor.nameInput = string(r[:len(r)-1])
+		}
+	case tcell.KeyRune:
+		if unicode.IsPrint(event.Rune()) {
+			mapCursor.nameInput += string(event.Rune())
+		}
+	}
+	return true // Swallow other keys while typing
+}
+
+// HandleMapCursorAction runs a bound action of the cursor key context.
+func HandleMapCursorAction(action string) bool {
+	switch action {
+	case "cursor.up":
+		MoveMapCursor(0, -1)
+	case "cursor.down":
+		MoveMapCursor(0, 1)
+	case "cursor.left":
+		MoveMapCursor(-1, 0)
+	case "cursor.right":
+		MoveMapCursor(1, 0)
+	case "cursor.add":
+		startCursorNaming()
+	case "cursor.exit":
+		ToggleMapCursor()
+	default:
+		return false
+	}
+	return true
+}
+
+// startCursorNaming prompts for the name of a city at the cursor.
+func startCursorNaming() {
+	mapCursor.naming = true
+	mapCursor.nameInput = ""
+	mapCursor.message = ""
+}
+
+// addCursorCity adds the location under the cursor as a city, shows it on
+// the map and saves it to the config. A city in a nautical zone, where no
+// zone boundary encloses the spot, is only added for this session, since
+// its zone is the longitude's rather than the place's. It returns a message
+// for the status bar.
+func addCursorCity(name string) string {
+	lat, lon := mapCursor.lat, mapCursor.lon
+	if name == "" {
+		name = formatLatLon(lat, lon)
+	}
+	if GetCityByName(name) != nil {
+		return fmt.Sprintf(T("A city named %q already exists"), name)
+	}
+
+	zone := timezoneAt(lat, lon)
+	custom := CustomCity{
+		Name:      name,
+		Timezone:  zone.Name,
+		Category:  "Custom",
+		Latitude:  lat,
+		Longitude: lon,
+	}
+	if zone.Nautical {
+		// Nautical zones are named Etc/GMT with the sign inverted
+		_, offset := time.Now().In(zone.Location).Zone()
+		custom.Timezone = "Etc/UTC"
+		if hours := offset / 3600; hours != 0 {
+			custom.Timezone = fmt.Sprintf("Etc/GMT%+d", -hours)
+		}
+		RegisterCustomCities([]CustomCity{custom})
+		return fmt.Sprintf(T("Added %s for this session only (nautical zone %s, not saved)"), name, zone.Name)
+	}
+	if city, _ := nearestCity(lat, lon); city != nil && city.Timezone == custom.Timezone {
+		custom.Category = city.Category
+	}
+
+	RegisterCustomCities([]CustomCity{custom})
+
+	config, err := LoadConfig()
+	if err != nil {
+		return fmt.Sprintf(T("Added %s (not saved: %v)"), name, err)
+	}
+	config.PutCustomCity(custom)
+	if err := SaveConfig(config); err != nil {
+		return fmt.Sprintf(T("Added %s (not saved: %v)"), name, err)
+	}
+	return fmt.Sprintf(T("Added %s (%s)"), name, custom.Timezone)
+}
+
+// RegisterCustomCities adds custom cities to the city database and to the
+// clock panels. Cities whose name is already taken are skipped.
+func RegisterCustomCities(cities []CustomCity) {
+	for _, c := range cities {
+		if GetCityByName(c.Name) != nil {
+			continue
+		}
+		city := City{
+			Name:        c.Name,
+			Timezone:    c.Timezone,
+			Country:     "Custom",
+			Category:    c.Category,
+			Coordinates: [2]float64{c.Latitude, c.Longitude},
+			Color:       customCityColor,
+		}
+		AllCities = append(AllCities, city)
+		addClockCity(city)
+	}
+}
+
+// mapCursorHelpGroup returns the help for the keys of the map cursor: its
+// bound actions, which come before the map's, and the naming prompt.
+func mapCursorHelpGroup() helpGroup {
+	group := keyHelpGroup(T("Map cursor"), keyContextCursor)
+	group.entries = append(group.entries, helpEntry{keys: T("Type, Enter"), help: T("Name a new city, then save it")})
+	return group
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"strings"
 	"time"
 )
@@ -18,72 +19,74 @@ func IsDayNightOverlayEnabled() bool {
 	return dayNightOverlayEnabled
 }
 
-// getDayPhaseForLocation determines the day phase for a specific longitude.
-// Returns: "day", "night", "dawn", "dusk", "morning", "evening"
-func getDayPhaseForLocation(t time.Time, longitude float64) string {
-	// Calculate local solar hour at this longitude
-	// Each 15 degrees = 1 hour time difference from UTC
-	utcHour := float64(t.UTC().Hour()) + float64(t.UTC().Minute())/60.0
-	localHour := utcHour + longitude/15.0
-	// Normalize to 0-24 range
-	for localHour < 0 {
-		localHour += 24
-	}
-	for localHour >= 24 {
-		localHour -= 24
-	}
-	intHour := int(localHour)
+// skyMarkersEnabled tracks whether the subsolar/sublunar markers are drawn
+var skyMarkersEnabled = false
 
-	switch {
-	case intHour >= 6 && intHour < 7:
-		return "dawn"
-	case intHour >= 7 && intHour < 8:
-		return "morning"
-	case intHour >= 8 && intHour < 17:
-		return "day"
-	case intHour >= 17 && intHour < 18:
-		return "dusk"
-	case intHour >= 18 && intHour < 20:
-		return "evening"
-	default:
-		return "night"
-	}
+// ToggleSkyMarkers toggles the subsolar and sublunar markers
+func ToggleSkyMarkers() {
+	skyMarkersEnabled = !skyMarkersEnabled
+}
+
+// IsSkyMarkersEnabled returns whether the subsolar/sublunar markers are drawn
+func IsSkyMarkersEnabled() bool {
+	return skyMarkersEnabled
+}
+
+// getDayPhaseForLocation determines the day phase at a latitude/longitude
+// from the sun's actual elevation there.
+// Returns: "day", "golden", "civil", "nautical", "astronomical", "night"
+func getDayPhaseForLocation(sun sunPosition, latitude, longitude float64) string {
+	return dayPhaseForElevation(sun.elevationAt(latitude, longitude))
 }
 
 // getColorForDayPhase returns the appropriate color tag for a day phase
 func getColorForDayPhase(phase string) string {
 	switch phase {
-	case "dawn":
-		return "#FFA500" // Amber for dawn
-	case "morning":
-		return "yellow" // Yellow for morning
 	case "day":
-		return "green" // Full bright green for day
-	case "dusk":
-		return "#CD853F" // Peru/dusk color
-	case "evening":
-		return "#6A5ACD" // Slate blue for evening
+		return themeColor(roleDay)
+	case "golden":
+		return themeColor(roleGolden) // Low sun just after sunrise / before sunset
+	case "civil":
+		return themeColor(roleCivil)
+	case "nautical":
+		return themeColor(roleNautical)
+	case "astronomical":
+		return themeColor(roleAstronomical)
 	case "night":
-		return "#2F4F4F" // Dark slate gray for night
+		return themeColor(roleNight)
 	default:
-		return "green"
+		return themeColor(roleLand)
 	}
 }
 
-// colorizeBrailleMapWithDayNight adds day/night coloring to the braille map.
-// Each longitude position represents a different local time.
-// brailleCols and brailleRows are the dimensions of the braille grid.
-func colorizeBrailleMapWithDayNight(brailleMap string, brailleCols, brailleRows int) string {
-	lines := strings.Split(strings.TrimRight(brailleMap, "\n"), "\n")
-
-	// Map dimensions: 2 * brailleCols x 4 * brailleRows (pixels)
-	// Longitude ranges from -180 to 180
+// dayPhaseTags returns the tags around a map cell in a day phase: its
+// colour, or on monochrome terminals bold for daylight and dim for night.
+// An empty phase is a cell off the map.
+func dayPhaseTags(phase string) (open, close string) {
+	if colorDepth == colorDepthMono {
+		switch phase {
+		case "day", "golden":
+			return "[::b]", "[::-]"
+		case "nautical", "astronomical", "night":
+			return "[::d]", "[::-]"
+		}
+		return "", ""
+	}
+	if phase == "" {
+		return "[" + themeColor(roleLand) + "]", "[-]"
+	}
+	return "[" + getColorForDayPhase(phase) + "]", "[-]"
+}
 
-	now := time.Now().UTC()
+// colorizeBrailleMapWithDayNight adds day/night coloring to the map.
+// Each map cell is coloured by the solar elevation at its centre, so the
+// terminator curves with latitude and season.
+// brailleCols and brailleRows are the dimensions of the braille grid showing vp.
+// Cells in taken hold markers, labels and overlays and keep their own colours.
+func colorizeBrailleMapWithDayNight(brailleMap string, brailleCols, brailleRows int, vp Viewport, taken *labelGrid) string {
+	lines := strings.Split(strings.TrimRight(brailleMap, "\n"), "\n")
 
-	// Apply day/night coloring
-	// Each braille column represents degreesPerCol longitude (360/brailleCols)
-	degreesPerCol := 360.0 / float64(brailleCols)
+	sun := computeSunPosition(time.Now())
 
 	for row := 0; row < len(lines); row++ {
 		line := lines[row]
@@ -110,25 +113,26 @@ func colorizeBrailleMapWithDayNight(brailleMap string, brailleCols, brailleRows
 				}
 			}
 
-			// Braille character - apply day/night color
-			if ch >= 0x2800 && ch <= 0x28FF {
-				longitude := -180.0 + float64(brailleCol)*degreesPerCol
-				phase := getDayPhaseForLocation(now, longitude)
-				color := getColorForDayPhase(phase)
-				newLine.WriteString("[")
-				newLine.WriteString(color)
-				newLine.WriteString("]")
+			// Map cell - apply day/night color
+			if taken.free(brailleCol, row) {
+				latitude, longitude, ok := vp.CellLatLon(brailleCol, row, brailleCols, brailleRows)
+				phase := ""
+				if ok {
+					phase = getDayPhaseForLocation(sun, latitude, longitude)
+				}
+				open, close := dayPhaseTags(phase)
+				newLine.WriteString(open)
 				newLine.WriteRune(ch)
-				newLine.WriteString("[-]")
+				newLine.WriteString(close)
 				brailleCol++
 				col++
 				continue
 			}
 
-			// Any other character (like city label letters) - pass through
-			// These replace braille chars, so they occupy a braille column position
+			// Overlay characters (like city label letters) - pass through
+			// These replace map cells, so they occupy as many columns as cells
 			newLine.WriteRune(ch)
-			brailleCol++
+			brailleCol += cellWidth(ch)
 			col++
 		}
 		lines[row] = newLine.String()
@@ -143,10 +147,98 @@ func colorizeBrailleMapWithDayNight(brailleMap string, brailleCols, brailleRows
 	return sb.String()
 }
 
+// overlaySkyMarkers plots the subsolar (☀) and sublunar (☾) points on the map
+// lines. Markers only replace free map cells, never city labels.
+func overlaySkyMarkers(lines []string, taken *labelGrid, t time.Time, brailleCols, brailleRows int, vp Viewport) {
+	sun := computeSunPosition(t)
+	moon := computeMoonPosition(t)
+
+	markers := []struct {
+		lat, lon float64
+		text     string
+	}{
+		{moon.declination, moon.sublunarLon, fmt.Sprintf("[%s::b]%s[-:-:-]", themeColor(roleMoon), MapGlyphs().moon)},
+		{sun.declination, sun.subsolarLon, fmt.Sprintf("[%s::b]%s[-:-:-]", themeColor(roleSun), MapGlyphs().sun)},
+	}
+	for _, m := range markers {
+		col, row, ok := LatLonToBraille(m.lat, m.lon, brailleCols, brailleRows, vp)
+		if ok {
+			overlayCell(lines, taken, col, row, m.text)
+		}
+	}
+}
+
+// overlayCell draws text over a free map cell and marks the cell taken.
+// Cells already holding a marker, label or other overlay are left alone.
+func overlayCell(lines []string, taken *labelGrid, col, row int, text string) {
+	if row >= len(lines) || !taken.free(col, row) {
+		return
+	}
+	lines[row] = replaceMapCell(lines[row], col, text)
+	taken.take(col, row)
+}
+
+// replaceMapCell replaces the cell at a visible column of a tagged line
+// with text. The line is unchanged if it is too short.
+func replaceMapCell(line string, col int, text string) string {
+	runes := []rune(line)
+	visible := 0
+	for i := 0; i < len(runes); i++ {
+		// Skip over tview color/style tags
+		if runes[i] == '[' {
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end < len(runes) {
+				i = end
+				continue
+			}
+		}
+		if visible == col {
+			return string(runes[:i]) + text + string(runes[i+1:])
+		}
+		visible += cellWidth(runes[i])
+	}
+	return line
+}
+
+// formatMoonStatus returns the moon phase and illumination for the status bar.
+func formatMoonStatus(t time.Time) string {
+	phase := computeMoonPhase(t)
+	return fmt.Sprintf("[%s]%s %s %d%%[-]", themeColor(roleMoon), MapGlyphs().moon, T(phase.Name), int(phase.Illumination*100+0.5))
+}
+
+// formatSunriseSunset returns a compact sunrise/sunset summary for clock lists.
+func formatSunriseSunset(st sunTimes) string {
+	switch {
+	case st.AlwaysUp:
+		return "[yellow]" + T("midnight sun") + "[-]"
+	case st.AlwaysDown:
+		return "[" + themeColor(roleNight) + "]" + T("polar night") + "[-]"
+	}
+	return fmt.Sprintf("[yellow]↑%s [orange]↓%s[-]", formatSunEvent(st.Sunrise), formatSunEvent(st.Sunset))
+}
+
+// formatSunEvent formats a sun event as a time of day, or "--:--" if it
+// does not occur.
+func formatSunEvent(t time.Time) string {
+	if t.IsZero() {
+		return "--:--"
+	}
+	return strings.TrimSpace(FormatCompactClock(t))
+}
+
+// formatDayLength formats a day length as hours and minutes (e.g., "10h 21m").
+func formatDayLength(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%dh %02dm", int(d.Hours()), int(d.Minutes())%60)
+}
+
 // GetDayNightStatus returns the current day/night overlay status text for the header
 func GetDayNightStatus() string {
 	if dayNightOverlayEnabled {
-		return "[yellow]Day/Night ON[white]"
+		return "[yellow]" + T("Day/Night ON") + "[white]"
 	}
-	return "[darkgray]Day/Night OFF[white]"
+	return "[darkgray]" + T("Day/Night OFF") + "[white]"
 }
@@ -0,0 +1,331 @@
+//go:build ignore
+
+// gen_coastlines builds coastline_data.go, the simplified land polygons that
+// are embedded in the binary and rasterised at run time.
+//
+// The source is Natural Earth polygons as GeoJSON. go generate uses the
+// 1:110m countries checked in under data/, whose union is the land. For
+// finer coastlines, download ne_50m_land.geojson for the world-scale levels
+// and, optionally, ne_10m_land.geojson for the levels used when zoomed in,
+// from https://github.com/nvkelso/natural-earth-vector/tree/master/geojson:
+//
+//	go run gen_coastlines.go gen_geometry.go -geojson ne_50m_land.geojson [-geojson-detail ne_10m_land.geojson] [-o coastline_data.go]
+//
+// Levels that simplify to the same polygons as the next finer level are
+// left out, so coarse sources do not embed the same data several times.
+//
+// With -bitmap it traces the land bitmap in mapdata.go instead, for a build
+// without the downloaded data. The bitmap is 0.75° per pixel, so its
+// outlines are staircases and small islands that host cities are added as
+// octagons from extraIslands.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// detailLevels are the Douglas-Peucker tolerances, in degrees, for each
+// embedded level of detail (finest first).
+var detailLevels = []float64{0.05, 0.1, 0.25, 0.5, 1, 2}
+
+// detailedBelow is the tolerance below which levels are built from the
+// -geojson-detail source, when one is given.
+const detailedBelow = 0.25
+
+// bitmapLevels are the tolerances used with -bitmap, which has no detail
+// finer than its 0.75° pixels.
+var bitmapLevels = []float64{0.75, 1.5, 3, 6}
+
+// extraIslands are islands drawn as small octagons with -bitmap: name,
+// latitude, longitude, and half-height/half-width in degrees.
+var extraIslands = []struct {
+	name       string
+	lat, lon   float64
+	rLat, rLon float64
+}{
+	{"Singapore", 1.35, 103.82, 0.12, 0.2},
+	{"Oahu", 21.47, -157.98, 0.22, 0.3},
+	{"Hawaii", 19.6, -155.5, 0.7, 0.6},
+	{"Maui", 20.8, -156.3, 0.2, 0.3},
+	{"Kauai", 22.05, -159.5, 0.15, 0.2},
+	{"Mauritius", -20.25, 57.55, 0.2, 0.2},
+	{"Reunion", -21.1, 55.5, 0.2, 0.2},
+	{"Malta", 35.9, 14.4, 0.1, 0.2},
+	{"Bahrain", 26.05, 50.55, 0.12, 0.08},
+	{"Tahiti", -17.65, -149.45, 0.15, 0.2},
+	{"Viti Levu", -17.8, 178.0, 0.3, 0.4},
+	{"Upolu", -13.9, -171.75, 0.1, 0.3},
+	{"Bermuda", 32.3, -64.75, 0.05, 0.08},
+	{"Guam", 13.45, 144.78, 0.15, 0.1},
+	{"Santiago", 15.1, -23.6, 0.15, 0.15},
+	{"Tenerife", 28.27, -16.6, 0.15, 0.2},
+	{"Gran Canaria", 27.95, -15.6, 0.15, 0.15},
+	{"Mallorca", 39.6, 2.95, 0.25, 0.4},
+	{"Barbados", 13.17, -59.55, 0.1, 0.08},
+	{"Zanzibar", -6.15, 39.3, 0.3, 0.15},
+	{"Sao Miguel", 37.78, -25.5, 0.08, 0.3},
+	{"Male", 4.17, 73.5, 0.1, 0.1},
+	{"Mahe", -4.68, 55.48, 0.1, 0.08},
+}
+
+// corner is a pixel corner in the bitmap, with y pointing down.
+type corner struct{ x, y int }
+
+// dataLevel is one level of detail to write: its tolerance, the rings to
+// simplify, and rings written as they are.
+type dataLevel struct {
+	tolerance float64
+	rings     [][]point
+	islands   [][]point
+}
+
+func main() {
+	landPath := flag.String("geojson", "", "land polygons as GeoJSON, e.g. ne_50m_land.geojson (required unless -bitmap)")
+	detailPath := flag.String("geojson-detail", "", "finer land polygons for zoomed-in levels, e.g. ne_10m_land.geojson")
+	bitmap := flag.Bool("bitmap", false, "trace the land bitmap in mapdata.go instead of GeoJSON")
+	outPath := flag.String("o", "coastline_data.go", "output Go file")
+	flag.Parse()
+
+	var levels []dataLevel
+	var source string
+	switch {
+	case *bitmap:
+		rings, err := traceBitmap("mapdata.go")
+		if err != nil {
+			log.Fatal(err)
+		}
+		var islands [][]point
+		for _, island := range extraIslands {
+			islands = append(islands, octagon(island.lat, island.lon, island.rLat, island.rLon))
+		}
+		for _, tol := range bitmapLevels {
+			levels = append(levels, dataLevel{tolerance: tol, rings: rings, islands: islands})
+		}
+		source = "mapdata.go bitmap"
+	case *landPath != "":
+		land, err := loadLand(*landPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		detail := land
+		source = filepath.Base(*landPath)
+		if *detailPath != "" {
+			if detail, err = loadLand(*detailPath); err != nil {
+				log.Fatal(err)
+			}
+			source = filepath.Base(*detailPath) + ", " + source
+		}
+		for _, tol := range detailLevels {
+			rings := land
+			if tol < detailedBelow {
+				rings = detail
+			}
+			levels = append(levels, dataLevel{tolerance: tol, rings: rings})
+		}
+	default:
+		log.Fatal("-geojson is required: pass data/ne_110m_admin_0_countries.geojson or a Natural Earth land file, or -bitmap")
+	}
+
+	if err := writeData(*outPath, source, levels); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadLand reads the rings of every land polygon in a GeoJSON file.
+func loadLand(path string) ([][]point, error) {
+	features, err := loadGeoJSON(path)
+	if err != nil {
+		return nil, err
+	}
+	var rings [][]point
+	for _, f := range features {
+		rings = append(rings, f.Rings()...)
+	}
+	return rings, nil
+}
+
+// traceBitmap reads WorldBitmap from mapdata.go and traces the outline of
+// every land region (and lake) into closed rings.
+func traceBitmap(path string) ([][]point, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open bitmap source: %w", err)
+	}
+	defer f.Close()
+
+	rowPattern := regexp.MustCompile(`^\s*"([01]+)"`)
+	var rows []string
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 1<<20), 1<<20)
+	for sc.Scan() {
+		if m := rowPattern.FindStringSubmatch(sc.Text()); m != nil {
+			rows = append(rows, m[1])
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read bitmap source: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no bitmap rows found in %s", path)
+	}
+	w, h := len(rows[0]), len(rows)
+
+	land := func(x, y int) bool {
+		return x >= 0 && y >= 0 && y < h && x < len(rows[y]) && rows[y][x] == '1'
+	}
+
+	// Directed boundary edges between pixel corners, clockwise on screen
+	// around land, so land is always on the right of travel
+	out := make(map[corner][]corner)
+	addEdge := func(a, b corner) { out[a] = append(out[a], b) }
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if !land(x, y) {
+				continue
+			}
+			if !land(x, y-1) {
+				addEdge(corner{x, y}, corner{x + 1, y})
+			}
+			if !land(x+1, y) {
+				addEdge(corner{x + 1, y}, corner{x + 1, y + 1})
+			}
+			if !land(x, y+1) {
+				addEdge(corner{x + 1, y + 1}, corner{x, y + 1})
+			}
+			if !land(x-1, y) {
+				addEdge(corner{x, y + 1}, corner{x, y})
+			}
+		}
+	}
+
+	// Sort start corners so output is deterministic
+	starts := make([]corner, 0, len(out))
+	for c := range out {
+		starts = append(starts, c)
+	}
+	sort.Slice(starts, func(i, j int) bool {
+		if starts[i].y != starts[j].y {
+			return starts[i].y < starts[j].y
+		}
+		return starts[i].x < starts[j].x
+	})
+
+	var rings [][]point
+	for _, start := range starts {
+		for len(out[start]) > 0 {
+			var ring []corner
+			cur := start
+			dx, dy := 0, 0
+			for {
+				next := takeEdge(out, cur, dx, dy)
+				ring = append(ring, cur)
+				dx, dy = next.x-cur.x, next.y-cur.y
+				cur = next
+				if cur == start {
+					break
+				}
+			}
+
+			// Drop corners where the outline runs straight on
+			var pts []point
+			n := len(ring)
+			for i, c := range ring {
+				prev, next := ring[(i+n-1)%n], ring[(i+1)%n]
+				if (c.x-prev.x)*(next.y-c.y) == (c.y-prev.y)*(next.x-c.x) {
+					continue
+				}
+				pts = append(pts, point{
+					lon: float64(c.x)/float64(w)*360 - 180,
+					lat: 90 - float64(c.y)/float64(h)*180,
+				})
+			}
+			if len(pts) >= 3 {
+				// Screen clockwise is clockwise in lon/lat too once y is
+				// flipped; reverse so exteriors are counterclockwise
+				reverse(pts)
+				rings = append(rings, pts)
+			}
+		}
+	}
+	return rings, nil
+}
+
+// takeEdge removes and returns the next corner from cur. Where two edges
+// leave the same corner (land touching diagonally), it turns right to keep
+// the two regions separate.
+func takeEdge(out map[corner][]corner, cur corner, dx, dy int) corner {
+	edges := out[cur]
+	pick := 0
+	if len(edges) > 1 {
+		rx, ry := -dy, dx // Clockwise turn on screen
+		for i, e := range edges {
+			if e.x-cur.x == rx && e.y-cur.y == ry {
+				pick = i
+				break
+			}
+		}
+	}
+	next := edges[pick]
+	out[cur] = append(edges[:pick], edges[pick+1:]...)
+	return next
+}
+
+// octagon returns a counterclockwise octagon around a point.
+func octagon(lat, lon, rLat, rLon float64) []point {
+	pts := make([]point, 8)
+	for i := range pts {
+		a := float64(i) * math.Pi / 4
+		pts[i] = point{lon: lon + rLon*math.Cos(a), lat: lat + rLat*math.Sin(a)}
+	}
+	return pts
+}
+
+// writeData writes the simplified rings at every detail level as Go source.
+// Islands are written unsimplified since they are already tiny.
+func writeData(path, source string, levels []dataLevel) error {
+	var b strings.Builder
+	b.WriteString("// Code generated by gen_coastlines.go; DO NOT EDIT.\n\n")
+	b.WriteString("package main\n\n")
+	fmt.Fprintf(&b, "// coastlineSource is the data the coastlines were generated from.\n")
+	fmt.Fprintf(&b, "const coastlineSource = %q\n\n", source)
+	b.WriteString("// coastlineLevels holds land polygons from finest to coarsest detail. Each\n")
+	b.WriteString("// polygon is a flat list of (lon, lat) pairs in hundredths of a degree.\n")
+	b.WriteString("var coastlineLevels = []coastlineLevel{\n")
+
+	finer := ""
+	for _, level := range levels {
+		var polys strings.Builder
+		for _, ring := range level.rings {
+			simple := simplifyRing(ring, level.tolerance)
+			if len(simple) < 3 {
+				simple = ring
+			}
+			writeRing(&polys, simple)
+		}
+		for _, island := range level.islands {
+			writeRing(&polys, island)
+		}
+		if polys.String() == finer {
+			continue
+		}
+		finer = polys.String()
+		fmt.Fprintf(&b, "\t{Tolerance: %g, Polygons: [][]int16{\n", level.tolerance)
+		b.WriteString(finer)
+		b.WriteString("\t}},\n")
+	}
+	b.WriteString("}\n")
+
+	if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
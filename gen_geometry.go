@@ -0,0 +1,203 @@
+//go:build ignore
+
+// gen_geometry holds the GeoJSON reading and polygon simplification shared
+// by the data generators. It is built together with each of them:
+//
+//	go run gen_coastlines.go gen_geometry.go
+//	go run gen_timezones.go gen_geometry.go
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+// point is a (lon, lat) pair in degrees.
+type point struct{ lon, lat float64 }
+
+// geoFeature is one GeoJSON feature: its properties and polygons. Each
+// polygon is its exterior ring followed by its holes, with exteriors
+// counterclockwise and holes clockwise.
+type geoFeature struct {
+	Properties map[string]any
+	Polygons   [][][]point
+}
+
+// Rings returns the rings of all the feature's polygons.
+func (f geoFeature) Rings() [][]point {
+	var rings [][]point
+	for _, poly := range f.Polygons {
+		rings = append(rings, poly...)
+	}
+	return rings
+}
+
+// loadGeoJSON reads the Polygon and MultiPolygon features of a GeoJSON
+// FeatureCollection, Feature or bare geometry.
+func loadGeoJSON(path string) ([]geoFeature, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read geojson: %w", err)
+	}
+
+	type geometry struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	type feature struct {
+		Properties map[string]any `json:"properties"`
+		Geometry   *geometry      `json:"geometry"`
+	}
+	var doc struct {
+		Type string `json:"type"`
+		feature
+		Coordinates json.RawMessage `json:"coordinates"`
+		Features    []feature       `json:"features"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse geojson: %w", err)
+	}
+
+	var features []feature
+	switch doc.Type {
+	case "FeatureCollection":
+		features = doc.Features
+	case "Feature":
+		features = append(features, doc.feature)
+	default:
+		features = append(features, feature{Geometry: &geometry{Type: doc.Type, Coordinates: doc.Coordinates}})
+	}
+
+	var out []geoFeature
+	for _, f := range features {
+		if f.Geometry == nil {
+			continue
+		}
+		gf := geoFeature{Properties: f.Properties}
+		addPolygon := func(poly [][][2]float64) {
+			var rings [][]point
+			for i, r := range poly {
+				ring := make([]point, 0, len(r))
+				for _, c := range r {
+					ring = append(ring, point{lon: c[0], lat: c[1]})
+				}
+				if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+					ring = ring[:len(ring)-1]
+				}
+				// Exteriors counterclockwise, holes clockwise, for nonzero filling
+				if (signedArea(ring) > 0) != (i == 0) {
+					reverse(ring)
+				}
+				rings = append(rings, ring)
+			}
+			if len(rings) > 0 {
+				gf.Polygons = append(gf.Polygons, rings)
+			}
+		}
+		switch f.Geometry.Type {
+		case "Polygon":
+			var poly [][][2]float64
+			if err := json.Unmarshal(f.Geometry.Coordinates, &poly); err != nil {
+				return nil, fmt.Errorf("parse polygon: %w", err)
+			}
+			addPolygon(poly)
+		case "MultiPolygon":
+			var multi [][][][2]float64
+			if err := json.Unmarshal(f.Geometry.Coordinates, &multi); err != nil {
+				return nil, fmt.Errorf("parse multipolygon: %w", err)
+			}
+			for _, poly := range multi {
+				addPolygon(poly)
+			}
+		}
+		if len(gf.Polygons) > 0 {
+			out = append(out, gf)
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no polygons found in %s", path)
+	}
+	return out, nil
+}
+
+// signedArea returns the ring's area in square degrees, positive when counterclockwise.
+func signedArea(ring []point) float64 {
+	var a float64
+	for i := range ring {
+		p, q := ring[i], ring[(i+1)%len(ring)]
+		a += p.lon*q.lat - q.lon*p.lat
+	}
+	return a / 2
+}
+
+// reverse reverses a ring in place.
+func reverse(ring []point) {
+	for i, j := 0, len(ring)-1; i < j; i, j = i+1, j-1 {
+		ring[i], ring[j] = ring[j], ring[i]
+	}
+}
+
+// simplifyRing applies Douglas-Peucker to a closed ring, splitting it at the
+// vertex farthest from the first so both halves keep their end points.
+func simplifyRing(ring []point, tolerance float64) []point {
+	if len(ring) <= 3 {
+		return ring
+	}
+	far, farDist := 0, -1.0
+	for i, p := range ring {
+		d := math.Hypot(p.lon-ring[0].lon, p.lat-ring[0].lat)
+		if d > farDist {
+			far, farDist = i, d
+		}
+	}
+	closed := append(append([]point{}, ring...), ring[0])
+	first := simplifyLine(closed[:far+1], tolerance)
+	second := simplifyLine(closed[far:], tolerance)
+	return append(first, second[1:len(second)-1]...)
+}
+
+// simplifyLine applies Douglas-Peucker to an open polyline.
+func simplifyLine(pts []point, tolerance float64) []point {
+	if len(pts) <= 2 {
+		return pts
+	}
+	a, b := pts[0], pts[len(pts)-1]
+	idx, maxDist := 0, 0.0
+	for i := 1; i < len(pts)-1; i++ {
+		if d := segmentDistance(pts[i], a, b); d > maxDist {
+			idx, maxDist = i, d
+		}
+	}
+	if maxDist <= tolerance {
+		return []point{a, b}
+	}
+	left := simplifyLine(pts[:idx+1], tolerance)
+	right := simplifyLine(pts[idx:], tolerance)
+	return append(left[:len(left)-1], right...)
+}
+
+// segmentDistance returns the distance from p to the segment a-b.
+func segmentDistance(p, a, b point) float64 {
+	dx, dy := b.lon-a.lon, b.lat-a.lat
+	if dx == 0 && dy == 0 {
+		return math.Hypot(p.lon-a.lon, p.lat-a.lat)
+	}
+	t := ((p.lon-a.lon)*dx + (p.lat-a.lat)*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(p.lon-(a.lon+t*dx), p.lat-(a.lat+t*dy))
+}
+
+// writeRing writes one ring as a line of fixed-point (lon, lat) pairs.
+func writeRing(b *strings.Builder, ring []point) {
+	b.WriteString("\t\t{")
+	for i, p := range ring {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(b, "%d, %d", int(math.Round(p.lon*100)), int(math.Round(p.lat*100)))
+	}
+	b.WriteString("},\n")
+}
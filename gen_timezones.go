@@ -0,0 +1,368 @@
+//go:build ignore
+
+// gen_timezones builds timezone_data.go, the simplified timezone boundary
+// polygons that are embedded in the binary to look up the zone at a point
+// on the map.
+//
+// The exact source is timezone-boundary-builder's GeoJSON release without
+// oceans (timezones.geojson.zip, which unpacks to combined.json), from
+// https://github.com/evansiroky/timezone-boundary-builder/releases. Each
+// feature is one IANA zone, named by its tzid property:
+//
+//	go run gen_timezones.go gen_geometry.go -geojson combined.json [-o timezone_data.go]
+//
+// Without it, go generate builds the zones from the Natural Earth country
+// borders and the tz database's zone.tab checked in under data/. A country
+// with one zone keeps its borders exactly. A country with several is split
+// between them by the nearest zone.tab location, so boundaries inside it
+// are only approximate:
+//
+//	go run gen_timezones.go gen_geometry.go -countries data/ne_110m_admin_0_countries.geojson -zonetab data/zone.tab
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// countryCodes maps the Natural Earth countries without an ISO code to the
+// zone.tab country whose zones they use.
+var countryCodes = map[string]string{
+	"CYN": "CY", // Northern Cyprus
+	"KOS": "RS", // Kosovo
+	"SOL": "SO", // Somaliland
+}
+
+// zoneRef is a zone.tab entry: a zone and the country and principal
+// location it is listed for.
+type zoneRef struct {
+	country string
+	zone    string
+	at      point
+}
+
+func main() {
+	geojsonPath := flag.String("geojson", "", "timezone polygons as GeoJSON, e.g. combined.json")
+	countriesPath := flag.String("countries", "", "country polygons as GeoJSON with iso_a2 properties, used without -geojson")
+	zoneTabPath := flag.String("zonetab", "", "the tz database's zone.tab, used with -countries")
+	outPath := flag.String("o", "timezone_data.go", "output Go file")
+	tolerance := flag.Float64("tolerance", 0.05, "simplification tolerance in degrees")
+	flag.Parse()
+
+	var zones map[string][][]point
+	var source, note string
+	var err error
+	switch {
+	case *geojsonPath != "":
+		zones, err = loadZonePolygons(*geojsonPath)
+		source = filepath.Base(*geojsonPath)
+	case *countriesPath != "" && *zoneTabPath != "":
+		zones, err = splitCountries(*countriesPath, *zoneTabPath)
+		source = filepath.Base(*countriesPath) + ", " + filepath.Base(*zoneTabPath)
+		note = "Zones inside countries with several are split by the nearest zone.tab\n// location, so boundaries inside those countries are approximate."
+	default:
+		log.Fatal("pass -geojson with timezone-boundary-builder's combined.json, or -countries and -zonetab")
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeData(*outPath, source, note, zones, *tolerance); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadZonePolygons reads timezone-boundary-builder's zones, named by their
+// tzid property.
+func loadZonePolygons(path string) (map[string][][]point, error) {
+	features, err := loadGeoJSON(path)
+	if err != nil {
+		return nil, err
+	}
+	zones := make(map[string][][]point)
+	for _, f := range features {
+		tzid, _ := f.Properties["tzid"].(string)
+		if tzid == "" {
+			continue
+		}
+		zones[tzid] = append(zones[tzid], f.Rings()...)
+	}
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("no features with a tzid property in %s", path)
+	}
+	return zones, nil
+}
+
+// loadZoneTab reads the zones and their locations from zone.tab.
+func loadZoneTab(path string) ([]zoneRef, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open zone.tab: %w", err)
+	}
+	defer f.Close()
+
+	var refs []zoneRef
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("zone.tab: malformed line %q", line)
+		}
+		at, err := parseISO6709(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("zone.tab: %s: %w", fields[2], err)
+		}
+		refs = append(refs, zoneRef{country: fields[0], zone: fields[2], at: at})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read zone.tab: %w", err)
+	}
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("no zones found in %s", path)
+	}
+	return refs, nil
+}
+
+// parseISO6709 parses zone.tab's ±DDMM±DDDMM or ±DDMMSS±DDDMMSS positions.
+func parseISO6709(s string) (point, error) {
+	split := strings.IndexAny(s[1:], "+-") + 1
+	if split == 0 {
+		return point{}, fmt.Errorf("bad position %q", s)
+	}
+	lat, err := parseDMS(s[:split], 2)
+	if err != nil {
+		return point{}, err
+	}
+	lon, err := parseDMS(s[split:], 3)
+	if err != nil {
+		return point{}, err
+	}
+	return point{lon: lon, lat: lat}, nil
+}
+
+// parseDMS parses a signed angle of degDigits degree digits followed by
+// minutes and, optionally, seconds.
+func parseDMS(s string, degDigits int) (float64, error) {
+	digits := s[1:]
+	if len(digits) != degDigits+2 && len(digits) != degDigits+4 {
+		return 0, fmt.Errorf("bad angle %q", s)
+	}
+	var parts []float64
+	for _, part := range []string{digits[:degDigits], digits[degDigits : degDigits+2], digits[degDigits+2:]} {
+		if part == "" {
+			parts = append(parts, 0)
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("bad angle %q", s)
+		}
+		parts = append(parts, float64(n))
+	}
+	angle := parts[0] + parts[1]/60 + parts[2]/3600
+	if s[0] == '-' {
+		angle = -angle
+	}
+	return angle, nil
+}
+
+// splitCountries builds zones from country polygons. Each piece of a
+// country goes to the zones whose zone.tab location it contains, preferring
+// the country's own; a piece with none goes to all of the country's zones.
+// A piece with several zones is split between them by nearest location.
+func splitCountries(countriesPath, zoneTabPath string) (map[string][][]point, error) {
+	refs, err := loadZoneTab(zoneTabPath)
+	if err != nil {
+		return nil, err
+	}
+	features, err := loadGeoJSON(countriesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := make(map[string][][]point)
+	for _, f := range features {
+		code, _ := f.Properties["iso_a2"].(string)
+		if a3, _ := f.Properties["adm0_a3"].(string); countryCodes[a3] != "" {
+			code = countryCodes[a3]
+		}
+		var own []zoneRef
+		for _, r := range refs {
+			if r.country == code {
+				own = append(own, r)
+			}
+		}
+
+		for _, poly := range f.Polygons {
+			var inside, ownInside []zoneRef
+			for _, r := range refs {
+				if polygonContains(poly, r.at) {
+					inside = append(inside, r)
+					if r.country == code {
+						ownInside = append(ownInside, r)
+					}
+				}
+			}
+			candidates := ownInside
+			if len(candidates) == 0 {
+				candidates = inside
+			}
+			if len(candidates) == 0 {
+				candidates = own
+			}
+			if len(candidates) == 0 {
+				name, _ := f.Properties["name"].(string)
+				return nil, fmt.Errorf("no zone.tab zones for %s (%s)", name, code)
+			}
+			for zone, rings := range splitPolygon(poly, candidates) {
+				zones[zone] = append(zones[zone], rings...)
+			}
+		}
+	}
+	return zones, nil
+}
+
+// polygonContains reports whether a point is inside a polygon's exterior
+// and outside its holes.
+func polygonContains(poly [][]point, p point) bool {
+	inside := false
+	for _, ring := range poly {
+		if ringContains(ring, p) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// ringContains reports whether a point is inside a ring (even-odd rule).
+func ringContains(ring []point, p point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.lat > p.lat) != (b.lat > p.lat) && p.lon < a.lon+(p.lat-a.lat)*(b.lon-a.lon)/(b.lat-a.lat) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// splitPolygon divides a polygon between zones by nearest location,
+// clipping its rings to each location's Voronoi cell. Distances are
+// measured with longitudes scaled to the polygon's middle latitude and
+// wrapped to the polygon's side of the antimeridian.
+func splitPolygon(poly [][]point, refs []zoneRef) map[string][][]point {
+	out := make(map[string][][]point)
+	if len(refs) == 1 {
+		out[refs[0].zone] = poly
+		return out
+	}
+
+	minLon, maxLon := math.Inf(1), math.Inf(-1)
+	minLat, maxLat := math.Inf(1), math.Inf(-1)
+	for _, p := range poly[0] {
+		minLon, maxLon = math.Min(minLon, p.lon), math.Max(maxLon, p.lon)
+		minLat, maxLat = math.Min(minLat, p.lat), math.Max(maxLat, p.lat)
+	}
+	midLon := (minLon + maxLon) / 2
+	scale := math.Max(0.2, math.Cos((minLat+maxLat)/2*math.Pi/180))
+	sites := make([]point, len(refs))
+	for i, r := range refs {
+		lon := midLon + math.Mod(math.Mod(r.at.lon-midLon+180, 360)+360, 360) - 180
+		sites[i] = point{lon: lon * scale, lat: r.at.lat}
+	}
+
+	for i, r := range refs {
+		for _, ring := range poly {
+			cell := make([]point, len(ring))
+			for k, p := range ring {
+				cell[k] = point{lon: p.lon * scale, lat: p.lat}
+			}
+			for j := range sites {
+				if j != i && sites[j] != sites[i] {
+					cell = clipNearer(cell, sites[i], sites[j])
+				}
+			}
+			if len(cell) < 3 {
+				continue
+			}
+			for k := range cell {
+				cell[k].lon /= scale
+			}
+			out[r.zone] = append(out[r.zone], cell)
+		}
+	}
+	return out
+}
+
+// clipNearer clips a ring to the half-plane of points nearer to a than to b
+// (Sutherland-Hodgman).
+func clipNearer(ring []point, a, b point) []point {
+	mid := point{lon: (a.lon + b.lon) / 2, lat: (a.lat + b.lat) / 2}
+	dir := point{lon: b.lon - a.lon, lat: b.lat - a.lat}
+	side := func(p point) float64 { return (p.lon-mid.lon)*dir.lon + (p.lat-mid.lat)*dir.lat }
+
+	var out []point
+	for i := range ring {
+		p, q := ring[i], ring[(i+1)%len(ring)]
+		sp, sq := side(p), side(q)
+		if sp <= 0 {
+			out = append(out, p)
+		}
+		if (sp < 0) != (sq < 0) && sp != sq {
+			t := sp / (sp - sq)
+			out = append(out, point{lon: p.lon + t*(q.lon-p.lon), lat: p.lat + t*(q.lat-p.lat)})
+		}
+	}
+	return out
+}
+
+// writeData writes every zone's simplified rings as Go source, sorted by
+// zone name so the output is deterministic.
+func writeData(path, source, note string, zones map[string][][]point, tolerance float64) error {
+	names := make([]string, 0, len(zones))
+	for name := range zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("// Code generated by gen_timezones.go; DO NOT EDIT.\n\n")
+	b.WriteString("package main\n\n")
+	b.WriteString("// timezoneSource is the data the timezone boundaries were generated from.\n")
+	if note != "" {
+		fmt.Fprintf(&b, "// %s\n", note)
+	}
+	fmt.Fprintf(&b, "const timezoneSource = %q\n\n", source)
+	b.WriteString("// timezoneShapes holds each zone's boundary rings, simplified to\n")
+	fmt.Fprintf(&b, "// %g degrees. A point is in a zone if an odd number of its rings enclose it.\n", tolerance)
+	b.WriteString("var timezoneShapes = []timezoneShape{\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "\t{Zone: %q, Rings: [][]int16{\n", name)
+		for _, ring := range zones[name] {
+			simple := simplifyRing(ring, tolerance)
+			if len(simple) < 3 {
+				simple = ring
+			}
+			writeRing(&b, simple)
+		}
+		b.WriteString("\t}},\n")
+	}
+	b.WriteString("}\n")
+
+	if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// helpEntry is a line of the help overlay: keys and what they do.
+type helpEntry struct {
+	keys   string // As shown, e.g. "0-9"; already escaped for tview
+	help   string
+	action string // Key registry action the keys are bound to, if any
+}
+
+// helpGroup is a titled group of help entries.
+type helpGroup struct {
+	title   string
+	entries []helpEntry
+}
+
+// keyHelpGroup returns a help group listing every action of a key context
+// with its current keys, so the help always matches the bindings.
+func keyHelpGroup(title, context string) helpGroup {
+	group := helpGroup{title: title}
+	for _, action := range KeyContextActions(context) {
+		keys := KeyLabels(action)
+		if len(keys) == 0 {
+			continue
+		}
+		group.entries = append(group.entries, helpEntry{
+			keys:   strings.Join(keys, "  "),
+			help:   keyActionHelp(action),
+			action: action,
+		})
+	}
+	return group
+}
+
+// helpOverlay is the state of the full-screen help overlay.
+type helpOverlay struct {
+	title    string
+	groups   []helpGroup
+	query    string
+	scroll   int
+	lines    int          // Lines of the last render, for scrolling
+	returnTo OverlayState // Overlay shown before the help
+	view     *tview.TextView
+}
+
+// matches reports whether an entry matches the search query.
+func (h *helpOverlay) matches(group helpGroup, entry helpEntry) bool {
+	query := strings.ToLower(strings.TrimSpace(h.query))
+	if query == "" {
+		return true
+	}
+	for _, text := range []string{group.title, entry.keys, entry.help, entry.action} {
+		if strings.Contains(strings.ToLower(text), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShowHelp opens the help overlay with every binding of the current
+// screen: the map, the menu or the open feature.
+func (om *OverlayManager) ShowHelp() {
+	h := &helpOverlay{returnTo: om.state}
+	switch om.state {
+	case OverlayNone:
+		h.title = T("Map")
+		h.groups = []helpGroup{
+			keyHelpGroup(T("Map"), keyContextMap),
+			mapCursorHelpGroup(),
+			paletteHelpGroup(),
+			mapMouseHelpGroup(),
+		}
+	case OverlayMenu:
+		h.title = T("Menu")
+		h.groups = []helpGroup{
+			keyHelpGroup(T("Menu"), keyContextMenu),
+			{title: T("Mouse"), entries: []helpEntry{
+				{keys: T("Click"), help: T("Open the feature")},
+				{keys: T("Wheel"), help: T("Move the selection")},
+			}},
+		}
+	case OverlayFeature:
+		h.title = T(modeNames[om.activeFeature])
+		h.groups = append(om.mm.HelpGroups(), helpGroup{title: T("Window"), entries: []helpEntry{
+			{keys: "Esc", help: T("Back out of a sub-view, then return to the menu")},
+		}})
+	default:
+		return
+	}
+	om.help = h
+	om.state = OverlayHelp
+	om.renderHelp()
+}
+
+// renderHelp draws the help overlay over the whole screen: a search line,
+// then the groups with their matching entries.
+func (om *OverlayManager) renderHelp() {
+	h := om.help
+	view := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(false)
+	view.SetBorder(true).
+		SetTitle(fmt.Sprintf("[ %s: %s ]", T("Help"), h.title)).
+		SetTitleAlign(tview.AlignCenter).
+		SetBorderPadding(1, 1, 2, 2)
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("[yellow::b]%s[-::-] %s[::b]_[::-]   [darkgray]%s  %s  Esc=%s[-]\n",
+		T("Search:"), tview.Escape(h.query), T("Type to filter"), T("↑/↓ PgUp/PgDn=Scroll"), T("Close")))
+	lines := 1
+	for _, group := range h.groups {
+		var entries []helpEntry
+		for _, entry := range group.entries {
+			if h.matches(group, entry) {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("\n[yellow::b]%s[-::-]\n", group.title))
+		lines += 2
+		for _, entry := range entries {
+			keys := entry.keys
+			if pad := 24 - tview.TaggedStringWidth(keys); pad > 0 {
+				keys += strings.Repeat(" ", pad)
+			}
+			line := fmt.Sprintf("  [white]%s[-] %s", keys, padTagged(entry.help, 28))
+			if entry.action != "" {
+				line += " [darkgray]" + entry.action + "[-]"
+			}
+			b.WriteString(line + "\n")
+			lines++
+		}
+	}
+	if lines == 1 {
+		b.WriteString("\n  [darkgray]" + T("No matching keys") + "[-]\n")
+		lines += 2
+	}
+	h.lines = lines
+
+	view.SetText(ThemeText(b.String()))
+	view.ScrollTo(h.scroll, 0)
+	h.view = view
+
+	om.pages.AddPage("help", view, true, true)
+	om.app.SetFocus(view)
+}
+
+// closeHelp removes the help overlay and returns to the screen below it.
+func (om *OverlayManager) closeHelp() {
+	om.pages.RemovePage("help")
+	om.state = om.help.returnTo
+}
+
+// scrollHelp scrolls the help by delta lines.
+func (om *OverlayManager) scrollHelp(delta int) {
+	h := om.help
+	h.scroll = clampInt(h.scroll+delta, 0, max(0, h.lines-1))
+}
+
+// helpPageSize returns the number of lines the help shows at once.
+func (om *OverlayManager) helpPageSize() int {
+	if om.help.view == nil {
+		return 10
+	}
+	_, _, _, height := om.help.view.GetInnerRect()
+	return max(1, height)
+}
+
+// handleHelpKey handles a key while the help is open. Typing searches; Esc
+// clears the search, then closes the help, as does the help key itself.
+func (om *OverlayManager) handleHelpKey(event *tcell.EventKey) bool {
+	h := om.help
+	switch event.Key() {
+	case tcell.KeyEscape:
+		if h.query == "" {
+			om.closeHelp()
+			return true
+		}
+		h.query, h.scroll = "", 0
+	case tcell.KeyF1:
+		om.closeHelp()
+		return true
+	case tcell.KeyUp:
+		om.scrollHelp(-1)
+	case tcell.KeyDown:
+		om.scrollHelp(1)
+	case tcell.KeyPgUp:
+		om.scrollHelp(-om.helpPageSize())
+	case tcell.KeyPgDn:
+		om.scrollHelp(om.helpPageSize())
+	case tcell.KeyHome:
+		h.scroll = 0
+	case tcell.KeyEnd:
+		h.scroll = max(0, h.lines-om.helpPageSize())
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if runes := []rune(h.query); len(runes) > 0 {
+			h.query, h.scroll = string(runes[:len(runes)-1]), 0
+		}
+	case tcell.KeyRune:
+		if event.Rune() == '?' && h.query == "" {
+			om.closeHelp()
+			return true
+		}
+		if unicode.IsPrint(event.Rune()) && len([]rune(h.query)) < 32 {
+			h.query, h.scroll = h.query+string(event.Rune()), 0
+		}
+	default:
+		return true // Swallow other keys while the help is open
+	}
+	om.renderHelp()
+	return true
+}
+
+// handleHelpMouse scrolls the help with the wheel.
+func (om *OverlayManager) handleHelpMouse(action mouseAction) bool {
+	switch action {
+	case mouseScrollUp:
+		om.scrollHelp(-3)
+	case mouseScrollDown:
+		om.scrollHelp(3)
+	default:
+		return false
+	}
+	om.renderHelp()
+	return true
+}
@@ -0,0 +1,1218 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// locale holds the translations and calendar conventions of a language.
+// English is the source language: its strings are the catalog keys.
+type locale struct {
+	tag           string
+	messages      map[string]string // English text → translation
+	weekdays      [7]string         // Sunday first; empty for English
+	shortWeekdays [7]string
+	months        [12]string
+	shortMonths   [12]string
+	firstWeekday  time.Weekday
+	dateStyle     string // Date style used unless the config picks one
+}
+
+// localeNames lists the supported languages in the order shown in errors.
+var localeNames = []string{"en", "de", "fr", "es"}
+
+// regionFirstWeekdays are the regions whose week starts on Sunday, unlike
+// the language default.
+var regionFirstWeekdays = map[string]time.Weekday{
+	"US": time.Sunday,
+	"CA": time.Sunday,
+	"MX": time.Sunday,
+	"BR": time.Sunday,
+	"JP": time.Sunday,
+	"IL": time.Sunday,
+}
+
+// locales are the supported languages.
+var locales = map[string]*locale{
+	"en": {tag: "en", firstWeekday: time.Monday, dateStyle: "text"},
+	"de": {
+		tag: "de",
+		messages: map[string]string{
+			// Menu and overlays
+			"Menu":            "Menü",
+			"Commands":        "Befehle",
+			"Help":            "Hilfe",
+			"Clocks":          "Uhren",
+			"Converter":       "Umrechner",
+			"Stopwatch":       "Stoppuhr",
+			"Timer":           "Timer",
+			"Alarm":           "Wecker",
+			"Meeting Planner": "Meeting-Planer",
+			"Normal":          "Normal",
+			"Navigation":      "Navigation",
+			"Meeting":         "Meeting",
+			"City Details":    "Stadtdetails",
+			"Map":             "Karte",
+			"Mouse":           "Maus",
+			"Window":          "Fenster",
+
+			// Key help
+			"Navigate":             "Navigieren",
+			"Previous city":        "Vorherige Stadt",
+			"Next city":            "Nächste Stadt",
+			"Previous day":         "Vorheriger Tag",
+			"Next day":             "Nächster Tag",
+			"Zoom in":              "Vergrößern",
+			"Zoom out":             "Verkleinern",
+			"Pan up":               "Nach oben verschieben",
+			"Pan down":             "Nach unten verschieben",
+			"Pan left":             "Nach links verschieben",
+			"Pan right":            "Nach rechts verschieben",
+			"Move up":              "Nach oben",
+			"Move down":            "Nach unten",
+			"Move left":            "Nach links",
+			"Move right":           "Nach rechts",
+			"Previous item":        "Vorheriger Eintrag",
+			"Next item":            "Nächster Eintrag",
+			"Previous zone":        "Vorherige Zone",
+			"Next zone":            "Nächste Zone",
+			"Details":              "Details",
+			"Day":                  "Tag",
+			"Today":                "Heute",
+			"Tomorrow":             "Morgen",
+			"Yesterday":            "Gestern",
+			"Day/Night":            "Tag/Nacht",
+			"Sun/Moon":             "Sonne/Mond",
+			"Zones":                "Zonen",
+			"Cursor":               "Cursor",
+			"Zoom":                 "Zoom",
+			"Pan":                  "Verschieben",
+			"Viewports":            "Ausschnitte",
+			"World":                "Welt",
+			"Projection":           "Projektion",
+			"Layout":               "Layout",
+			"Sort":                 "Sortieren",
+			"Deselect/Quit":        "Abwählen/Beenden",
+			"Quit":                 "Beenden",
+			"Open":                 "Öffnen",
+			"Close":                "Schließen",
+			"Enter Time":           "Zeit eingeben",
+			"Select Zone":          "Zone wählen",
+			"Reset":                "Zurücksetzen",
+			"Start/Pause":          "Start/Pause",
+			"Lap":                  "Runde",
+			"Set Duration":         "Dauer setzen",
+			"Add Alarm":            "Wecker stellen",
+			"Delete Last":          "Letzten löschen",
+			"Search":               "Suchen",
+			"Toggle":               "Umschalten",
+			"Remove":               "Entfernen",
+			"All in Category":      "Ganze Kategorie",
+			"Groups":               "Gruppen",
+			"Save Group":           "Gruppe speichern",
+			"Clear":                "Leeren",
+			"Hours":                "Arbeitszeit",
+			"Duration":             "Dauer",
+			"Delete":               "Löschen",
+			"Keys:":                "Tasten:",
+			"Mouse:":               "Maus:",
+			"Controls:":            "Steuerung:",
+			"Esc=Exit":             "Esc=Zurück",
+			"Esc=Exit (continues)": "Esc=Zurück (läuft weiter)",
+			"Search:":              "Suche:",
+			"Type to filter":       "Tippen zum Filtern",
+			"No matching keys":     "Keine passenden Tasten",
+			"Move":                 "Bewegen",
+			"Add City":             "Stadt hinzufügen",
+			"Exit":                 "Verlassen",
+			"Pane":                 "Bereich",
+			"Timeline":             "Zeitleiste",
+
+			// Converter
+			"TIME CONVERTER":                      "ZEITUMRECHNER",
+			"Source:":                             "Quelle:",
+			"Enter source time below":             "Quellzeit unten eingeben",
+			"Enter time:":                         "Zeit eingeben:",
+			"Press %s to enter time":              "%s drücken, um eine Zeit einzugeben",
+			"Converted Times:":                    "Umgerechnete Zeiten:",
+			"Use %s/%s to select source timezone": "Mit %s/%s die Quellzeitzone wählen",
+
+			// Stopwatch and timer
+			"STOPWATCH":                "STOPPUHR",
+			"COUNTDOWN TIMER":          "COUNTDOWN",
+			"Status:":                  "Status:",
+			"Running":                  "Läuft",
+			"Paused":                   "Pausiert",
+			"Ready":                    "Bereit",
+			"Lap Times:":               "Rundenzeiten:",
+			"Lap %d: %s":               "Runde %d: %s",
+			"ALARM!":                   "ALARM!",
+			"Alarm Triggered!":         "Alarm ausgelöst!",
+			"Press any key":            "Beliebige Taste drücken",
+			"Enter duration:":          "Dauer eingeben:",
+			"Press %s to set duration": "%s drücken, um die Dauer einzustellen",
+
+			// Alarms
+			"ALARMS":                       "WECKER",
+			"Press any key to dismiss":     "Beliebige Taste zum Schließen",
+			"Add New Alarm:":               "Neuer Wecker:",
+			"City: %s":                     "Stadt: %s",
+			"City: %s, Time: %s":           "Stadt: %s, Zeit: %s",
+			"Select repeat:":               "Wiederholung wählen:",
+			"Once":                         "Einmalig",
+			"Daily":                        "Täglich",
+			"Weekday (Mon-Fri)":            "Werktags (Mo-Fr)",
+			"Press Esc to cancel":          "Esc zum Abbrechen",
+			"No alarms set.":               "Keine Wecker gestellt.",
+			"Press %s to add a new alarm.": "%s drücken, um einen Wecker zu stellen.",
+			"Active Alarms (%d):":          "Aktive Wecker (%d):",
+			"Select city/timezone: (%s/%s to navigate, Enter to select)": "Stadt/Zeitzone wählen: (%s/%s zum Navigieren, Enter zum Auswählen)",
+
+			// Meeting planner
+			"Meeting Timeline":           "Meeting-Zeitleiste",
+			"Cities:":                    "Städte:",
+			"Business hours:":            "Arbeitszeit:",
+			"Duration:":                  "Dauer:",
+			"Window:":                    "Zeitfenster:",
+			"Group:":                     "Gruppe:",
+			"Week:":                      "Woche:",
+			"Your Reference Time (UTC):": "Ihre Referenzzeit (UTC):",
+			"24-Hour Timeline (Green=All, Yellow=Some, Red=None, █=Proposed):": "24-Stunden-Zeitleiste (Grün=Alle, Gelb=Einige, Rot=Keine, █=Vorschlag):",
+			"No window of %s fits everyone's business hours.":                  "Kein Zeitfenster von %s passt in alle Arbeitszeiten.",
+			"BEST Times (all cities in business hours):":                       "BESTE Zeiten (alle Städte in der Arbeitszeit):",
+			"Acceptable Times (most cities available):":                        "Akzeptable Zeiten (die meisten Städte verfügbar):",
+
+			// Clocks
+			"Local":                  "Lokal",
+			"Americas & Europe":      "Amerika & Europa",
+			"Asia, Africa & Oceania": "Asien, Afrika & Ozeanien",
+			"Morning":                "Morgen",
+			"Afternoon":              "Nachmittag",
+			"Golden hour":            "Goldene Stunde",
+			"Dawn":                   "Morgengrauen",
+			"Dusk":                   "Abenddämmerung",
+			"Twilight":               "Dämmerung",
+			"Night":                  "Nacht",
+
+			// Accessible layout
+			"Alarm %s %s":       "Wecker %s %s",
+			"Announcements:":    "Ansagen:",
+			"Timer finished":    "Timer abgelaufen",
+			"unknown time zone": "unbekannte Zeitzone",
+
+			// Layouts
+			"Grid":   "Raster",
+			"Wall":   "Wand",
+			"Table":  "Tabelle",
+			"Offset": "Versatz",
+			"Name":   "Name",
+			"City":   "Stadt",
+			"Time":   "Zeit",
+			"Date":   "Datum",
+			"Phase":  "Phase",
+
+			// Meeting planner views
+			"Meeting Time Planner": "Meeting-Zeitplaner",
+			"No cities match.":     "Keine passenden Städte.",
+			"Selected (%d)":        "Ausgewählt (%d)",
+			"none yet":             "noch keine",
+			"(Esc to clear)":       "(Esc zum Leeren)",
+			"type to filter by name, country, alias or zone": "tippen, um nach Name, Land, Alias oder Zone zu filtern",
+			"%d-%d of %d":             "%d-%d von %d",
+			"%d cities":               "%d Städte",
+			"PgUp/PgDn=Page":          "PgUp/PgDn=Seite",
+			"Enter=Back to Selection": "Enter=Zurück zur Auswahl",
+			"any time":                "jederzeit",
+			"Saved Meeting Groups":    "Gespeicherte Meeting-Gruppen",
+			"No saved groups yet.":    "Noch keine gespeicherten Gruppen.",
+			"Select cities and press %s to save them as a group.": "Städte wählen und %s drücken, um sie als Gruppe zu speichern.",
+			"Loaded group %q":    "Gruppe %q geladen",
+			"Deleted group %q":   "Gruppe %q gelöscht",
+			"Saved group %q":     "Gruppe %q gespeichert",
+			"(unknown: %s)":      "(unbekannt: %s)",
+			"Enter=Load":         "Enter=Laden",
+			"Esc=Back":           "Esc=Zurück",
+			"Save Meeting Group": "Meeting-Gruppe speichern",
+			"%d selected":        "%d ausgewählt",
+			"Defaults:":          "Vorgaben:",
+			"Name:":              "Name:",
+			"e.g. EMEA sync":     "z. B. EMEA-Abstimmung",
+			"Type a name":        "Namen eingeben",
+			"Enter=Save":         "Enter=Speichern",
+			"Enter=Save (replaces a group with the same name)": "Enter=Speichern (ersetzt eine gleichnamige Gruppe)",
+			"Esc=Cancel": "Esc=Abbrechen",
+
+			// Sun, moon and map
+			"midnight sun":         "Mitternachtssonne",
+			"polar night":          "Polarnacht",
+			"Day/Night ON":         "Tag/Nacht AN",
+			"Day/Night OFF":        "Tag/Nacht AUS",
+			"New Moon":             "Neumond",
+			"Waxing Crescent":      "Zunehmende Sichel",
+			"First Quarter":        "Erstes Viertel",
+			"Waxing Gibbous":       "Zunehmender Mond",
+			"Full Moon":            "Vollmond",
+			"Waning Gibbous":       "Abnehmender Mond",
+			"Last Quarter":         "Letztes Viertel",
+			"Waning Crescent":      "Abnehmende Sichel",
+			"North America":        "Nordamerika",
+			"South America":        "Südamerika",
+			"Europe":               "Europa",
+			"Africa & Middle East": "Afrika & Nahost",
+			"South Asia":           "Südasien",
+			"SE Asia":              "Südostasien",
+			"East Asia":            "Ostasien",
+			"Oceania":              "Ozeanien",
+
+			// City details
+			"Use arrow keys to navigate cities": "Mit den Pfeiltasten zwischen Städten wechseln",
+			"left panel":                        "linke Spalte",
+			"right panel":                       "rechte Spalte",
+			"%s selected":                       "%s ausgewählt",
+			"City:":                             "Stadt:",
+			"Timezone:":                         "Zeitzone:",
+			"Current Time:":                     "Aktuelle Zeit:",
+			"Date:":                             "Datum:",
+			"UTC Offset:":                       "UTC-Versatz:",
+			"Day of Week:":                      "Wochentag:",
+			"DST Active:":                       "Sommerzeit:",
+			"Sun Now:":                          "Sonne jetzt:",
+			"Sun:":                              "Sonne:",
+			"Sunrise:":                          "Aufgang:",
+			"Sunset:":                           "Untergang:",
+			"Solar Noon:":                       "Sonnenmittag:",
+			"Day Length:":                       "Tageslänge:",
+			"Golden Hour:":                      "Goldene Stunde:",
+			"Moon:":                             "Mond:",
+			"Midnight sun":                      "Mitternachtssonne",
+			"Polar night":                       "Polarnacht",
+			"(dawn %s)":                         "(Dämmerung %s)",
+			"(dusk %s)":                         "(Dämmerung %s)",
+			"%d%% lit":                          "%d%% beleuchtet",
+			"Sun on %s":                         "Sonne am %s",
+			"Yes":                               "Ja",
+			"No":                                "Nein",
+			"Hide details":                      "Details ausblenden",
+			"Prev/Next day":                     "Vor-/Folgetag",
+			"Press %s for full details":         "%s für alle Details drücken",
+
+			// Map cursor
+			"Map cursor":                     "Kartencursor",
+			"Enter=Add":                      "Enter=Hinzufügen",
+			"A city named %q already exists": "Eine Stadt namens %q gibt es schon",
+			"Added %s (%s)":                  "%s hinzugefügt (%s)",
+			"Added %s (not saved: %v)":       "%s hinzugefügt (nicht gespeichert: %v)",
+			"Added %s for this session only (nautical zone %s, not saved)": "%s nur für diese Sitzung hinzugefügt (nautische Zone %s, nicht gespeichert)",
+			"%s (nautical)": "%s (nautisch)",
+
+			// Command palette
+			"Command palette":                                "Befehlspalette",
+			"Open the world clock list":                      "Weltuhrenliste öffnen",
+			"Open the time converter":                        "Zeitumrechner öffnen",
+			"Open the stopwatch":                             "Stoppuhr öffnen",
+			"Open the alarms":                                "Wecker öffnen",
+			"Open the meeting planner":                       "Meeting-Planer öffnen",
+			"Start a timer, e.g. timer 25m":                  "Timer starten, z. B. timer 25m",
+			"Convert a time, e.g. convert 15:00 tokyo":       "Zeit umrechnen, z. B. convert 15:00 tokyo",
+			"Jump to a city and show its details":            "Zu einer Stadt springen und Details zeigen",
+			"Add a city to the clocks":                       "Stadt zu den Uhren hinzufügen",
+			"Switch map projection (%s)":                     "Kartenprojektion wechseln (%s)",
+			"Switch dashboard layout (%s)":                   "Layout wechseln (%s)",
+			"Open the feature menu":                          "Funktionsmenü öffnen",
+			"No matching commands":                           "Keine passenden Befehle",
+			"Enter=Run  Tab=Complete  ↑/↓=Select  Esc=Close": "Enter=Ausführen  Tab=Ergänzen  ↑/↓=Wählen  Esc=Schließen",
+			"%s is not available right now":                  "%s ist gerade nicht verfügbar",
+			"the timer is not available":                     "der Timer ist nicht verfügbar",
+			"the converter is not available":                 "der Umrechner ist nicht verfügbar",
+			"invalid duration %q (e.g. 25m, 1h30m, 90s)":     "ungültige Dauer %q (z. B. 25m, 1h30m, 90s)",
+			"duration must be positive":                      "die Dauer muss positiv sein",
+			"unknown city %q":                                "unbekannte Stadt %q",
+			"%s is already on the clocks":                    "%s ist schon bei den Uhren",
+			"added %s but could not save it: %w":             "%s hinzugefügt, aber nicht gespeichert: %w",
+
+			// Help overlay
+			"Open the feature":   "Funktion öffnen",
+			"Move the selection": "Auswahl bewegen",
+			"Back out of a sub-view, then return to the menu": "Unteransicht verlassen, dann zurück zum Menü",
+			"↑/↓ PgUp/PgDn=Scroll":                            "↑/↓ PgUp/PgDn=Blättern",
+			"Click":                                           "Klicken",
+			"Wheel":                                           "Mausrad",
+			"Click, Drag":                                     "Klicken, Ziehen",
+			"Click a city":                                    "Stadt anklicken",
+			"Move, Click":                                     "Bewegen, Klicken",
+			"Type":                                            "Tippen",
+			"Type, Enter":                                     "Tippen, Enter",
+			"Drag the slider":                                 "Schieberegler ziehen",
+			"Time input":                                      "Zeiteingabe",
+			"Type the time as HH:MM, after Enter Time":          "Zeit als HH:MM tippen, nach Zeit eingeben",
+			"Delete the last digit":                             "Letzte Ziffer löschen",
+			"Set the time on the slider":                        "Zeit am Schieberegler einstellen",
+			"Name a new city, then save it":                     "Neue Stadt benennen, dann speichern",
+			"Zoom the map":                                      "Karte zoomen",
+			"Select it and show its details":                    "Auswählen und Details zeigen",
+			"Move the map cursor, when shown":                   "Kartencursor bewegen, wenn sichtbar",
+			"Adding an alarm":                                   "Wecker stellen",
+			"Choose the zone, then confirm the time":            "Zone wählen, dann Zeit bestätigen",
+			"Type the time as HH:MM":                            "Zeit als HH:MM tippen",
+			"Repeat once, daily or on weekdays":                 "Einmal, täglich oder werktags wiederholen",
+			"City selection":                                    "Städteauswahl",
+			"Filter cities by name, country, alias or zone":     "Städte nach Name, Land, Alias oder Zone filtern",
+			"Delete the last character of the filter":           "Letztes Zeichen des Filters löschen",
+			"Move through the list":                             "Durch die Liste bewegen",
+			"Clear the filter, then close the planner":          "Filter leeren, dann Planer schließen",
+			"Back to the city selection":                        "Zurück zur Städteauswahl",
+			"Choose a meeting slot":                             "Termin wählen",
+			"Saved groups":                                      "Gespeicherte Gruppen",
+			"Load the group":                                    "Gruppe laden",
+			"Saving a group":                                    "Gruppe speichern",
+			"Name the group":                                    "Gruppe benennen",
+			"Save it":                                           "Speichern",
+			"Cancel":                                            "Abbrechen",
+			"Duration input":                                    "Dauereingabe",
+			"Type the duration as HH:MM:SS, after Set Duration": "Dauer als HH:MM:SS tippen, nach Dauer setzen",
+			"Search commands, or type one, e.g. timer 10m":      "Befehle suchen oder einen tippen, z. B. timer 10m",
+			"Select a command":                                  "Befehl wählen",
+			"Run it, asking for arguments if needed":            "Ausführen, bei Bedarf mit Argumenten",
+			"Complete the selected command":                     "Gewählten Befehl ergänzen",
+			"Close the palette":                                 "Palette schließen",
+		},
+		weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		shortWeekdays: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortMonths:  [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		firstWeekday: time.Monday,
+		dateStyle:    "eu",
+	},
+	"fr": {
+		tag: "fr",
+		messages: map[string]string{
+			// Menu and overlays
+			"Menu":            "Menu",
+			"Commands":        "Commandes",
+			"Help":            "Aide",
+			"Clocks":          "Horloges",
+			"Converter":       "Convertisseur",
+			"Stopwatch":       "Chronomètre",
+			"Timer":           "Minuteur",
+			"Alarm":           "Alarme",
+			"Meeting Planner": "Réunions",
+			"Normal":          "Normal",
+			"Navigation":      "Navigation",
+			"Meeting":         "Réunion",
+			"City Details":    "Détails de la ville",
+			"Map":             "Carte",
+			"Mouse":           "Souris",
+			"Window":          "Fenêtre",
+
+			// Key help
+			"Navigate":             "Naviguer",
+			"Previous city":        "Ville précédente",
+			"Next city":            "Ville suivante",
+			"Previous day":         "Jour précédent",
+			"Next day":             "Jour suivant",
+			"Zoom in":              "Zoom avant",
+			"Zoom out":             "Zoom arrière",
+			"Pan up":               "Déplacer vers le haut",
+			"Pan down":             "Déplacer vers le bas",
+			"Pan left":             "Déplacer vers la gauche",
+			"Pan right":            "Déplacer vers la droite",
+			"Move up":              "Monter",
+			"Move down":            "Descendre",
+			"Move left":            "À gauche",
+			"Move right":           "À droite",
+			"Previous item":        "Élément précédent",
+			"Next item":            "Élément suivant",
+			"Previous zone":        "Zone précédente",
+			"Next zone":            "Zone suivante",
+			"Details":              "Détails",
+			"Day":                  "Jour",
+			"Today":                "Aujourd'hui",
+			"Tomorrow":             "Demain",
+			"Yesterday":            "Hier",
+			"Day/Night":            "Jour/Nuit",
+			"Sun/Moon":             "Soleil/Lune",
+			"Zones":                "Fuseaux",
+			"Cursor":               "Curseur",
+			"Zoom":                 "Zoom",
+			"Pan":                  "Déplacer",
+			"Viewports":            "Vues",
+			"World":                "Monde",
+			"Projection":           "Projection",
+			"Layout":               "Disposition",
+			"Sort":                 "Trier",
+			"Deselect/Quit":        "Désélectionner/Quitter",
+			"Quit":                 "Quitter",
+			"Open":                 "Ouvrir",
+			"Close":                "Fermer",
+			"Enter Time":           "Saisir l'heure",
+			"Select Zone":          "Choisir le fuseau",
+			"Reset":                "Réinitialiser",
+			"Start/Pause":          "Démarrer/Pause",
+			"Lap":                  "Tour",
+			"Set Duration":         "Régler la durée",
+			"Add Alarm":            "Ajouter une alarme",
+			"Delete Last":          "Supprimer la dernière",
+			"Search":               "Rechercher",
+			"Toggle":               "Basculer",
+			"Remove":               "Retirer",
+			"All in Category":      "Toute la catégorie",
+			"Groups":               "Groupes",
+			"Save Group":           "Enregistrer le groupe",
+			"Clear":                "Vider",
+			"Hours":                "Horaires",
+			"Duration":             "Durée",
+			"Delete":               "Supprimer",
+			"Keys:":                "Touches :",
+			"Mouse:":               "Souris :",
+			"Controls:":            "Commandes :",
+			"Esc=Exit":             "Esc=Quitter",
+			"Esc=Exit (continues)": "Esc=Quitter (continue)",
+			"Search:":              "Recherche :",
+			"Type to filter":       "Tapez pour filtrer",
+			"No matching keys":     "Aucune touche correspondante",
+			"Move":                 "Déplacer",
+			"Add City":             "Ajouter la ville",
+			"Exit":                 "Quitter",
+			"Pane":                 "Volet",
+			"Timeline":             "Chronologie",
+
+			// Converter
+			"TIME CONVERTER":                      "CONVERTISSEUR D'HEURE",
+			"Source:":                             "Source :",
+			"Enter source time below":             "Saisissez l'heure source ci-dessous",
+			"Enter time:":                         "Saisir l'heure :",
+			"Press %s to enter time":              "Appuyez sur %s pour saisir une heure",
+			"Converted Times:":                    "Heures converties :",
+			"Use %s/%s to select source timezone": "%s/%s pour choisir le fuseau source",
+
+			// Stopwatch and timer
+			"STOPWATCH":                "CHRONOMÈTRE",
+			"COUNTDOWN TIMER":          "MINUTEUR",
+			"Status:":                  "État :",
+			"Running":                  "En marche",
+			"Paused":                   "En pause",
+			"Ready":                    "Prêt",
+			"Lap Times:":               "Temps au tour :",
+			"Lap %d: %s":               "Tour %d : %s",
+			"ALARM!":                   "ALARME !",
+			"Alarm Triggered!":         "Alarme déclenchée !",
+			"Press any key":            "Appuyez sur une touche",
+			"Enter duration:":          "Saisir la durée :",
+			"Press %s to set duration": "Appuyez sur %s pour régler la durée",
+
+			// Alarms
+			"ALARMS":                       "ALARMES",
+			"Press any key to dismiss":     "Appuyez sur une touche pour fermer",
+			"Add New Alarm:":               "Nouvelle alarme :",
+			"City: %s":                     "Ville : %s",
+			"City: %s, Time: %s":           "Ville : %s, Heure : %s",
+			"Select repeat:":               "Répétition :",
+			"Once":                         "Une fois",
+			"Daily":                        "Tous les jours",
+			"Weekday (Mon-Fri)":            "En semaine (lun-ven)",
+			"Press Esc to cancel":          "Esc pour annuler",
+			"No alarms set.":               "Aucune alarme.",
+			"Press %s to add a new alarm.": "Appuyez sur %s pour ajouter une alarme.",
+			"Active Alarms (%d):":          "Alarmes actives (%d) :",
+			"Select city/timezone: (%s/%s to navigate, Enter to select)": "Choisir la ville/le fuseau : (%s/%s pour naviguer, Entrée pour choisir)",
+
+			// Meeting planner
+			"Meeting Timeline":           "Chronologie de la réunion",
+			"Cities:":                    "Villes :",
+			"Business hours:":            "Heures de bureau :",
+			"Duration:":                  "Durée :",
+			"Window:":                    "Plage :",
+			"Group:":                     "Groupe :",
+			"Week:":                      "Semaine :",
+			"Your Reference Time (UTC):": "Votre heure de référence (UTC) :",
+			"24-Hour Timeline (Green=All, Yellow=Some, Red=None, █=Proposed):": "Chronologie sur 24 heures (vert=toutes, jaune=certaines, rouge=aucune, █=proposé) :",
+			"No window of %s fits everyone's business hours.":                  "Aucune plage de %s ne convient aux heures de bureau de tous.",
+			"BEST Times (all cities in business hours):":                       "MEILLEURS créneaux (toutes les villes aux heures de bureau) :",
+			"Acceptable Times (most cities available):":                        "Créneaux acceptables (la plupart des villes disponibles) :",
+
+			// Clocks
+			"Local":                  "Local",
+			"Americas & Europe":      "Amériques et Europe",
+			"Asia, Africa & Oceania": "Asie, Afrique et Océanie",
+			"Morning":                "Matin",
+			"Afternoon":              "Après-midi",
+			"Golden hour":            "Heure dorée",
+			"Dawn":                   "Aube",
+			"Dusk":                   "Crépuscule",
+			"Twilight":               "Pénombre",
+			"Night":                  "Nuit",
+
+			// Accessible layout
+			"Alarm %s %s":       "Alarme %s %s",
+			"Announcements:":    "Annonces :",
+			"Timer finished":    "Minuteur terminé",
+			"unknown time zone": "fuseau horaire inconnu",
+
+			// Layouts
+			"Grid":   "Grille",
+			"Wall":   "Mur",
+			"Table":  "Tableau",
+			"Offset": "Décalage",
+			"Name":   "Nom",
+			"City":   "Ville",
+			"Time":   "Heure",
+			"Date":   "Date",
+			"Phase":  "Phase",
+
+			// Meeting planner views
+			"Meeting Time Planner": "Planificateur de réunions",
+			"No cities match.":     "Aucune ville ne correspond.",
+			"Selected (%d)":        "Sélection (%d)",
+			"none yet":             "aucune pour l'instant",
+			"(Esc to clear)":       "(Esc pour effacer)",
+			"type to filter by name, country, alias or zone": "tapez pour filtrer par nom, pays, alias ou fuseau",
+			"%d-%d of %d":             "%d-%d sur %d",
+			"%d cities":               "%d villes",
+			"PgUp/PgDn=Page":          "PgUp/PgDn=Page",
+			"Enter=Back to Selection": "Enter=Retour à la sélection",
+			"any time":                "à toute heure",
+			"Saved Meeting Groups":    "Groupes de réunion enregistrés",
+			"No saved groups yet.":    "Aucun groupe enregistré pour l'instant.",
+			"Select cities and press %s to save them as a group.": "Choisissez des villes et appuyez sur %s pour les enregistrer en groupe.",
+			"Loaded group %q":    "Groupe %q chargé",
+			"Deleted group %q":   "Groupe %q supprimé",
+			"Saved group %q":     "Groupe %q enregistré",
+			"(unknown: %s)":      "(inconnues : %s)",
+			"Enter=Load":         "Enter=Charger",
+			"Esc=Back":           "Esc=Retour",
+			"Save Meeting Group": "Enregistrer le groupe de réunion",
+			"%d selected":        "%d sélectionnées",
+			"Defaults:":          "Réglages :",
+			"Name:":              "Nom :",
+			"e.g. EMEA sync":     "p. ex. point EMEA",
+			"Type a name":        "Saisissez un nom",
+			"Enter=Save":         "Enter=Enregistrer",
+			"Enter=Save (replaces a group with the same name)": "Enter=Enregistrer (remplace un groupe du même nom)",
+			"Esc=Cancel": "Esc=Annuler",
+
+			// Sun, moon and map
+			"midnight sun":         "soleil de minuit",
+			"polar night":          "nuit polaire",
+			"Day/Night ON":         "Jour/Nuit ACTIVÉ",
+			"Day/Night OFF":        "Jour/Nuit DÉSACTIVÉ",
+			"New Moon":             "Nouvelle lune",
+			"Waxing Crescent":      "Premier croissant",
+			"First Quarter":        "Premier quartier",
+			"Waxing Gibbous":       "Gibbeuse croissante",
+			"Full Moon":            "Pleine lune",
+			"Waning Gibbous":       "Gibbeuse décroissante",
+			"Last Quarter":         "Dernier quartier",
+			"Waning Crescent":      "Dernier croissant",
+			"North America":        "Amérique du Nord",
+			"South America":        "Amérique du Sud",
+			"Europe":               "Europe",
+			"Africa & Middle East": "Afrique & Moyen-Orient",
+			"South Asia":           "Asie du Sud",
+			"SE Asia":              "Asie du Sud-Est",
+			"East Asia":            "Asie de l'Est",
+			"Oceania":              "Océanie",
+
+			// City details
+			"Use arrow keys to navigate cities": "Utilisez les flèches pour parcourir les villes",
+			"left panel":                        "volet gauche",
+			"right panel":                       "volet droit",
+			"%s selected":                       "%s sélectionné",
+			"City:":                             "Ville :",
+			"Timezone:":                         "Fuseau :",
+			"Current Time:":                     "Heure actuelle :",
+			"Date:":                             "Date :",
+			"UTC Offset:":                       "Décalage UTC :",
+			"Day of Week:":                      "Jour :",
+			"DST Active:":                       "Heure d'été :",
+			"Sun Now:":                          "Soleil :",
+			"Sun:":                              "Soleil :",
+			"Sunrise:":                          "Lever :",
+			"Sunset:":                           "Coucher :",
+			"Solar Noon:":                       "Midi solaire :",
+			"Day Length:":                       "Durée du jour :",
+			"Golden Hour:":                      "Heure dorée :",
+			"Moon:":                             "Lune :",
+			"Midnight sun":                      "Soleil de minuit",
+			"Polar night":                       "Nuit polaire",
+			"(dawn %s)":                         "(aube %s)",
+			"(dusk %s)":                         "(crépuscule %s)",
+			"%d%% lit":                          "%d%% éclairée",
+			"Sun on %s":                         "Soleil le %s",
+			"Yes":                               "Oui",
+			"No":                                "Non",
+			"Hide details":                      "Masquer les détails",
+			"Prev/Next day":                     "Jour préc./suiv.",
+			"Press %s for full details":         "Appuyez sur %s pour tous les détails",
+
+			// Map cursor
+			"Map cursor":                     "Curseur de carte",
+			"Enter=Add":                      "Enter=Ajouter",
+			"A city named %q already exists": "Une ville nommée %q existe déjà",
+			"Added %s (%s)":                  "%s ajoutée (%s)",
+			"Added %s (not saved: %v)":       "%s ajoutée (non enregistrée : %v)",
+			"Added %s for this session only (nautical zone %s, not saved)": "%s ajoutée pour cette session seulement (fuseau nautique %s, non enregistrée)",
+			"%s (nautical)": "%s (nautique)",
+
+			// Command palette
+			"Command palette":                                "Palette de commandes",
+			"Open the world clock list":                      "Ouvrir la liste des horloges",
+			"Open the time converter":                        "Ouvrir le convertisseur",
+			"Open the stopwatch":                             "Ouvrir le chronomètre",
+			"Open the alarms":                                "Ouvrir les alarmes",
+			"Open the meeting planner":                       "Ouvrir le planificateur de réunions",
+			"Start a timer, e.g. timer 25m":                  "Lancer un minuteur, p. ex. timer 25m",
+			"Convert a time, e.g. convert 15:00 tokyo":       "Convertir une heure, p. ex. convert 15:00 tokyo",
+			"Jump to a city and show its details":            "Aller à une ville et afficher ses détails",
+			"Add a city to the clocks":                       "Ajouter une ville aux horloges",
+			"Switch map projection (%s)":                     "Changer de projection (%s)",
+			"Switch dashboard layout (%s)":                   "Changer de disposition (%s)",
+			"Open the feature menu":                          "Ouvrir le menu des fonctions",
+			"No matching commands":                           "Aucune commande correspondante",
+			"Enter=Run  Tab=Complete  ↑/↓=Select  Esc=Close": "Enter=Exécuter  Tab=Compléter  ↑/↓=Choisir  Esc=Fermer",
+			"%s is not available right now":                  "%s n'est pas disponible pour l'instant",
+			"the timer is not available":                     "le minuteur n'est pas disponible",
+			"the converter is not available":                 "le convertisseur n'est pas disponible",
+			"invalid duration %q (e.g. 25m, 1h30m, 90s)":     "durée invalide %q (p. ex. 25m, 1h30m, 90s)",
+			"duration must be positive":                      "la durée doit être positive",
+			"unknown city %q":                                "ville inconnue %q",
+			"%s is already on the clocks":                    "%s est déjà dans les horloges",
+			"added %s but could not save it: %w":             "%s ajoutée mais non enregistrée : %w",
+
+			// Help overlay
+			"Open the feature":   "Ouvrir la fonction",
+			"Move the selection": "Déplacer la sélection",
+			"Back out of a sub-view, then return to the menu": "Quitter la sous-vue, puis revenir au menu",
+			"↑/↓ PgUp/PgDn=Scroll":                            "↑/↓ PgUp/PgDn=Défiler",
+			"Click":                                           "Clic",
+			"Wheel":                                           "Molette",
+			"Click, Drag":                                     "Clic, glisser",
+			"Click a city":                                    "Clic sur une ville",
+			"Move, Click":                                     "Déplacer, clic",
+			"Type":                                            "Saisir",
+			"Type, Enter":                                     "Saisir, Enter",
+			"Drag the slider":                                 "Faire glisser le curseur",
+			"Time input":                                      "Saisie de l'heure",
+			"Type the time as HH:MM, after Enter Time":          "Saisir l'heure en HH:MM, après Saisir l'heure",
+			"Delete the last digit":                             "Effacer le dernier chiffre",
+			"Set the time on the slider":                        "Régler l'heure avec le curseur",
+			"Name a new city, then save it":                     "Nommer une nouvelle ville, puis l'enregistrer",
+			"Zoom the map":                                      "Zoomer sur la carte",
+			"Select it and show its details":                    "La sélectionner et afficher ses détails",
+			"Move the map cursor, when shown":                   "Déplacer le curseur de la carte, s'il est affiché",
+			"Adding an alarm":                                   "Ajout d'une alarme",
+			"Choose the zone, then confirm the time":            "Choisir la zone, puis confirmer l'heure",
+			"Type the time as HH:MM":                            "Saisir l'heure en HH:MM",
+			"Repeat once, daily or on weekdays":                 "Répéter une fois, chaque jour ou en semaine",
+			"City selection":                                    "Choix des villes",
+			"Filter cities by name, country, alias or zone":     "Filtrer les villes par nom, pays, alias ou zone",
+			"Delete the last character of the filter":           "Effacer le dernier caractère du filtre",
+			"Move through the list":                             "Parcourir la liste",
+			"Clear the filter, then close the planner":          "Vider le filtre, puis fermer le planificateur",
+			"Back to the city selection":                        "Retour au choix des villes",
+			"Choose a meeting slot":                             "Choisir un créneau de réunion",
+			"Saved groups":                                      "Groupes enregistrés",
+			"Load the group":                                    "Charger le groupe",
+			"Saving a group":                                    "Enregistrement d'un groupe",
+			"Name the group":                                    "Nommer le groupe",
+			"Save it":                                           "L'enregistrer",
+			"Cancel":                                            "Annuler",
+			"Duration input":                                    "Saisie de la durée",
+			"Type the duration as HH:MM:SS, after Set Duration": "Saisir la durée en HH:MM:SS, après Régler la durée",
+			"Search commands, or type one, e.g. timer 10m":      "Chercher une commande ou en saisir une, p. ex. timer 10m",
+			"Select a command":                                  "Choisir une commande",
+			"Run it, asking for arguments if needed":            "L'exécuter, en demandant les arguments si besoin",
+			"Complete the selected command":                     "Compléter la commande choisie",
+			"Close the palette":                                 "Fermer la palette",
+		},
+		weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		shortWeekdays: [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+		months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortMonths:  [12]string{"janv", "févr", "mars", "avr", "mai", "juin", "juil", "août", "sept", "oct", "nov", "déc"},
+		firstWeekday: time.Monday,
+		dateStyle:    "text",
+	},
+	"es": {
+		tag: "es",
+		messages: map[string]string{
+			// Menu and overlays
+			"Menu":            "Menú",
+			"Commands":        "Comandos",
+			"Help":            "Ayuda",
+			"Clocks":          "Relojes",
+			"Converter":       "Conversor",
+			"Stopwatch":       "Cronómetro",
+			"Timer":           "Temporizador",
+			"Alarm":           "Alarma",
+			"Meeting Planner": "Reuniones",
+			"Normal":          "Normal",
+			"Navigation":      "Navegación",
+			"Meeting":         "Reunión",
+			"City Details":    "Detalles de la ciudad",
+			"Map":             "Mapa",
+			"Mouse":           "Ratón",
+			"Window":          "Ventana",
+
+			// Key help
+			"Navigate":             "Navegar",
+			"Previous city":        "Ciudad anterior",
+			"Next city":            "Ciudad siguiente",
+			"Previous day":         "Día anterior",
+			"Next day":             "Día siguiente",
+			"Zoom in":              "Acercar",
+			"Zoom out":             "Alejar",
+			"Pan up":               "Desplazar arriba",
+			"Pan down":             "Desplazar abajo",
+			"Pan left":             "Desplazar a la izquierda",
+			"Pan right":            "Desplazar a la derecha",
+			"Move up":              "Mover arriba",
+			"Move down":            "Mover abajo",
+			"Move left":            "Mover a la izquierda",
+			"Move right":           "Mover a la derecha",
+			"Previous item":        "Elemento anterior",
+			"Next item":            "Elemento siguiente",
+			"Previous zone":        "Zona anterior",
+			"Next zone":            "Zona siguiente",
+			"Details":              "Detalles",
+			"Day":                  "Día",
+			"Today":                "Hoy",
+			"Tomorrow":             "Mañana",
+			"Yesterday":            "Ayer",
+			"Day/Night":            "Día/Noche",
+			"Sun/Moon":             "Sol/Luna",
+			"Zones":                "Zonas",
+			"Cursor":               "Cursor",
+			"Zoom":                 "Zoom",
+			"Pan":                  "Desplazar",
+			"Viewports":            "Vistas",
+			"World":                "Mundo",
+			"Projection":           "Proyección",
+			"Layout":               "Diseño",
+			"Sort":                 "Ordenar",
+			"Deselect/Quit":        "Deseleccionar/Salir",
+			"Quit":                 "Salir",
+			"Open":                 "Abrir",
+			"Close":                "Cerrar",
+			"Enter Time":           "Introducir hora",
+			"Select Zone":          "Elegir zona",
+			"Reset":                "Reiniciar",
+			"Start/Pause":          "Iniciar/Pausa",
+			"Lap":                  "Vuelta",
+			"Set Duration":         "Fijar duración",
+			"Add Alarm":            "Añadir alarma",
+			"Delete Last":          "Borrar la última",
+			"Search":               "Buscar",
+			"Toggle":               "Alternar",
+			"Remove":               "Quitar",
+			"All in Category":      "Toda la categoría",
+			"Groups":               "Grupos",
+			"Save Group":           "Guardar grupo",
+			"Clear":                "Vaciar",
+			"Hours":                "Horario",
+			"Duration":             "Duración",
+			"Delete":               "Borrar",
+			"Keys:":                "Teclas:",
+			"Mouse:":               "Ratón:",
+			"Controls:":            "Controles:",
+			"Esc=Exit":             "Esc=Salir",
+			"Esc=Exit (continues)": "Esc=Salir (sigue en marcha)",
+			"Search:":              "Buscar:",
+			"Type to filter":       "Escribe para filtrar",
+			"No matching keys":     "Ninguna tecla coincide",
+			"Move":                 "Mover",
+			"Add City":             "Añadir ciudad",
+			"Exit":                 "Salir",
+			"Pane":                 "Panel",
+			"Timeline":             "Cronología",
+
+			// Converter
+			"TIME CONVERTER":                      "CONVERSOR DE HORA",
+			"Source:":                             "Origen:",
+			"Enter source time below":             "Introduce la hora de origen abajo",
+			"Enter time:":                         "Introducir hora:",
+			"Press %s to enter time":              "Pulsa %s para introducir una hora",
+			"Converted Times:":                    "Horas convertidas:",
+			"Use %s/%s to select source timezone": "Usa %s/%s para elegir la zona de origen",
+
+			// Stopwatch and timer
+			"STOPWATCH":                "CRONÓMETRO",
+			"COUNTDOWN TIMER":          "TEMPORIZADOR",
+			"Status:":                  "Estado:",
+			"Running":                  "En marcha",
+			"Paused":                   "En pausa",
+			"Ready":                    "Listo",
+			"Lap Times:":               "Tiempos por vuelta:",
+			"Lap %d: %s":               "Vuelta %d: %s",
+			"ALARM!":                   "¡ALARMA!",
+			"Alarm Triggered!":         "¡Alarma activada!",
+			"Press any key":            "Pulsa cualquier tecla",
+			"Enter duration:":          "Introducir duración:",
+			"Press %s to set duration": "Pulsa %s para fijar la duración",
+
+			// Alarms
+			"ALARMS":                       "ALARMAS",
+			"Press any key to dismiss":     "Pulsa cualquier tecla para cerrar",
+			"Add New Alarm:":               "Nueva alarma:",
+			"City: %s":                     "Ciudad: %s",
+			"City: %s, Time: %s":           "Ciudad: %s, Hora: %s",
+			"Select repeat:":               "Repetición:",
+			"Once":                         "Una vez",
+			"Daily":                        "Diaria",
+			"Weekday (Mon-Fri)":            "Laborables (lun-vie)",
+			"Press Esc to cancel":          "Esc para cancelar",
+			"No alarms set.":               "No hay alarmas.",
+			"Press %s to add a new alarm.": "Pulsa %s para añadir una alarma.",
+			"Active Alarms (%d):":          "Alarmas activas (%d):",
+			"Select city/timezone: (%s/%s to navigate, Enter to select)": "Elige ciudad/zona: (%s/%s para navegar, Intro para elegir)",
+
+			// Meeting planner
+			"Meeting Timeline":           "Cronograma de la reunión",
+			"Cities:":                    "Ciudades:",
+			"Business hours:":            "Horario laboral:",
+			"Duration:":                  "Duración:",
+			"Window:":                    "Franja:",
+			"Group:":                     "Grupo:",
+			"Week:":                      "Semana:",
+			"Your Reference Time (UTC):": "Tu hora de referencia (UTC):",
+			"24-Hour Timeline (Green=All, Yellow=Some, Red=None, █=Proposed):": "Cronograma de 24 horas (verde=todas, amarillo=algunas, rojo=ninguna, █=propuesta):",
+			"No window of %s fits everyone's business hours.":                  "Ninguna franja de %s encaja en el horario laboral de todos.",
+			"BEST Times (all cities in business hours):":                       "MEJORES horas (todas las ciudades en horario laboral):",
+			"Acceptable Times (most cities available):":                        "Horas aceptables (la mayoría de ciudades disponibles):",
+
+			// Clocks
+			"Local":                  "Local",
+			"Americas & Europe":      "América y Europa",
+			"Asia, Africa & Oceania": "Asia, África y Oceanía",
+			"Morning":                "Mañana",
+			"Afternoon":              "Tarde",
+			"Golden hour":            "Hora dorada",
+			"Dawn":                   "Amanecer",
+			"Dusk":                   "Anochecer",
+			"Twilight":               "Crepúsculo",
+			"Night":                  "Noche",
+
+			// Accessible layout
+			"Alarm %s %s":       "Alarma %s %s",
+			"Announcements:":    "Avisos:",
+			"Timer finished":    "Temporizador terminado",
+			"unknown time zone": "zona horaria desconocida",
+
+			// Layouts
+			"Grid":   "Cuadrícula",
+			"Wall":   "Muro",
+			"Table":  "Tabla",
+			"Offset": "Desfase",
+			"Name":   "Nombre",
+			"City":   "Ciudad",
+			"Time":   "Hora",
+			"Date":   "Fecha",
+			"Phase":  "Fase",
+
+			// Meeting planner views
+			"Meeting Time Planner": "Planificador de reuniones",
+			"No cities match.":     "Ninguna ciudad coincide.",
+			"Selected (%d)":        "Seleccionadas (%d)",
+			"none yet":             "ninguna todavía",
+			"(Esc to clear)":       "(Esc para borrar)",
+			"type to filter by name, country, alias or zone": "escribe para filtrar por nombre, país, alias o zona",
+			"%d-%d of %d":             "%d-%d de %d",
+			"%d cities":               "%d ciudades",
+			"PgUp/PgDn=Page":          "PgUp/PgDn=Página",
+			"Enter=Back to Selection": "Enter=Volver a la selección",
+			"any time":                "a cualquier hora",
+			"Saved Meeting Groups":    "Grupos de reunión guardados",
+			"No saved groups yet.":    "Todavía no hay grupos guardados.",
+			"Select cities and press %s to save them as a group.": "Elige ciudades y pulsa %s para guardarlas como grupo.",
+			"Loaded group %q":    "Grupo %q cargado",
+			"Deleted group %q":   "Grupo %q borrado",
+			"Saved group %q":     "Grupo %q guardado",
+			"(unknown: %s)":      "(desconocidas: %s)",
+			"Enter=Load":         "Enter=Cargar",
+			"Esc=Back":           "Esc=Volver",
+			"Save Meeting Group": "Guardar grupo de reunión",
+			"%d selected":        "%d seleccionadas",
+			"Defaults:":          "Ajustes:",
+			"Name:":              "Nombre:",
+			"e.g. EMEA sync":     "p. ej. reunión EMEA",
+			"Type a name":        "Escribe un nombre",
+			"Enter=Save":         "Enter=Guardar",
+			"Enter=Save (replaces a group with the same name)": "Enter=Guardar (sustituye a un grupo con el mismo nombre)",
+			"Esc=Cancel": "Esc=Cancelar",
+
+			// Sun, moon and map
+			"midnight sun":         "sol de medianoche",
+			"polar night":          "noche polar",
+			"Day/Night ON":         "Día/Noche ACTIVADO",
+			"Day/Night OFF":        "Día/Noche DESACTIVADO",
+			"New Moon":             "Luna nueva",
+			"Waxing Crescent":      "Creciente",
+			"First Quarter":        "Cuarto creciente",
+			"Waxing Gibbous":       "Gibosa creciente",
+			"Full Moon":            "Luna llena",
+			"Waning Gibbous":       "Gibosa menguante",
+			"Last Quarter":         "Cuarto menguante",
+			"Waning Crescent":      "Menguante",
+			"North America":        "Norteamérica",
+			"South America":        "Sudamérica",
+			"Europe":               "Europa",
+			"Africa & Middle East": "África y Oriente Medio",
+			"South Asia":           "Asia del Sur",
+			"SE Asia":              "Sudeste asiático",
+			"East Asia":            "Asia oriental",
+			"Oceania":              "Oceanía",
+
+			// City details
+			"Use arrow keys to navigate cities": "Usa las flechas para recorrer las ciudades",
+			"left panel":                        "panel izquierdo",
+			"right panel":                       "panel derecho",
+			"%s selected":                       "%s seleccionado",
+			"City:":                             "Ciudad:",
+			"Timezone:":                         "Zona horaria:",
+			"Current Time:":                     "Hora actual:",
+			"Date:":                             "Fecha:",
+			"UTC Offset:":                       "Desfase UTC:",
+			"Day of Week:":                      "Día de la semana:",
+			"DST Active:":                       "Horario de verano:",
+			"Sun Now:":                          "Sol ahora:",
+			"Sun:":                              "Sol:",
+			"Sunrise:":                          "Salida:",
+			"Sunset:":                           "Puesta:",
+			"Solar Noon:":                       "Mediodía solar:",
+			"Day Length:":                       "Duración del día:",
+			"Golden Hour:":                      "Hora dorada:",
+			"Moon:":                             "Luna:",
+			"Midnight sun":                      "Sol de medianoche",
+			"Polar night":                       "Noche polar",
+			"(dawn %s)":                         "(alba %s)",
+			"(dusk %s)":                         "(anochecer %s)",
+			"%d%% lit":                          "%d%% iluminada",
+			"Sun on %s":                         "Sol el %s",
+			"Yes":                               "Sí",
+			"No":                                "No",
+			"Hide details":                      "Ocultar detalles",
+			"Prev/Next day":                     "Día ant./sig.",
+			"Press %s for full details":         "Pulsa %s para ver todos los detalles",
+
+			// Map cursor
+			"Map cursor":                     "Cursor del mapa",
+			"Enter=Add":                      "Enter=Añadir",
+			"A city named %q already exists": "Ya existe una ciudad llamada %q",
+			"Added %s (%s)":                  "%s añadida (%s)",
+			"Added %s (not saved: %v)":       "%s añadida (sin guardar: %v)",
+			"Added %s for this session only (nautical zone %s, not saved)": "%s añadida solo para esta sesión (zona náutica %s, sin guardar)",
+			"%s (nautical)": "%s (náutica)",
+
+			// Command palette
+			"Command palette":                                "Paleta de comandos",
+			"Open the world clock list":                      "Abrir la lista de relojes",
+			"Open the time converter":                        "Abrir el conversor",
+			"Open the stopwatch":                             "Abrir el cronómetro",
+			"Open the alarms":                                "Abrir las alarmas",
+			"Open the meeting planner":                       "Abrir el planificador de reuniones",
+			"Start a timer, e.g. timer 25m":                  "Iniciar un temporizador, p. ej. timer 25m",
+			"Convert a time, e.g. convert 15:00 tokyo":       "Convertir una hora, p. ej. convert 15:00 tokyo",
+			"Jump to a city and show its details":            "Ir a una ciudad y mostrar sus detalles",
+			"Add a city to the clocks":                       "Añadir una ciudad a los relojes",
+			"Switch map projection (%s)":                     "Cambiar la proyección (%s)",
+			"Switch dashboard layout (%s)":                   "Cambiar la disposición (%s)",
+			"Open the feature menu":                          "Abrir el menú de funciones",
+			"No matching commands":                           "Ningún comando coincide",
+			"Enter=Run  Tab=Complete  ↑/↓=Select  Esc=Close": "Enter=Ejecutar  Tab=Completar  ↑/↓=Elegir  Esc=Cerrar",
+			"%s is not available right now":                  "%s no está disponible ahora",
+			"the timer is not available":                     "el temporizador no está disponible",
+			"the converter is not available":                 "el conversor no está disponible",
+			"invalid duration %q (e.g. 25m, 1h30m, 90s)":     "duración no válida %q (p. ej. 25m, 1h30m, 90s)",
+			"duration must be positive":                      "la duración debe ser positiva",
+			"unknown city %q":                                "ciudad desconocida %q",
+			"%s is already on the clocks":                    "%s ya está en los relojes",
+			"added %s but could not save it: %w":             "%s añadida pero sin guardar: %w",
+
+			// Help overlay
+			"Open the feature":   "Abrir la función",
+			"Move the selection": "Mover la selección",
+			"Back out of a sub-view, then return to the menu": "Salir de la subvista y luego volver al menú",
+			"↑/↓ PgUp/PgDn=Scroll":                            "↑/↓ PgUp/PgDn=Desplazar",
+			"Click":                                           "Clic",
+			"Wheel":                                           "Rueda",
+			"Click, Drag":                                     "Clic, arrastrar",
+			"Click a city":                                    "Clic en una ciudad",
+			"Move, Click":                                     "Mover, clic",
+			"Type":                                            "Escribir",
+			"Type, Enter":                                     "Escribir, Enter",
+			"Drag the slider":                                 "Arrastrar el control deslizante",
+			"Time input":                                      "Entrada de hora",
+			"Type the time as HH:MM, after Enter Time":          "Escribir la hora como HH:MM, tras Introducir hora",
+			"Delete the last digit":                             "Borrar el último dígito",
+			"Set the time on the slider":                        "Ajustar la hora con el control deslizante",
+			"Name a new city, then save it":                     "Nombrar una ciudad nueva y guardarla",
+			"Zoom the map":                                      "Hacer zoom en el mapa",
+			"Select it and show its details":                    "Seleccionarla y mostrar sus detalles",
+			"Move the map cursor, when shown":                   "Mover el cursor del mapa, si está visible",
+			"Adding an alarm":                                   "Añadir una alarma",
+			"Choose the zone, then confirm the time":            "Elegir la zona y luego confirmar la hora",
+			"Type the time as HH:MM":                            "Escribir la hora como HH:MM",
+			"Repeat once, daily or on weekdays":                 "Repetir una vez, a diario o entre semana",
+			"City selection":                                    "Selección de ciudades",
+			"Filter cities by name, country, alias or zone":     "Filtrar ciudades por nombre, país, alias o zona",
+			"Delete the last character of the filter":           "Borrar el último carácter del filtro",
+			"Move through the list":                             "Recorrer la lista",
+			"Clear the filter, then close the planner":          "Vaciar el filtro y luego cerrar el planificador",
+			"Back to the city selection":                        "Volver a la selección de ciudades",
+			"Choose a meeting slot":                             "Elegir una franja para la reunión",
+			"Saved groups":                                      "Grupos guardados",
+			"Load the group":                                    "Cargar el grupo",
+			"Saving a group":                                    "Guardar un grupo",
+			"Name the group":                                    "Nombrar el grupo",
+			"Save it":                                           "Guardarlo",
+			"Cancel":                                            "Cancelar",
+			"Duration input":                                    "Entrada de duración",
+			"Type the duration as HH:MM:SS, after Set Duration": "Escribir la duración como HH:MM:SS, tras Fijar duración",
+			"Search commands, or type one, e.g. timer 10m":      "Buscar comandos o escribir uno, p. ej. timer 10m",
+			"Select a command":                                  "Elegir un comando",
+			"Run it, asking for arguments if needed":            "Ejecutarlo, pidiendo argumentos si hace falta",
+			"Complete the selected command":                     "Completar el comando elegido",
+			"Close the palette":                                 "Cerrar la paleta",
+		},
+		weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		shortWeekdays: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		shortMonths:  [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+		firstWeekday: time.Monday,
+		dateStyle:    "text",
+	},
+}
+
+// global locale, English until SetLocale picks another
+var (
+	currentLocale = locales["en"]
+	firstWeekday  = time.Monday
+)
+
+// SetLocale selects the language from a tag such as "de", "de-AT" or
+// "de_DE.UTF-8". The region, if any, picks the first day of the week.
+// An empty tag and "C" or "POSIX" select English.
+func SetLocale(tag string) error {
+	lang, region := parseLocaleTag(tag)
+	if lang == "" || lang == "c" || lang == "posix" {
+		lang = "en"
+	}
+	loc, ok := locales[lang]
+	if !ok {
+		return fmt.Errorf("unknown language %q (choose from %s)", tag, strings.Join(localeNames, ", "))
+	}
+	currentLocale = loc
+	firstWeekday = loc.firstWeekday
+	if day, ok := regionFirstWeekdays[region]; ok {
+		firstWeekday = day
+	}
+	return nil
+}
+
+// ApplyLocale selects the language from tag, or from the environment if
+// tag is empty. An unsupported environment locale falls back to English;
+// only an unknown tag is an error.
+func ApplyLocale(tag string) error {
+	if tag != "" {
+		return SetLocale(tag)
+	}
+	if err := SetLocale(EnvLocale()); err != nil {
+		return SetLocale("en")
+	}
+	return nil
+}
+
+// EnvLocale returns the locale from the environment: LC_ALL, LC_MESSAGES
+// or LANG, whichever is set first.
+func EnvLocale() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// parseLocaleTag splits a locale tag into a lowercase language and an
+// uppercase region, dropping any encoding or modifier.
+func parseLocaleTag(tag string) (lang, region string) {
+	tag = strings.TrimSpace(tag)
+	if i := strings.IndexAny(tag, ".@"); i >= 0 {
+		tag = tag[:i]
+	}
+	lang, region, _ = strings.Cut(strings.ReplaceAll(tag, "-", "_"), "_")
+	return strings.ToLower(lang), strings.ToUpper(region)
+}
+
+// T returns the translation of an English UI string in the current
+// language, or the string itself if it has none.
+func T(s string) string {
+	if msg, ok := currentLocale.messages[s]; ok {
+		return msg
+	}
+	return s
+}
+
+// FirstWeekday returns the first day of the week in the current locale.
+func FirstWeekday() time.Weekday {
+	return firstWeekday
+}
+
+// WeekdayName returns the name of a weekday in the current language.
+func WeekdayName(day time.Weekday) string {
+	if currentLocale.weekdays[day] == "" {
+		return day.String()
+	}
+	return currentLocale.weekdays[day]
+}
+
+// ShortWeekday returns the abbreviated name of a weekday in the current
+// language.
+func ShortWeekday(day time.Weekday) string {
+	if currentLocale.shortWeekdays[day] == "" {
+		return day.String()[:3]
+	}
+	return currentLocale.shortWeekdays[day]
+}
+
+// formatLocal formats t like t.Format(layout), with the weekday and month
+// names in the current language.
+func formatLocal(t time.Time, layout string) string {
+	l := currentLocale
+	if l.weekdays[0] == "" {
+		return t.Format(layout)
+	}
+	// Longer names first, so "Monday" is not taken for "Mon"
+	names := []struct {
+		token string
+		name  string
+	}{
+		{"Monday", l.weekdays[t.Weekday()]},
+		{"January", l.months[t.Month()-1]},
+		{"Mon", l.shortWeekdays[t.Weekday()]},
+		{"Jan", l.shortMonths[t.Month()-1]},
+	}
+
+	var b strings.Builder
+	for layout != "" {
+		start, end, name := len(layout), len(layout), ""
+		for _, n := range names {
+			if i := strings.Index(layout, n.token); i >= 0 && i < start {
+				start, end, name = i, i+len(n.token), n.name
+			}
+		}
+		b.WriteString(t.Format(layout[:start]))
+		b.WriteString(name)
+		layout = layout[end:]
+	}
+	return b.String()
+}
+
+// textWidth returns the number of cells a plain string occupies.
+func textWidth(s string) int {
+	return utf8.RuneCountInString(s)
+}
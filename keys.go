@@ -0,0 +1,635 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Key contexts: an action is only looked up while its context is active.
+// The context of an action is the part of its name before the dot.
+const (
+	keyContextMap       = "map"
+	keyContextCursor    = "cursor"
+	keyContextMenu      = "menu"
+	keyContextConverter = "converter"
+	keyContextStopwatch = "stopwatch"
+	keyContextTimer     = "timer"
+	keyContextAlarm     = "alarm"
+	keyContextMeeting   = "meeting"
+	keyContextPicker    = "picker"
+)
+
+// keyLayer is a context active on top of another, such as the map cursor
+// over the map: its keys are looked up first, and keys it does not bind
+// reach the parent.
+type keyLayer struct {
+	parent  string
+	shadows []string // Parent actions whose keys the layer may take over
+}
+
+// keyContextLayers are the layered contexts. A layer binding a key of any
+// other parent action is reported as a conflict.
+var keyContextLayers = map[string]keyLayer{
+	keyContextCursor: {parent: keyContextMap, shadows: []string{"map.up", "map.down", "map.layout", "map.menu", "map.back"}},
+}
+
+// keyAction is a named action that can be bound to keys.
+type keyAction struct {
+	name     string   // e.g. "map.zoom-in"
+	help     string   // Short help label
+	group    string   // Status bar label shared by related actions, which are listed together under it
+	defaults []string // Default key specs
+}
+
+// keyActions is the registry of every bindable action, in help order.
+//
+// A key spec is a key with optional modifiers, e.g. "x", "Shift+Up",
+// "Ctrl+N", "Space" or "Enter". A chord is several keys separated by
+// spaces, e.g. "g g". A lowercase letter also matches its uppercase form
+// unless the uppercase letter is bound itself.
+var keyActions = []keyAction{
+	// Map
+	{"map.up", "Previous city", "Navigate", []string{"Up"}},
+	{"map.down", "Next city", "Navigate", []string{"Down", "Tab"}},
+	{"map.menu", "Menu", "", []string{"m", "Space", "Enter"}},
+	{"map.palette", "Commands", "", []string{":", "Ctrl+P"}},
+	{"map.help", "Help", "", []string{"?", "F1"}},
+	{"map.details", "Details", "", []string{"i"}},
+	{"map.prev-day", "Previous day", "Day", []string{",", "<"}},
+	{"map.next-day", "Next day", "Day", []string{".", ">"}},
+	{"map.today", "Today", "", []string{"t"}},
+	{"map.day-night", "Day/Night", "", []string{"d"}},
+	{"map.sky-markers", "Sun/Moon", "", []string{"p"}},
+	{"map.timezones", "Zones", "", []string{"z"}},
+	{"map.cursor", "Cursor", "", []string{"x"}},
+	{"map.zoom-in", "Zoom in", "Zoom", []string{"+", "="}},
+	{"map.zoom-out", "Zoom out", "Zoom", []string{"-", "_"}},
+	{"map.pan-up", "Pan up", "Pan", []string{"Shift+Up"}},
+	{"map.pan-down", "Pan down", "Pan", []string{"Shift+Down"}},
+	{"map.pan-left", "Pan left", "Pan", []string{"Shift+Left"}},
+	{"map.pan-right", "Pan right", "Pan", []string{"Shift+Right"}},
+	{"map.viewport", "Viewports", "", []string{"v"}},
+	{"map.world", "World", "", []string{"0"}},
+	{"map.projection", "Projection", "", []string{"o"}},
+	{"map.layout", "Layout", "", []string{"l"}},
+	{"map.sort", "Sort", "", []string{"s"}},
+	{"map.back", "Deselect/Quit", "", []string{"Esc"}},
+	{"map.quit", "Quit", "", []string{"q"}},
+
+	// Map cursor, while shown; other keys reach the map
+	{"cursor.up", "Move up", "Move", []string{"Up", "k"}},
+	{"cursor.down", "Move down", "Move", []string{"Down", "j"}},
+	{"cursor.left", "Move left", "Move", []string{"Left", "h"}},
+	{"cursor.right", "Move right", "Move", []string{"Right", "l"}},
+	{"cursor.add", "Add City", "", []string{"Enter", "a"}},
+	{"cursor.exit", "Exit", "", []string{"Esc"}},
+
+	// Menu
+	{"menu.up", "Previous item", "Navigate", []string{"Up"}},
+	{"menu.down", "Next item", "Navigate", []string{"Down"}},
+	{"menu.open", "Open", "", []string{"Enter"}},
+	{"menu.close", "Close", "", []string{"Esc", "m", "Space"}},
+	{"menu.palette", "Commands", "", []string{":"}},
+	{"menu.help", "Help", "", []string{"?", "F1"}},
+
+	// Converter
+	{"converter.input", "Enter Time", "", []string{"c"}},
+	{"converter.prev", "Previous zone", "Select Zone", []string{"Up", "k"}},
+	{"converter.next", "Next zone", "Select Zone", []string{"Down", "j"}},
+	{"converter.reset", "Reset", "", []string{"r"}},
+	{"converter.help", "Help", "", []string{"?", "F1"}},
+
+	// Stopwatch
+	{"stopwatch.toggle", "Start/Pause", "", []string{"Space", "s"}},
+	{"stopwatch.reset", "Reset", "", []string{"r"}},
+	{"stopwatch.lap", "Lap", "", []string{"l"}},
+	{"stopwatch.help", "Help", "", []string{"?", "F1"}},
+
+	// Timer
+	{"timer.set", "Set Duration", "", []string{"t"}},
+	{"timer.toggle", "Start/Pause", "", []string{"Space", "s"}},
+	{"timer.reset", "Reset", "", []string{"r"}},
+	{"timer.help", "Help", "", []string{"?", "F1"}},
+
+	// Alarm
+	{"alarm.add", "Add Alarm", "", []string{"a"}},
+	{"alarm.delete-last", "Delete Last", "", []string{"d"}},
+	{"alarm.prev", "Previous zone", "Select Zone", []string{"Up", "k"}},
+	{"alarm.next", "Next zone", "Select Zone", []string{"Down", "j"}},
+	{"alarm.help", "Help", "", []string{"?", "F1"}},
+
+	// Meeting planner city picker: printable keys type into its search, so
+	// every action here needs a modifier or a named key
+	{"picker.up", "Previous city", "Navigate", []string{"Up", "Ctrl+P"}},
+	{"picker.down", "Next city", "Navigate", []string{"Down", "Ctrl+N"}},
+	{"picker.toggle", "Toggle", "", []string{"Enter"}},
+	{"picker.pane", "Pane", "", []string{"Tab"}},
+	{"picker.remove", "Remove", "", []string{"Delete"}},
+	{"picker.category", "All in Category", "", []string{"Ctrl+A"}},
+	{"picker.timeline", "Timeline", "", []string{"Ctrl+T"}},
+	{"picker.groups", "Groups", "", []string{"Ctrl+G"}},
+	{"picker.save", "Save Group", "", []string{"Ctrl+S"}},
+	{"picker.clear", "Clear", "", []string{"Ctrl+K"}},
+	{"picker.help", "Help", "", []string{"F1"}},
+
+	// Meeting planner timeline and saved groups
+	{"meeting.up", "Move up", "Navigate", []string{"Up", "k"}},
+	{"meeting.down", "Move down", "Navigate", []string{"Down", "j"}},
+	{"meeting.groups", "Groups", "", []string{"g"}},
+	{"meeting.save", "Save Group", "", []string{"s"}},
+	{"meeting.clear", "Clear", "", []string{"c"}},
+	{"meeting.hours", "Hours", "", []string{"b"}},
+	{"meeting.duration", "Duration", "", []string{"u"}},
+	{"meeting.window", "Window", "", []string{"w"}},
+	{"meeting.prev-day", "Previous day", "Day", []string{",", "<"}},
+	{"meeting.next-day", "Next day", "Day", []string{".", ">"}},
+	{"meeting.delete-group", "Delete", "", []string{"d"}},
+	{"meeting.help", "Help", "", []string{"?", "F1"}},
+}
+
+// keyPresets replace the bindings of some actions, for people used to vim
+// or emacs. Keys not mentioned keep their defaults.
+var keyPresets = map[string]map[string][]string{
+	"default": {},
+	"vim": {
+		"map.up":        {"k", "Up"},
+		"map.down":      {"j", "Down", "Tab"},
+		"map.pan-up":    {"K", "Shift+Up"},
+		"map.pan-down":  {"J", "Shift+Down"},
+		"map.pan-left":  {"H", "Shift+Left"},
+		"map.pan-right": {"L", "Shift+Right"},
+		"map.world":     {"g g", "0"},
+		"menu.up":       {"k", "Up"},
+		"menu.down":     {"j", "Down"},
+		"menu.open":     {"l", "Enter"},
+	},
+	"emacs": {
+		"map.up":         {"Ctrl+P", "Up"},
+		"map.down":       {"Ctrl+N", "Down", "Tab"},
+		"map.palette":    {"Alt+x", ":"},
+		"map.pan-up":     {"Alt+v", "Shift+Up"},
+		"map.pan-down":   {"Ctrl+V", "Shift+Down"},
+		"map.pan-left":   {"Ctrl+B", "Shift+Left"},
+		"map.pan-right":  {"Ctrl+F", "Shift+Right"},
+		"map.back":       {"Ctrl+G", "Esc"},
+		"map.quit":       {"Ctrl+X Ctrl+C", "q"},
+		"cursor.up":      {"Ctrl+P", "Up"},
+		"cursor.down":    {"Ctrl+N", "Down"},
+		"cursor.exit":    {"Ctrl+G", "Esc"},
+		"menu.up":        {"Ctrl+P", "Up"},
+		"menu.down":      {"Ctrl+N", "Down"},
+		"menu.close":     {"Ctrl+G", "Esc", "m", "Space"},
+		"converter.prev": {"Ctrl+P", "Up", "k"},
+		"converter.next": {"Ctrl+N", "Down", "j"},
+		"alarm.prev":     {"Ctrl+P", "Up", "k"},
+		"alarm.next":     {"Ctrl+N", "Down", "j"},
+		"meeting.up":     {"Ctrl+P", "Up", "k"},
+		"meeting.down":   {"Ctrl+N", "Down", "j"},
+	},
+}
+
+// keyNames maps the names of non-character keys in key specs to tcell keys.
+var keyNames = map[string]tcell.Key{
+	"up": tcell.KeyUp, "down": tcell.KeyDown, "left": tcell.KeyLeft, "right": tcell.KeyRight,
+	"enter": tcell.KeyEnter, "esc": tcell.KeyEscape, "tab": tcell.KeyTab, "backtab": tcell.KeyBacktab,
+	"backspace": tcell.KeyBackspace2, "delete": tcell.KeyDelete, "insert": tcell.KeyInsert,
+	"home": tcell.KeyHome, "end": tcell.KeyEnd, "pgup": tcell.KeyPgUp, "pgdn": tcell.KeyPgDn,
+	"f1": tcell.KeyF1, "f2": tcell.KeyF2, "f3": tcell.KeyF3, "f4": tcell.KeyF4,
+	"f5": tcell.KeyF5, "f6": tcell.KeyF6, "f7": tcell.KeyF7, "f8": tcell.KeyF8,
+	"f9": tcell.KeyF9, "f10": tcell.KeyF10, "f11": tcell.KeyF11, "f12": tcell.KeyF12,
+}
+
+// keyNameAliases are other accepted spellings of key names.
+var keyNameAliases = map[string]string{
+	"escape": "esc", "return": "enter", "del": "delete", "ins": "insert",
+	"pageup": "pgup", "pagedown": "pgdn",
+}
+
+// keyDisplay is how key names are shown in help text.
+var keyDisplay = map[string]string{
+	"up": "↑", "down": "↓", "left": "←", "right": "→", "space": "Space", "enter": "Enter",
+	"esc": "Esc", "tab": "Tab", "backtab": "Shift+Tab", "backspace": "Backspace",
+	"delete": "Del", "insert": "Ins", "home": "Home", "end": "End", "pgup": "PgUp", "pgdn": "PgDn",
+}
+
+// keyBindingState holds the active bindings and a chord in progress.
+type keyBindingState struct {
+	bindings map[string][]string          // Action → normalized key sequences
+	lookup   map[string]map[string]string // Context → key sequence → action
+	prefixes map[string]map[string]bool   // Context → unfinished chords
+	pending  []string                     // Keys of the chord typed so far
+}
+
+// global key bindings, loaded with LoadKeyBindings
+var keyBindings = newKeyBindingState()
+
+// newKeyBindingState returns the default bindings. They never conflict.
+func newKeyBindingState() *keyBindingState {
+	state, err := buildKeyBindings("", nil)
+	if err != nil {
+		panic(err)
+	}
+	return state
+}
+
+// LoadKeyBindings applies a preset ("default", "vim" or "emacs") and the
+// user's overrides, given as action → key specs, on top of the default
+// bindings. Unknown actions, invalid keys and conflicting bindings are
+// reported as errors, and the current bindings are kept.
+func LoadKeyBindings(preset string, overrides map[string][]string) error {
+	state, err := buildKeyBindings(preset, overrides)
+	if err != nil {
+		return err
+	}
+	keyBindings = state
+	return nil
+}
+
+// buildKeyBindings resolves and checks a set of bindings.
+func buildKeyBindings(preset string, overrides map[string][]string) (*keyBindingState, error) {
+	specs := make(map[string][]string, len(keyActions))
+	for _, a := range keyActions {
+		specs[a.name] = a.defaults
+	}
+
+	if preset != "" {
+		bindings, ok := keyPresets[strings.ToLower(preset)]
+		if !ok {
+			return nil, fmt.Errorf("unknown key preset %q (choose from default, vim, emacs)", preset)
+		}
+		for action, keys := range bindings {
+			specs[action] = keys
+		}
+	}
+	for action, keys := range overrides {
+		if _, ok := specs[action]; !ok {
+			return nil, fmt.Errorf("unknown key action %q", action)
+		}
+		specs[action] = keys
+	}
+
+	state := &keyBindingState{
+		bindings: make(map[string][]string),
+		lookup:   make(map[string]map[string]string),
+		prefixes: make(map[string]map[string]bool),
+	}
+	var conflicts []string
+	for _, a := range keyActions { // Registry order keeps errors stable
+		context := keyActionContext(a.name)
+		if state.lookup[context] == nil {
+			state.lookup[context] = make(map[string]string)
+			state.prefixes[context] = make(map[string]bool)
+		}
+		for _, spec := range specs[a.name] {
+			seq, err := parseKeySequence(spec)
+			if err != nil {
+				return nil, fmt.Errorf("key binding for %s: %w", a.name, err)
+			}
+			if other, ok := state.lookup[context][seq]; ok && other != a.name {
+				conflicts = append(conflicts, fmt.Sprintf("%q is bound to both %s and %s", spec, other, a.name))
+				continue
+			}
+			state.lookup[context][seq] = a.name
+			state.bindings[a.name] = append(state.bindings[a.name], seq)
+			keys := strings.Split(seq, " ")
+			for i := 1; i < len(keys); i++ {
+				state.prefixes[context][strings.Join(keys[:i], " ")] = true
+			}
+		}
+	}
+
+	// A key that starts a chord cannot also be bound on its own
+	for context, prefixes := range state.prefixes {
+		for prefix := range prefixes {
+			if action, ok := state.lookup[context][prefix]; ok {
+				conflicts = append(conflicts, fmt.Sprintf("%q (%s) starts a longer key chord", formatKeySequence(prefix), action))
+			}
+		}
+	}
+
+	// A layer may only hide the parent keys it is meant to take over
+	for context, layer := range keyContextLayers {
+		for seq, action := range state.lookup[context] {
+			if other, ok := state.lookup[layer.parent][seq]; ok && !containsString(layer.shadows, other) {
+				conflicts = append(conflicts, fmt.Sprintf("%q is bound to both %s and %s", formatKeySequence(seq), other, action))
+			}
+			if state.prefixes[layer.parent][seq] {
+				conflicts = append(conflicts, fmt.Sprintf("%q (%s) starts a longer key chord", formatKeySequence(seq), action))
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return nil, fmt.Errorf("conflicting key bindings: %s", strings.Join(conflicts, "; "))
+	}
+	return state, nil
+}
+
+// PrintKeyBindings writes every action with its current keys, grouped by
+// context, for -list-keys.
+func PrintKeyBindings(w io.Writer) {
+	context := ""
+	for _, a := range keyActions {
+		if c := keyActionContext(a.name); c != context {
+			if context != "" {
+				fmt.Fprintln(w)
+			}
+			context = c
+			fmt.Fprintf(w, "%s:\n", strings.ToUpper(context[:1])+context[1:])
+		}
+		keys := make([]string, len(keyBindings.bindings[a.name]))
+		for i, seq := range keyBindings.bindings[a.name] {
+			keys[i] = formatKeySequence(seq)
+		}
+		fmt.Fprintf(w, "  %-22s %-28s %s\n", a.name, strings.Join(keys, "  "), a.help)
+	}
+}
+
+// keyActionContext returns the context of an action name.
+func keyActionContext(action string) string {
+	context, _, _ := strings.Cut(action, ".")
+	return context
+}
+
+// parseKeySequence normalizes a key spec or chord, e.g. "Ctrl+X  ctrl+c"
+// becomes "ctrl+x ctrl+c".
+func parseKeySequence(spec string) (string, error) {
+	fields := strings.Fields(spec)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("empty key")
+	}
+	keys := make([]string, len(fields))
+	for i, field := range fields {
+		key, err := parseKey(field)
+		if err != nil {
+			return "", err
+		}
+		keys[i] = key
+	}
+	return strings.Join(keys, " "), nil
+}
+
+// parseKey normalizes one key with modifiers, e.g. "Shift+Up" becomes
+// "shift+up" and "Shift+a" becomes "A".
+func parseKey(spec string) (string, error) {
+	key := spec
+	var mods []string
+	if spec != "+" {
+		if strings.HasSuffix(spec, "++") { // A modified "+"
+			key = "+"
+			mods = strings.Split(strings.TrimSuffix(spec, "++"), "+")
+		} else if parts := strings.Split(spec, "+"); len(parts) > 1 {
+			key = parts[len(parts)-1]
+			mods = parts[:len(parts)-1]
+		}
+	}
+
+	var ctrl, alt, shift bool
+	for _, mod := range mods {
+		switch strings.ToLower(mod) {
+		case "ctrl", "control":
+			ctrl = true
+		case "alt", "meta":
+			alt = true
+		case "shift":
+			shift = true
+		default:
+			return "", fmt.Errorf("unknown modifier %q in key %q", mod, spec)
+		}
+	}
+
+	name := strings.ToLower(key)
+	if alias, ok := keyNameAliases[name]; ok {
+		name = alias
+	}
+	runes := []rune(key)
+	switch {
+	case name == "space":
+		key = "space"
+	case keyNames[name] != 0:
+		key = name
+	case len(runes) == 1 && ctrl && unicode.IsLetter(runes[0]) && runes[0] < unicode.MaxASCII:
+		key = strings.ToLower(key)
+	case len(runes) == 1 && !ctrl:
+		if shift {
+			if !unicode.IsLetter(runes[0]) {
+				return "", fmt.Errorf("shift cannot modify %q in key %q", key, spec)
+			}
+			key, shift = strings.ToUpper(key), false
+		}
+	default:
+		return "", fmt.Errorf("unknown key %q", spec)
+	}
+	return keyWithModifiers(key, ctrl, alt, shift), nil
+}
+
+// keyWithModifiers returns a normalized key name with its modifiers in a
+// fixed order.
+func keyWithModifiers(key string, ctrl, alt, shift bool) string {
+	if shift {
+		key = "shift+" + key
+	}
+	if alt {
+		key = "alt+" + key
+	}
+	if ctrl {
+		key = "ctrl+" + key
+	}
+	return key
+}
+
+// eventKeyName returns the normalized name of a key event, as produced by
+// parseKey.
+func eventKeyName(event *tcell.EventKey) string {
+	mods := event.Modifiers()
+	alt := mods&tcell.ModAlt != 0
+	switch key := event.Key(); {
+	case key == tcell.KeyRune:
+		if event.Rune() == ' ' {
+			return keyWithModifiers("space", false, alt, false)
+		}
+		// Shift is already part of the character
+		return keyWithModifiers(string(event.Rune()), false, alt, false)
+	case key == tcell.KeyBackspace:
+		return keyWithModifiers("backspace", false, alt, false)
+	case key >= tcell.KeyCtrlA && key <= tcell.KeyCtrlZ && key != tcell.KeyTab && key != tcell.KeyEnter:
+		return keyWithModifiers(string(rune('a'+key-tcell.KeyCtrlA)), true, alt, false)
+	default:
+		for name, k := range keyNames {
+			if k == key {
+				return keyWithModifiers(name, mods&tcell.ModCtrl != 0, alt, mods&tcell.ModShift != 0)
+			}
+		}
+		return ""
+	}
+}
+
+// MatchKey looks up a key event among the bindings of a context. It
+// returns the bound action, or an empty action with ok set when the key
+// was taken as the start of a chord. ok is false for keys that are not
+// bound; they are left for the view to handle.
+func MatchKey(context string, event *tcell.EventKey) (action string, ok bool) {
+	key := eventKeyName(event)
+	if key == "" {
+		keyBindings.pending = nil
+		return "", false
+	}
+	pending := keyBindings.pending
+	keyBindings.pending = nil
+
+	// A layer's keys come first, but an exact key in its parent beats a
+	// letter that only matches the layer in lowercase
+	contexts := []string{context}
+	if layer, ok := keyContextLayers[context]; ok {
+		contexts = append(contexts, layer.parent)
+	}
+	candidates := []string{key}
+	if lower := strings.ToLower(key); lower != key && len([]rune(key)) == 1 {
+		candidates = append(candidates, lower) // Caps Lock, Shift
+	}
+	for _, k := range candidates {
+		seq := strings.Join(append(append([]string{}, pending...), k), " ")
+		for _, c := range contexts {
+			if action, ok := keyBindings.lookup[c][seq]; ok {
+				return action, true
+			}
+			if keyBindings.prefixes[c][seq] {
+				keyBindings.pending = append(pending, k)
+				return "", true
+			}
+		}
+	}
+	if len(pending) > 0 {
+		// The chord was broken off; try the key on its own
+		return MatchKey(context, event)
+	}
+	return "", false
+}
+
+// formatKeySequence returns a normalized key sequence as shown in help.
+func formatKeySequence(seq string) string {
+	keys := strings.Split(seq, " ")
+	for i, key := range keys {
+		var mods string
+		for _, mod := range []string{"ctrl+", "alt+", "shift+"} {
+			if strings.HasPrefix(key, mod) && key != mod {
+				key = strings.TrimPrefix(key, mod)
+				mods += strings.ToUpper(mod[:1]) + mod[1:]
+			}
+		}
+		if display, ok := keyDisplay[key]; ok {
+			key = display
+		} else if r := []rune(key); len(r) == 1 && unicode.IsUpper(r[0]) {
+			key = "Shift+" + key // Lowercase letters are shown in uppercase
+		} else {
+			key = strings.ToUpper(key)
+		}
+		keys[i] = mods + tview.Escape(key)
+	}
+	return strings.Join(keys, " ")
+}
+
+// KeyLabel returns the first key bound to an action as shown in help, or
+// an empty string if it is unbound.
+func KeyLabel(action string) string {
+	if seqs := keyBindings.bindings[action]; len(seqs) > 0 {
+		return formatKeySequence(seqs[0])
+	}
+	return ""
+}
+
+// KeyLabels returns every key bound to an action as shown in help.
+func KeyLabels(action string) []string {
+	var labels []string
+	for _, seq := range keyBindings.bindings[action] {
+		labels = append(labels, formatKeySequence(seq))
+	}
+	return labels
+}
+
+// KeyHelp returns help entries for actions, e.g. "C=Enter Time  ↑/↓=Select
+// Zone", using their current bindings. Neighbouring actions of the same
+// group share an entry; unbound actions are left out. Extra entries
+// are appended as they are.
+func KeyHelp(actions []string, extra ...string) string {
+	var entries []string
+	var keys []string
+	label := ""
+	flush := func() {
+		if len(keys) > 0 {
+			entries = append(entries, strings.Join(keys, "/")+"="+label)
+		}
+		keys = nil
+	}
+	for _, action := range actions {
+		key := KeyLabel(action)
+		if key == "" {
+			continue
+		}
+		help := keyActionGroup(action)
+		if help != label {
+			flush()
+			label = help
+		}
+		if !containsString(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	flush()
+	return strings.Join(append(entries, extra...), "  ")
+}
+
+// ContextKeyHelp returns help entries for every action of a context.
+func ContextKeyHelp(context string, extra ...string) string {
+	return KeyHelp(KeyContextActions(context), extra...)
+}
+
+// KeyContextActions returns the names of the actions of a context, in
+// registry order.
+func KeyContextActions(context string) []string {
+	var actions []string
+	for _, a := range keyActions {
+		if keyActionContext(a.name) == context {
+			actions = append(actions, a.name)
+		}
+	}
+	return actions
+}
+
+// keyActionHelp returns the help label of an action in the current
+// language.
+func keyActionHelp(action string) string {
+	for _, a := range keyActions {
+		if a.name == action {
+			return T(a.help)
+		}
+	}
+	return action
+}
+
+// keyActionGroup returns the status bar label of an action in the current
+// language: its group's label, or its own help label.
+func keyActionGroup(action string) string {
+	for _, a := range keyActions {
+		if a.name == action && a.group != "" {
+			return T(a.group)
+		}
+	}
+	return keyActionHelp(action)
+}
+
+// containsString reports whether list contains s.
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+// Label priorities: higher priorities are placed first and win collisions
+const (
+	labelPriorityOther     = iota
+	labelPriorityReference // First city of a clock panel, the reference for offsets
+	labelPrioritySelected  // City selected with the navigation keys
+)
+
+// Where a label sits relative to its marker
+const (
+	labelRight  = iota // Label starts right of the marker
+	labelLeft          // Label ends left of the marker
+	labelColumn        // Label starts in the marker's column, above or below it
+)
+
+// mapLabel is a city marker and its label, waiting to be placed on the map.
+type mapLabel struct {
+	name     string // City name
+	col, row int    // Braille cell of the marker dot
+	abbr     string // Short city name, e.g. "NYC"
+	clock    string // Local time from FormatCompactClock, fixed width; dropped first when space is short
+	color    string // tview color of the city
+	priority int
+	selected bool
+}
+
+// labelCandidate is a position for a label around its marker. gap cells
+// between the marker and the label are bridged by a leader line.
+type labelCandidate struct {
+	dRow int
+	side int
+	gap  int
+}
+
+// labelCandidates are tried in order of preference: beside the marker, then
+// diagonally next to it, then directly above or below, then further away
+// with a leader line.
+var labelCandidates = []labelCandidate{
+	{0, labelRight, 0}, {0, labelLeft, 0},
+	{-1, labelRight, 0}, {1, labelRight, 0}, {-1, labelLeft, 0}, {1, labelLeft, 0},
+	{-1, labelColumn, 0}, {1, labelColumn, 0},
+	{0, labelRight, 2}, {0, labelLeft, 2},
+	{-2, labelColumn, 1}, {2, labelColumn, 1},
+}
+
+// placedLabel is a label with the cells it was given on the map.
+type placedLabel struct {
+	label    *mapLabel
+	text     string
+	col, row int      // First cell of the text
+	leader   [][2]int // Cells of the leader line as {col, row}
+	glyph    string   // Leader line glyph
+}
+
+// text returns the label text, without the time in compact form.
+func (l *mapLabel) text(compact bool) string {
+	text := l.abbr
+	if !compact && l.clock != "" {
+		text += " " + l.clock
+	}
+	if l.selected {
+		text = "* " + text
+	}
+	return text
+}
+
+// layout returns where a label of the given width goes for this candidate.
+func (c labelCandidate) layout(col, row, width int) (start, labelRow int, leader [][2]int, glyph string) {
+	labelRow = row + c.dRow
+	switch c.side {
+	case labelRight:
+		start, glyph = col+1+c.gap, MapGlyphs().leaderH
+		for i := 1; i <= c.gap; i++ {
+			leader = append(leader, [2]int{col + i, row})
+		}
+	case labelLeft:
+		start, glyph = col-width-c.gap, MapGlyphs().leaderH
+		for i := 1; i <= c.gap; i++ {
+			leader = append(leader, [2]int{col - i, row})
+		}
+	case labelColumn:
+		start, glyph = col, MapGlyphs().leaderV
+		step := 1
+		if c.dRow < 0 {
+			step = -1
+		}
+		for i := 1; i <= c.gap; i++ {
+			leader = append(leader, [2]int{col, row + i*step})
+		}
+	}
+	return start, labelRow, leader, glyph
+}
+
+// labelGrid tracks which braille cells hold markers, labels or leaders,
+// and on the rendered map which cells any overlay has taken.
+type labelGrid struct {
+	cells      [][]bool
+	cols, rows int
+}
+
+// newLabelGrid returns an empty grid of the given size.
+func newLabelGrid(cols, rows int) *labelGrid {
+	g := &labelGrid{cols: cols, rows: rows, cells: make([][]bool, max(rows, 0))}
+	for row := range g.cells {
+		g.cells[row] = make([]bool, cols)
+	}
+	return g
+}
+
+// free reports whether a cell is on the grid and unused.
+func (g *labelGrid) free(col, row int) bool {
+	return row >= 0 && row < g.rows && col >= 0 && col < g.cols && !g.cells[row][col]
+}
+
+// take marks a cell as used.
+func (g *labelGrid) take(col, row int) {
+	if row >= 0 && row < g.rows && col >= 0 && col < g.cols {
+		g.cells[row][col] = true
+	}
+}
+
+// layoutMapLabels places labels around their markers without overlapping
+// each other or any marker. Labels are placed by priority; a label that
+// fits nowhere is shortened to the city name, and dropped only if even that
+// does not fit. When compact is set, times are dropped from every label but
+// the selected one.
+func layoutMapLabels(labels []mapLabel, cols, rows int, compact bool) []placedLabel {
+	grid := newLabelGrid(cols, rows)
+	for _, l := range labels {
+		grid.take(l.col, l.row)
+	}
+
+	order := make([]*mapLabel, len(labels))
+	for i := range labels {
+		order[i] = &labels[i]
+	}
+	sort.SliceStable(order, func(i, j int) bool { return order[i].priority > order[j].priority })
+
+	var placed []placedLabel
+	for _, l := range order {
+		texts := []string{l.text(compact && !l.selected), l.text(true)}
+		if p, ok := placeLabel(grid, l, texts); ok {
+			placed = append(placed, p)
+		}
+	}
+	return placed
+}
+
+// placeLabel finds the first candidate position where one of the texts
+// fits, trying every position for a text before falling back to the next.
+func placeLabel(grid *labelGrid, l *mapLabel, texts []string) (placedLabel, bool) {
+	for _, text := range texts {
+		width := tview.TaggedStringWidth(tview.Escape(text))
+		for _, c := range labelCandidates {
+			start, row, leader, glyph := c.layout(l.col, l.row, width)
+			if !labelFits(grid, start, row, width, leader) {
+				continue
+			}
+			for col := start; col < start+width; col++ {
+				grid.take(col, row)
+			}
+			for _, cell := range leader {
+				grid.take(cell[0], cell[1])
+			}
+			return placedLabel{label: l, text: text, col: start, row: row, leader: leader, glyph: glyph}, true
+		}
+	}
+	return placedLabel{}, false
+}
+
+// labelFits reports whether a label and its leader line fit in free cells.
+func labelFits(grid *labelGrid, start, row, width int, leader [][2]int) bool {
+	for col := start; col < start+width; col++ {
+		if !grid.free(col, row) {
+			return false
+		}
+	}
+	for _, cell := range leader {
+		if !grid.free(cell[0], cell[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// overlayMapLabels draws city markers and their labels on the map lines and
+// returns where the labels went, with the grid of cells they took for the
+// later overlays. If full labels would leave some cities unlabelled, the
+// layout is redone in compact form so that times are dropped before cities
+// are.
+func overlayMapLabels(lines []string, labels []mapLabel, cols, rows int, pulse bool) ([]placedLabel, *labelGrid) {
+	placed := layoutMapLabels(labels, cols, rows, false)
+	if len(placed) < len(labels) {
+		if compact := layoutMapLabels(labels, cols, rows, true); len(compact) > len(placed) {
+			placed = compact
+		}
+	}
+
+	taken := newLabelGrid(cols, rows)
+	for _, l := range labels {
+		overlayCell(lines, taken, l.col, l.row, fmt.Sprintf("[%s::b]%s[-:-:-]", l.color, MapGlyphs().marker))
+	}
+
+	for _, p := range placed {
+		for _, cell := range p.leader {
+			overlayCell(lines, taken, cell[0], cell[1], fmt.Sprintf("[%s]%s[-]", themeColor(roleLeader), p.glyph))
+		}
+		if p.row >= len(lines) {
+			continue
+		}
+
+		text := tview.Escape(p.text)
+		var styled string
+		switch {
+		case p.label.selected && pulse:
+			styled = fmt.Sprintf("[white:%s:b]%s[-:-:-]", p.label.color, text)
+		case p.label.selected:
+			styled = fmt.Sprintf("[%s::]%s[-:-:-]", p.label.color, text)
+		default:
+			styled = fmt.Sprintf("[%s::b]%s[-:-:-]", p.label.color, text)
+		}
+		width := tview.TaggedStringWidth(text)
+		lines[p.row] = replaceMapCells(lines[p.row], p.col, width, styled)
+		for col := p.col; col < p.col+width; col++ {
+			taken.take(col, p.row)
+		}
+	}
+	return placed, taken
+}
+
+// cityAt returns the name of the city whose marker, label or leader line
+// covers a braille cell, or an empty string.
+func cityAt(labels []mapLabel, placed []placedLabel, col, row int) string {
+	for _, l := range labels {
+		if l.col == col && l.row == row {
+			return l.name
+		}
+	}
+	for _, p := range placed {
+		width := tview.TaggedStringWidth(tview.Escape(p.text))
+		if row == p.row && col >= p.col && col < p.col+width {
+			return p.label.name
+		}
+		for _, cell := range p.leader {
+			if cell[0] == col && cell[1] == row {
+				return p.label.name
+			}
+		}
+	}
+	return ""
+}
+
+// replaceMapCells replaces width display cells of a map line, starting at
+// col, with text. Color tags in the line are skipped when counting cells.
+// The line is returned unchanged if it is too short.
+func replaceMapCells(line string, col, width int, text string) string {
+	runes := []rune(line)
+	visible := 0
+	start := -1
+	for i := 0; i < len(runes); i++ {
+		// Skip over tview color/style tags
+		if runes[i] == '[' {
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end < len(runes) {
+				i = end
+				continue
+			}
+		}
+		if visible == col {
+			start = i
+		}
+		visible += cellWidth(runes[i])
+		if start >= 0 && visible >= col+width {
+			var sb strings.Builder
+			sb.WriteString(string(runes[:start]))
+			sb.WriteString(text)
+			sb.WriteString(string(runes[i+1:]))
+			return sb.String()
+		}
+	}
+	return line
+}
+
+// cellWidth returns how many terminal cells a rune takes up.
+func cellWidth(r rune) int {
+	return max(1, tview.TaggedStringWidth(tview.Escape(string(r))))
+}
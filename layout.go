@@ -0,0 +1,457 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+)
+
+// Dashboard layouts
+const (
+	layoutAuto  = "auto"  // Chosen from the terminal size
+	layoutMap   = "map"   // Full-screen map
+	layoutPanel = "panel" // Map with the clock panels beside it
+	layoutGrid  = "grid"  // Cards with big clocks
+	layoutWall  = "wall"  // An analog clock per city
+	layoutTable = "table" // One row per city, sortable
+	layoutStrip = "strip" // A single line, for small tmux panes
+)
+
+// layoutNames lists the layouts in the order the layout key cycles them.
+var layoutNames = []string{layoutAuto, layoutMap, layoutPanel, layoutGrid, layoutWall, layoutTable, layoutStrip}
+
+// Table sort orders
+const (
+	sortByOffset = "offset"
+	sortByName   = "name"
+)
+
+// Card width of the grid layout, borders included
+const cardWidth = 24
+
+// cardHeight returns the card height of the grid layout, borders included:
+// the big digits and four lines of text.
+func cardHeight() int {
+	return BigDigitRows(1) + 5
+}
+
+var (
+	dashboardLayout = layoutAuto   // Chosen layout
+	activeLayout    = layoutMap    // Layout last drawn; auto resolves to a concrete one
+	tableSort       = sortByOffset // Sort order of the table layout
+)
+
+// SetLayout chooses the dashboard layout by name.
+func SetLayout(name string) error {
+	for _, l := range layoutNames {
+		if strings.EqualFold(l, name) {
+			dashboardLayout = l
+			return nil
+		}
+	}
+	if name == "" {
+		dashboardLayout = layoutAuto
+		return nil
+	}
+	return fmt.Errorf("unknown layout %q (choose from %s)", name, strings.Join(layoutNames, ", "))
+}
+
+// CycleLayout switches to the layout after the one on screen. Auto is
+// left out: once a layout is picked by key, it stays.
+func CycleLayout() {
+	for i, l := range layoutNames {
+		if l == activeLayout {
+			next := layoutNames[(i+1)%len(layoutNames)]
+			if next == layoutAuto {
+				next = layoutNames[1]
+			}
+			dashboardLayout = next
+			return
+		}
+	}
+}
+
+// ResolveLayout returns the layout to draw on a screen of the given size:
+// the chosen one, or for auto the one that suits the size best.
+func ResolveLayout(width, height int) string {
+	activeLayout = dashboardLayout
+	if activeLayout == layoutAuto {
+		switch {
+		case height < 8 || width < 40:
+			activeLayout = layoutStrip
+		case height < 20 || width < 80:
+			activeLayout = layoutTable
+		case width >= 160:
+			activeLayout = layoutPanel
+		default:
+			activeLayout = layoutMap
+		}
+	}
+	return activeLayout
+}
+
+// IsMapLayout returns true if the layout on screen shows the map.
+func IsMapLayout() bool {
+	return activeLayout == layoutMap || activeLayout == layoutPanel
+}
+
+// ToggleTableSort switches the table between sorting by UTC offset and by
+// name. It returns false if the table is not on screen.
+func ToggleTableSort() bool {
+	if activeLayout != layoutTable {
+		return false
+	}
+	if tableSort == sortByOffset {
+		tableSort = sortByName
+	} else {
+		tableSort = sortByOffset
+	}
+	return true
+}
+
+// GetLayoutStatus returns the layout label for the status bar, or an empty
+// string for the map layouts.
+func GetLayoutStatus() string {
+	switch activeLayout {
+	case layoutGrid:
+		return "[aqua]" + T("Grid") + "[-]"
+	case layoutWall:
+		return "[aqua]" + T("Wall") + "[-]"
+	case layoutTable:
+		order := T("Offset")
+		if tableSort == sortByName {
+			order = T("Name")
+		}
+		return "[aqua]" + T("Table") + " ▼ " + order + "[-]"
+	}
+	return ""
+}
+
+// dashboardCity is a city of the clock panels, with its local time.
+type dashboardCity struct {
+	region   Region
+	city     *City
+	local    time.Time
+	selected bool
+}
+
+// dashboardCities returns the cities of both clock panels at now. Cities
+// with an unknown time zone are left out.
+func dashboardCities(left, right []Region, now time.Time) []dashboardCity {
+	var cities []dashboardCity
+	for _, panel := range []struct {
+		name    string
+		regions []Region
+	}{{"left", left}, {"right", right}} {
+		for i, r := range panel.regions {
+			loc, err := time.LoadLocation(r.Timezone)
+			if err != nil {
+				continue
+			}
+			cities = append(cities, dashboardCity{
+				region:   r,
+				city:     GetCityByName(r.Name),
+				local:    now.In(loc),
+				selected: IsNavigationActive() && navState.selectedPanel == panel.name && navState.selectedIndex == i,
+			})
+		}
+	}
+	return cities
+}
+
+// abbreviation returns the city's short name, e.g. "NYC".
+func (c dashboardCity) abbreviation() string {
+	if c.city != nil {
+		return c.city.Abbreviation()
+	}
+	return strings.ToUpper(truncateText(c.region.Name, 3))
+}
+
+// phase returns the coloured day phase at the city, or an empty string if
+// its coordinates are unknown.
+func (c dashboardCity) phase() string {
+	if c.city == nil {
+		return ""
+	}
+	return getDayPhase(c.local, c.city.Coordinates[0], c.city.Coordinates[1])
+}
+
+// phaseColor returns the colour of the day phase at the city.
+func (c dashboardCity) phaseColor() string {
+	if c.city == nil {
+		return themeColor(roleText)
+	}
+	sun := computeSunPosition(c.local)
+	return getColorForDayPhase(getDayPhaseForLocation(sun, c.city.Coordinates[0], c.city.Coordinates[1]))
+}
+
+// offset returns the city's UTC offset in seconds.
+func (c dashboardCity) offset() int {
+	_, offset := c.local.Zone()
+	return offset
+}
+
+// formatRelativeOffset formats the difference between two UTC offsets in
+// seconds, e.g. "+5h", "-3:30h" or "±0h".
+func formatRelativeOffset(seconds int) string {
+	if seconds == 0 {
+		return "±0h"
+	}
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	if seconds%3600 == 0 {
+		return fmt.Sprintf("%s%dh", sign, seconds/3600)
+	}
+	return fmt.Sprintf("%s%d:%02dh", sign, seconds/3600, seconds%3600/60)
+}
+
+// scrollToSelected returns the first of total rows to show in visible
+// rows so that the selected row, if any, is on screen.
+func scrollToSelected(selected, total, visible int) int {
+	if selected < visible || visible <= 0 {
+		return 0
+	}
+	return min(selected-visible+1, max(0, total-visible))
+}
+
+// renderClockSidePanel returns the clock panels of the panel layout: the
+// two groups of cities one above the other.
+func renderClockSidePanel(left, right []Region) string {
+	return "[yellow::b]" + T("Americas & Europe") + "[-::-]" + formatClockPanel(left, "left") +
+		"\n[yellow::b]" + T("Asia, Africa & Oceania") + "[-::-]" + formatClockPanel(right, "right")
+}
+
+// clockSidePanelWidth returns the width the clock panels need, at most
+// half the screen.
+func clockSidePanelWidth(panel string, width int) int {
+	need := 0
+	for _, line := range strings.Split(panel, "\n") {
+		need = max(need, tview.TaggedStringWidth(line))
+	}
+	return min(need+1, width/2)
+}
+
+// renderClockGrid returns the grid layout: a card per city with its time,
+// date, offset from here and day phase. The selected card has a double
+// border and is scrolled into view.
+func renderClockGrid(cities []dashboardCity, width, height int, now time.Time) string {
+	columns := max(1, width/cardWidth)
+	rows := (len(cities) + columns - 1) / columns
+	selectedRow := -1
+	for i, c := range cities {
+		if c.selected {
+			selectedRow = i / columns
+		}
+	}
+	first := scrollToSelected(selectedRow, rows, height/cardHeight())
+	_, here := now.Zone()
+
+	var b strings.Builder
+	for row := first; row < rows; row++ {
+		cards := make([][]string, 0, columns)
+		for i := row * columns; i < min(len(cities), (row+1)*columns); i++ {
+			cards = append(cards, renderClockCard(cities[i], here))
+		}
+		for line := 0; line < cardHeight(); line++ {
+			for _, card := range cards {
+				b.WriteString(card[line])
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// renderClockCard returns the lines of one card of the grid layout.
+func renderClockCard(c dashboardCity, here int) []string {
+	inner := cardWidth - 2
+	h, v, tl, tr, bl, br := "─", "│", "┌", "┐", "└", "┘"
+	if c.selected {
+		h, v, tl, tr, bl, br = "═", "║", "╔", "╗", "╚", "╝"
+	}
+	if MapRenderer() == rendererASCII {
+		h, v, tl, tr, bl, br = "-", "|", "+", "+", "+", "+"
+		if c.selected {
+			h = "="
+		}
+	}
+	color := colorToTag(c.region.Color)
+	if c.selected {
+		color = "yellow"
+	}
+	border := func(text string) string {
+		return fmt.Sprintf("[%s]%s[-]%s[%s]%s[-]", color, v, padTagged(text, inner), color, v)
+	}
+
+	name := truncateText(LocalCityName(c.region.Name), inner-6)
+	date := FormatShortDate(c.local)
+	relative := formatRelativeOffset(c.offset() - here)
+	lines := []string{
+		fmt.Sprintf("[%s]%s%s%s[-]", color, tl, strings.Repeat(h, inner), tr),
+		border(fmt.Sprintf(" [%s::b]%s[-::-]%s[darkgray]%s[-] ", colorToTag(c.region.Color), name,
+			strings.Repeat(" ", max(1, inner-2-textWidth(name)-3)), c.abbreviation())),
+	}
+	for _, digits := range RenderBigDigits(strings.TrimSpace(FormatClockMinutes(c.local)), 1) {
+		pad := max(0, (inner-textWidth(digits))/2)
+		lines = append(lines, border(strings.Repeat(" ", pad)+"[white::b]"+digits+"[-::-]"))
+	}
+	return append(lines,
+		border(fmt.Sprintf(" [silver]%s[-]%s[green]%s[-] ", date,
+			strings.Repeat(" ", max(1, inner-2-textWidth(date)-textWidth(relative))), relative)),
+		border(" "+c.phase()),
+		fmt.Sprintf("[%s]%s%s%s[-]", color, bl, strings.Repeat(h, inner), br),
+	)
+}
+
+// Smallest analog face of the wall layout, in lines
+const minWallFaceRows = 4
+
+// renderClockWall returns the wall layout: an analog clock per city, its
+// dial coloured by the day phase there, with the name and time below.
+// Tiles are laid out to make the faces as large as the screen allows; if
+// even the smallest faces do not fit, the wall scrolls to the selected
+// city.
+func renderClockWall(cities []dashboardCity, width, height int) string {
+	if len(cities) == 0 {
+		return ""
+	}
+	// Try every column count and keep the one with the largest faces; a
+	// face is twice as wide as high in cells to look round
+	columns, faceRows := 1, 0
+	for c := 1; c <= len(cities); c++ {
+		rows := (len(cities) + c - 1) / c
+		tileWidth, tileHeight := width/c, height/rows
+		face := min(tileHeight-3, (tileWidth-2)/2)
+		if face > faceRows {
+			columns, faceRows = c, face
+		}
+	}
+	if faceRows < minWallFaceRows {
+		faceRows = minWallFaceRows
+		columns = max(1, width/(faceRows*2+2))
+	}
+	faceCols := faceRows * 2
+	tileWidth := max(faceCols+2, width/columns)
+
+	rows := (len(cities) + columns - 1) / columns
+	selectedRow := -1
+	for i, c := range cities {
+		if c.selected {
+			selectedRow = i / columns
+		}
+	}
+	first := scrollToSelected(selectedRow, rows, height/(faceRows+3))
+
+	centre := func(text string) string {
+		pad := max(0, (tileWidth-tview.TaggedStringWidth(text))/2)
+		return padTagged(strings.Repeat(" ", pad)+text, tileWidth)
+	}
+	var b strings.Builder
+	for row := first; row < rows; row++ {
+		tiles := make([][]string, 0, columns)
+		for i := row * columns; i < min(len(cities), (row+1)*columns); i++ {
+			c := cities[i]
+			face := RenderAnalogFace(c.local, faceCols, faceRows, c.phaseColor(), faceRows >= 6)
+			tile := make([]string, 0, faceRows+3)
+			for _, line := range face {
+				tile = append(tile, centre(line))
+			}
+			name := fmt.Sprintf("[%s::b]%s[-::-]", colorToTag(c.region.Color), truncateText(LocalCityName(c.region.Name), tileWidth-2))
+			if c.selected {
+				name = fmt.Sprintf("[black:yellow]%s[-:-]", truncateText(LocalCityName(c.region.Name), tileWidth-2))
+			}
+			tile = append(tile, centre(name),
+				centre("[silver]"+strings.TrimSpace(FormatClock(c.local))+"[-]"), "")
+			tiles = append(tiles, tile)
+		}
+		for line := 0; line < faceRows+3; line++ {
+			for _, tile := range tiles {
+				b.WriteString(tile[line])
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// renderClockTable returns the table layout: a header and a row per city,
+// sorted by UTC offset or by name. The selected row is marked and scrolled
+// into view.
+func renderClockTable(cities []dashboardCity, height int, now time.Time) string {
+	sorted := append([]dashboardCity{}, cities...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if tableSort == sortByName || sorted[i].offset() == sorted[j].offset() {
+			return LocalCityName(sorted[i].region.Name) < LocalCityName(sorted[j].region.Name)
+		}
+		return sorted[i].offset() < sorted[j].offset()
+	})
+	selected := -1
+	for i, c := range sorted {
+		if c.selected {
+			selected = i
+		}
+	}
+	_, here := now.Zone()
+
+	cityHeader, offsetHeader := T("City"), "UTC"
+	if tableSort == sortByName {
+		cityHeader += " ▼"
+	} else {
+		offsetHeader += " ▼"
+	}
+	dateWidth := ShortDateWidth()
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("   [yellow::b]%s %s  %s  %s  %s  %s[-::-]\n",
+		padTagged(cityHeader, 18), padTagged(T("Time"), ClockWidth()), padTagged(T("Date"), dateWidth),
+		padTagged(offsetHeader, 9), padTagged(T("Local"), 7), T("Phase")))
+
+	first := scrollToSelected(selected, len(sorted), height-1)
+	for i := first; i < len(sorted); i++ {
+		c := sorted[i]
+		indicator := "   "
+		if c.selected {
+			indicator = "[yellow]►[-]  "
+		}
+		b.WriteString(fmt.Sprintf("%s[%s::b]%s[-::-] [white::b]%s[-::-]  [silver]%s[-]  [darkgray]%s[-]  [green]%s[-]  %s\n",
+			indicator, colorToTag(c.region.Color), padTagged(LocalCityName(c.region.Name), 18),
+			FormatClock(c.local), padTagged(FormatShortDate(c.local), dateWidth),
+			padTagged("UTC"+c.local.Format("-07:00"), 9), padTagged(formatRelativeOffset(c.offset()-here), 7), c.phase()))
+	}
+	return b.String()
+}
+
+// renderClockStrip returns the strip layout: one line of short names and
+// times. Cities that do not fit are counted at the end.
+func renderClockStrip(cities []dashboardCity, width int) string {
+	var b strings.Builder
+	used := 0
+	for i, c := range cities {
+		entry := fmt.Sprintf("[%s::b]%s[-::-] %s", colorToTag(c.region.Color), c.abbreviation(),
+			strings.TrimSpace(FormatClockMinutes(c.local)))
+		if c.selected {
+			entry = fmt.Sprintf("[black:yellow]%s %s[-:-]", c.abbreviation(), strings.TrimSpace(FormatClockMinutes(c.local)))
+		}
+		separator := ""
+		if i > 0 {
+			separator = " [darkgray]│[-] "
+		}
+		more := fmt.Sprintf(" [darkgray]+%d[-]", len(cities)-i)
+		entryWidth := tview.TaggedStringWidth(separator + entry)
+		// Keep room for the count of the cities left out
+		if i < len(cities)-1 {
+			entryWidth += tview.TaggedStringWidth(more)
+		}
+		if used+entryWidth > width {
+			b.WriteString(more)
+			break
+		}
+		b.WriteString(separator + entry)
+		used += tview.TaggedStringWidth(separator + entry)
+	}
+	return b.String()
+}
@@ -13,9 +13,21 @@ import (
 
 // CLI flag variables
 var (
-	flagCities string
-	flagPreset string
-	flagList   bool
+	flagCities     string
+	flagPreset     string
+	flagList       bool
+	flagProjection string
+	flagMeridian   float64
+	flagTheme      string
+	flagColors     string
+	flagKeys       string
+	flagListKeys   bool
+	flagClock      string
+	flagDate       string
+	flagLang       string
+	flagRenderer   string
+	flagAccessible bool
+	flagLayout     string
 )
 
 // Preset city groups
@@ -79,7 +91,7 @@ func convertAllCitiesToRegions() ([]Region, []Region, bool) {
 			Color:    city.Color,
 		}
 
-		if city.Category == "Americas" || city.Category == "Europe" {
+		if isLeftPanelCity(city) {
 			left = append(left, region)
 		} else {
 			right = append(right, region)
@@ -89,6 +101,22 @@ func convertAllCitiesToRegions() ([]Region, []Region, bool) {
 	return left, right, true
 }
 
+// isLeftPanelCity reports whether a city belongs on the left clock panel,
+// which holds the Americas and Europe; the rest of the world is on the right.
+func isLeftPanelCity(city City) bool {
+	return city.Category == "Americas" || city.Category == "Europe"
+}
+
+// addClockCity adds a city to the clock panel for its category.
+func addClockCity(city City) {
+	region := Region{Name: city.Name, Timezone: city.Timezone, Color: city.Color}
+	if isLeftPanelCity(city) {
+		leftRegions = append(leftRegions, region)
+	} else {
+		rightRegions = append(rightRegions, region)
+	}
+}
+
 // filterRegionsByNames filters the left and right region lists to only include the specified cities.
 func filterRegionsByNames(cityNames []string) ([]Region, []Region, bool) {
 	var left, right []Region
@@ -101,7 +129,7 @@ func filterRegionsByNames(cityNames []string) ([]Region, []Region, bool) {
 				Color:    city.Color,
 			}
 			// Put in appropriate panel based on category
-			if city.Category == "Americas" || city.Category == "Europe" {
+			if isLeftPanelCity(*city) {
 				left = append(left, region)
 			} else {
 				right = append(right, region)
@@ -347,6 +375,11 @@ func getBrailleWorldMap() string {
 }
 
 func main() {
+	// Subcommands run without the TUI
+	if len(os.Args) > 1 && os.Args[1] == "meeting" {
+		os.Exit(runMeetingCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	// Ensure config directory exists
 	if err := EnsureConfigDir(); err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: Could not create config directory: %v\n", err)
@@ -359,12 +392,150 @@ func main() {
 	flag.StringVar(&flagCities, "cities", "", "comma-separated list of city names (e.g., 'Tokyo,London,New York')")
 	flag.StringVar(&flagPreset, "preset", "", "use predefined city groups (business, family, americas, europe, asia)")
 	flag.BoolVar(&flagList, "list", false, "show all available cities and exit")
+	flag.StringVar(&flagProjection, "projection", "", "map projection (equirectangular, mercator, robinson, pacific)")
+	flag.Float64Var(&flagMeridian, "meridian", defaultCentreMeridian, "centre meridian of the pacific projection, in degrees east")
+	flag.StringVar(&flagTheme, "theme", "", "colour theme (dark, light, high-contrast, colorblind or a theme from the config)")
+	flag.StringVar(&flagColors, "colors", "", "colour depth (auto, truecolor, 256, 16, mono)")
+	flag.StringVar(&flagKeys, "keys", "", "key binding preset (default, vim, emacs)")
+	flag.BoolVar(&flagListKeys, "list-keys", false, "show all key binding actions with their keys and exit")
+	flag.StringVar(&flagClock, "clock", "", "clock format (12h, 24h)")
+	flag.StringVar(&flagDate, "date", "", "date style (text, long, iso, us, eu)")
+	flag.StringVar(&flagLang, "lang", "", "UI language (en, de, fr, es; default from LANG)")
+	flag.StringVar(&flagRenderer, "renderer", "", "map renderer (auto, braille, halfblock, ascii)")
+	flag.StringVar(&flagLayout, "layout", "", "dashboard layout (auto, map, panel, grid, wall, table, strip)")
+	flag.BoolVar(&flagAccessible, "accessible", false, "screen-reader friendly text layout instead of the map")
 	flag.Parse()
 
+	// Map projection: CLI flags take precedence over config
+	meridianSet := false
+	flag.Visit(func(f *flag.Flag) { meridianSet = meridianSet || f.Name == "meridian" })
+	projection := flagProjection
+	if config != nil {
+		if projection == "" {
+			projection = config.MapProjection
+		}
+		if !meridianSet && config.CentreMeridian != nil {
+			flagMeridian = *config.CentreMeridian
+		}
+	}
+	SetCentreMeridian(flagMeridian)
+	if projection != "" {
+		if err := SetProjection(projection); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+	}
+
+	// Map renderer: CLI flag takes precedence over config
+	renderer := flagRenderer
+	if renderer == "" && config != nil {
+		renderer = config.Renderer
+	}
+	if err := SetMapRenderer(renderer); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Dashboard layout: CLI flag takes precedence over config
+	layout := flagLayout
+	if layout == "" && config != nil {
+		layout = config.Layout
+	}
+	if err := SetLayout(layout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Accessible layout: on if either the flag or the config asks for it
+	SetAccessibleMode(flagAccessible || (config != nil && config.Accessible))
+
+	// Colour theme: CLI flags take precedence over config
+	theme, colorMode := flagTheme, flagColors
+	if config != nil {
+		if err := RegisterUserThemes(config.Themes); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		if theme == "" {
+			theme = config.Theme
+		}
+		if colorMode == "" {
+			colorMode = config.ColorMode
+		}
+	}
+	if theme == "" {
+		theme = "dark"
+	}
+	if err := SetColorMode(colorMode); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if err := SetTheme(theme); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Key bindings: the preset flag takes precedence over config
+	keyPreset := flagKeys
+	var keyOverrides map[string][]string
+	if config != nil {
+		if keyPreset == "" {
+			keyPreset = config.KeyPreset
+		}
+		keyOverrides = config.KeyBindings
+	}
+	if err := LoadKeyBindings(keyPreset, keyOverrides); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if flagListKeys {
+		PrintKeyBindings(os.Stdout)
+		return
+	}
+
+	// Language: the flag takes precedence over config, then the environment.
+	// It comes before the time format, which defaults to the language's
+	// date style.
+	language := flagLang
+	if language == "" && config != nil {
+		language = config.Locale
+	}
+	if err := ApplyLocale(language); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Time format: CLI flags take precedence over config
+	var timeFormat TimeFormatConfig
+	if config != nil {
+		timeFormat = config.TimeFormat
+	}
+	if flagClock != "" {
+		timeFormat.Clock = flagClock
+	}
+	if flagDate != "" {
+		timeFormat.Date = flagDate
+	}
+	if err := SetTimeFormat(timeFormat); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
 	// Use CLI flags if provided, otherwise fall back to config
 	// (config values already loaded, but CLI takes precedence)
 	_ = config // Future: auto-save config if user makes changes
 
+	// Cities added from the map cursor join the database and the clock
+	// panels, as do cities added from the command palette
+	if config != nil {
+		RegisterCustomCities(config.CustomCities)
+		for _, name := range config.AddedCities {
+			if city := GetCityByName(name); city != nil && !isOnClocks(city.Name) {
+				addClockCity(*city)
+			}
+		}
+	}
+
 	// Get configured cities based on flags
 	// Update the package-level variables so all functions see the filtered lists
 	configLeft, configRight, shouldRun := GetConfiguredCities()
@@ -385,21 +556,45 @@ func main() {
 		SetTextAlign(tview.AlignCenter).
 		SetScrollable(false)
 	mapView.SetBorder(false) // No border — map IS the screen
+	mapView.SetBackgroundColor(themeTcellColor(roleWater))
+
+	// The accessible layout's city list replaces the map
+	var mapArea tview.Primitive = mapView
+	accessible := newAccessibleList()
+	if IsAccessibleMode() {
+		mapArea = accessible
+	}
 
 	// Status bar: 1 row at bottom
 	statusBar := tview.NewTextView().
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignLeft).
-		SetScrollable(false)
+		SetScrollable(false).
+		SetWrap(false) // A long status is cut at the edge, never wrapped away
 	statusBar.SetBorder(false)
 
+	// Clock panels beside the map: hidden (zero width) outside the panel layout
+	clockPanelView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(false).
+		SetWrap(false)
+
+	// City details: hidden (zero width) until toggled with I
+	detailsView := InitNavigation()
+	mapRow := tview.NewFlex().
+		AddItem(mapArea, 0, 1, false).
+		AddItem(clockPanelView, 0, 0, false).
+		AddItem(detailsView, 0, 0, false)
+
 	// Layout: map fills everything except 1 row for status
 	baseLayout := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(mapView, 0, 1, false).  // fills all available space
+		AddItem(mapRow, 0, 1, false).   // fills all available space
 		AddItem(statusBar, 1, 0, false) // 1 fixed row at bottom
 
 	pages.AddPage("base", baseLayout, true, true)
-	app.SetRoot(pages, true)
+	// The root rebuilds the views whenever the screen is drawn at a new size
+	root := newSizedRoot(pages)
+	app.SetRoot(root, true)
 
 	// ── MODE SYSTEM ──
 	mm := newModeManager()
@@ -420,111 +615,218 @@ func main() {
 	mm.RegisterHandler(ModeAlarm, alarm)
 	mm.RegisterHandler(ModeMeeting, meeting)
 
+	// ── STATUS BAR ──
+	// updateStatusBar shows the selected city, or the local time, followed by
+	// the labels that are not empty, with [=] at the right edge.
+	updateStatusBar := func(width int, labels ...string) {
+		var statusText string
+		if IsMapCursorActive() {
+			statusText = formatCursorStatus(time.Now()) + " [darkgray][=][-]"
+		} else if IsNavigationActive() && navState.selectedCity != nil {
+			r := navState.selectedCity
+			loc, _ := time.LoadLocation(r.Timezone)
+			now := time.Now().In(loc)
+			color := colorToTag(r.Color)
+			statusText = fmt.Sprintf("[%s]%s[white]  %s  %s  %s  %s [darkgray][=][-]",
+				color, GetCityByName(r.Name).Abbreviation(), LocalCityName(r.Name), FormatShortDate(now), strings.TrimSpace(FormatClockMinutes(now))+" "+now.Format("MST"),
+				formatMoonStatus(now))
+		} else {
+			now := time.Now()
+			statusText = fmt.Sprintf("[green]%s[white]  %s  %s  %s [darkgray][=][-]",
+				T("Local"), FormatShortDate(now), strings.TrimSpace(FormatClockMinutes(now)), formatMoonStatus(now))
+		}
+
+		for _, label := range labels {
+			if label != "" {
+				statusText = strings.Replace(statusText, " [darkgray][=][-]", "  "+label+" [darkgray][=][-]", 1)
+			}
+		}
+
+		// Right-align [=] by padding with spaces, measured in screen cells
+		padding := width - tview.TaggedStringWidth(statusText)
+		if padding > 0 {
+			statusText = strings.Replace(statusText, " [darkgray][=][-]", strings.Repeat(" ", padding)+" [darkgray][=][-]", 1)
+		}
+		statusBar.SetText(ThemeText(statusText))
+	}
+
 	// ── UPDATE FUNCTION ──
+	// accessibleRedraw is set by updateUI when the accessible layout or the
+	// feature shown over it changed
+	accessibleRedraw := false
 	updateUI := func() {
-		// Use a temporary view to get the screen size if we can't get it from app
-		// In tview, we usually get it from the primitive's Draw method or from app's Screen
-		// But since we want it in updateUI, we can use tcell.Screen if we had access to it.
-		// A better way is to use a Box to fill the screen and get its Rect.
-		// However, for simplicity and since we are in a closure, we'll try to get it from the root.
+		// The size the screen is drawn at; the first draw queues a render
+		// through the root's resize function, so nothing is guessed before it
+		width, height := root.Size()
+		if width <= 0 || height <= 0 {
+			return
+		}
 
-		// Actually, tview.Application doesn't expose GetScreen.
-		// But we can get it when the app starts.
-		// For now, let's use a safe default or try to get it from the mapView's inner rect if it was drawn.
+		// Make room for the details panel when it is open
+		mapWidth := width
+		if IsDetailsVisible() {
+			mapWidth = max(0, mapWidth-detailsPanelWidth)
+			mapRow.ResizeItem(detailsView, detailsPanelWidth, 0)
+		} else {
+			mapRow.ResizeItem(detailsView, 0, 0)
+		}
+		updateNavigationView()
+
+		// The accessible layout replaces the map with a stable list whose
+		// lines are only rewritten when they change
+		if IsAccessibleMode() {
+			lines := renderAccessibleLines(leftRegions, rightRegions, time.Now())
+			accessibleRedraw = accessible.SetLines(lines)
+			if accessibleRedraw {
+				statusBar.SetText(ThemeText("[darkgray]" + KeyHelp([]string{"map.up", "map.down", "map.details", "map.menu", "map.help", "map.quit"})))
+			}
+			if om.state == OverlayFeature && om.renderFeature() {
+				accessibleRedraw = true
+			}
+			return
+		}
 
-		_, _, width, height := pages.GetInnerRect()
-		if width == 0 || height == 0 {
-			// Fallback to a reasonable default if not yet drawn
-			width, height = 80, 24
+		// Layouts without the map fill the map view with text; the strip
+		// also hides the status bar
+		layout := ResolveLayout(width, height)
+		if layout == layoutStrip {
+			baseLayout.ResizeItem(statusBar, 0, 0)
+		} else {
+			baseLayout.ResizeItem(statusBar, 1, 0)
+		}
+		if !IsMapLayout() {
+			mapRow.ResizeItem(clockPanelView, 0, 0)
+			mapView.SetTextAlign(tview.AlignLeft).
+				SetBackgroundColor(themeTcellColor(roleBackground))
+			SetMapMouseLayout(0, 0, 0, 0, 0, 0, nil, nil) // No map under the mouse
+			now := time.Now()
+			cities := dashboardCities(leftRegions, rightRegions, now)
+			var text string
+			switch layout {
+			case layoutGrid:
+				text = renderClockGrid(cities, mapWidth, height-1, now)
+			case layoutWall:
+				text = renderClockWall(cities, mapWidth, height-1)
+			case layoutTable:
+				text = renderClockTable(cities, height-1, now)
+			default:
+				text = renderClockStrip(cities, mapWidth)
+			}
+			mapView.SetText(ThemeText(text))
+			if layout != layoutStrip {
+				updateStatusBar(width, GetLayoutStatus())
+			}
+			if om.state == OverlayFeature {
+				om.renderFeature()
+			}
+			return
+		}
+		mapView.SetTextAlign(tview.AlignCenter).
+			SetBackgroundColor(themeTcellColor(roleWater))
+
+		// The panel layout shows the clock panels beside the map
+		if layout == layoutPanel {
+			panel := renderClockSidePanel(leftRegions, rightRegions)
+			panelWidth := clockSidePanelWidth(panel, mapWidth)
+			clockPanelView.SetText(ThemeText(panel))
+			mapRow.ResizeItem(clockPanelView, panelWidth, 0)
+			mapWidth = max(0, mapWidth-panelWidth)
+		} else {
+			mapRow.ResizeItem(clockPanelView, 0, 0)
+		}
+
+		// The map has the screen less the status bar; offset bands take a
+		// row above and below it
+		mapHeight := height - 1
+		if IsTimezoneOverlayEnabled() {
+			mapHeight -= 2
 		}
 
-		// Calculate braille dimensions (subtract 1 for status bar)
-		brailleCols := width
-		brailleRows := height - 1
+		// Calculate braille dimensions and fit the viewport to them
+		brailleCols, brailleRows := GetBrailleGridSize(mapWidth, mapHeight)
+		if brailleCols == 0 || brailleRows == 0 {
+			// No room for the map, e.g. a details panel wider than the screen
+			mapView.SetText("")
+			SetMapMouseLayout(0, 0, 0, 0, 0, 0, nil, nil)
+			updateStatusBar(width, GetLayoutStatus())
+			if om.state == OverlayFeature {
+				om.renderFeature()
+			}
+			return
+		}
+		vp := mapViewport
+		vp.Projection = CurrentProjection()
+		vp = vp.Fit(brailleCols, brailleRows)
 
 		// 1. Render base map
-		brailleMap := RenderBrailleMap(width, height)
+		brailleMap := RenderMap(mapWidth, mapHeight, vp)
 		lines := strings.Split(strings.TrimRight(brailleMap, "\n"), "\n")
 
 		// 2. Prepare city markers
 		allConfigured := append([]Region{}, leftRegions...)
 		allConfigured = append(allConfigured, rightRegions...)
 
-		// Sort by longitude for geographic navigation
-		// (Actually, let's just use them as they are for now, but markers need to be overlaid)
-
-		// occupied tracks columns used in each row to avoid overlap
-		occupied := make(map[int]map[int]bool)
-
-		for _, r := range allConfigured {
+		var labels []mapLabel
+		for i, r := range allConfigured {
 			city := GetCityByName(r.Name)
 			if city == nil {
 				continue
 			}
 
 			// Get grid position
-			col, row := LatLonToBraille(city.Coordinates[0], city.Coordinates[1], brailleCols, brailleRows)
+			col, row, visible := LatLonToBraille(city.Coordinates[0], city.Coordinates[1], brailleCols, brailleRows, vp)
+			if !visible {
+				continue
+			}
 
 			// Generate label: "NYC 3:04p"
 			loc, err := time.LoadLocation(r.Timezone)
 			if err != nil {
 				continue
 			}
-			now := time.Now().In(loc)
-			timeStr := now.Format("3:04PM")
-			timeStr = strings.Replace(timeStr, "PM", "p", 1)
-			timeStr = strings.Replace(timeStr, "AM", "a", 1)
-
-			abbr := city.Abbreviation()
-			label := fmt.Sprintf("%s %s", abbr, timeStr)
-			labelLen := len(label)
-
-			// Simple overlap avoidance: nudge down if collision
-			for {
-				collision := false
-				if occupied[row] == nil {
-					occupied[row] = make(map[int]bool)
-				}
-				for c := col; c < col+labelLen; c++ {
-					if occupied[row][c] {
-						collision = true
-						break
-					}
-				}
-				if collision && row < brailleRows-1 {
-					row++
-				} else {
-					break
-				}
+			label := mapLabel{
+				name:     r.Name,
+				col:      col,
+				row:      row,
+				abbr:     city.Abbreviation(),
+				clock:    FormatCompactClock(time.Now().In(loc)),
+				color:    colorToTag(r.Color),
+				priority: labelPriorityOther,
 			}
-			// Mark as occupied
-			for c := col; c < col+labelLen; c++ {
-				occupied[row][c] = true
+			if i == 0 || i == len(leftRegions) {
+				label.priority = labelPriorityReference
 			}
-
-			// Selection effect
-			isSelected := IsNavigationActive() && navState.selectedCity != nil && navState.selectedCity.Name == r.Name
-			var coloredLabel string
-			if isSelected {
-				if navState.pulseState {
-					coloredLabel = fmt.Sprintf("[white:%s:b]%s[-:-:-]", colorToTag(r.Color), label)
-				} else {
-					coloredLabel = fmt.Sprintf("[%s::]* %s[-]", colorToTag(r.Color), label)
-					// Note: adding '*' increases length, but we'll ignore for simple overlap logic
-				}
-			} else {
-				coloredLabel = fmt.Sprintf("[%s::b]%s[-]", colorToTag(r.Color), label)
+			if IsNavigationActive() && navState.selectedCity != nil && navState.selectedCity.Name == r.Name {
+				label.priority = labelPrioritySelected
+				label.selected = true
 			}
+			labels = append(labels, label)
+		}
+		placed, taken := overlayMapLabels(lines, labels, brailleCols, brailleRows, navState.pulseState)
+		topRows := 0
+		if IsTimezoneOverlayEnabled() {
+			topRows = 1 // Offset band above the map
+		}
+		// The map view sits at the top left of the screen, mapWidth wide
+		screenX, screenY, _, _ := pages.GetInnerRect()
+		SetMapMouseLayout(screenX, screenY, mapWidth, topRows, brailleCols, brailleRows, labels, placed)
 
-			// Overlay on map lines
-			if row < len(lines) {
-				runes := []rune(lines[row])
-				if col+labelLen <= len(runes) {
-					before := string(runes[:col])
-					after := string(runes[col+labelLen:])
-					lines[row] = before + coloredLabel + after
-				}
-			}
+		// Subsolar and sublunar points
+		if IsSkyMarkersEnabled() {
+			overlaySkyMarkers(lines, taken, time.Now(), brailleCols, brailleRows, vp)
+		}
+
+		// The cursor or the zone under the map centre, then timezone
+		// boundaries around them
+		var zoneLabel string
+		SetMapCursorGrid(brailleCols, brailleRows, vp)
+		if IsMapCursorActive() {
+			overlayMapCursor(lines, taken)
+		} else if IsTimezoneOverlayEnabled() {
+			zoneLabel = overlayZoneCursor(lines, taken, time.Now(), brailleCols, brailleRows, vp)
+		}
+		if IsTimezoneBoundariesEnabled() {
+			overlayTimezoneBoundaries(lines, taken, time.Now(), brailleCols, brailleRows, vp)
 		}
 
 		// 3. Join lines back
@@ -533,43 +835,27 @@ func main() {
 		// 4. Apply day/night colorization
 		var finalMap string
 		if IsDayNightOverlayEnabled() {
-			finalMap = colorizeBrailleMapWithDayNight(brailleMap, brailleCols, brailleRows)
+			finalMap = colorizeBrailleMapWithDayNight(brailleMap, brailleCols, brailleRows, vp, taken)
 		} else {
-			// Plain green for land
+			// Plain land colour
 			var sb strings.Builder
 			for _, line := range lines {
-				sb.WriteString("[green]")
+				sb.WriteString("[" + themeColor(roleLand) + "]")
 				sb.WriteString(line)
 				sb.WriteString("[-]\n")
 			}
 			finalMap = sb.String()
 		}
 
-		mapView.SetText(finalMap)
-
-		// 5. Update status bar
-		var statusText string
-		if IsNavigationActive() && navState.selectedCity != nil {
-			r := navState.selectedCity
-			loc, _ := time.LoadLocation(r.Timezone)
-			now := time.Now().In(loc)
-			color := colorToTag(r.Color)
-			statusText = fmt.Sprintf("[%s]%s[white]  %s  %s  %s [darkgray][=][-]",
-				color, GetCityByName(r.Name).Abbreviation(), r.Name, now.Format("Mon Jan 2"), now.Format("3:04 PM MST"))
-		} else {
-			now := time.Now()
-			statusText = fmt.Sprintf("[green]Local[white]  %s  %s [darkgray][=][-]",
-				now.Format("Mon Jan 2"), now.Format("3:04 PM"))
+		if IsTimezoneOverlayEnabled() {
+			top, bottom := renderOffsetBands(time.Now(), brailleCols, brailleRows, vp)
+			finalMap = top + "\n" + finalMap + bottom + "\n"
 		}
 
-		// Right-align [=] by padding with spaces
-		// We need to strip tags to get visible length
-		visibleLen := len(stripTags(statusText))
-		padding := width - visibleLen
-		if padding > 0 {
-			statusText = strings.Replace(statusText, " [darkgray][=][-]", strings.Repeat(" ", padding)+" [darkgray][=][-]", 1)
-		}
-		statusBar.SetText(statusText)
+		mapView.SetText(ThemeText(finalMap))
+
+		// 5. Update status bar
+		updateStatusBar(width, GetViewportStatus(), GetProjectionStatus(), zoneLabel)
 
 		// 6. Update overlays
 		if om.state == OverlayFeature {
@@ -577,7 +863,89 @@ func main() {
 		}
 	}
 
+	// The root reports a new size while the app is drawing, and a queued
+	// update only runs once the draw is done, so queue it from a goroutine
+	root.SetResizeFunc(func() {
+		go app.QueueUpdateDraw(func() {
+			updateUI()
+			om.Refit()
+		})
+	})
+
 	// ── KEY BINDINGS ──
+	// handleMapAction runs an action bound on the map screen. It returns
+	// false if the action does not apply right now.
+	handleMapAction := func(action string) bool {
+		switch action {
+		case "map.back":
+			if IsNavigationActive() {
+				Deselect()
+				return true
+			}
+			app.Stop()
+		case "map.quit":
+			app.Stop()
+		case "map.up":
+			NavigateUp(leftRegions, rightRegions)
+		case "map.down":
+			NavigateDown(leftRegions, rightRegions)
+		case "map.menu":
+			om.ShowMenu()
+		case "map.palette":
+			om.ShowPalette()
+		case "map.help":
+			om.ShowHelp()
+		case "map.details":
+			ToggleDetails(leftRegions, rightRegions)
+		case "map.prev-day", "map.next-day", "map.today":
+			if !IsDetailsVisible() {
+				return false
+			}
+			switch action {
+			case "map.prev-day":
+				ShiftDetailsDate(-1)
+			case "map.next-day":
+				ShiftDetailsDate(1)
+			default:
+				ShiftDetailsDate(0)
+			}
+		case "map.day-night":
+			ToggleDayNightOverlay()
+		case "map.sky-markers":
+			ToggleSkyMarkers()
+		case "map.timezones":
+			CycleTimezoneOverlay()
+		case "map.cursor":
+			ToggleMapCursor()
+		case "map.zoom-in":
+			ZoomMap(true)
+		case "map.zoom-out":
+			ZoomMap(false)
+		case "map.pan-up":
+			PanMap(1, 0)
+		case "map.pan-down":
+			PanMap(-1, 0)
+		case "map.pan-left":
+			PanMap(0, -1)
+		case "map.pan-right":
+			PanMap(0, 1)
+		case "map.viewport":
+			CycleViewport()
+		case "map.world":
+			ResetViewport()
+		case "map.projection":
+			CycleProjection()
+		case "map.layout":
+			CycleLayout()
+		case "map.sort":
+			return ToggleTableSort()
+		default:
+			return false
+		}
+		return true
+	}
+	om.SetMapActionRunner(handleMapAction)
+
 	app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		// Overlay system takes priority
 		if om.state != OverlayNone {
@@ -589,73 +957,88 @@ func main() {
 		}
 
 		// Map View (OverlayNone)
-		switch event.Key() {
-		case tcell.KeyEscape:
-			if IsNavigationActive() {
-				Deselect()
+		// The map cursor's keys are layered over the map's
+		context := keyContextMap
+		if IsMapCursorActive() {
+			if HandleMapCursorKey(event) {
 				updateUI()
 				return nil
 			}
-			app.Stop()
-			return nil
-		case tcell.KeyUp:
-			NavigateUp(leftRegions, rightRegions)
-			updateUI()
-			return nil
-		case tcell.KeyDown:
-			NavigateDown(leftRegions, rightRegions)
-			updateUI()
-			return nil
-		case tcell.KeyTab:
-			NavigateDown(leftRegions, rightRegions)
+			context = keyContextCursor
+		}
+
+		action, ok := MatchKey(context, event)
+		if action == "" {
+			if ok {
+				return nil // Part of a key chord
+			}
+			return event
+		}
+		if keyActionContext(action) == keyContextCursor && HandleMapCursorAction(action) {
 			updateUI()
 			return nil
-		case tcell.KeyEnter:
-			if IsNavigationActive() {
-				// Feature selected on map -> open menu
-				om.ShowMenu()
-				updateUI()
-				return nil
-			}
-			om.ShowMenu()
+		}
+		if handleMapAction(action) {
 			updateUI()
 			return nil
-		case tcell.KeyRune:
-			switch event.Rune() {
-			case 'm', 'M', ' ':
-				om.ShowMenu()
-				updateUI()
-				return nil
-			case 'd', 'D':
-				ToggleDayNightOverlay()
-				updateUI()
-				return nil
-			case 'q', 'Q':
-				app.Stop()
-				return nil
-			}
 		}
 		return event
 	})
 
+	// ── MOUSE ──
+	app.SetMouseCapture(func(event *tcell.EventMouse, action tview.MouseAction) (*tcell.EventMouse, tview.MouseAction) {
+		ma, ok := translateMouse(event, action)
+		if !ok {
+			return nil, action
+		}
+		x, y := event.Position()
+		var handled bool
+		if om.state != OverlayNone {
+			handled = om.HandleMouse(ma, x, y)
+		} else {
+			handled = HandleMapMouse(ma, x, y, leftRegions, rightRegions)
+		}
+		if handled {
+			updateUI()
+		}
+		// Views are driven from here, not by tview's own focus and scrolling
+		return nil, action
+	})
+
 	// ── TICKER ──
 	go func() {
 		ticker := time.NewTicker(500 * time.Millisecond) // 500ms for pulse
 		defer ticker.Stop()
 
 		for range ticker.C {
-			navState.pulseState = !navState.pulseState
+			if !IsAccessibleMode() {
+				navState.pulseState = !navState.pulseState
+			}
 			// Check alarms
 			triggered := alarm.CheckAlarms()
 			if len(triggered) > 0 {
 				fmt.Print("\a")
 			}
-			app.QueueUpdateDraw(func() { updateUI() })
+			for _, a := range triggered {
+				AnnounceAlarm(a)
+			}
+			if !IsAccessibleMode() {
+				app.QueueUpdateDraw(func() { updateUI() })
+				continue
+			}
+			// Redraw only when something on screen changed, so screen
+			// readers are not made to re-read an unchanged screen
+			app.QueueUpdate(func() {
+				updateUI()
+				if accessibleRedraw {
+					app.ForceDraw()
+				}
+			})
 		}
 	}()
 
 	// ── RUN ──
-	if err := app.EnableMouse(false).Run(); err != nil {
+	if err := app.EnableMouse(true).Run(); err != nil {
 		panic(err)
 	}
 }
@@ -680,6 +1063,15 @@ func stripTags(text string) string {
 	return result.String()
 }
 
+// padTagged pads a string containing tview color tags with spaces so that it
+// occupies width screen cells.
+func padTagged(text string, width int) string {
+	if pad := width - tview.TaggedStringWidth(text); pad > 0 {
+		return text + strings.Repeat(" ", pad)
+	}
+	return text
+}
+
 // colorizeBrailleMap takes the raw braille map string and adds color tags +
 // overlays city markers at their positions.
 func colorizeBrailleMap(brailleMap string) string {
@@ -736,7 +1128,6 @@ func formatClockPanel(regs []Region, panelName string) string {
 	} else {
 		refTime = time.Now().UTC()
 	}
-	refDay := refTime.Truncate(24 * time.Hour)
 
 	for i, r := range regs {
 		loc, err := time.LoadLocation(r.Timezone)
@@ -746,28 +1137,28 @@ func formatClockPanel(regs []Region, panelName string) string {
 		}
 
 		now := time.Now().In(loc)
-		timeStr := now.Format("15:04:05")
-		dateStr := now.Format("Mon, 02 Jan 2006")
+		timeStr := FormatClock(now)
+		dateStr := FormatDate(now)
 		offsetStr := now.Format("-07:00")
-		dayPhase := getDayPhase(now)
+		dayPhase := ""
+		if city := GetCityByName(r.Name); city != nil {
+			dayPhase = getDayPhase(now, city.Coordinates[0], city.Coordinates[1])
+		}
 		colorTag := colorToTag(r.Color)
 
-		// Calculate relative offset from reference
-		hourOffset := now.Sub(refTime).Hours()
-		offsetHours := int(hourOffset + 0.5) // Round to nearest hour
-
-		// Format offset string
+		// Relative offset from the reference, from the UTC offsets
+		_, offset := now.Zone()
+		_, refOffset := refTime.Zone()
 		var offsetDisplay string
-		if offsetHours == 0 {
-			offsetDisplay = "[green]+0h"
-		} else if offsetHours > 0 {
-			offsetDisplay = fmt.Sprintf("[green]+%dh", offsetHours)
+		if offset >= refOffset {
+			offsetDisplay = "[green]" + formatRelativeOffset(offset-refOffset)
 		} else {
-			offsetDisplay = fmt.Sprintf("[red]%dh", offsetHours)
+			offsetDisplay = "[red]" + formatRelativeOffset(offset-refOffset)
 		}
 
-		// Determine if different day (check day difference)
-		nowDay := now.Truncate(24 * time.Hour)
+		// Determine if different day, comparing calendar dates
+		nowDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		refDay := time.Date(refTime.Year(), refTime.Month(), refTime.Day(), 0, 0, 0, 0, time.UTC)
 		dayDiff := int(nowDay.Sub(refDay).Hours() / 24)
 
 		var dayIndicator string
@@ -795,14 +1186,14 @@ func formatClockPanel(regs []Region, panelName string) string {
 		}
 
 		b.WriteString(fmt.Sprintf(
-			"%s[%s::b]%-13s[-::-] [white::b]%s[-::-]  [silver]%s  [darkgray]UTC%s  %s %s%s\n",
+			"%s[%s::b]%s[-::-] [white::b]%s[-::-]  [silver]%s  [darkgray]UTC%s  %s %s%s\n",
 			selectIndicator,
 			colorTag,
-			r.Name,
+			padTagged(LocalCityName(r.Name), 13),
 			timeStr,
 			dateStr,
 			offsetStr,
-			dayPhase,
+			padTagged(dayPhase, 12),
 			offsetDisplay,
 			dayIndicator,
 		))
@@ -811,47 +1202,33 @@ func formatClockPanel(regs []Region, panelName string) string {
 	return b.String()
 }
 
-// getDayPhase returns a colored label for the time of day.
-func getDayPhase(t time.Time) string {
-	hour := t.Hour()
-	switch {
-	case hour >= 5 && hour < 8:
-		return "[#FFA07A]Dawn[-]"
-	case hour >= 8 && hour < 12:
-		return "[yellow]Morning[-]"
-	case hour >= 12 && hour < 17:
-		return "[#FFD700]Afternoon[-]"
-	case hour >= 17 && hour < 20:
-		return "[orange]Evening[-]"
-	case hour >= 20 && hour < 22:
-		return "[#CD853F]Dusk[-]"
+// getDayPhase returns a colored label for the sun's position at a location,
+// in the active theme's day phase colours.
+func getDayPhase(t time.Time, lat, lon float64) string {
+	sun := computeSunPosition(t)
+	rising := sun.isSunRising(lon)
+	switch dayPhaseForElevation(sun.elevationAt(lat, lon)) {
+	case "day":
+		if rising {
+			return "[yellow]" + T("Morning") + "[-]"
+		}
+		return "[" + themeColor(roleGolden) + "]" + T("Afternoon") + "[-]"
+	case "golden":
+		return "[orange]" + T("Golden hour") + "[-]"
+	case "civil":
+		if rising {
+			return "[" + themeColor(roleCivil) + "]" + T("Dawn") + "[-]"
+		}
+		return "[" + themeColor(roleNautical) + "]" + T("Dusk") + "[-]"
+	case "nautical", "astronomical":
+		return "[" + themeColor(roleAstronomical) + "]" + T("Twilight") + "[-]"
 	default:
-		return "[#6A5ACD]Night[-]"
+		return "[" + themeColor(roleNight) + "]" + T("Night") + "[-]"
 	}
 }
 
-// colorToTag maps tcell.Color to tview color tag strings.
+// colorToTag maps a city's tcell.Color to a tview color tag in the active
+// theme.
 func colorToTag(c tcell.Color) string {
-	colorMap := map[tcell.Color]string{
-		tcell.ColorDodgerBlue:  "dodgerblue",
-		tcell.ColorDarkCyan:    "darkcyan",
-		tcell.ColorGreen:       "green",
-		tcell.ColorDarkGreen:   "darkgreen",
-		tcell.ColorRed:         "red",
-		tcell.ColorGold:        "gold",
-		tcell.ColorOrange:      "orange",
-		tcell.ColorDarkMagenta: "darkmagenta",
-		tcell.ColorDeepPink:    "deeppink",
-		tcell.ColorYellow:      "yellow",
-		tcell.ColorLimeGreen:   "limegreen",
-		tcell.ColorSandyBrown:  "sandybrown",
-		tcell.ColorCoral:       "coral",
-		tcell.ColorLightCyan:   "lightcyan",
-		tcell.ColorTurquoise:   "turquoise",
-		tcell.ColorOrangeRed:   "orangered",
-	}
-	if tag, ok := colorMap[c]; ok {
-		return tag
-	}
-	return "white"
+	return themedMarkerColor(c)
 }
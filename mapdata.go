@@ -4,6 +4,8 @@ package main
 // 480 columns x 192 rows (4x the previous 240x96 resolution).
 // Each character is '1' for land or '0' for water.
 // Rows go top-to-bottom (north pole -> south pole).
+// It is the default source for gen_coastlines.go, which traces it into the
+// vector coastlines the map is drawn from.
 var WorldBitmap = []string{
 	"000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", // 0,
 	"000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", // 1,
@@ -1,77 +1,132 @@
 package main
 
 import (
+	"fmt"
+	"os"
 	"strings"
 )
 
-// ScaleBitmap scales a string bitmap to the target dimensions using nearest-neighbor sampling.
-// Input: src is the source bitmap (each string is a row of '0'/'1' characters).
-// Output: 2D bool grid where true = land.
-func ScaleBitmap(src []string, srcW, srcH, dstW, dstH int) [][]bool {
-	result := make([][]bool, dstH)
-	for i := range result {
-		result[i] = make([]bool, dstW)
-	}
+// Map renderers: how the rasterised coastlines become terminal cells.
+const (
+	rendererBraille   = "braille"   // 2x4 dots per cell in Braille patterns
+	rendererHalfBlock = "halfblock" // 1x2 pixels per cell in ▀ ▄ █ blocks
+	rendererASCII     = "ascii"     // '#' for land and '.' for water
+)
 
-	for y := 0; y < dstH; y++ {
-		for x := 0; x < dstW; x++ {
-			// Map destination pixel to source pixel
-			srcX := (x * srcW) / dstW
-			srcY := (y * srcH) / dstH
+// rendererNames lists the renderers in the order shown in errors.
+var rendererNames = []string{"auto", rendererBraille, rendererHalfBlock, rendererASCII}
 
-			// Clamp to source bounds
-			if srcX >= srcW {
-				srcX = srcW - 1
-			}
-			if srcY >= srcH {
-				srcY = srcH - 1
-			}
+// mapGlyphs are the glyphs drawn over the map by markers and overlays.
+type mapGlyphs struct {
+	marker           string // City marker
+	sun, moon        string // Subsolar and sublunar points
+	cursor           string // Map cursor and zone reticle
+	leaderH, leaderV string // Label leader lines
+	zoneH, zoneV     string // Timezone boundaries
+	zoneCross        string
+}
 
-			// Get pixel value
-			if srcY < len(src) && srcX < len(src[srcY]) {
-				result[y][x] = src[srcY][srcX] == '1'
-			}
+// unicodeGlyphs suit any terminal that can show the block or Braille map;
+// asciiGlyphs are for terminals limited to ASCII.
+var (
+	unicodeGlyphs = mapGlyphs{marker: "•", sun: "☀", moon: "☾", cursor: "✛",
+		leaderH: "─", leaderV: "│", zoneH: "┄", zoneV: "┆", zoneCross: "┼"}
+	asciiGlyphs = mapGlyphs{marker: "o", sun: "*", moon: "(", cursor: "X",
+		leaderH: "-", leaderV: "|", zoneH: "~", zoneV: ":", zoneCross: "+"}
+)
+
+// global map renderer, detected from the terminal until set
+var mapRenderer = detectMapRenderer()
+
+// detectMapRenderer picks a renderer the terminal can show: ASCII for
+// terminals and locales without Unicode, half blocks for the Linux console
+// (whose fonts lack Braille) and Braille everywhere else.
+func detectMapRenderer() string {
+	switch os.Getenv("TERM") {
+	case "dumb", "vt100", "vt102", "vt220", "ansi":
+		return rendererASCII
+	case "linux":
+		return rendererHalfBlock
+	}
+	if locale := EnvLocale(); locale != "" {
+		lower := strings.ToLower(locale)
+		if !strings.Contains(lower, "utf-8") && !strings.Contains(lower, "utf8") {
+			return rendererASCII
 		}
 	}
+	return rendererBraille
+}
+
+// SetMapRenderer sets the map renderer: "auto", "braille", "halfblock" or
+// "ascii".
+func SetMapRenderer(name string) error {
+	switch strings.ToLower(name) {
+	case "", "auto":
+		mapRenderer = detectMapRenderer()
+	case rendererBraille, rendererHalfBlock, rendererASCII:
+		mapRenderer = strings.ToLower(name)
+	default:
+		return fmt.Errorf("unknown renderer %q (choose from %s)", name, strings.Join(rendererNames, ", "))
+	}
+	return nil
+}
 
-	return result
+// MapRenderer returns the name of the active map renderer.
+func MapRenderer() string {
+	return mapRenderer
 }
 
-// GetBrailleGridSize returns the braille grid dimensions for a given terminal size.
-// Subtracts 2 for border (top/bottom) and 1 for status bar.
+// MapGlyphs returns the overlay glyphs for the active renderer.
+func MapGlyphs() mapGlyphs {
+	if mapRenderer == rendererASCII {
+		return asciiGlyphs
+	}
+	return unicodeGlyphs
+}
+
+// GetBrailleGridSize returns the map grid dimensions for the area the map
+// is drawn in: one cell per screen cell, never negative.
 func GetBrailleGridSize(termWidth, termHeight int) (cols, rows int) {
-	cols = termWidth - 2  // Border left/right
-	rows = termHeight - 3 // Border top/bottom + status bar
-	return cols, rows
+	return max(0, termWidth), max(0, termHeight)
 }
 
-// LatLonToBraille converts geographic coordinates to braille grid position.
-// Uses equirectangular projection.
-func LatLonToBraille(lat, lon float64, brailleCols, brailleRows int) (col, row int) {
-	// Equirectangular projection
-	col = int((lon + 180.0) / 360.0 * float64(brailleCols))
-	row = int((90.0 - lat) / 180.0 * float64(brailleRows))
+// LatLonToBraille converts geographic coordinates to braille grid position
+// within a viewport, using the viewport's projection.
+// ok is false if the point is outside the viewport.
+func LatLonToBraille(lat, lon float64, brailleCols, brailleRows int, vp Viewport) (col, row int, ok bool) {
+	p := newMapProjector(vp, brailleCols, brailleRows)
+	dLon, inside := p.relativeLon(lon)
+	if !inside || lat > vp.North || lat < vp.South {
+		return 0, 0, false
+	}
+
+	gx, gy := p.toGrid(lat, dLon)
+	col, row = int(gx), int(gy)
 
 	// Clamp to grid bounds
-	if col < 0 {
-		col = 0
-	}
-	if col >= brailleCols {
-		col = brailleCols - 1
-	}
-	if row < 0 {
-		row = 0
-	}
-	if row >= brailleRows {
-		row = brailleRows - 1
-	}
+	col = max(0, min(col, brailleCols-1))
+	row = max(0, min(row, brailleRows-1))
 
-	return col, row
+	return col, row, true
 }
 
-// RenderBrailleMap renders the world map to a braille string for the given terminal dimensions.
+// RenderMap renders the area of the world inside a viewport with the active
+// renderer. Every renderer fills the same grid of cells, so positions on the
+// map do not depend on it.
+func RenderMap(termWidth, termHeight int, vp Viewport) string {
+	switch mapRenderer {
+	case rendererHalfBlock:
+		return RenderHalfBlockMap(termWidth, termHeight, vp)
+	case rendererASCII:
+		return RenderASCIIMap(termWidth, termHeight, vp)
+	}
+	return RenderBrailleMap(termWidth, termHeight, vp)
+}
+
+// RenderBrailleMap renders the area of the world inside a viewport to a braille string
+// for the given terminal dimensions.
 // Returns a multi-line braille string (no color tags - colorization is separate).
-func RenderBrailleMap(termWidth, termHeight int) string {
+func RenderBrailleMap(termWidth, termHeight int, vp Viewport) string {
 	// Calculate available braille grid
 	brailleCols, brailleRows := GetBrailleGridSize(termWidth, termHeight)
 
@@ -79,8 +134,8 @@ func RenderBrailleMap(termWidth, termHeight int) string {
 	pixelW := brailleCols * 2
 	pixelH := brailleRows * 4
 
-	// Scale bitmap to target size
-	scaled := ScaleBitmap(WorldBitmap, WorldBitmapWidth, WorldBitmapHeight, pixelW, pixelH)
+	// Rasterise the vector coastlines at the target size
+	scaled := RasterizeCoastlines(pixelW, pixelH, vp)
 
 	// Encode to braille characters
 	// Braille encoding: each braille char is 2 cols x 4 rows of dots
@@ -130,3 +185,75 @@ func RenderBrailleMap(termWidth, termHeight int) string {
 
 	return sb.String()
 }
+
+// RenderHalfBlockMap renders the area of the world inside a viewport with
+// half blocks, two pixels per cell stacked vertically.
+func RenderHalfBlockMap(termWidth, termHeight int, vp Viewport) string {
+	cols, rows := GetBrailleGridSize(termWidth, termHeight)
+	scaled := RasterizeCoastlines(cols, rows*2, vp)
+	blocks := [4]rune{' ', '▀', '▄', '█'} // Indexed by top | bottom<<1
+
+	var sb strings.Builder
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			index := 0
+			if scaled[row*2][col] {
+				index |= 1
+			}
+			if scaled[row*2+1][col] {
+				index |= 2
+			}
+			sb.WriteRune(blocks[index])
+		}
+		sb.WriteRune('\n')
+	}
+	return sb.String()
+}
+
+// RenderASCIIMap renders the area of the world inside a viewport in plain
+// ASCII: '#' for cells that are mostly land and '.' for the rest.
+func RenderASCIIMap(termWidth, termHeight int, vp Viewport) string {
+	cols, rows := GetBrailleGridSize(termWidth, termHeight)
+	// Sample 2x2 pixels per cell: a cell is land if most of it is, or if
+	// it is an island with no land in the cells around it
+	scaled := RasterizeCoastlines(cols*2, rows*2, vp)
+	land := make([][]int, rows)
+	for row := range land {
+		land[row] = make([]int, cols)
+		for col := range land[row] {
+			for dy := 0; dy < 2; dy++ {
+				for dx := 0; dx < 2; dx++ {
+					if scaled[row*2+dy][col*2+dx] {
+						land[row][col]++
+					}
+				}
+			}
+		}
+	}
+
+	var sb strings.Builder
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			if land[row][col] >= 2 || land[row][col] == 1 && isolatedCell(land, col, row) {
+				sb.WriteByte('#')
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteRune('\n')
+	}
+	return sb.String()
+}
+
+// isolatedCell reports whether none of the eight cells around a cell holds
+// any land.
+func isolatedCell(land [][]int, col, row int) bool {
+	for y := row - 1; y <= row+1; y++ {
+		for x := col - 1; x <= col+1; x++ {
+			if (x != col || y != row) && y >= 0 && y < len(land) && x >= 0 && x < len(land[y]) && land[y][x] > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
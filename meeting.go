@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,13 +15,37 @@ import (
 type MeetingPlanner struct {
 	app            *tview.Application
 	selectedCities []City
-	businessStart  int // Hour (0-23) when business hours start
-	businessEnd    int // Hour (0-23) when business hours end
-	selectedIndex  int // Current selection index in city list
-	mode           int // 0 = selecting cities, 1 = viewing timeline
-	timelineStart  int // Starting hour for timeline view
+	businessStart  int           // Hour (0-23) when business hours start
+	businessEnd    int           // Hour (0-23) when business hours end
+	picker         *cityPicker   // City selection list
+	mode           int           // One of the meetingView* constants
+	timelineStart  int           // Starting hour for timeline view
+	date           time.Time     // Day to plan for (zero = today, UTC date)
+	granularity    time.Duration // Slot size for the availability search
+	duration       time.Duration // Meeting length
+	windowStart    int           // Preferred window start, UTC hour
+	windowEnd      int           // Preferred window end, UTC hour (exclusive)
+	groups         []MeetingGroup
+	groupIndex     int       // Current selection index in the group list
+	activeGroup    string    // Name of the last loaded or saved group
+	nameInput      string    // Group name being typed
+	statusMsg      string    // Feedback from the last group action
+	pickedStart    time.Time // Meeting start picked on the timeline (zero = none)
+	timelineRow    int       // Row of the first city in the last rendered timeline
 }
 
+// meetingTimelineIndent is the width of the city name column before the
+// timeline cells.
+const meetingTimelineIndent = 13
+
+// Meeting planner views.
+const (
+	meetingViewSelect   = iota // Selecting cities
+	meetingViewTimeline        // Viewing the timeline
+	meetingViewGroups          // Choosing a saved group
+	meetingViewSaveName        // Typing a name for the current group
+)
+
 // NewMeetingPlanner creates a new MeetingPlanner instance.
 func NewMeetingPlanner(app *tview.Application) *MeetingPlanner {
 	return &MeetingPlanner{
@@ -27,9 +53,13 @@ func NewMeetingPlanner(app *tview.Application) *MeetingPlanner {
 		selectedCities: []City{},
 		businessStart:  9,  // 9 AM
 		businessEnd:    17, // 5 PM
-		selectedIndex:  0,
-		mode:           0,
+		picker:         newCityPicker(),
+		mode:           meetingViewSelect,
 		timelineStart:  0,
+		granularity:    30 * time.Minute,
+		duration:       time.Hour,
+		windowStart:    0,
+		windowEnd:      24,
 	}
 }
 
@@ -78,8 +108,10 @@ func (mp *MeetingPlanner) IsSelected(city City) bool {
 // ClearSelection clears all selected cities.
 func (mp *MeetingPlanner) ClearSelection() {
 	mp.selectedCities = []City{}
-	mp.selectedIndex = 0
-	mp.mode = 0
+	mp.picker.pane = pickerPaneResults
+	mp.picker.selCursor = 0
+	mp.mode = meetingViewSelect
+	mp.activeGroup = ""
 }
 
 // SetBusinessHours sets the business hours for the meeting planner.
@@ -88,157 +120,322 @@ func (mp *MeetingPlanner) SetBusinessHours(start, end int) {
 	mp.businessEnd = end
 }
 
-// GetBestMeetingTimes calculates the best meeting times across all selected cities.
-// Hours are in UTC. For each UTC hour, we check what local time it would be in each city.
-func (mp *MeetingPlanner) GetBestMeetingTimes() []struct {
-	Hour         int
-	AllAvailable bool
-	Count        int
-} {
-	var results []struct {
-		Hour         int
-		AllAvailable bool
-		Count        int
+// MeetingSlot describes how many selected cities are in business hours
+// for one slot of the planned day.
+type MeetingSlot struct {
+	Start        time.Time // Slot start in UTC
+	Count        int       // Number of cities in business hours
+	AllAvailable bool      // True when every selected city is available
+}
+
+// MeetingWindow is a contiguous stretch of time long enough to host the
+// meeting, with the most comfortable placement of the meeting inside it.
+type MeetingWindow struct {
+	Start        time.Time // Window start in UTC
+	End          time.Time // Window end in UTC (exclusive)
+	MeetingStart time.Time // Proposed meeting start in UTC
+	MeetingEnd   time.Time // Proposed meeting end in UTC (exclusive)
+	AllAvailable bool      // True for best windows, false for acceptable ones
+	Comfort      float64   // Average comfort of the proposed meeting, 0-1
+}
+
+// SetDate sets the day to plan for. Only the UTC calendar date is used.
+func (mp *MeetingPlanner) SetDate(date time.Time) {
+	mp.date = date
+	mp.pickedStart = time.Time{}
+}
+
+// SetDuration sets the meeting length used by the window search.
+func (mp *MeetingPlanner) SetDuration(d time.Duration) {
+	if d > 0 {
+		mp.duration = d
 	}
+}
 
-	now := time.Now()
+// SetGranularity sets the slot size used by the availability search.
+func (mp *MeetingPlanner) SetGranularity(d time.Duration) {
+	if d > 0 {
+		mp.granularity = d
+	}
+}
+
+// planDay returns midnight UTC of the day being planned.
+func (mp *MeetingPlanner) planDay() time.Time {
+	d := mp.date
+	if d.IsZero() {
+		d = time.Now()
+	}
+	d = d.UTC()
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// inBusinessHours reports whether t falls within business hours in loc.
+func (mp *MeetingPlanner) inBusinessHours(t time.Time, loc *time.Location) bool {
+	local := t.In(loc)
+	minutes := local.Hour()*60 + local.Minute()
+	return minutes >= mp.businessStart*60 && minutes < mp.businessEnd*60
+}
+
+// GetMeetingSlots calculates availability for each slot of the planned day.
+// Slots are in UTC. For each slot, we check what local time it would be in each city.
+func (mp *MeetingPlanner) GetMeetingSlots() []MeetingSlot {
+	var slots []MeetingSlot
+
+	day := mp.planDay()
+	end := day.Add(24 * time.Hour)
 
-	for utcHour := 0; utcHour < 24; utcHour++ {
+	for t := day; t.Before(end); t = t.Add(mp.granularity) {
 		count := 0
 		for _, city := range mp.selectedCities {
 			loc, err := time.LoadLocation(city.Timezone)
 			if err != nil {
 				continue
 			}
-			// Create a time at the specified UTC hour, then convert to city's timezone
-			utcTime := time.Date(now.Year(), now.Month(), now.Day(), utcHour, 0, 0, 0, time.UTC)
-			cityTime := utcTime.In(loc)
-			hourInCity := cityTime.Hour()
-			if hourInCity >= mp.businessStart && hourInCity < mp.businessEnd {
+			if mp.inBusinessHours(t, loc) {
 				count++
 			}
 		}
-		results = append(results, struct {
-			Hour         int
-			AllAvailable bool
-			Count        int
-		}{
-			Hour:         utcHour,
-			AllAvailable: count == len(mp.selectedCities) && len(mp.selectedCities) > 0,
+		slots = append(slots, MeetingSlot{
+			Start:        t,
 			Count:        count,
+			AllAvailable: count == len(mp.selectedCities) && len(mp.selectedCities) > 0,
 		})
 	}
-	return results
+	return slots
 }
 
-// RenderCitySelection renders the city selection view.
-func (mp *MeetingPlanner) RenderCitySelection() string {
-	var b strings.Builder
-	b.WriteString("[yellow::b]Meeting Time Planner[::-]\n\n")
-	b.WriteString("[::b]Select cities (space to toggle, Enter to view timeline):[::-]\n\n")
+// inPreferredWindow reports whether t falls within the preferred UTC window.
+func (mp *MeetingPlanner) inPreferredWindow(t time.Time) bool {
+	minutes := t.Hour()*60 + t.Minute()
+	return minutes >= mp.windowStart*60 && minutes < mp.windowEnd*60
+}
 
-	// Group cities by category
-	categories := []string{"Americas", "Europe", "MiddleEast", "Africa", "Asia", "Oceania"}
+// cityComfort rates how convenient t is for a city, from 0 (outside business
+// hours) to 1 (the middle of the business day). Edges of the day score 0.5.
+func (mp *MeetingPlanner) cityComfort(t time.Time, loc *time.Location) float64 {
+	if !mp.inBusinessHours(t, loc) {
+		return 0
+	}
+	local := t.In(loc)
+	minutes := float64(local.Hour()*60 + local.Minute())
+	start := float64(mp.businessStart * 60)
+	end := float64(mp.businessEnd * 60)
+	mid := (start + end) / 2
+	half := (end - start) / 2
+	if half <= 0 {
+		return 1
+	}
+	dist := minutes - mid
+	if dist < 0 {
+		dist = -dist
+	}
+	return 1 - 0.5*dist/half
+}
 
-	for _, category := range categories {
-		cities := GetCitiesByCategory(category)
-		if len(cities) == 0 {
-			continue
+// meetingCandidate is one possible meeting start evaluated over its full duration.
+type meetingCandidate struct {
+	start     time.Time
+	available int     // Cities in business hours for the whole meeting
+	comfort   float64 // Average comfort across cities and slots
+}
+
+// evaluateCandidate checks every slot of a meeting starting at start.
+func (mp *MeetingPlanner) evaluateCandidate(start time.Time, locs []*time.Location) meetingCandidate {
+	c := meetingCandidate{start: start}
+	end := start.Add(mp.duration)
+	samples := 0
+	for _, loc := range locs {
+		ok := true
+		for t := start; t.Before(end); t = t.Add(mp.granularity) {
+			comfort := mp.cityComfort(t, loc)
+			if comfort == 0 {
+				ok = false
+			}
+			c.comfort += comfort
+			samples++
+		}
+		if ok {
+			c.available++
 		}
+	}
+	if samples > 0 {
+		c.comfort /= float64(samples)
+	}
+	return c
+}
 
-		b.WriteString(fmt.Sprintf("[%s::b]%s:[::-]\n", getCategoryColor(category), category))
+// GetMeetingWindows finds contiguous windows long enough to host a meeting of
+// the configured duration. Best windows have every city in business hours for
+// the whole meeting; acceptable windows have most cities available and stay
+// clear of the best windows. Both lists are ranked by the comfort of their
+// proposed meeting, most comfortable first.
+// A window open at midnight UTC is followed into the day before or after, so
+// an overlap across midnight is found whole rather than as two halves.
+func (mp *MeetingPlanner) GetMeetingWindows() (best, acceptable []MeetingWindow) {
+	var locs []*time.Location
+	for _, city := range mp.selectedCities {
+		if loc, err := time.LoadLocation(city.Timezone); err == nil {
+			locs = append(locs, loc)
+		}
+	}
+	if len(locs) == 0 {
+		return nil, nil
+	}
 
-		for _, city := range cities {
-			selected := mp.IsSelected(city)
-			marker := "[ ] "
-			if selected {
-				marker = "[green]✓[white] "
-			}
+	day := mp.planDay()
+	windowStart := day.Add(time.Duration(mp.windowStart) * time.Hour)
+	windowEnd := day.Add(time.Duration(mp.windowEnd) * time.Hour)
 
-			// Highlight the current selection
-			prefix := "  "
-			if city.Name == AllCities[mp.selectedIndex].Name {
-				prefix = "[yellow]►[white] "
-			}
+	// Search the days either side too unless the preferred window ends
+	// before midnight. Windows are kept whole, but the proposed meeting
+	// starts on the planned day, and windows without such a start are dropped.
+	searchStart, searchEnd := windowStart, windowEnd
+	if mp.windowStart == 0 {
+		searchStart = day.Add(-24 * time.Hour)
+	}
+	if mp.windowEnd == 24 {
+		searchEnd = day.Add(48 * time.Hour)
+	}
+	onPlanDay := func(start time.Time) bool {
+		return !start.Before(windowStart) && !start.Add(mp.duration).After(windowEnd)
+	}
+
+	var candidates []meetingCandidate
+	for start := searchStart; !start.Add(mp.duration).After(searchEnd); start = start.Add(mp.granularity) {
+		candidates = append(candidates, mp.evaluateCandidate(start, locs))
+	}
+
+	// Best windows first; acceptable ones are made of the meetings a strict
+	// majority can attend that do not overlap any of them
+	best = mp.collectWindows(candidates, true, onPlanDay, func(c meetingCandidate) bool {
+		return c.available == len(locs)
+	})
+	acceptable = mp.collectWindows(candidates, false, onPlanDay, func(c meetingCandidate) bool {
+		return c.available < len(locs) && c.available*2 > len(locs) &&
+			!overlapsWindow(best, c.start, c.start.Add(mp.duration))
+	})
+	return best, acceptable
+}
 
-			b.WriteString(fmt.Sprintf("%s%s%s (%s)\n", prefix, marker, city.Name, city.Timezone))
+// collectWindows merges the kept candidates whose meetings overlap or touch
+// into windows, ranked by comfort. Each window proposes its most
+// comfortable meeting that starts on the planned day; windows without one
+// are dropped.
+func (mp *MeetingPlanner) collectWindows(candidates []meetingCandidate, all bool, onPlanDay func(time.Time) bool, keep func(meetingCandidate) bool) []MeetingWindow {
+	var windows []MeetingWindow
+	var current *MeetingWindow
+	flush := func() {
+		if current != nil && !current.MeetingStart.IsZero() {
+			windows = append(windows, *current)
 		}
-		b.WriteString("\n")
+		current = nil
 	}
+	for _, c := range candidates {
+		if !keep(c) {
+			continue
+		}
+		if current == nil || c.start.After(current.End) {
+			flush()
+			current = &MeetingWindow{Start: c.start, AllAvailable: all}
+		}
+		current.End = c.start.Add(mp.duration)
+		if onPlanDay(c.start) && (current.MeetingStart.IsZero() || c.comfort > current.Comfort) {
+			current.MeetingStart = c.start
+			current.MeetingEnd = c.start.Add(mp.duration)
+			current.Comfort = c.comfort
+		}
+	}
+	flush()
+	sortWindowsByComfort(windows)
+	return windows
+}
 
-	b.WriteString(fmt.Sprintf("\n[::b]Selected: %d cities[::-]\n", len(mp.selectedCities)))
-	b.WriteString("[silver]Press Enter to view timeline | Space to toggle | C to clear[::-]\n")
+// overlapsWindow reports whether the span from start to end overlaps any
+// of the windows.
+func overlapsWindow(windows []MeetingWindow, start, end time.Time) bool {
+	for _, w := range windows {
+		if start.Before(w.End) && w.Start.Before(end) {
+			return true
+		}
+	}
+	return false
+}
 
-	return b.String()
+// sortWindowsByComfort orders windows by comfort, earliest first on ties.
+func sortWindowsByComfort(windows []MeetingWindow) {
+	sort.SliceStable(windows, func(i, j int) bool {
+		return windows[i].Comfort > windows[j].Comfort
+	})
 }
 
 // RenderTimeline renders the meeting timeline view.
 func (mp *MeetingPlanner) RenderTimeline() string {
 	var b strings.Builder
 
-	b.WriteString("[yellow::b]Meeting Timeline[::-]\n\n")
+	b.WriteString(fmt.Sprintf("[yellow::b]%s[::-]  [silver]%s[-]\n", T("Meeting Timeline"), FormatDate(mp.planDay())))
+	b.WriteString(fmt.Sprintf("[::b]%s[::-] %s\n\n", T("Week:"), mp.weekStrip()))
 
 	// Show selected cities
-	b.WriteString("[::b]Cities:[::-] ")
+	b.WriteString(fmt.Sprintf("[::b]%s[::-] ", T("Cities:")))
 	for i, city := range mp.selectedCities {
 		if i > 0 {
 			b.WriteString(", ")
 		}
-		b.WriteString(fmt.Sprintf("[%s]%s[white]", colorToTag(city.Color), city.Name))
+		b.WriteString(fmt.Sprintf("[%s]%s[white]", colorToTag(city.Color), LocalCityName(city.Name)))
+	}
+	b.WriteString(fmt.Sprintf("\n[::b]%s[::-] %d:00 - %d:00  [::b]%s[::-] %s  [::b]%s[::-] %s\n",
+		T("Business hours:"), mp.businessStart, mp.businessEnd, T("Duration:"), formatGranularity(mp.duration),
+		T("Window:"), mp.windowLabel()))
+	if mp.activeGroup != "" {
+		b.WriteString(fmt.Sprintf("[::b]%s[::-] %s\n", T("Group:"), mp.activeGroup))
+	}
+	if mp.statusMsg != "" {
+		b.WriteString(fmt.Sprintf("[silver]%s[-]\n", mp.statusMsg))
 	}
-	b.WriteString(fmt.Sprintf("\n[::b]Business hours:[::-] %d:00 - %d:00\n\n", mp.businessStart, mp.businessEnd))
+	b.WriteString("\n")
 
 	// Calculate and display best times
-	times := mp.GetBestMeetingTimes()
+	bestWindows, goodWindows := mp.GetMeetingWindows()
 
-	// Find best slots (all available)
-	var bestHours []int
-	var goodHours []int
+	// Display best meeting times
+	if len(bestWindows) > 0 {
+		b.WriteString("[green::b]✓ " + T("BEST Times (all cities in business hours):") + "[::-]\n")
+		b.WriteString(formatWindowList(bestWindows, 3, mp.planDay()))
+		b.WriteString("\n")
+	}
 
-	for _, t := range times {
-		if t.AllAvailable {
-			bestHours = append(bestHours, t.Hour)
-		} else if t.Count >= len(mp.selectedCities)/2 && len(mp.selectedCities) > 0 {
-			goodHours = append(goodHours, t.Hour)
-		}
+	if len(goodWindows) > 0 {
+		b.WriteString("[yellow::b]⚠ " + T("Acceptable Times (most cities available):") + "[::-]\n")
+		b.WriteString(formatWindowList(goodWindows, 3, mp.planDay()))
+		b.WriteString("\n")
 	}
 
-	// Display best meeting times
-	if len(bestHours) > 0 {
-		b.WriteString("[green::b]✓ BEST Times (all cities in business hours):[::-]\n")
-		for i, h := range bestHours {
-			if i > 0 && i%6 == 0 {
-				b.WriteString("\n")
-			}
-			b.WriteString(fmt.Sprintf(" %02d:00 ", h))
-		}
-		b.WriteString("\n\n")
+	if len(bestWindows) == 0 && len(goodWindows) == 0 {
+		b.WriteString("[red]" + fmt.Sprintf(T("No window of %s fits everyone's business hours."), formatGranularity(mp.duration)) + "[-]\n\n")
 	}
 
-	if len(goodHours) > 0 {
-		b.WriteString("[yellow::b]⚠ Acceptable Times (most cities available):[::-]\n")
-		for i, h := range goodHours {
-			if i > 0 && i%6 == 0 {
-				b.WriteString("\n")
-			}
-			b.WriteString(fmt.Sprintf(" %02d:00 ", h))
-		}
-		b.WriteString("\n\n")
+	// The top proposal is highlighted in the timeline
+	var proposal *MeetingWindow
+	if len(bestWindows) > 0 {
+		proposal = &bestWindows[0]
+	} else if len(goodWindows) > 0 {
+		proposal = &goodWindows[0]
 	}
 
-	if len(bestHours) == 0 && len(goodHours) == 0 {
-		b.WriteString("[red]No overlapping business hours found.[-]\n\n")
+	// A slot picked with the mouse replaces the top proposal
+	if !mp.pickedStart.IsZero() {
+		proposal = &MeetingWindow{MeetingStart: mp.pickedStart, MeetingEnd: mp.pickedStart.Add(mp.duration)}
+		b.WriteString(fmt.Sprintf("[aqua::b]◆ Picked: %s-%s UTC[::-] [darkgray](click it again to clear)[white]\n\n",
+			formatMeetingClock(proposal.MeetingStart), formatUTCEnd(proposal.MeetingStart, proposal.MeetingEnd)))
 	}
 
 	// Show detailed timeline
-	b.WriteString("[::b]24-Hour Timeline (Green=All, Yellow=Some, Red=None):[::-]\n")
-	b.WriteString("     ")
-
-	// Header row with hours
-	for h := 0; h < 24; h++ {
-		b.WriteString(fmt.Sprintf("%02d", h))
-	}
+	slots := mp.GetMeetingSlots()
+	b.WriteString("[::b]" + T("24-Hour Timeline (Green=All, Yellow=Some, Red=None, █=Proposed):") + "[::-]\n")
+	b.WriteString("             ")
+	b.WriteString(mp.timelineHeader())
 	b.WriteString("\n")
+	mp.timelineRow = strings.Count(b.String(), "\n")
 
 	// For each city, show their availability
 	for _, city := range mp.selectedCities {
@@ -247,64 +444,188 @@ func (mp *MeetingPlanner) RenderTimeline() string {
 			continue
 		}
 
-		now := time.Now()
-
 		colorTag := colorToTag(city.Color)
-		displayName := city.Name
-		if len(displayName) > 12 {
-			displayName = displayName[:12]
+		displayName := LocalCityName(city.Name)
+		if runes := []rune(displayName); len(runes) > 12 {
+			displayName = string(runes[:12])
 		}
-		b.WriteString(fmt.Sprintf("[%s]%-12s[white] ", colorTag, displayName))
-
-		for h := 0; h < 24; h++ {
-			// Calculate what local hour it would be in this city when UTC is h:00
-			utcTime := time.Date(now.Year(), now.Month(), now.Day(), h, 0, 0, 0, time.UTC)
-			cityTime := utcTime.In(loc)
-			displayHour := cityTime.Hour()
-
-			if displayHour >= mp.businessStart && displayHour < mp.businessEnd {
-				b.WriteString("[green]▀[white]")
-			} else if displayHour >= mp.businessStart-2 && displayHour < mp.businessEnd+2 {
-				b.WriteString("[yellow]▀[white]")
+		b.WriteString(fmt.Sprintf("[%s]%s[white] ", colorTag, padTagged(displayName, 12)))
+
+		for _, slot := range slots {
+			// Calculate what local time it would be in this city at the slot start
+			local := slot.Start.In(loc)
+			minutes := local.Hour()*60 + local.Minute()
+
+			cell := "▀"
+			if proposal != nil && !slot.Start.Before(proposal.MeetingStart) && slot.Start.Before(proposal.MeetingEnd) {
+				cell = "█"
+			}
+
+			if mp.inBusinessHours(slot.Start, loc) {
+				b.WriteString("[green]" + cell + "[white]")
+			} else if minutes >= (mp.businessStart-2)*60 && minutes < (mp.businessEnd+2)*60 {
+				b.WriteString("[yellow]" + cell + "[white]")
 			} else {
-				b.WriteString("[red]▀[white]")
+				b.WriteString("[red]" + cell + "[white]")
 			}
 		}
+
+		// Proposed meeting in this city's local time
+		if proposal != nil {
+			b.WriteString(fmt.Sprintf(" [silver]%s-%s[-]",
+				formatMeetingClock(proposal.MeetingStart.In(loc)), formatMeetingClock(proposal.MeetingEnd.In(loc))))
+		}
 		b.WriteString("\n")
 	}
 
-	b.WriteString("\n[yellow::b]Your Reference Time (UTC):[::-] ")
+	b.WriteString("\n[yellow::b]" + T("Your Reference Time (UTC):") + "[::-] ")
 	utcNow := time.Now().UTC()
-	b.WriteString(utcNow.Format("15:04"))
+	b.WriteString(formatMeetingClock(utcNow))
 	b.WriteString("\n\n")
 
-	b.WriteString("[silver]Press Enter to edit selection | B to adjust business hours | Escape to exit[::-]\n")
+	b.WriteString("[silver]" + KeyHelp([]string{"meeting.prev-day", "meeting.next-day", "meeting.hours", "meeting.duration",
+		"meeting.window", "meeting.save"}, "Enter=Edit selection", T("Esc=Exit")) + "[::-]\n")
 
 	return b.String()
 }
 
+// weekStrip returns the days of the week being planned, starting on the
+// first day of the week of the current locale, with the planned day
+// highlighted and weekends dimmed.
+func (mp *MeetingPlanner) weekStrip() string {
+	day := mp.planDay()
+	start := day.AddDate(0, 0, -((int(day.Weekday())-int(FirstWeekday()))+7)%7)
+
+	var b strings.Builder
+	for i := 0; i < 7; i++ {
+		d := start.AddDate(0, 0, i)
+		label := fmt.Sprintf("%s %d", ShortWeekday(d.Weekday()), d.Day())
+		switch {
+		case d.Equal(day):
+			b.WriteString("[black:yellow] " + label + " [-:-]")
+		case d.Weekday() == time.Saturday || d.Weekday() == time.Sunday:
+			b.WriteString("[darkgray] " + label + " [-]")
+		default:
+			b.WriteString(" " + label + " ")
+		}
+	}
+	return b.String()
+}
+
+// timelineHeader returns the hour labels aligned with the timeline cells.
+func (mp *MeetingPlanner) timelineHeader() string {
+	cellsPerHour := int(time.Hour / mp.granularity)
+	if cellsPerHour < 1 {
+		cellsPerHour = 1
+	}
+	// Leave at least one space between the hour labels
+	step := 1
+	for cellsPerHour*step < HourWidth()+1 {
+		step++
+	}
+
+	var b strings.Builder
+	for h := 0; h < 24; h += step {
+		label := fmt.Sprintf("%02d", h)
+		if timeFmt.hour12 {
+			label = FormatHour(time.Date(2000, 1, 1, h, 0, 0, 0, time.UTC))
+		}
+		b.WriteString(fmt.Sprintf("%-*s", cellsPerHour*step, label))
+	}
+	return b.String()
+}
+
+// formatWindowList formats up to limit windows, one per line, as the proposed
+// meeting in UTC with the surrounding window and its comfort score.
+func formatWindowList(windows []MeetingWindow, limit int, day time.Time) string {
+	var b strings.Builder
+	for i, w := range windows {
+		if i == limit {
+			b.WriteString(fmt.Sprintf("  [darkgray]+%d more[white]\n", len(windows)-limit))
+			break
+		}
+		b.WriteString(fmt.Sprintf("  %d. %s UTC  [darkgray](window %s, comfort %d%%)[white]\n",
+			i+1, formatUTCRange(w.MeetingStart, w.MeetingEnd, day),
+			formatUTCRange(w.Start, w.End, day), int(w.Comfort*100+0.5)))
+	}
+	return b.String()
+}
+
+// formatUTCEnd formats the end of a UTC range, showing midnight as 24:00
+// on 24-hour clocks.
+func formatUTCEnd(start, end time.Time) string {
+	if !timeFmt.hour12 && end.After(start) && end.Hour() == 0 && end.Minute() == 0 && end.Day() != start.Day() {
+		return "24:00"
+	}
+	return formatMeetingClock(end)
+}
+
+// formatUTCRange formats a UTC range, marking one that starts the day
+// before the planned day, e.g. "22:00-01:00 (-1d)". A range that runs into
+// the next day shows by ending before it starts.
+func formatUTCRange(start, end, day time.Time) string {
+	s := formatMeetingClock(start) + "-" + formatUTCEnd(start, end)
+	if diff := int(math.Floor(start.Sub(day).Hours() / 24)); diff != 0 {
+		s += fmt.Sprintf(" (%+dd)", diff)
+	}
+	return s
+}
+
+// formatMeetingClock formats a meeting time in the chosen clock format,
+// without padding.
+func formatMeetingClock(t time.Time) string {
+	return strings.TrimSpace(FormatClockMinutes(t))
+}
+
 // Render returns the appropriate view based on mode.
 func (mp *MeetingPlanner) Render() string {
-	if mp.mode == 0 || len(mp.selectedCities) == 0 {
+	switch mp.mode {
+	case meetingViewGroups:
+		return mp.RenderGroupList()
+	case meetingViewSaveName:
+		return mp.RenderSavePrompt()
+	}
+	if mp.inPicker() {
 		return mp.RenderCitySelection()
 	}
 	return mp.RenderTimeline()
 }
 
+// inPicker reports whether the city picker is shown: in the selection view,
+// and in place of a timeline with no cities.
+func (mp *MeetingPlanner) inPicker() bool {
+	return mp.mode == meetingViewSelect || (mp.mode == meetingViewTimeline && len(mp.selectedCities) == 0)
+}
+
 // HandleKey handles key input for the meeting planner.
 // Takes the rune character for character input.
 func (mp *MeetingPlanner) HandleKey(ch rune) bool {
-	switch ch {
-	case ' ':
-		// Toggle current city
-		if mp.selectedIndex < len(AllCities) {
-			mp.ToggleCity(AllCities[mp.selectedIndex])
-		}
-		return true
-	case 'c', 'C':
+	switch mp.mode {
+	case meetingViewSaveName:
+		return mp.handleSaveNameKey(ch)
+	case meetingViewGroups:
+		return true // Swallow other keys while the list is open
+	}
+	return mp.inPicker() && mp.handlePickerKey(ch)
+}
+
+// HandleAction runs a bound meeting planner action.
+func (mp *MeetingPlanner) HandleAction(action string) bool {
+	switch mp.mode {
+	case meetingViewSaveName:
+		return false
+	case meetingViewGroups:
+		return mp.handleGroupListAction(action)
+	}
+
+	mp.statusMsg = ""
+	if mp.inPicker() {
+		return mp.handlePickerAction(action)
+	}
+	switch action {
+	case "meeting.clear":
 		mp.ClearSelection()
-		return true
-	case 'b', 'B':
+	case "meeting.hours":
 		// Cycle through business hour presets
 		if mp.businessStart == 9 {
 			mp.businessStart = 8
@@ -316,39 +637,89 @@ func (mp *MeetingPlanner) HandleKey(ch rune) bool {
 			mp.businessStart = 9
 			mp.businessEnd = 17
 		}
-		return true
+	case "meeting.duration":
+		mp.cycleDuration()
+	case "meeting.window":
+		mp.cycleWindow()
+	case "meeting.prev-day":
+		mp.SetDate(mp.planDay().AddDate(0, 0, -1))
+	case "meeting.next-day":
+		mp.SetDate(mp.planDay().AddDate(0, 0, 1))
+	case "meeting.groups":
+		mp.openGroupList()
+	case "meeting.save":
+		mp.promptGroupName()
+	default:
+		return false
 	}
-	return false
+	return true
+}
+
+// promptGroupName asks for a name to save the selected cities under.
+func (mp *MeetingPlanner) promptGroupName() {
+	if len(mp.selectedCities) > 0 {
+		mp.nameInput = mp.activeGroup
+		mp.mode = meetingViewSaveName
+	}
+}
+
+// IsTextInput reports whether the planner is taking a group name. The city
+// picker's search needs no such state: its bound keys are never printable.
+func (mp *MeetingPlanner) IsTextInput() bool {
+	return mp.mode == meetingViewSaveName
 }
 
 // HandleSpecialKey handles special keys (not character input).
+// Escape is only consumed when it backs out of a group view, so the
+// overlay can close the planner otherwise.
 func (mp *MeetingPlanner) HandleSpecialKey(key tcell.Key) bool {
-	switch key {
-	case tcell.KeyEscape:
-		mp.mode = 0
-		mp.selectedCities = []City{}
-		return true
-	case tcell.KeyEnter:
-		if mp.mode == 0 && len(mp.selectedCities) > 0 {
-			mp.mode = 1
-		} else if mp.mode == 1 {
-			mp.mode = 0
-		}
-		return true
-	case tcell.KeyUp:
-		if mp.selectedIndex > 0 {
-			mp.selectedIndex--
-		}
-		return true
-	case tcell.KeyDown:
-		if mp.selectedIndex < len(AllCities)-1 {
-			mp.selectedIndex++
-		}
+	switch mp.mode {
+	case meetingViewSaveName:
+		return mp.handleSaveNameSpecialKey(key)
+	case meetingViewGroups:
+		return mp.handleGroupListSpecialKey(key)
+	}
+
+	if mp.inPicker() {
+		return mp.handlePickerSpecialKey(key)
+	}
+	if key == tcell.KeyEnter {
+		mp.mode = meetingViewSelect
 		return true
 	}
 	return false
 }
 
+// HandleMouse handles clicks on the city picker and the timeline.
+func (mp *MeetingPlanner) HandleMouse(action mouseAction, x, y int) bool {
+	switch {
+	case mp.inPicker():
+		return mp.handlePickerMouse(action, x, y)
+	case mp.mode == meetingViewTimeline:
+		return mp.handleTimelineMouse(action, x, y)
+	}
+	return false
+}
+
+// handleTimelineMouse picks the meeting start from a click on a timeline
+// cell. Clicking the picked slot again clears it.
+func (mp *MeetingPlanner) handleTimelineMouse(action mouseAction, x, y int) bool {
+	if action != mouseClick || y < mp.timelineRow || y >= mp.timelineRow+len(mp.selectedCities) {
+		return false
+	}
+	slots := mp.GetMeetingSlots()
+	i := x - meetingTimelineIndent
+	if i < 0 || i >= len(slots) {
+		return false
+	}
+	if slots[i].Start.Equal(mp.pickedStart) {
+		mp.pickedStart = time.Time{}
+	} else {
+		mp.pickedStart = slots[i].Start
+	}
+	return true
+}
+
 // HasSelectedCities returns whether any cities are selected.
 func (mp *MeetingPlanner) HasSelectedCities() bool {
 	return len(mp.selectedCities) > 0
@@ -409,6 +780,25 @@ func (m *MeetingMode) HandleKey(key rune) bool {
 	return m.planner.HandleKey(key)
 }
 
+// HandleAction runs a bound meeting planner action.
+func (m *MeetingMode) HandleAction(action string) bool {
+	return m.planner.HandleAction(action)
+}
+
+// IsTextInput reports whether the planner is taking typed text.
+func (m *MeetingMode) IsTextInput() bool {
+	return m.planner.IsTextInput()
+}
+
+// KeyContext returns the picker's key context while the city picker is
+// shown, so typed letters reach its search.
+func (m *MeetingMode) KeyContext() string {
+	if m.planner.inPicker() {
+		return keyContextPicker
+	}
+	return keyContextMeeting
+}
+
 // Render returns the rendered content for the meeting mode.
 func (m *MeetingMode) Render() string {
 	return m.planner.Render()
@@ -416,14 +806,54 @@ func (m *MeetingMode) Render() string {
 
 // GetHelpText returns the help text for the meeting mode.
 func (m *MeetingMode) GetHelpText() string {
-	if m.planner.mode == 0 {
-		return "[darkgray]Keys:[white] ↑/↓=Navigate  Space=Toggle  Enter=View Timeline  C=Clear  Esc=Exit"
+	if m.planner.inPicker() {
+		return "[darkgray]" + T("Keys:") + "[white] " + T("Type to filter") + "  " + KeyHelp([]string{"picker.up", "picker.down", "picker.toggle",
+			"picker.pane", "picker.remove", "picker.category", "picker.groups", "picker.save", "picker.clear", "picker.timeline"},
+			T("Esc=Exit"))
+	}
+	switch m.planner.mode {
+	case meetingViewGroups:
+		return "[darkgray]" + T("Keys:") + "[white] " + KeyHelp([]string{"meeting.up", "meeting.down", "meeting.delete-group"},
+			T("Enter=Load"), T("Esc=Back"))
+	case meetingViewSaveName:
+		return "[darkgray]" + T("Keys:") + "[white] " + T("Type a name") + "  " + T("Enter=Save") + "  " + T("Esc=Cancel")
+	}
+	return "[darkgray]" + T("Keys:") + "[white] " + KeyHelp([]string{"meeting.prev-day", "meeting.next-day", "meeting.hours",
+		"meeting.duration", "meeting.window", "meeting.save"}, T("Enter=Back to Selection"), T("Esc=Exit"))
+}
+
+// HelpGroups returns the keys the planner's views handle themselves, for
+// the help overlay.
+func (m *MeetingMode) HelpGroups() []helpGroup {
+	return []helpGroup{
+		{title: T("City selection"), entries: []helpEntry{
+			{keys: T("Type"), help: T("Filter cities by name, country, alias or zone")},
+			{keys: "Backspace", help: T("Delete the last character of the filter")},
+			{keys: "PgUp  PgDn  Home  End", help: T("Move through the list")},
+			{keys: "Esc", help: T("Clear the filter, then close the planner")},
+		}},
+		{title: T("Timeline"), entries: []helpEntry{
+			{keys: "Enter", help: T("Back to the city selection")},
+			{keys: T("Click"), help: T("Choose a meeting slot")},
+		}},
+		{title: T("Saved groups"), entries: []helpEntry{
+			{keys: "Enter", help: T("Load the group")},
+			{keys: "Esc", help: T("Back to the city selection")},
+		}},
+		{title: T("Saving a group"), entries: []helpEntry{
+			{keys: T("Type"), help: T("Name the group")},
+			{keys: "Enter", help: T("Save it")},
+			{keys: "Esc", help: T("Cancel")},
+		}},
 	}
-	return "[darkgray]Keys:[white] Enter=Back to Selection  B=Change Hours  C=Clear  Esc=Exit"
+}
+
+// HandleMouse handles mouse input for the meeting mode.
+func (m *MeetingMode) HandleMouse(action mouseAction, x, y int) bool {
+	return m.planner.HandleMouse(action, x, y)
 }
 
 // HandleSpecialKeyEvent handles non-rune key events (Enter, Backspace, etc.).
 func (m *MeetingMode) HandleSpecialKeyEvent(key tcell.Key) bool {
-	// Meeting mode handles special keys via HandleSpecialKey in the main input handler
-	return false
+	return m.planner.HandleSpecialKey(key)
 }
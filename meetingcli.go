@@ -0,0 +1,324 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Exit codes for the meeting subcommand.
+const (
+	meetingExitOK        = 0
+	meetingExitNoOverlap = 1
+	meetingExitUsage     = 2
+)
+
+// meetingReport is the JSON shape printed by `localize meeting --json`.
+type meetingReport struct {
+	Date          string              `json:"date"`
+	BusinessHours string              `json:"business_hours"`
+	Duration      string              `json:"duration"`
+	Granularity   string              `json:"granularity"`
+	Window        string              `json:"window"`
+	Cities        []meetingCity       `json:"cities"`
+	Best          []meetingReportSpan `json:"best"`
+	Acceptable    []meetingReportSpan `json:"acceptable"`
+}
+
+// meetingCity identifies a participant city in the JSON report.
+type meetingCity struct {
+	Name     string `json:"name"`
+	Timezone string `json:"timezone"`
+}
+
+// meetingReportSpan is a meeting window in UTC, its proposed meeting, and the
+// proposed meeting in each city's local time.
+type meetingReportSpan struct {
+	StartUTC        string                      `json:"start_utc"`
+	EndUTC          string                      `json:"end_utc"`
+	MeetingStartUTC string                      `json:"meeting_start_utc"`
+	MeetingEndUTC   string                      `json:"meeting_end_utc"`
+	Comfort         float64                     `json:"comfort"`
+	Local           map[string]meetingLocalSpan `json:"local"`
+}
+
+// meetingLocalSpan is the proposed meeting in one city's local time.
+type meetingLocalSpan struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// runMeetingCommand implements `localize meeting` and returns the process exit code.
+func runMeetingCommand(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("meeting", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	cities := fs.String("cities", "", "comma-separated list of city names (e.g., 'Tokyo,London,NYC')")
+	group := fs.String("group", "", "start from a saved meeting group (e.g., 'EMEA sync')")
+	date := fs.String("date", "", "day to plan for as YYYY-MM-DD in UTC (default today)")
+	hours := fs.String("hours", "9-17", "business hours as START-END (e.g., '9-17')")
+	granularity := fs.Duration("granularity", 30*time.Minute, "slot size for the search (e.g., 15m)")
+	duration := fs.Duration("duration", time.Hour, "meeting length (e.g., 90m)")
+	asJSON := fs.Bool("json", false, "print the result as JSON instead of a table")
+	clock := fs.String("clock", "", "clock format of the table, 12h or 24h (default from the config)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: localize meeting (--cities Tokyo,London,NYC | --group NAME) [--date 2026-11-03] [--hours 9-17] [--duration 1h] [--granularity 30m] [--clock 12h] [--json]")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return meetingExitUsage
+	}
+
+	// The table follows the configured language and time format; JSON
+	// stays RFC 3339
+	var timeFormat TimeFormatConfig
+	language := ""
+	if config, err := LoadConfig(); err == nil {
+		timeFormat, language = config.TimeFormat, config.Locale
+	}
+	if err := ApplyLocale(language); err != nil {
+		fmt.Fprintf(stderr, "localize meeting: %v\n", err)
+		return meetingExitUsage
+	}
+	if *clock != "" {
+		timeFormat.Clock = *clock
+	}
+	if err := SetTimeFormat(timeFormat); err != nil {
+		fmt.Fprintf(stderr, "localize meeting: %v\n", err)
+		return meetingExitUsage
+	}
+
+	// Track explicitly set flags so they override group defaults
+	setFlags := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { setFlags[f.Name] = true })
+
+	mp := NewMeetingPlanner(nil)
+	if *group != "" {
+		config, err := LoadConfig()
+		if err != nil {
+			fmt.Fprintf(stderr, "localize meeting: %v\n", err)
+			return meetingExitUsage
+		}
+		saved := config.FindMeetingGroup(*group)
+		if saved == nil {
+			fmt.Fprintf(stderr, "localize meeting: unknown group: %s\n", *group)
+			return meetingExitUsage
+		}
+		for _, name := range mp.ApplyGroup(*saved) {
+			fmt.Fprintf(stderr, "localize meeting: warning: group city no longer known: %s\n", name)
+		}
+	}
+
+	for _, name := range strings.Split(*cities, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		city := GetCityByName(name)
+		if city == nil {
+			fmt.Fprintf(stderr, "localize meeting: unknown city: %s\n", name)
+			return meetingExitUsage
+		}
+		mp.AddCity(*city)
+	}
+	if !mp.HasSelectedCities() {
+		fmt.Fprintln(stderr, "localize meeting: --cities or --group is required")
+		fs.Usage()
+		return meetingExitUsage
+	}
+
+	if setFlags["hours"] || *group == "" {
+		start, end, err := parseHourRange(*hours)
+		if err != nil {
+			fmt.Fprintf(stderr, "localize meeting: invalid --hours: %v\n", err)
+			return meetingExitUsage
+		}
+		mp.SetBusinessHours(start, end)
+	}
+
+	if *granularity <= 0 || *granularity > time.Hour || time.Hour%*granularity != 0 {
+		fmt.Fprintln(stderr, "localize meeting: --granularity must evenly divide one hour")
+		return meetingExitUsage
+	}
+	mp.SetGranularity(*granularity)
+
+	if setFlags["duration"] || *group == "" {
+		if *duration <= 0 || *duration > 24*time.Hour {
+			fmt.Fprintln(stderr, "localize meeting: --duration must be between 1m and 24h")
+			return meetingExitUsage
+		}
+		mp.SetDuration(*duration)
+	}
+
+	if *date != "" {
+		day, err := time.ParseInLocation("2006-01-02", *date, time.UTC)
+		if err != nil {
+			fmt.Fprintf(stderr, "localize meeting: invalid --date (use YYYY-MM-DD): %s\n", *date)
+			return meetingExitUsage
+		}
+		mp.SetDate(day)
+	}
+
+	best, acceptable := mp.GetMeetingWindows()
+	if *asJSON {
+		if err := writeMeetingJSON(stdout, mp, best, acceptable); err != nil {
+			fmt.Fprintf(stderr, "localize meeting: %v\n", err)
+			return meetingExitUsage
+		}
+	} else {
+		writeMeetingTable(stdout, mp, best, acceptable)
+	}
+
+	if len(best) == 0 {
+		return meetingExitNoOverlap
+	}
+	return meetingExitOK
+}
+
+// parseHourRange parses a business hour range such as "9-17".
+func parseHourRange(s string) (int, int, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected START-END, got %q", s)
+	}
+	start, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid start hour %q", parts[0])
+	}
+	end, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid end hour %q", parts[1])
+	}
+	if start < 0 || end > 24 || start >= end {
+		return 0, 0, fmt.Errorf("hours must satisfy 0 <= start < end <= 24")
+	}
+	return start, end, nil
+}
+
+// writeMeetingTable prints the meeting windows as aligned text columns.
+func writeMeetingTable(w io.Writer, mp *MeetingPlanner, best, acceptable []MeetingWindow) {
+	fmt.Fprintf(w, "Meeting windows for %s (%s meeting, business hours %02d:00-%02d:00, %s slots, %s)\n",
+		FormatDate(mp.planDay()), formatGranularity(mp.duration), mp.businessStart, mp.businessEnd,
+		formatGranularity(mp.granularity), mp.windowLabel())
+
+	sections := []struct {
+		title   string
+		windows []MeetingWindow
+	}{
+		{"BEST (all cities in business hours)", best},
+		{"ACCEPTABLE (most cities available)", acceptable},
+	}
+
+	for _, section := range sections {
+		fmt.Fprintf(w, "\n%s\n", section.title)
+		if len(section.windows) == 0 {
+			fmt.Fprintln(w, "  none")
+			continue
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		header := []string{"  Meeting (UTC)"}
+		for _, city := range mp.GetSelectedCities() {
+			header = append(header, city.Name)
+		}
+		header = append(header, "Window (UTC)", "Comfort")
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+		for _, win := range section.windows {
+			row := []string{"  " + formatUTCRange(win.MeetingStart, win.MeetingEnd, mp.planDay())}
+			for _, city := range mp.GetSelectedCities() {
+				loc, err := time.LoadLocation(city.Timezone)
+				if err != nil {
+					row = append(row, "?")
+					continue
+				}
+				row = append(row, formatLocalRange(win.MeetingStart, win.MeetingEnd, loc))
+			}
+			row = append(row, formatUTCRange(win.Start, win.End, mp.planDay()))
+			row = append(row, fmt.Sprintf("%d%%", int(win.Comfort*100+0.5)))
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		tw.Flush()
+	}
+}
+
+// writeMeetingJSON prints the meeting windows as an indented JSON document.
+func writeMeetingJSON(w io.Writer, mp *MeetingPlanner, best, acceptable []MeetingWindow) error {
+	report := meetingReport{
+		Date:          mp.planDay().Format("2006-01-02"),
+		BusinessHours: fmt.Sprintf("%02d:00-%02d:00", mp.businessStart, mp.businessEnd),
+		Duration:      formatGranularity(mp.duration),
+		Granularity:   formatGranularity(mp.granularity),
+		Window:        mp.windowLabel(),
+		Best:          []meetingReportSpan{},
+		Acceptable:    []meetingReportSpan{},
+	}
+	for _, city := range mp.GetSelectedCities() {
+		report.Cities = append(report.Cities, meetingCity{Name: city.Name, Timezone: city.Timezone})
+	}
+	for _, win := range best {
+		report.Best = append(report.Best, newMeetingReportSpan(mp, win))
+	}
+	for _, win := range acceptable {
+		report.Acceptable = append(report.Acceptable, newMeetingReportSpan(mp, win))
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// newMeetingReportSpan converts a window to its JSON form.
+func newMeetingReportSpan(mp *MeetingPlanner, win MeetingWindow) meetingReportSpan {
+	span := meetingReportSpan{
+		StartUTC:        win.Start.Format(time.RFC3339),
+		EndUTC:          win.End.Format(time.RFC3339),
+		MeetingStartUTC: win.MeetingStart.Format(time.RFC3339),
+		MeetingEndUTC:   win.MeetingEnd.Format(time.RFC3339),
+		Comfort:         math.Round(win.Comfort*100) / 100,
+		Local:           make(map[string]meetingLocalSpan),
+	}
+	for _, city := range mp.GetSelectedCities() {
+		loc, err := time.LoadLocation(city.Timezone)
+		if err != nil {
+			continue
+		}
+		span.Local[city.Name] = meetingLocalSpan{
+			Start: win.MeetingStart.In(loc).Format(time.RFC3339),
+			End:   win.MeetingEnd.In(loc).Format(time.RFC3339),
+		}
+	}
+	return span
+}
+
+// formatLocalRange formats a UTC range in a city's local time, marking day changes.
+func formatLocalRange(from, to time.Time, loc *time.Location) string {
+	start := from.In(loc)
+	end := to.In(loc)
+	s := formatMeetingClock(start) + "-" + formatMeetingClock(end)
+
+	// Compare local calendar dates against the UTC planning day
+	utcDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	localDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	switch diff := int(localDay.Sub(utcDay).Hours() / 24); {
+	case diff > 0:
+		s += fmt.Sprintf(" (+%dd)", diff)
+	case diff < 0:
+		s += fmt.Sprintf(" (%dd)", diff)
+	}
+	return s
+}
+
+// formatGranularity formats a slot size compactly (e.g., "30m", "1h").
+func formatGranularity(d time.Duration) string {
+	if d%time.Hour == 0 {
+		return fmt.Sprintf("%dh", d/time.Hour)
+	}
+	return fmt.Sprintf("%dm", d/time.Minute)
+}
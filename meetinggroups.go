@@ -0,0 +1,289 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// meetingDurations are the meeting lengths cycled with U, in minutes.
+var meetingDurations = []int{30, 45, 60, 90, 120}
+
+// meetingWindows are the preferred UTC windows cycled with W.
+var meetingWindows = [][2]int{
+	{0, 24},
+	{6, 12},
+	{9, 17},
+	{12, 18},
+	{14, 22},
+}
+
+// cycleDuration advances to the next meeting duration preset.
+func (mp *MeetingPlanner) cycleDuration() {
+	current := int(mp.duration.Minutes())
+	next := meetingDurations[0]
+	for i, d := range meetingDurations {
+		if d == current && i+1 < len(meetingDurations) {
+			next = meetingDurations[i+1]
+			break
+		}
+	}
+	mp.duration = time.Duration(next) * time.Minute
+}
+
+// cycleWindow advances to the next preferred window preset.
+func (mp *MeetingPlanner) cycleWindow() {
+	next := meetingWindows[0]
+	for i, w := range meetingWindows {
+		if w[0] == mp.windowStart && w[1] == mp.windowEnd && i+1 < len(meetingWindows) {
+			next = meetingWindows[i+1]
+			break
+		}
+	}
+	mp.windowStart, mp.windowEnd = next[0], next[1]
+}
+
+// windowLabel describes the preferred UTC window.
+func (mp *MeetingPlanner) windowLabel() string {
+	if mp.windowStart == 0 && mp.windowEnd == 24 {
+		return T("any time")
+	}
+	return fmt.Sprintf("%02d:00-%02d:00 UTC", mp.windowStart, mp.windowEnd)
+}
+
+// CurrentGroup captures the current selection and settings as a group.
+func (mp *MeetingPlanner) CurrentGroup(name string) MeetingGroup {
+	group := MeetingGroup{
+		Name:          name,
+		BusinessStart: mp.businessStart,
+		BusinessEnd:   mp.businessEnd,
+		Duration:      int(mp.duration.Minutes()),
+		WindowStart:   mp.windowStart,
+		WindowEnd:     mp.windowEnd,
+	}
+	for _, city := range mp.selectedCities {
+		group.Cities = append(group.Cities, city.Name)
+	}
+	return group
+}
+
+// ApplyGroup replaces the selection and settings with those of a saved group.
+// It returns the names of any cities that are no longer known.
+func (mp *MeetingPlanner) ApplyGroup(group MeetingGroup) []string {
+	var missing []string
+	mp.selectedCities = []City{}
+	for _, name := range group.Cities {
+		city := GetCityByName(name)
+		if city == nil {
+			missing = append(missing, name)
+			continue
+		}
+		mp.AddCity(*city)
+	}
+
+	if group.BusinessEnd > group.BusinessStart {
+		mp.SetBusinessHours(group.BusinessStart, group.BusinessEnd)
+	}
+	if group.Duration > 0 {
+		mp.duration = time.Duration(group.Duration) * time.Minute
+	}
+	mp.windowStart, mp.windowEnd = group.WindowStart, group.WindowEnd
+	if mp.windowEnd == 0 {
+		mp.windowEnd = 24
+	}
+	mp.activeGroup = group.Name
+	return missing
+}
+
+// openGroupList loads saved groups from the config and shows the list.
+func (mp *MeetingPlanner) openGroupList() {
+	config, err := LoadConfig()
+	if err != nil {
+		mp.statusMsg = err.Error()
+		return
+	}
+	mp.groups = config.MeetingGroups
+	mp.groupIndex = 0
+	mp.mode = meetingViewGroups
+}
+
+// loadSelectedGroup applies the highlighted group and shows its timeline.
+func (mp *MeetingPlanner) loadSelectedGroup() {
+	if mp.groupIndex >= len(mp.groups) {
+		return
+	}
+	group := mp.groups[mp.groupIndex]
+	missing := mp.ApplyGroup(group)
+	mp.statusMsg = fmt.Sprintf(T("Loaded group %q"), group.Name)
+	if len(missing) > 0 {
+		mp.statusMsg += " " + fmt.Sprintf(T("(unknown: %s)"), strings.Join(missing, ", "))
+	}
+	mp.mode = meetingViewTimeline
+	if len(mp.selectedCities) == 0 {
+		mp.mode = meetingViewSelect
+	}
+}
+
+// deleteSelectedGroup removes the highlighted group from the config.
+func (mp *MeetingPlanner) deleteSelectedGroup() {
+	if mp.groupIndex >= len(mp.groups) {
+		return
+	}
+	config, err := LoadConfig()
+	if err != nil {
+		mp.statusMsg = err.Error()
+		return
+	}
+	name := mp.groups[mp.groupIndex].Name
+	config.DeleteMeetingGroup(name)
+	if err := SaveConfig(config); err != nil {
+		mp.statusMsg = err.Error()
+		return
+	}
+	if strings.EqualFold(mp.activeGroup, name) {
+		mp.activeGroup = ""
+	}
+	mp.groups = config.MeetingGroups
+	if mp.groupIndex >= len(mp.groups) && mp.groupIndex > 0 {
+		mp.groupIndex--
+	}
+	mp.statusMsg = fmt.Sprintf(T("Deleted group %q"), name)
+}
+
+// saveCurrentGroup stores the current selection under the typed name.
+func (mp *MeetingPlanner) saveCurrentGroup() {
+	name := strings.TrimSpace(mp.nameInput)
+	if name == "" {
+		return
+	}
+	config, err := LoadConfig()
+	if err != nil {
+		mp.statusMsg = err.Error()
+		return
+	}
+	config.PutMeetingGroup(mp.CurrentGroup(name))
+	if err := SaveConfig(config); err != nil {
+		mp.statusMsg = err.Error()
+		return
+	}
+	mp.activeGroup = name
+	mp.nameInput = ""
+	mp.statusMsg = fmt.Sprintf(T("Saved group %q"), name)
+	mp.mode = meetingViewTimeline
+}
+
+// handleGroupListAction runs a bound action in the group list.
+func (mp *MeetingPlanner) handleGroupListAction(action string) bool {
+	switch action {
+	case "meeting.delete-group":
+		mp.deleteSelectedGroup()
+		return true
+	case "meeting.down":
+		return mp.handleGroupListSpecialKey(tcell.KeyDown)
+	case "meeting.up":
+		return mp.handleGroupListSpecialKey(tcell.KeyUp)
+	}
+	return false
+}
+
+// handleGroupListSpecialKey handles navigation keys in the group list.
+func (mp *MeetingPlanner) handleGroupListSpecialKey(key tcell.Key) bool {
+	switch key {
+	case tcell.KeyUp:
+		if mp.groupIndex > 0 {
+			mp.groupIndex--
+		}
+		return true
+	case tcell.KeyDown:
+		if mp.groupIndex < len(mp.groups)-1 {
+			mp.groupIndex++
+		}
+		return true
+	case tcell.KeyEnter:
+		mp.loadSelectedGroup()
+		return true
+	case tcell.KeyEscape:
+		mp.mode = meetingViewSelect
+		return true
+	}
+	return false
+}
+
+// handleSaveNameKey appends typed characters to the group name.
+func (mp *MeetingPlanner) handleSaveNameKey(ch rune) bool {
+	if unicode.IsPrint(ch) && len([]rune(mp.nameInput)) < 32 {
+		mp.nameInput += string(ch)
+	}
+	return true
+}
+
+// handleSaveNameSpecialKey handles editing keys in the save prompt.
+func (mp *MeetingPlanner) handleSaveNameSpecialKey(key tcell.Key) bool {
+	switch key {
+	case tcell.KeyEnter:
+		mp.saveCurrentGroup()
+		return true
+	case tcell.KeyEscape:
+		mp.nameInput = ""
+		mp.mode = meetingViewSelect
+		return true
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if runes := []rune(mp.nameInput); len(runes) > 0 {
+			mp.nameInput = string(runes[:len(runes)-1])
+		}
+		return true
+	}
+	return false
+}
+
+// RenderGroupList renders the saved group picker.
+func (mp *MeetingPlanner) RenderGroupList() string {
+	var b strings.Builder
+	b.WriteString("[" + themeColor(roleAccent) + "::b]" + T("Saved Meeting Groups") + "[::-]\n\n")
+
+	if len(mp.groups) == 0 {
+		b.WriteString("[" + themeColor(roleMuted) + "]" + T("No saved groups yet.") + "[-]\n")
+		b.WriteString(fmt.Sprintf(T("Select cities and press %s to save them as a group."), KeyLabel("picker.save")) + "\n")
+	}
+	for i, group := range mp.groups {
+		prefix := "  "
+		if i == mp.groupIndex {
+			prefix = "[" + themeColor(roleAccent) + "]►[-] "
+		}
+		window := T("any time")
+		if group.WindowEnd > group.WindowStart && !(group.WindowStart == 0 && group.WindowEnd == 24) {
+			window = fmt.Sprintf("%02d-%02d UTC", group.WindowStart, group.WindowEnd)
+		}
+		b.WriteString(fmt.Sprintf("%s[::b]%s[::-]\n", prefix, tview.Escape(group.Name)))
+		b.WriteString(fmt.Sprintf("    [%s]%s[-]\n", themeColor(roleSubtle), strings.Join(group.Cities, ", ")))
+		b.WriteString(fmt.Sprintf("    [%s]%02d:00-%02d:00, %dm, %s[-]\n",
+			themeColor(roleMuted), group.BusinessStart, group.BusinessEnd, group.Duration, window))
+	}
+
+	if mp.statusMsg != "" {
+		b.WriteString(fmt.Sprintf("\n[%s]%s[-]\n", themeColor(roleSubtle), mp.statusMsg))
+	}
+	b.WriteString("\n[" + themeColor(roleSubtle) + "]" + KeyHelp([]string{"meeting.delete-group"}, T("Enter=Load"), T("Esc=Back")) + "[::-]\n")
+	return b.String()
+}
+
+// RenderSavePrompt renders the group name prompt.
+func (mp *MeetingPlanner) RenderSavePrompt() string {
+	var b strings.Builder
+	b.WriteString("[" + themeColor(roleAccent) + "::b]" + T("Save Meeting Group") + "[::-]\n\n")
+	b.WriteString(fmt.Sprintf("[::b]%s[::-] "+T("%d selected")+"\n", T("Cities:"), len(mp.selectedCities)))
+	b.WriteString(fmt.Sprintf("[::b]%s[::-] %02d:00-%02d:00, %s, %s\n\n",
+		T("Defaults:"), mp.businessStart, mp.businessEnd, formatGranularity(mp.duration), mp.windowLabel()))
+
+	name := tview.Escape(mp.nameInput)
+	if name == "" {
+		name = "[" + themeColor(roleMuted) + "]" + T("e.g. EMEA sync") + "[-]"
+	}
+	b.WriteString(fmt.Sprintf("[::b]%s[::-] [%s]%s[-]▏\n\n", T("Name:"), themeColor(roleAccent), name))
+	b.WriteString("[" + themeColor(roleSubtle) + "]" + T("Enter=Save (replaces a group with the same name)") + " | " + T("Esc=Cancel") + "[::-]\n")
+	return b.String()
+}
@@ -36,10 +36,55 @@ type ModeHandler interface {
 	GetMode() Mode
 	HandleKey(key rune) bool
 	HandleSpecialKeyEvent(key tcell.Key) bool // Handle non-rune keys (Enter, Backspace, etc.)
+	HandleAction(action string) bool          // Handle a bound action of the mode's key context
 	Render() string
 	GetHelpText() string
 }
 
+// modeKeyContexts are the key binding contexts of the modes.
+var modeKeyContexts = map[Mode]string{
+	ModeConverter: keyContextConverter,
+	ModeStopwatch: keyContextStopwatch,
+	ModeTimer:     keyContextTimer,
+	ModeAlarm:     keyContextAlarm,
+	ModeMeeting:   keyContextMeeting,
+}
+
+// KeyContextHandler is implemented by mode handlers whose views use
+// different key contexts.
+type KeyContextHandler interface {
+	KeyContext() string
+}
+
+// TextInputHandler is implemented by mode handlers that sometimes take
+// typed text, during which bound keys are typed rather than run.
+type TextInputHandler interface {
+	IsTextInput() bool
+}
+
+// HelpProvider is implemented by mode handlers with keys outside the key
+// registry, such as typed input, so the help overlay can list them.
+type HelpProvider interface {
+	HelpGroups() []helpGroup
+}
+
+// mouseAction is a mouse event as seen by the views.
+type mouseAction int
+
+const (
+	mouseClick      mouseAction = iota // Left button pressed
+	mouseDrag                          // Moved with the left button held down
+	mouseHover                         // Moved with no button held down
+	mouseScrollUp                      // Wheel scrolled up
+	mouseScrollDown                    // Wheel scrolled down
+)
+
+// MouseHandler is implemented by mode handlers that respond to the mouse.
+// x and y are the cell of the rendered content under the pointer.
+type MouseHandler interface {
+	HandleMouse(action mouseAction, x, y int) bool
+}
+
 // modeManager handles switching between different modes.
 type modeManager struct {
 	currentMode   Mode
@@ -114,6 +159,31 @@ func (mm *modeManager) HandleKey(key rune) bool {
 	return false
 }
 
+// KeyContext returns the key binding context of the current mode, or of its
+// current view.
+func (mm *modeManager) KeyContext() string {
+	if handler, ok := mm.handlers[mm.currentMode].(KeyContextHandler); ok {
+		return handler.KeyContext()
+	}
+	return modeKeyContexts[mm.currentMode]
+}
+
+// IsTextInput reports whether the current mode is taking typed text.
+func (mm *modeManager) IsTextInput() bool {
+	if handler, ok := mm.handlers[mm.currentMode].(TextInputHandler); ok {
+		return handler.IsTextInput()
+	}
+	return false
+}
+
+// HandleAction delegates a bound action to the current mode's handler.
+func (mm *modeManager) HandleAction(action string) bool {
+	if handler, ok := mm.handlers[mm.currentMode]; ok {
+		return handler.HandleAction(action)
+	}
+	return false
+}
+
 // HandleSpecialKeyEvent delegates non-rune key events (Enter, Backspace, etc.)
 // to the current mode's handler.
 func (mm *modeManager) HandleSpecialKeyEvent(key tcell.Key) bool {
@@ -123,6 +193,15 @@ func (mm *modeManager) HandleSpecialKeyEvent(key tcell.Key) bool {
 	return false
 }
 
+// HandleMouse delegates a mouse event to the current mode's handler, if
+// it responds to the mouse.
+func (mm *modeManager) HandleMouse(action mouseAction, x, y int) bool {
+	if handler, ok := mm.handlers[mm.currentMode].(MouseHandler); ok {
+		return handler.HandleMouse(action, x, y)
+	}
+	return false
+}
+
 // HandleSpecialKey delegates special key handling to the current mode's handler.
 func (mm *modeManager) HandleSpecialKey(key tcell.Key) bool {
 	if handler, ok := mm.handlers[mm.currentMode]; ok {
@@ -155,15 +234,28 @@ func (mm *modeManager) GetHelpText() string {
 	return getNormalModeHelp()
 }
 
+// HelpGroups returns the help overlay groups of the current mode: its bound
+// actions, then the keys its handler handles itself.
+func (mm *modeManager) HelpGroups() []helpGroup {
+	var groups []helpGroup
+	if context := mm.KeyContext(); context != "" {
+		groups = append(groups, keyHelpGroup(T(modeNames[mm.currentMode]), context))
+	}
+	if handler, ok := mm.handlers[mm.currentMode].(HelpProvider); ok {
+		groups = append(groups, handler.HelpGroups()...)
+	}
+	return groups
+}
+
 // updateModeIndicator updates the mode indicator display.
 func (mm *modeManager) updateModeIndicator() {
 	var status string
 	if mm.currentMode == ModeNormal {
-		status = "[darkgray]Normal[white]"
+		status = "[darkgray]" + T(modeNames[ModeNormal]) + "[white]"
 	} else {
-		status = fmt.Sprintf("[yellow]%s[white]", modeNames[mm.currentMode])
+		status = fmt.Sprintf("[yellow]%s[white]", T(modeNames[mm.currentMode]))
 	}
-	mm.modeIndicator.SetText(status)
+	mm.modeIndicator.SetText(ThemeText(status))
 }
 
 // getNormalModeHelp returns the help text for Normal mode.
@@ -171,5 +263,5 @@ func getNormalModeHelp() string {
 	if IsNavigationActive() {
 		return GetNavigationHelpText()
 	}
-	return "[darkgray]Keys:[white] C=Converter  S=Stopwatch  T=Timer  A=Alarm  D=Day/Night  ↑/↓=Navigate  Q/Esc=Quit"
+	return "[darkgray]" + T("Keys:") + "[white] " + ContextKeyHelp(keyContextMap)
 }
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+// Moon constants. moonriseElevation is the geocentric altitude of the moon's
+// centre at rise/set, combining parallax, refraction and semi-diameter.
+const (
+	moonriseElevation = 0.125
+	moonObliquity     = 23.4397
+	moonScanStep      = 10 * time.Minute
+)
+
+// moonPosition holds the moon's geocentric position for an instant, using the
+// low-precision series from Meeus (good to a fraction of a degree).
+type moonPosition struct {
+	declination float64 // Degrees (= sublunar latitude)
+	sublunarLon float64 // Longitude where the moon is overhead, in degrees
+	eclipticLon float64 // Degrees
+}
+
+// moonPhase describes how much of the moon is lit.
+type moonPhase struct {
+	Elongation   float64 // Moon's ecliptic longitude minus the sun's, 0-360 degrees
+	Illumination float64 // Lit fraction of the disc, 0-1
+	Name         string
+}
+
+// computeMoonPosition calculates the moon's declination, ecliptic longitude
+// and sublunar point for t.
+func computeMoonPosition(t time.Time) moonPosition {
+	d := julianDay(t) - julianDayJ2000
+
+	meanLong := 218.316 + 13.176396*d
+	meanAnom := (134.963 + 13.064993*d) * degreesToRadians
+	argLat := (93.272 + 13.229350*d) * degreesToRadians
+	elong := (297.850 + 12.190749*d) * degreesToRadians
+	sunAnom := (357.529 + 0.98560028*d) * degreesToRadians
+
+	lon := meanLong +
+		6.289*math.Sin(meanAnom) +
+		1.274*math.Sin(2*elong-meanAnom) +
+		0.658*math.Sin(2*elong) +
+		0.214*math.Sin(2*meanAnom) -
+		0.186*math.Sin(sunAnom) -
+		0.114*math.Sin(2*argLat)
+	lat := 5.128 * math.Sin(argLat)
+
+	lambda := lon * degreesToRadians
+	beta := lat * degreesToRadians
+	eps := moonObliquity * degreesToRadians
+
+	ra := math.Atan2(math.Sin(lambda)*math.Cos(eps)-math.Tan(beta)*math.Sin(eps), math.Cos(lambda)) * radiansToDegrees
+	decl := math.Asin(math.Sin(beta)*math.Cos(eps)+math.Cos(beta)*math.Sin(eps)*math.Sin(lambda)) * radiansToDegrees
+
+	// Greenwich mean sidereal time turns right ascension into a longitude
+	gmst := 280.46061837 + 360.98564736629*d
+
+	return moonPosition{
+		declination: decl,
+		sublunarLon: normalizeLongitude(ra - gmst),
+		eclipticLon: math.Mod(math.Mod(lon, 360)+360, 360),
+	}
+}
+
+// elevationAt returns the moon's geocentric elevation in degrees at the given
+// latitude and longitude.
+func (m moonPosition) elevationAt(lat, lon float64) float64 {
+	return sunPosition{declination: m.declination, subsolarLon: m.sublunarLon}.elevationAt(lat, lon)
+}
+
+// computeMoonPhase calculates the moon's phase and illumination at t.
+func computeMoonPhase(t time.Time) moonPhase {
+	elong := math.Mod(computeMoonPosition(t).eclipticLon-computeSunPosition(t).eclipticLon+360, 360)
+	return moonPhase{
+		Elongation:   elong,
+		Illumination: (1 - math.Cos(elong*degreesToRadians)) / 2,
+		Name:         moonPhaseName(elong),
+	}
+}
+
+// moonPhaseName names the phase for an elongation, with the four principal
+// phases each covering a 45 degree band.
+func moonPhaseName(elongation float64) string {
+	names := []string{
+		"New Moon", "Waxing Crescent", "First Quarter", "Waxing Gibbous",
+		"Full Moon", "Waning Gibbous", "Last Quarter", "Waning Crescent",
+	}
+	return names[int(math.Mod(elongation+22.5, 360)/45)%len(names)]
+}
+
+// moonRiseSet finds moonrise and moonset at a latitude/longitude during the
+// calendar day of day in its own location. Either is zero if it does not occur.
+func moonRiseSet(day time.Time, lat, lon float64) (rise, set time.Time) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+
+	prevT := start
+	prevEl := computeMoonPosition(prevT).elevationAt(lat, lon) - moonriseElevation
+	for t := start.Add(moonScanStep); !t.After(end); t = t.Add(moonScanStep) {
+		el := computeMoonPosition(t).elevationAt(lat, lon) - moonriseElevation
+		if (prevEl < 0) != (el < 0) {
+			// Interpolate the crossing within this step
+			frac := prevEl / (prevEl - el)
+			crossing := prevT.Add(time.Duration(frac * float64(moonScanStep)))
+			if el >= 0 && rise.IsZero() {
+				rise = crossing
+			} else if el < 0 && set.IsZero() {
+				set = crossing
+			}
+		}
+		prevT, prevEl = t, el
+	}
+	return rise, set
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// mapMouseState remembers where the map was last drawn, so mouse events can
+// be mapped back to braille cells and city markers.
+type mapMouseState struct {
+	x, y       int // Screen position of the first braille cell
+	cols, rows int
+	labels     []mapLabel
+	placed     []placedLabel
+}
+
+// global map mouse state
+var mapMouse = &mapMouseState{}
+
+// translateMouse converts a tview mouse event to the actions the views
+// handle. ok is false for events they ignore (right clicks, releases, ...).
+func translateMouse(event *tcell.EventMouse, action tview.MouseAction) (mouseAction, bool) {
+	switch action {
+	case tview.MouseLeftDown:
+		return mouseClick, true
+	case tview.MouseMove:
+		if event.Buttons()&tcell.Button1 != 0 {
+			return mouseDrag, true
+		}
+		return mouseHover, true
+	case tview.MouseScrollUp:
+		return mouseScrollUp, true
+	case tview.MouseScrollDown:
+		return mouseScrollDown, true
+	}
+	return 0, false
+}
+
+// SetMapMouseLayout records the last drawn map: the inner rectangle of the
+// map view, the rows above the map (offset bands), the braille grid size,
+// and the city markers with their placed labels.
+func SetMapMouseLayout(viewX, viewY, viewWidth, topRows, cols, rows int, labels []mapLabel, placed []placedLabel) {
+	// The map view centres its lines
+	mapMouse.x = viewX + max(0, (viewWidth-cols)/2)
+	mapMouse.y = viewY + topRows
+	mapMouse.cols, mapMouse.rows = cols, rows
+	mapMouse.labels, mapMouse.placed = labels, placed
+}
+
+// cellAt converts a screen position to a braille cell of the map.
+func (m *mapMouseState) cellAt(x, y int) (col, row int, ok bool) {
+	col, row = x-m.x, y-m.y
+	return col, row, col >= 0 && col < m.cols && row >= 0 && row < m.rows
+}
+
+// HandleMapMouse handles a mouse event on the map screen. The wheel zooms
+// the map; clicking a city marker or label selects the city and opens its
+// details. While the map cursor is shown, it follows the pointer and jumps
+// to clicked cells.
+func HandleMapMouse(action mouseAction, x, y int, leftRegions, rightRegions []Region) bool {
+	switch action {
+	case mouseScrollUp:
+		ZoomMap(true)
+		return true
+	case mouseScrollDown:
+		ZoomMap(false)
+		return true
+	}
+
+	col, row, ok := mapMouse.cellAt(x, y)
+	if !ok {
+		return false
+	}
+	switch action {
+	case mouseClick:
+		if name := cityAt(mapMouse.labels, mapMouse.placed, col, row); name != "" && !IsMapCursorActive() {
+			return OpenCityDetails(name, leftRegions, rightRegions)
+		}
+		if IsMapCursorActive() {
+			return SetMapCursorCell(col, row)
+		}
+	case mouseHover, mouseDrag:
+		if IsMapCursorActive() {
+			return SetMapCursorCell(col, row)
+		}
+	}
+	return false
+}
+
+// mapMouseHelpGroup returns the help for the mouse on the map screen.
+func mapMouseHelpGroup() helpGroup {
+	return helpGroup{title: T("Mouse"), entries: []helpEntry{
+		{keys: T("Wheel"), help: T("Zoom the map")},
+		{keys: T("Click a city"), help: T("Select it and show its details")},
+		{keys: T("Move, Click"), help: T("Move the map cursor, when shown")},
+	}}
+}
@@ -2,9 +2,9 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
@@ -17,6 +17,7 @@ type NavigationState struct {
 	selectedTime     time.Time      // time when selected
 	selectedTimezone *time.Location // timezone of selected city
 	pulseState       bool           // toggles for pulsing effect
+	dateOffset       int            // days from today shown in the sun details
 }
 
 // global navigation state
@@ -26,6 +27,9 @@ var navState = &NavigationState{
 	detailsVisible: false,
 }
 
+// detailsPanelWidth is the width of the city details panel beside the map
+const detailsPanelWidth = 42
+
 // NavigationView is the details panel for selected city
 var NavigationView *tview.TextView
 
@@ -35,9 +39,9 @@ func InitNavigation() *tview.TextView {
 		SetDynamicColors(true).
 		SetScrollable(false)
 	NavigationView.SetBorder(true).
-		SetTitle(" [ City Details ] ").
+		SetTitle(fmt.Sprintf(" [ %s ] ", T("City Details"))).
 		SetTitleAlign(tview.AlignCenter).
-		SetBorderColor(tcell.ColorYellow)
+		SetBorderColor(themeTcellColor(roleAccent))
 	return NavigationView
 }
 
@@ -60,7 +64,6 @@ func IsDetailsVisible() bool {
 func SelectCity(panel string, index int, regions []Region) {
 	navState.selectedPanel = panel
 	navState.selectedIndex = index
-	navState.detailsVisible = false
 	navState.selectedCity = nil
 
 	if index >= 0 && index < len(regions) {
@@ -142,12 +145,40 @@ func ToggleDetails(leftRegions []Region, rightRegions []Region) bool {
 	return true
 }
 
+// OpenCityDetails selects a city by name in whichever panel lists it and
+// shows its details, reporting whether the city was found.
+func OpenCityDetails(name string, leftRegions []Region, rightRegions []Region) bool {
+	for panel, regions := range map[string][]Region{"left": leftRegions, "right": rightRegions} {
+		for i, r := range regions {
+			if r.Name == name {
+				SelectCity(panel, i, regions)
+				navState.detailsVisible = true
+				updateNavigationView()
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Deselect clears the current selection
 func Deselect() {
 	navState.selectedIndex = -1
 	navState.selectedPanel = "left"
 	navState.detailsVisible = false
 	navState.selectedCity = nil
+	navState.dateOffset = 0
+	updateNavigationView()
+}
+
+// ShiftDetailsDate moves the date used for sun details by the given number of days.
+// A zero shift returns to today.
+func ShiftDetailsDate(days int) {
+	if days == 0 {
+		navState.dateOffset = 0
+	} else {
+		navState.dateOffset += days
+	}
 	updateNavigationView()
 }
 
@@ -166,8 +197,8 @@ func updateNavigationView() {
 	}
 
 	if navState.selectedIndex < 0 {
-		NavigationView.SetText("[darkgray]Use arrow keys to navigate cities[white]")
-		NavigationView.SetBorderColor(tcell.ColorYellow)
+		NavigationView.SetText(ThemeText("[darkgray]" + T("Use arrow keys to navigate cities") + "[white]"))
+		NavigationView.SetBorderColor(themeTcellColor(roleAccent))
 		return
 	}
 
@@ -186,7 +217,7 @@ func updateNavigationView() {
 	offsetStr := fmt.Sprintf("%+03d:%02d", offsetHours, offsetMins)
 
 	// Day of week
-	dayOfWeek := now.Weekday().String()
+	dayOfWeek := WeekdayName(now.Weekday())
 
 	// Check DST (simplified - compare January and July offsets)
 	isDST := false
@@ -204,75 +235,127 @@ func updateNavigationView() {
 	}
 
 	// Date formatting
-	dateStr := now.Format("Monday, 02 January 2006")
-	timeStr := now.Format("15:04:05")
+	dateStr := FormatDate(now)
+	timeStr := strings.TrimSpace(FormatClock(now))
 
-	panelArrow := "→"
+	// Sun details for today or the chosen date
+	sunText := ""
+	if city := GetCityByName(r.Name); city != nil {
+		sunText = formatSunDetails(now, city.Coordinates[0], city.Coordinates[1], navState.detailsVisible)
+	}
+
+	panelArrow, panelName := "→", T("left panel")
 	if navState.selectedPanel == "right" {
-		panelArrow = "←"
+		panelArrow, panelName = "←", T("right panel")
 	}
 
 	var detailsText string
 	if navState.detailsVisible {
-		detailsText = fmt.Sprintf(`[yellow]%s[white] selected %s
-
-[aqua]City:[white]      %s
-[aqua]Timezone:[white]   %s
-[aqua]Current Time:[white] %s
-[aqua]Date:[white]       %s
-[aqua]UTC Offset:[white]  %s
-[aqua]Day of Week:[white] %s
-[aqua]DST Active:[white]  %s
-
-[darkgray]Press Enter to hide details[white]`,
-			panelArrow,
-			navState.selectedPanel+" panel",
-			r.Name,
-			r.Timezone,
-			timeStr,
-			dateStr,
-			offsetStr,
-			dayOfWeek,
-			formatBool(isDST),
+		detailsText = fmt.Sprintf("[yellow]%s[white] %s\n\n%s%s%s%s%s%s%s%s\n[darkgray]%s  %s/%s=%s  %s=%s[white]",
+			panelArrow, fmt.Sprintf(T("%s selected"), panelName),
+			detailRow("City:", LocalCityName(r.Name)),
+			detailRow("Timezone:", r.Timezone),
+			detailRow("Current Time:", timeStr),
+			detailRow("Date:", dateStr),
+			detailRow("UTC Offset:", offsetStr),
+			detailRow("Day of Week:", dayOfWeek),
+			detailRow("DST Active:", formatBool(isDST)),
+			sunText,
+			KeyLabel("map.details")+"="+T("Hide details"),
+			KeyLabel("map.prev-day"), KeyLabel("map.next-day"), T("Prev/Next day"),
+			KeyLabel("map.today"), T("Today"),
 		)
 	} else {
-		detailsText = fmt.Sprintf(`[yellow]%s[white] %s selected
-
-[aqua]City:[white]    %s
-[aqua]Timezone:[white] %s
-[aqua]UTC Offset:[white] %s
-
-[darkgray]Press Enter for full details[white]`,
-			panelArrow,
-			navState.selectedPanel+" panel",
-			r.Name,
-			r.Timezone,
-			offsetStr,
+		detailsText = fmt.Sprintf("[yellow]%s[white] %s\n\n%s%s%s%s\n[darkgray]%s[white]",
+			panelArrow, fmt.Sprintf(T("%s selected"), panelName),
+			detailRow("City:", LocalCityName(r.Name)),
+			detailRow("Timezone:", r.Timezone),
+			detailRow("UTC Offset:", offsetStr),
+			sunText,
+			fmt.Sprintf(T("Press %s for full details"), KeyLabel("map.details")),
 		)
 	}
 
-	NavigationView.SetText(detailsText)
+	NavigationView.SetText(ThemeText(detailsText))
 
 	// Set border color based on selection
 	if navState.selectedPanel == "left" {
-		NavigationView.SetBorderColor(tcell.ColorGreen)
+		NavigationView.SetBorderColor(themeTcellColor(roleGood))
+	} else {
+		NavigationView.SetBorderColor(themeTcellColor(roleWarn))
+	}
+}
+
+// formatSunDetails describes the sun at a location for now's date shifted by
+// the details date offset. full adds twilight, golden hour and day length.
+func formatSunDetails(now time.Time, lat, lon float64, full bool) string {
+	day := now.AddDate(0, 0, navState.dateOffset)
+	st := computeSunTimes(day, lat, lon)
+
+	var b strings.Builder
+	if navState.dateOffset != 0 {
+		b.WriteString("\n[yellow]" + fmt.Sprintf(T("Sun on %s"), FormatDate(day)) + "[white]\n")
 	} else {
-		NavigationView.SetBorderColor(tcell.ColorOrange)
+		b.WriteString("\n" + detailRow("Sun Now:", getDayPhase(now, lat, lon)))
+	}
+
+	if !full {
+		b.WriteString(detailRow("Sun:", formatSunriseSunset(st)))
+		return b.String()
+	}
+
+	switch {
+	case st.AlwaysUp:
+		b.WriteString(detailRow("Sunrise:", "[yellow]"+T("Midnight sun")+"[white]"))
+	case st.AlwaysDown:
+		b.WriteString(detailRow("Sunrise:", "["+themeColor(roleNight)+"]"+T("Polar night")+"[white]"))
+	default:
+		b.WriteString(detailRow("Sunrise:", fmt.Sprintf("%s  [darkgray]"+T("(dawn %s)")+"[white]",
+			formatSunEvent(st.Sunrise), formatSunEvent(st.CivilDawn))))
+		b.WriteString(detailRow("Sunset:", fmt.Sprintf("%s  [darkgray]"+T("(dusk %s)")+"[white]",
+			formatSunEvent(st.Sunset), formatSunEvent(st.CivilDusk))))
+	}
+	b.WriteString(detailRow("Solar Noon:", formatSunEvent(st.SolarNoon)))
+	b.WriteString(detailRow("Day Length:", formatDayLength(st.DayLength)))
+	if !st.Sunrise.IsZero() && !st.GoldenMorningEnd.IsZero() {
+		b.WriteString(detailRow("Golden Hour:", fmt.Sprintf("%s-%s, %s-%s",
+			formatSunEvent(st.Sunrise), formatSunEvent(st.GoldenMorningEnd),
+			formatSunEvent(st.GoldenEveningStart), formatSunEvent(st.Sunset))))
+	}
+
+	rise, set := moonRiseSet(day, lat, lon)
+	b.WriteString(detailRow("Moon:", fmt.Sprintf("↑%s ↓%s  [darkgray]"+T("%d%% lit")+"[white]",
+		formatSunEvent(rise), formatSunEvent(set),
+		int(computeMoonPhase(st.SolarNoon).Illumination*100+0.5))))
+	return b.String()
+}
+
+// detailLabels are the labels of the details panel, aligned to the widest
+// in the current language.
+var detailLabels = []string{"City:", "Timezone:", "Current Time:", "Date:", "UTC Offset:", "Day of Week:",
+	"DST Active:", "Sun Now:", "Sun:", "Sunrise:", "Sunset:", "Solar Noon:", "Day Length:", "Golden Hour:", "Moon:"}
+
+// detailRow formats a line of the details panel with its label translated.
+func detailRow(label, value string) string {
+	width := 0
+	for _, l := range detailLabels {
+		width = max(width, textWidth(T(l)))
 	}
+	return fmt.Sprintf("[aqua]%s[white] %s\n", padTagged(T(label), width), value)
 }
 
 // formatBool returns Yes/No string for boolean
 func formatBool(b bool) string {
 	if b {
-		return "[green]Yes[white]"
+		return "[green]" + T("Yes") + "[white]"
 	}
-	return "[darkgray]No[white]"
+	return "[darkgray]" + T("No") + "[white]"
 }
 
 // GetNavigationHelpText returns help text for navigation mode
 func GetNavigationHelpText() string {
 	if navState.selectedIndex < 0 {
-		return "[darkgray]Keys:[white] ↑/↓=Navigate  Enter=Select  Tab=Switch Panel  Q/Esc=Quit"
+		return "[darkgray]" + T("Keys:") + "[white] " + KeyHelp([]string{"map.up", "map.down", "map.menu", "map.quit"})
 	}
-	return "[darkgray]Keys:[white] ↑/↓=Navigate  Enter=Toggle Details  Tab=Switch Panel  Esc=Deselect"
+	return "[darkgray]" + T("Keys:") + "[white] " + KeyHelp([]string{"map.up", "map.down", "map.details", "map.prev-day", "map.next-day", "map.back"})
 }
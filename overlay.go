@@ -15,6 +15,8 @@ const (
 	OverlayNone OverlayState = iota
 	OverlayMenu
 	OverlayFeature
+	OverlayPalette
+	OverlayHelp
 )
 
 type MenuItem struct {
@@ -31,6 +33,17 @@ type OverlayManager struct {
 	activeFeature Mode
 	app           *tview.Application
 	mm            *modeManager
+	menuView      *tview.TextView // Last rendered menu, for mouse hit tests
+	featureView   *tview.TextView // Last rendered feature, for mouse hit tests
+	featureShown  string          // Title, text, size and scroll of featureView
+	featureWidth  int             // Largest size the open feature has needed
+	featureHeight int
+	featureLines  int // Content lines of the open feature
+	featureRows   int // Content lines shown at once
+	featureScroll int // First content line shown
+	palette       *commandPalette
+	help          *helpOverlay
+	runMapAction  func(action string) bool // Runs a map action for the command palette
 }
 
 func NewOverlayManager(app *tview.Application, pages *tview.Pages, mm *modeManager) *OverlayManager {
@@ -45,11 +58,18 @@ func NewOverlayManager(app *tview.Application, pages *tview.Pages, mm *modeManag
 			{Label: "Alarm", Mode: ModeAlarm, Icon: "🔔"},
 			{Label: "Meeting Planner", Mode: ModeMeeting, Icon: "🗓️"},
 		},
-		app: app,
-		mm:  mm,
+		app:     app,
+		mm:      mm,
+		palette: newCommandPalette(),
 	}
 }
 
+// SetMapActionRunner sets the function the command palette uses to run
+// map actions.
+func (om *OverlayManager) SetMapActionRunner(run func(action string) bool) {
+	om.runMapAction = run
+}
+
 func (om *OverlayManager) ShowMenu() {
 	om.state = OverlayMenu
 	om.selectedIndex = 0
@@ -63,53 +83,42 @@ func (om *OverlayManager) renderMenu() {
 		SetWrap(false)
 
 	menuContent.SetBorder(true).
-		SetTitle("[ Menu ]").
+		SetTitle(fmt.Sprintf("[ %s ]", T("Menu"))).
 		SetTitleAlign(tview.AlignCenter).
 		SetBorderPadding(1, 1, 2, 2)
 
 	var sb strings.Builder
+	width := 32
 	for i, item := range om.menuItems {
+		line := fmt.Sprintf(" %s %s \n", item.Icon, padTagged(T(item.Label), 18))
 		if i == om.selectedIndex {
-			sb.WriteString(fmt.Sprintf("[black:white] %s %-18s [-:-]\n", item.Icon, item.Label))
-		} else {
-			sb.WriteString(fmt.Sprintf(" %s %-18s \n", item.Icon, item.Label))
+			line = "[black:white]" + strings.TrimSuffix(line, "\n") + "[-:-]\n"
 		}
+		sb.WriteString(line)
+		width = max(width, tview.TaggedStringWidth(line)+6) // Border and padding
 	}
-	menuContent.SetText(sb.String())
+	menuContent.SetText(ThemeText(sb.String()))
+	om.menuView = menuContent
 
-	// Centered layout
-	flex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().
-			AddItem(nil, 0, 1, false).
-			AddItem(menuContent, 32, 0, true).
-			AddItem(nil, 0, 1, false),
-			10, 0, true).
-		AddItem(nil, 0, 1, false)
-
-	om.pages.AddPage("menu", flex, true, true)
+	om.pages.AddPage("menu", om.centredOverlay(menuContent, width, len(om.menuItems)+4), true, true)
 	om.app.SetFocus(menuContent)
 }
 
 func (om *OverlayManager) ShowFeature(mode Mode) {
 	om.state = OverlayFeature
 	om.activeFeature = mode
+	om.featureWidth, om.featureHeight, om.featureScroll = 0, 0, 0
+	om.featureShown = ""
 	om.mm.SwitchTo(mode)
 	om.pages.RemovePage("menu")
 	om.renderFeature()
 }
 
-func (om *OverlayManager) renderFeature() {
-	featureContent := tview.NewTextView().
-		SetDynamicColors(true).
-		SetTextAlign(tview.AlignLeft).
-		SetWrap(false)
-
-	title := modeNames[om.activeFeature]
-	featureContent.SetBorder(true).
-		SetTitle(fmt.Sprintf("[ %s ]", title)).
-		SetTitleAlign(tview.AlignCenter).
-		SetBorderPadding(1, 1, 2, 2)
+// renderFeature shows the open feature, sized to its content. The view is
+// only rebuilt when its text, title or size changed; renderFeature reports
+// whether it was, so callers that redraw by hand can skip unchanged frames.
+func (om *OverlayManager) renderFeature() bool {
+	title := T(modeNames[om.activeFeature])
 
 	// Get feature content
 	var content string
@@ -120,12 +129,11 @@ func (om *OverlayManager) renderFeature() {
 		content = om.mm.Render()
 	}
 
-	// Add ASCII clock for relevant features
+	// Add an analog clock for relevant features
 	if om.activeFeature == ModeTimer || om.activeFeature == ModeStopwatch || om.activeFeature == ModeAlarm {
-		clock := RenderASCIIClock(time.Now())
+		clockLines := RenderAnalogFace(time.Now(), 27, 13, "white", true)
 		// Layout clock and content side-by-side
 		lines := strings.Split(content, "\n")
-		clockLines := strings.Split(clock, "\n")
 
 		maxLines := len(lines)
 		if len(clockLines) > maxLines {
@@ -154,26 +162,47 @@ func (om *OverlayManager) renderFeature() {
 		content = combined.String()
 	}
 
-	featureContent.SetText(content)
+	// Size the overlay to its content and the screen. While a feature is
+	// open it only grows, so it does not jump as its content changes.
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+	contentWidth := tview.TaggedStringWidth(title) + 4
+	for _, line := range lines {
+		contentWidth = max(contentWidth, tview.TaggedStringWidth(line))
+	}
+	om.featureWidth = max(om.featureWidth, contentWidth+6) // Border and padding
+	om.featureHeight = max(om.featureHeight, len(lines)+4)
+	width, height := om.fitScreen(om.featureWidth, om.featureHeight)
+
+	// Scroll content that does not fit the screen
+	om.featureLines, om.featureRows = len(lines), max(1, height-4)
+	om.featureScroll = clampInt(om.featureScroll, 0, max(0, om.featureLines-om.featureRows))
+	frameTitle := fmt.Sprintf("[ %s ]", title)
+	if om.featureScroll > 0 || om.featureLines > om.featureRows {
+		frameTitle = fmt.Sprintf("[ %s %d/%d ]", title, om.featureScroll+om.featureRows, om.featureLines)
+	}
 
-	// Centered layout - size depends on content
-	width := 64
-	height := 18
-	if om.activeFeature == ModeNavigation {
-		height = 22
+	content = ThemeText(content)
+	shown := fmt.Sprintf("%s\x00%s\x00%dx%d+%d", frameTitle, content, width, height, om.featureScroll)
+	if shown == om.featureShown && om.pages.HasPage("feature") {
+		return false
 	}
+	om.featureShown = shown
 
-	flex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().
-			AddItem(nil, 0, 1, false).
-			AddItem(featureContent, width, 0, true).
-			AddItem(nil, 0, 1, false),
-			height, 0, true).
-		AddItem(nil, 0, 1, false)
+	featureContent := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft).
+		SetWrap(false)
+	featureContent.SetBorder(true).
+		SetTitle(frameTitle).
+		SetTitleAlign(tview.AlignCenter).
+		SetBorderPadding(1, 1, 2, 2)
+	featureContent.SetText(content)
+	featureContent.ScrollTo(om.featureScroll, 0)
+	om.featureView = featureContent
 
-	om.pages.AddPage("feature", flex, true, true)
+	om.pages.AddPage("feature", om.centredOverlay(featureContent, width, height), true, true)
 	om.app.SetFocus(featureContent)
+	return true
 }
 
 func (om *OverlayManager) CloseOverlay() {
@@ -184,48 +213,207 @@ func (om *OverlayManager) CloseOverlay() {
 	} else if om.state == OverlayMenu {
 		om.pages.RemovePage("menu")
 		om.state = OverlayNone
+	} else if om.state == OverlayPalette {
+		om.closePalette()
+	} else if om.state == OverlayHelp {
+		om.closeHelp()
 	}
 }
 
 func (om *OverlayManager) HandleInput(event *tcell.EventKey) bool {
 	if om.state == OverlayMenu {
-		switch event.Key() {
-		case tcell.KeyUp:
+		action, ok := MatchKey(keyContextMenu, event)
+		switch action {
+		case "menu.up":
 			om.selectedIndex--
 			if om.selectedIndex < 0 {
 				om.selectedIndex = len(om.menuItems) - 1
 			}
 			om.renderMenu()
-			return true
-		case tcell.KeyDown:
+		case "menu.down":
 			om.selectedIndex++
 			if om.selectedIndex >= len(om.menuItems) {
 				om.selectedIndex = 0
 			}
 			om.renderMenu()
-			return true
-		case tcell.KeyEnter:
+		case "menu.open":
 			om.ShowFeature(om.menuItems[om.selectedIndex].Mode)
-			return true
-		case tcell.KeyEscape:
-			om.CloseOverlay()
-			return true
-		}
-		if event.Rune() == 'm' || event.Rune() == 'M' || event.Rune() == ' ' {
+		case "menu.close":
 			om.CloseOverlay()
-			return true
+		case "menu.palette":
+			om.pages.RemovePage("menu")
+			om.ShowPalette()
+		case "menu.help":
+			om.ShowHelp()
 		}
+		return ok
+	} else if om.state == OverlayPalette {
+		return om.handlePaletteKey(event)
+	} else if om.state == OverlayHelp {
+		return om.handleHelpKey(event)
 	} else if om.state == OverlayFeature {
 		if event.Key() == tcell.KeyEscape {
+			// Let the mode back out of a sub-view before closing the overlay
+			if om.mm.HandleSpecialKeyEvent(tcell.KeyEscape) {
+				return true
+			}
 			om.CloseOverlay()
 			return true
 		}
+		// Bound actions come first, unless the mode is taking text
+		if !om.mm.IsTextInput() {
+			action, ok := MatchKey(om.mm.KeyContext(), event)
+			if strings.HasSuffix(action, ".help") {
+				om.ShowHelp()
+				return true
+			}
+			if action != "" && om.mm.HandleAction(action) {
+				return true
+			}
+			if action == "" && ok {
+				return true // Part of a key chord
+			}
+		}
 		// Delegate to mode handlers
 		if event.Key() == tcell.KeyRune {
 			return om.mm.HandleKey(event.Rune())
 		}
-		// Handle special keys like Enter, Backspace for converter/meeting
-		return om.mm.HandleSpecialKeyEvent(event.Key())
+		// Handle special keys like Enter, Backspace for converter/meeting,
+		// then the scroll keys of a feature taller than the screen
+		if om.mm.HandleSpecialKeyEvent(event.Key()) {
+			return true
+		}
+		return om.scrollFeatureKey(event.Key())
+	}
+	return false
+}
+
+// HandleMouse handles a mouse event at screen position x, y while an
+// overlay is shown. Menu items are highlighted on hover and opened on click;
+// a click outside the menu closes it. Events inside a feature are passed to
+// its mode with content-relative coordinates.
+func (om *OverlayManager) HandleMouse(action mouseAction, x, y int) bool {
+	switch om.state {
+	case OverlayMenu:
+		if om.menuView == nil {
+			return false
+		}
+		rx, ry, rw, rh := om.menuView.GetInnerRect()
+		inside := x >= rx && x < rx+rw && y >= ry && y < ry+rh
+		index := y - ry
+		switch action {
+		case mouseClick:
+			if !inside {
+				om.CloseOverlay()
+				return true
+			}
+			if index >= 0 && index < len(om.menuItems) {
+				om.ShowFeature(om.menuItems[index].Mode)
+				return true
+			}
+		case mouseHover:
+			if inside && index >= 0 && index < len(om.menuItems) && index != om.selectedIndex {
+				om.selectedIndex = index
+				om.renderMenu()
+				return true
+			}
+		case mouseScrollUp:
+			return om.HandleInput(tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone))
+		case mouseScrollDown:
+			return om.HandleInput(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+		}
+	case OverlayPalette:
+		return om.handlePaletteMouse(action, x, y)
+	case OverlayHelp:
+		return om.handleHelpMouse(action)
+	case OverlayFeature:
+		if om.featureView == nil {
+			return false
+		}
+		rx, ry, rw, rh := om.featureView.GetInnerRect()
+		if x < rx || x >= rx+rw || y < ry || y >= ry+rh {
+			return false
+		}
+		if om.mm.HandleMouse(action, x-rx, y-ry+om.featureScroll) {
+			return true
+		}
+		switch action {
+		case mouseScrollUp:
+			return om.scrollFeature(-3)
+		case mouseScrollDown:
+			return om.scrollFeature(3)
+		}
+	}
+	return false
+}
+
+// Refit rebuilds the open overlays for the current screen size, since the
+// size of an overlay is fixed when its page is built. The help stays on top
+// of the overlay it was opened from.
+func (om *OverlayManager) Refit() {
+	below := om.state
+	if below == OverlayHelp {
+		below = om.help.returnTo
+	}
+	switch below {
+	case OverlayMenu:
+		om.renderMenu()
+	case OverlayFeature:
+		om.renderFeature()
+	case OverlayPalette:
+		om.renderPalette()
+	}
+	if om.state == OverlayHelp {
+		om.renderHelp()
+	}
+}
+
+// fitScreen shrinks an overlay size to the screen.
+func (om *OverlayManager) fitScreen(width, height int) (int, int) {
+	_, _, screenWidth, screenHeight := om.pages.GetRect()
+	if screenWidth > 0 && screenHeight > 0 {
+		width, height = min(width, screenWidth), min(height, screenHeight)
+	}
+	return width, height
+}
+
+// centredOverlay places a view in the middle of the screen, width by
+// height cells, shrunk to fit the screen.
+func (om *OverlayManager) centredOverlay(view tview.Primitive, width, height int) *tview.Flex {
+	width, height = om.fitScreen(width, height)
+	return tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().
+			AddItem(nil, 0, 1, false).
+			AddItem(view, width, 0, true).
+			AddItem(nil, 0, 1, false),
+			height, 0, true).
+		AddItem(nil, 0, 1, false)
+}
+
+// scrollFeature scrolls a feature taller than the screen by delta lines.
+// It returns false if the feature fits.
+func (om *OverlayManager) scrollFeature(delta int) bool {
+	if om.featureLines <= om.featureRows {
+		return false
+	}
+	om.featureScroll = clampInt(om.featureScroll+delta, 0, om.featureLines-om.featureRows)
+	om.renderFeature()
+	return true
+}
+
+// scrollFeatureKey scrolls a feature taller than the screen with PgUp,
+// PgDn, Home and End. It returns false for other keys.
+func (om *OverlayManager) scrollFeatureKey(key tcell.Key) bool {
+	switch key {
+	case tcell.KeyPgUp:
+		return om.scrollFeature(-om.featureRows)
+	case tcell.KeyPgDn:
+		return om.scrollFeature(om.featureRows)
+	case tcell.KeyHome:
+		return om.scrollFeature(-om.featureLines)
+	case tcell.KeyEnd:
+		return om.scrollFeature(om.featureLines)
 	}
 	return false
 }
@@ -234,28 +422,32 @@ func RenderClockList(regions []Region) string {
 	var sb strings.Builder
 
 	// Group by category if possible, or just Americas/Europe vs Others
-	sb.WriteString("[yellow::b]Americas & Europe[-]\n")
+	sb.WriteString("[yellow::b]" + T("Americas & Europe") + "[-]\n")
 	for _, r := range regions {
 		city := GetCityByName(r.Name)
-		if city == nil || (city.Category != "Americas" && city.Category != "Europe") {
+		if city == nil || !isLeftPanelCity(*city) {
 			continue
 		}
 		loc, _ := time.LoadLocation(r.Timezone)
 		now := time.Now().In(loc)
-		sb.WriteString(fmt.Sprintf("  [white]%s  %-14s [green]%8s  [silver]%s[-]\n",
-			city.Abbreviation(), r.Name, now.Format("3:04 PM"), now.Format("Mon Jan 2")))
+		sun := computeSunTimes(now, city.Coordinates[0], city.Coordinates[1])
+		sb.WriteString(fmt.Sprintf("  [white]%s  %s [green]%s  [silver]%s %s\n",
+			city.Abbreviation(), padTagged(LocalCityName(r.Name), 14), FormatClockMinutes(now),
+			padTagged(FormatShortDate(now), ShortDateWidth()), formatSunriseSunset(sun)))
 	}
 
-	sb.WriteString("\n[yellow::b]Asia, Africa & Oceania[-]\n")
+	sb.WriteString("\n[yellow::b]" + T("Asia, Africa & Oceania") + "[-]\n")
 	for _, r := range regions {
 		city := GetCityByName(r.Name)
-		if city == nil || (city.Category == "Americas" || city.Category == "Europe") {
+		if city == nil || isLeftPanelCity(*city) {
 			continue
 		}
 		loc, _ := time.LoadLocation(r.Timezone)
 		now := time.Now().In(loc)
-		sb.WriteString(fmt.Sprintf("  [white]%s  %-14s [green]%8s  [silver]%s[-]\n",
-			city.Abbreviation(), r.Name, now.Format("3:04 PM"), now.Format("Mon Jan 2")))
+		sun := computeSunTimes(now, city.Coordinates[0], city.Coordinates[1])
+		sb.WriteString(fmt.Sprintf("  [white]%s  %s [green]%s  [silver]%s %s\n",
+			city.Abbreviation(), padTagged(LocalCityName(r.Name), 14), FormatClockMinutes(now),
+			padTagged(FormatShortDate(now), ShortDateWidth()), formatSunriseSunset(sun)))
 	}
 
 	return sb.String()
@@ -0,0 +1,572 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Layout of the command palette
+const (
+	paletteWidth      = 72
+	paletteRows       = 10 // Commands shown at once
+	paletteListRow    = 2  // Row of the first command, below the input line
+	paletteHistoryLen = 10 // Recent command lines kept in the config
+)
+
+// paletteCommand is a command of the command palette. Commands with
+// arguments can be typed in full, e.g. "timer 10m", or chosen from the list,
+// which prompts for the arguments.
+type paletteCommand struct {
+	name     string // What is typed, e.g. "timer"
+	args     string // Argument hint, e.g. "<duration>"; empty if it takes none
+	optional bool   // The arguments may be left out
+	title    string // Description shown in the list
+	action   string // Key action whose binding is shown, if any
+	run      func(om *OverlayManager, args string) error
+}
+
+// paletteEntry is a line of the palette list.
+type paletteEntry struct {
+	command *paletteCommand
+	line    string // Command line run when the entry is chosen
+	recent  bool   // From the history
+}
+
+// commandPalette is the state of the command palette overlay.
+type commandPalette struct {
+	commands []paletteCommand
+	query    string
+	entries  []paletteEntry
+	selected int
+	scroll   int
+	message  string   // Error of the last command, or an argument prompt
+	history  []string // Recent command lines, newest first
+	loaded   bool     // History has been read from the config
+	view     *tview.TextView
+}
+
+// newCommandPalette creates the palette with every command: the features,
+// the map actions of the key registry and the commands taking arguments.
+func newCommandPalette() *commandPalette {
+	p := &commandPalette{}
+	for _, f := range []struct {
+		name, title string
+		mode        Mode
+	}{
+		{"clocks", "Open the world clock list", ModeNavigation},
+		{"converter", "Open the time converter", ModeConverter},
+		{"stopwatch", "Open the stopwatch", ModeStopwatch},
+		{"alarm", "Open the alarms", ModeAlarm},
+		{"meeting", "Open the meeting planner", ModeMeeting},
+	} {
+		mode := f.mode
+		p.commands = append(p.commands, paletteCommand{
+			name:  f.name,
+			title: T(f.title),
+			run: func(om *OverlayManager, _ string) error {
+				om.ShowFeature(mode)
+				return nil
+			},
+		})
+	}
+
+	p.commands = append(p.commands,
+		paletteCommand{name: "timer", args: "<duration>", optional: true, title: T("Start a timer, e.g. timer 25m"), run: runTimerCommand},
+		paletteCommand{name: "convert", args: "<HH:MM> <city>", optional: true, title: T("Convert a time, e.g. convert 15:00 tokyo"), run: runConvertCommand},
+		paletteCommand{name: "city", args: "<name>", title: T("Jump to a city and show its details"), run: runCityCommand},
+		paletteCommand{name: "add", args: "<city>", title: T("Add a city to the clocks"), run: runAddCommand},
+		paletteCommand{name: "projection", args: "<name>", optional: true, title: fmt.Sprintf(T("Switch map projection (%s)"), strings.Join(projectionKeys(), ", ")), action: "map.projection", run: runProjectionCommand},
+		paletteCommand{name: "layout", args: "<name>", optional: true, title: fmt.Sprintf(T("Switch dashboard layout (%s)"), strings.Join(layoutNames, ", ")), action: "map.layout", run: runLayoutCommand},
+		paletteCommand{name: "menu", title: T("Open the feature menu"), action: "map.menu", run: func(om *OverlayManager, _ string) error {
+			om.ShowMenu()
+			return nil
+		}},
+	)
+
+	// Every map action of the key registry
+	for _, action := range KeyContextActions(keyContextMap) {
+		switch action {
+		case "map.up", "map.down", "map.back", "map.palette":
+			continue // Navigation keys
+		}
+		if p.find(strings.TrimPrefix(action, keyContextMap+".")) != nil {
+			continue
+		}
+		action := action
+		p.commands = append(p.commands, paletteCommand{
+			name:   strings.TrimPrefix(action, keyContextMap+"."),
+			title:  keyActionHelp(action),
+			action: action,
+			run: func(om *OverlayManager, _ string) error {
+				if om.runMapAction == nil || !om.runMapAction(action) {
+					return fmt.Errorf(T("%s is not available right now"), keyActionHelp(action))
+				}
+				return nil
+			},
+		})
+	}
+	return p
+}
+
+// find returns the command with the given name.
+func (p *commandPalette) find(name string) *paletteCommand {
+	for i := range p.commands {
+		if strings.EqualFold(p.commands[i].name, name) {
+			return &p.commands[i]
+		}
+	}
+	return nil
+}
+
+// refilter rebuilds the list for the query. An empty query lists recent
+// commands, then every command. A query starting with the name of a
+// command and a space is that command with arguments; anything else is
+// matched fuzzily against command names and titles.
+func (p *commandPalette) refilter() {
+	p.entries = p.entries[:0]
+	p.selected, p.scroll = 0, 0
+	query := strings.TrimSpace(p.query)
+
+	if query == "" {
+		for _, line := range p.history {
+			name, _, _ := strings.Cut(line, " ")
+			if cmd := p.find(name); cmd != nil {
+				p.entries = append(p.entries, paletteEntry{command: cmd, line: line, recent: true})
+			}
+		}
+		for i := range p.commands {
+			p.entries = append(p.entries, paletteEntry{command: &p.commands[i], line: p.commands[i].name})
+		}
+		return
+	}
+
+	if name, args, ok := strings.Cut(query, " "); ok {
+		if cmd := p.find(name); cmd != nil && cmd.args != "" {
+			p.entries = append(p.entries, paletteEntry{command: cmd, line: cmd.name + " " + strings.TrimSpace(args)})
+			return
+		}
+	}
+
+	type scored struct {
+		entry paletteEntry
+		score int
+	}
+	var matches []scored
+	for i := range p.commands {
+		cmd := &p.commands[i]
+		score, ok := fuzzyScore(query, cmd.name)
+		if !ok {
+			if score, ok = fuzzyScore(query, cmd.title); !ok {
+				continue
+			}
+			score /= 2 // Names rank above titles
+		}
+		matches = append(matches, scored{paletteEntry{command: cmd, line: cmd.name}, score})
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+	for _, m := range matches {
+		p.entries = append(p.entries, m.entry)
+	}
+}
+
+// fuzzyScore scores how well query matches text as a subsequence, ignoring
+// case and spaces in the query. Consecutive letters and letters starting a
+// word score higher. ok is false if the query does not match.
+func fuzzyScore(query, text string) (score int, ok bool) {
+	q := []rune(strings.ToLower(strings.ReplaceAll(query, " ", "")))
+	t := []rune(strings.ToLower(text))
+	qi, prev := 0, -2
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] != q[qi] {
+			continue
+		}
+		score += 10
+		if ti == prev+1 {
+			score += 15
+		}
+		if ti == 0 || !unicode.IsLetter(t[ti-1]) {
+			score += 20
+		}
+		prev = ti
+		qi++
+	}
+	if qi < len(q) {
+		return 0, false
+	}
+	return score - len(t), true // Shorter texts win ties
+}
+
+// move moves the selection by delta entries, keeping it in view.
+func (p *commandPalette) move(delta int) {
+	if len(p.entries) == 0 {
+		return
+	}
+	p.selected = clampInt(p.selected+delta, 0, len(p.entries)-1)
+	if p.selected < p.scroll {
+		p.scroll = p.selected
+	} else if p.selected >= p.scroll+paletteRows {
+		p.scroll = p.selected - paletteRows + 1
+	}
+}
+
+// remember puts a command line at the front of the history and saves it.
+func (p *commandPalette) remember(line string) {
+	history := []string{line}
+	for _, h := range p.history {
+		if h != line && len(history) < paletteHistoryLen {
+			history = append(history, h)
+		}
+	}
+	p.history = history
+
+	// The history is a convenience; failing to save it is not worth a message
+	if config, err := LoadConfig(); err == nil {
+		config.RecentCommands = history
+		_ = SaveConfig(config)
+	}
+}
+
+// ShowPalette opens the command palette.
+func (om *OverlayManager) ShowPalette() {
+	p := om.palette
+	if !p.loaded {
+		if config, err := LoadConfig(); err == nil {
+			p.history = config.RecentCommands
+		}
+		p.loaded = true
+	}
+	p.query, p.message = "", ""
+	p.refilter()
+	om.state = OverlayPalette
+	om.renderPalette()
+}
+
+// renderPalette draws the palette: the input line, a page of commands with
+// the selected one highlighted, and a line for prompts and errors.
+func (om *OverlayManager) renderPalette() {
+	p := om.palette
+	view := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(false)
+	view.SetBorder(true).
+		SetTitle(fmt.Sprintf("[ %s ]", T("Commands"))).
+		SetTitleAlign(tview.AlignCenter).
+		SetBorderPadding(1, 1, 2, 2)
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("[yellow::b]:[-::-] %s[::b]_[::-]\n\n", tview.Escape(p.query)))
+
+	if len(p.entries) == 0 {
+		b.WriteString("  [darkgray]" + T("No matching commands") + "[-]\n")
+	}
+	for i := p.scroll; i < len(p.entries) && i < p.scroll+paletteRows; i++ {
+		e := p.entries[i]
+		line := e.line
+		if !e.recent && e.command.args != "" && line == e.command.name {
+			line += " " + e.command.args
+		}
+		marker := "  "
+		if e.recent {
+			marker = "↺ "
+		}
+		detail := e.command.title
+		if key := KeyLabel(e.command.action); key != "" {
+			detail += "  [" + key + "]"
+		}
+		text := fmt.Sprintf("%s%-26s %s", marker, line, detail)
+		if width := paletteWidth - 6; len([]rune(text)) > width {
+			text = string([]rune(text)[:width-1]) + "…"
+		}
+		if i == p.selected {
+			b.WriteString(fmt.Sprintf("[black:white]%s[-:-]\n", tview.Escape(text)))
+		} else {
+			b.WriteString(tview.Escape(text) + "\n")
+		}
+	}
+	for i := len(p.entries) - p.scroll; i < paletteRows; i++ {
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	if p.message != "" {
+		b.WriteString(p.message)
+	} else {
+		b.WriteString("[darkgray]" + T("Enter=Run  Tab=Complete  ↑/↓=Select  Esc=Close") + "[-]")
+	}
+	view.SetText(ThemeText(b.String()))
+	p.view = view
+
+	om.pages.AddPage("palette", om.centredOverlay(view, paletteWidth, paletteRows+8), true, true)
+	om.app.SetFocus(view)
+}
+
+// closePalette removes the palette from the screen.
+func (om *OverlayManager) closePalette() {
+	om.pages.RemovePage("palette")
+	om.state = OverlayNone
+}
+
+// handlePaletteKey handles a key while the palette is open. Everything
+// typed goes to the query.
+func (om *OverlayManager) handlePaletteKey(event *tcell.EventKey) bool {
+	p := om.palette
+	switch event.Key() {
+	case tcell.KeyEscape:
+		om.closePalette()
+		return true
+	case tcell.KeyEnter:
+		om.runPaletteEntry()
+		return true
+	case tcell.KeyUp, tcell.KeyCtrlP:
+		p.move(-1)
+	case tcell.KeyDown, tcell.KeyCtrlN:
+		p.move(1)
+	case tcell.KeyPgUp:
+		p.move(-paletteRows)
+	case tcell.KeyPgDn:
+		p.move(paletteRows)
+	case tcell.KeyTab:
+		if p.selected < len(p.entries) {
+			e := p.entries[p.selected]
+			p.query = e.line
+			if e.command.args != "" && e.line == e.command.name {
+				p.query += " "
+			}
+			p.refilter()
+		}
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if runes := []rune(p.query); len(runes) > 0 {
+			p.query = string(runes[:len(runes)-1])
+			p.message = ""
+			p.refilter()
+		}
+	case tcell.KeyRune:
+		if unicode.IsPrint(event.Rune()) && len([]rune(p.query)) < 64 {
+			p.query += string(event.Rune())
+			p.message = ""
+			p.refilter()
+		}
+	default:
+		return true // Swallow other keys while the palette is open
+	}
+	om.renderPalette()
+	return true
+}
+
+// runPaletteEntry runs the selected entry. A command chosen without the
+// arguments it needs prompts for them instead; a command that fails keeps
+// the palette open with its error.
+func (om *OverlayManager) runPaletteEntry() {
+	p := om.palette
+	if p.selected >= len(p.entries) {
+		return
+	}
+	e := p.entries[p.selected]
+	_, args, _ := strings.Cut(e.line, " ")
+	args = strings.TrimSpace(args)
+	if args == "" && e.command.args != "" && !e.command.optional {
+		p.query = e.command.name + " "
+		p.message = fmt.Sprintf("[yellow]%s %s[-]  [darkgray]%s[-]", e.command.name, e.command.args, e.command.title)
+		p.refilter()
+		om.renderPalette()
+		return
+	}
+
+	om.closePalette()
+	if err := e.command.run(om, args); err != nil {
+		p.message = fmt.Sprintf("[red]%s[-]", tview.Escape(err.Error()))
+		p.query = e.line
+		p.refilter()
+		if om.state == OverlayNone {
+			om.state = OverlayPalette
+			om.renderPalette()
+		}
+		return
+	}
+	p.remember(e.line)
+}
+
+// handlePaletteMouse highlights the command under the pointer, runs it on
+// click, and scrolls the list with the wheel.
+func (om *OverlayManager) handlePaletteMouse(action mouseAction, x, y int) bool {
+	p := om.palette
+	if p.view == nil {
+		return false
+	}
+	rx, ry, rw, rh := p.view.GetInnerRect()
+	inside := x >= rx && x < rx+rw && y >= ry && y < ry+rh
+	index := p.scroll + y - ry - paletteListRow
+	onEntry := inside && y-ry >= paletteListRow && y-ry < paletteListRow+paletteRows && index < len(p.entries)
+
+	switch action {
+	case mouseClick:
+		if !inside {
+			om.closePalette()
+			return true
+		}
+		if onEntry {
+			p.selected = index
+			om.runPaletteEntry()
+			return true
+		}
+	case mouseHover:
+		if onEntry && index != p.selected {
+			p.selected = index
+			om.renderPalette()
+			return true
+		}
+	case mouseScrollUp:
+		p.move(-1)
+		om.renderPalette()
+		return true
+	case mouseScrollDown:
+		p.move(1)
+		om.renderPalette()
+		return true
+	}
+	return false
+}
+
+// runTimerCommand starts a timer: "timer 25m", "timer 1h30m" or
+// "timer 10" (minutes). Without a duration it opens the timer.
+func runTimerCommand(om *OverlayManager, args string) error {
+	timer, ok := om.mm.handlers[ModeTimer].(*timerMode)
+	if !ok {
+		return errors.New(T("the timer is not available"))
+	}
+	if args != "" {
+		d, err := parsePaletteDuration(args)
+		if err != nil {
+			return err
+		}
+		timer.reset()
+		timer.state.duration = d
+		timer.start()
+	}
+	om.ShowFeature(ModeTimer)
+	return nil
+}
+
+// parsePaletteDuration parses a Go duration such as "25m" or "1h30m"; a
+// plain number is taken as minutes.
+func parsePaletteDuration(s string) (time.Duration, error) {
+	if minutes, err := strconv.Atoi(s); err == nil {
+		s = fmt.Sprintf("%dm", minutes)
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf(T("invalid duration %q (e.g. 25m, 1h30m, 90s)"), s)
+	}
+	if d <= 0 {
+		return 0, errors.New(T("duration must be positive"))
+	}
+	return d, nil
+}
+
+// runConvertCommand opens the converter with a source time and city:
+// "convert 15:00 tokyo". The city defaults to the converter's current one.
+func runConvertCommand(om *OverlayManager, args string) error {
+	converter, ok := om.mm.handlers[ModeConverter].(*converterMode)
+	if !ok {
+		return errors.New(T("the converter is not available"))
+	}
+	if args != "" {
+		clock, cityName, _ := strings.Cut(args, " ")
+		if err := converter.SetSource(clock, strings.TrimSpace(cityName)); err != nil {
+			return err
+		}
+	}
+	om.ShowFeature(ModeConverter)
+	return nil
+}
+
+// runCityCommand selects a city and shows its details, adding it to the
+// clocks first if needed.
+func runCityCommand(om *OverlayManager, args string) error {
+	city := GetCityByName(args)
+	if city == nil {
+		return fmt.Errorf(T("unknown city %q"), args)
+	}
+	var err error
+	if !isOnClocks(city.Name) {
+		err = addAndSaveClockCity(*city)
+	}
+	OpenCityDetails(city.Name, leftRegions, rightRegions)
+	return err
+}
+
+// runAddCommand adds a city from the database to the clock panels.
+func runAddCommand(om *OverlayManager, args string) error {
+	city := GetCityByName(args)
+	if city == nil {
+		return fmt.Errorf(T("unknown city %q"), args)
+	}
+	if isOnClocks(city.Name) {
+		return fmt.Errorf(T("%s is already on the clocks"), LocalCityName(city.Name))
+	}
+	return addAndSaveClockCity(*city)
+}
+
+// runProjectionCommand switches the map projection, or cycles to the next
+// one if none is given.
+func runProjectionCommand(om *OverlayManager, args string) error {
+	if args == "" {
+		CycleProjection()
+		return nil
+	}
+	return SetProjection(args)
+}
+
+// runLayoutCommand switches to the named layout, or to the next one
+// without an argument.
+func runLayoutCommand(om *OverlayManager, args string) error {
+	if args == "" {
+		CycleLayout()
+		return nil
+	}
+	return SetLayout(args)
+}
+
+// isOnClocks reports whether a city is shown on the clock panels.
+func isOnClocks(name string) bool {
+	for _, r := range append(append([]Region{}, leftRegions...), rightRegions...) {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// addAndSaveClockCity adds a city to the clock panels and saves it to the
+// config, so that it is on the clocks again after a restart.
+func addAndSaveClockCity(city City) error {
+	addClockCity(city)
+	config, err := LoadConfig()
+	if err != nil {
+		return fmt.Errorf(T("added %s but could not save it: %w"), LocalCityName(city.Name), err)
+	}
+	config.PutAddedCity(city.Name)
+	if err := SaveConfig(config); err != nil {
+		return fmt.Errorf(T("added %s but could not save it: %w"), LocalCityName(city.Name), err)
+	}
+	return nil
+}
+
+// paletteHelpGroup returns the help for the command palette.
+func paletteHelpGroup() helpGroup {
+	return helpGroup{title: T("Command palette"), entries: []helpEntry{
+		{keys: T("Type"), help: T("Search commands, or type one, e.g. timer 10m")},
+		{keys: "↑ ↓  Ctrl+P Ctrl+N", help: T("Select a command")},
+		{keys: "Enter", help: T("Run it, asking for arguments if needed")},
+		{keys: "Tab", help: T("Complete the selected command")},
+		{keys: "Esc", help: T("Close the palette")},
+	}}
+}
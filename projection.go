@@ -0,0 +1,297 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Projection maps latitude and longitude to flat map coordinates. Longitude
+// is given relative to the central meridian of the map (dLon, in degrees) so
+// a projection never has to care where the map is centred.
+type Projection interface {
+	Name() string
+	Forward(lat, dLon float64) (x, y float64)
+	Inverse(x, y float64) (lat, dLon float64, ok bool)
+}
+
+// equirectangularProjection maps degrees straight to x/y (plate carrée).
+type equirectangularProjection struct{}
+
+// mercatorProjection is the conformal Mercator projection, clamped to
+// ±mercatorMaxLat so the poles stay finite.
+type mercatorProjection struct{}
+
+// robinsonProjection is the Robinson compromise projection, interpolated
+// from its published table.
+type robinsonProjection struct{}
+
+// mercatorMaxLat is the latitude at which the Mercator projection is cut off
+const mercatorMaxLat = 85.0
+
+// robinsonTable holds Robinson's X (parallel length) and Y (parallel
+// distance from the equator) for every 5° of latitude from 0° to 90°.
+var robinsonTable = [19][2]float64{
+	{1.0000, 0.0000}, {0.9986, 0.0620}, {0.9954, 0.1240}, {0.9900, 0.1860},
+	{0.9822, 0.2480}, {0.9730, 0.3100}, {0.9600, 0.3720}, {0.9427, 0.4340},
+	{0.9216, 0.4958}, {0.8962, 0.5571}, {0.8679, 0.6176}, {0.8350, 0.6769},
+	{0.7986, 0.7346}, {0.7597, 0.7903}, {0.7186, 0.8435}, {0.6732, 0.8936},
+	{0.6213, 0.9394}, {0.5722, 0.9761}, {0.5322, 1.0000},
+}
+
+// Robinson scale factors for x and y
+const (
+	robinsonScaleX = 0.8487
+	robinsonScaleY = 1.3523
+)
+
+// Name returns the projection's display name.
+func (equirectangularProjection) Name() string { return "Equirectangular" }
+
+// Forward projects a point to degrees of x/y.
+func (equirectangularProjection) Forward(lat, dLon float64) (x, y float64) {
+	return dLon, lat
+}
+
+// Inverse converts x/y back to latitude and relative longitude.
+func (equirectangularProjection) Inverse(x, y float64) (lat, dLon float64, ok bool) {
+	return y, x, y >= -90 && y <= 90
+}
+
+// Name returns the projection's display name.
+func (mercatorProjection) Name() string { return "Mercator" }
+
+// Forward projects a point onto the Mercator cylinder, in radians.
+func (mercatorProjection) Forward(lat, dLon float64) (x, y float64) {
+	lat = math.Max(-mercatorMaxLat, math.Min(mercatorMaxLat, lat))
+	return dLon * degreesToRadians, math.Log(math.Tan(math.Pi/4 + lat*degreesToRadians/2))
+}
+
+// Inverse converts Mercator x/y back to latitude and relative longitude.
+func (mercatorProjection) Inverse(x, y float64) (lat, dLon float64, ok bool) {
+	lat = math.Atan(math.Sinh(y)) * radiansToDegrees
+	return lat, x * radiansToDegrees, math.Abs(lat) <= mercatorMaxLat
+}
+
+// Name returns the projection's display name.
+func (robinsonProjection) Name() string { return "Robinson" }
+
+// robinsonRow interpolates the Robinson table at an absolute latitude.
+func robinsonRow(absLat float64) (x, y float64) {
+	pos := math.Min(absLat, 90) / 5
+	i := int(pos)
+	if i >= len(robinsonTable)-1 {
+		return robinsonTable[len(robinsonTable)-1][0], robinsonTable[len(robinsonTable)-1][1]
+	}
+	f := pos - float64(i)
+	a, b := robinsonTable[i], robinsonTable[i+1]
+	return a[0] + (b[0]-a[0])*f, a[1] + (b[1]-a[1])*f
+}
+
+// Forward projects a point with the Robinson table.
+func (robinsonProjection) Forward(lat, dLon float64) (x, y float64) {
+	px, py := robinsonRow(math.Abs(lat))
+	y = robinsonScaleY * py
+	if lat < 0 {
+		y = -y
+	}
+	return robinsonScaleX * px * dLon * degreesToRadians, y
+}
+
+// Inverse converts Robinson x/y back to latitude and relative longitude.
+// Points outside the projection's outline are not ok.
+func (robinsonProjection) Inverse(x, y float64) (lat, dLon float64, ok bool) {
+	py := math.Abs(y) / robinsonScaleY
+	if py > 1 {
+		return 0, 0, false
+	}
+	// Y grows monotonically with latitude, so find the table interval
+	i := 0
+	for i < len(robinsonTable)-2 && robinsonTable[i+1][1] < py {
+		i++
+	}
+	a, b := robinsonTable[i], robinsonTable[i+1]
+	f := (py - a[1]) / (b[1] - a[1])
+	lat = (float64(i) + f) * 5
+	if y < 0 {
+		lat = -lat
+	}
+	px, _ := robinsonRow(math.Abs(lat))
+	dLon = x / (robinsonScaleX * px) * radiansToDegrees
+	return lat, dLon, math.Abs(dLon) <= 180
+}
+
+// projectionMode is one entry of the projection menu cycled with 'o'.
+// Centred modes recentre the world view on the configured meridian.
+type projectionMode struct {
+	Key        string
+	Projection Projection
+	Centred    bool
+}
+
+// projectionModes lists the selectable projections in cycling order.
+var projectionModes = []projectionMode{
+	{Key: "equirectangular", Projection: equirectangularProjection{}},
+	{Key: "mercator", Projection: mercatorProjection{}},
+	{Key: "robinson", Projection: robinsonProjection{}},
+	{Key: "pacific", Projection: equirectangularProjection{}, Centred: true},
+}
+
+// defaultCentreMeridian is the centre of the Pacific-centred world view
+const defaultCentreMeridian = 150.0
+
+var (
+	projectionModeIndex = 0                     // Index into projectionModes
+	centreMeridian      = defaultCentreMeridian // Centre meridian for centred modes
+)
+
+// CurrentProjection returns the projection the map is drawn with.
+func CurrentProjection() Projection {
+	return projectionModes[projectionModeIndex].Projection
+}
+
+// worldViewportForProjection returns the world view for the current
+// projection mode, recentred on the centre meridian where needed.
+func worldViewportForProjection() Viewport {
+	vp := worldViewport
+	if projectionModes[projectionModeIndex].Centred {
+		vp.West = centreMeridian - 180
+		vp.East = centreMeridian + 180
+	}
+	return vp
+}
+
+// SetProjection selects a projection mode by key (e.g. "robinson").
+func SetProjection(key string) error {
+	for i, mode := range projectionModes {
+		if strings.EqualFold(mode.Key, key) {
+			projectionModeIndex = i
+			if mapViewport.IsWorld() {
+				mapViewport = worldViewportForProjection()
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown projection %q (choose from %s)", key, strings.Join(projectionKeys(), ", "))
+}
+
+// projectionKeys returns the keys of the projection modes.
+func projectionKeys() []string {
+	keys := make([]string, len(projectionModes))
+	for i, mode := range projectionModes {
+		keys[i] = mode.Key
+	}
+	return keys
+}
+
+// SetCentreMeridian sets the centre meridian used by the centred projection.
+func SetCentreMeridian(lon float64) {
+	centreMeridian = normalizeLongitude(lon)
+	if mapViewport.IsWorld() {
+		mapViewport = worldViewportForProjection()
+	}
+}
+
+// CycleProjection switches the map to the next projection mode.
+func CycleProjection() {
+	_ = SetProjection(projectionModes[(projectionModeIndex+1)%len(projectionModes)].Key)
+}
+
+// GetProjectionStatus returns the projection label for the status bar, or
+// an empty string for the default equirectangular map.
+func GetProjectionStatus() string {
+	mode := projectionModes[projectionModeIndex]
+	switch {
+	case mode.Centred:
+		return fmt.Sprintf("[aqua]Pacific %.0f°[-]", centreMeridian)
+	case projectionModeIndex == 0:
+		return ""
+	}
+	return fmt.Sprintf("[aqua]%s[-]", mode.Projection.Name())
+}
+
+// mapProjector places a projected viewport onto a grid of w x h units
+// (braille cells or dots). Coordinates are fractional grid positions.
+type mapProjector struct {
+	proj       Projection
+	vp         Viewport
+	centre     float64 // Central meridian of the viewport
+	minX, maxX float64
+	minY, maxY float64
+	w, h       float64
+}
+
+// newMapProjector builds a projector for a viewport on a w x h grid.
+func newMapProjector(vp Viewport, w, h int) mapProjector {
+	p := mapProjector{
+		proj:   vp.projection(),
+		vp:     vp,
+		centre: (vp.West + vp.East) / 2,
+		minX:   math.Inf(1), maxX: math.Inf(-1),
+		minY: math.Inf(1), maxY: math.Inf(-1),
+		w: float64(w), h: float64(h),
+	}
+
+	// Sample the viewport's edges; parallels may bulge (Robinson)
+	half := vp.LonSpan() / 2
+	lats := []float64{vp.North, vp.South}
+	for i := 1; i < 8; i++ {
+		lats = append(lats, vp.South+vp.LatSpan()*float64(i)/8)
+	}
+	if vp.South < 0 && vp.North > 0 {
+		lats = append(lats, 0)
+	}
+	for _, lat := range lats {
+		for _, dLon := range []float64{-half, 0, half} {
+			x, y := p.proj.Forward(lat, dLon)
+			p.minX, p.maxX = math.Min(p.minX, x), math.Max(p.maxX, x)
+			p.minY, p.maxY = math.Min(p.minY, y), math.Max(p.maxY, y)
+		}
+	}
+	return p
+}
+
+// toGrid converts a latitude and a longitude relative to the viewport's
+// central meridian to a fractional grid position.
+func (p mapProjector) toGrid(lat, dLon float64) (gx, gy float64) {
+	x, y := p.proj.Forward(lat, dLon)
+	gx = (x - p.minX) / (p.maxX - p.minX) * p.w
+	gy = (p.maxY - y) / (p.maxY - p.minY) * p.h
+	return gx, gy
+}
+
+// relativeLon returns a longitude relative to the central meridian, or ok
+// false if it falls outside the viewport.
+func (p mapProjector) relativeLon(lon float64) (dLon float64, ok bool) {
+	dx := math.Mod(lon-p.vp.West, 360)
+	if dx < 0 {
+		dx += 360
+	}
+	return dx + p.vp.West - p.centre, dx <= p.vp.LonSpan()
+}
+
+// fromGrid converts a fractional grid position back to latitude and
+// longitude. ok is false outside the projection or the viewport.
+func (p mapProjector) fromGrid(gx, gy float64) (lat, lon float64, ok bool) {
+	x := p.minX + gx/p.w*(p.maxX-p.minX)
+	y := p.maxY - gy/p.h*(p.maxY-p.minY)
+	lat, dLon, ok := p.proj.Inverse(x, y)
+	if !ok || math.Abs(dLon) > p.vp.LonSpan()/2 || lat > p.vp.North || lat < p.vp.South {
+		return 0, 0, false
+	}
+	return lat, normalizeLongitude(p.centre + dLon), true
+}
+
+// localAspect returns how much taller than wide a small square of degrees
+// appears at a latitude, relative to the equirectangular map.
+func localAspect(proj Projection, lat float64) float64 {
+	const d = 0.5
+	x0, _ := proj.Forward(lat, -d)
+	x1, _ := proj.Forward(lat, d)
+	_, y0 := proj.Forward(lat-d, 0)
+	_, y1 := proj.Forward(lat+d, 0)
+	if x1 == x0 {
+		return 1
+	}
+	return math.Abs(y1-y0) / math.Abs(x1-x0)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// sizedRoot is the root primitive. It remembers the screen size it is
+// drawn at and reports when the size changes, so the views can be rebuilt
+// for the new size rather than a guessed one.
+type sizedRoot struct {
+	tview.Primitive
+	width, height int
+	onResize      func()
+}
+
+// newSizedRoot wraps the root of the screen.
+func newSizedRoot(root tview.Primitive) *sizedRoot {
+	return &sizedRoot{Primitive: root}
+}
+
+// SetResizeFunc sets the function called when the screen size changes.
+// It is called while the application is drawing and holds its lock, so it
+// must not change pages or focus itself; queue an update instead.
+func (r *sizedRoot) SetResizeFunc(onResize func()) {
+	r.onResize = onResize
+}
+
+// Size returns the size the screen was last drawn at, or zero before the
+// first draw.
+func (r *sizedRoot) Size() (width, height int) {
+	return r.width, r.height
+}
+
+// Draw draws the screen, reporting first if the size changed.
+func (r *sizedRoot) Draw(screen tcell.Screen) {
+	_, _, width, height := r.GetRect()
+	if width != r.width || height != r.height {
+		r.width, r.height = width, height
+		if r.onResize != nil {
+			r.onResize()
+		}
+	}
+	r.Primitive.Draw(screen)
+}
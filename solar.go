@@ -0,0 +1,221 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+// Solar elevation thresholds in degrees. Sunrise and sunset use -0.833° to
+// account for atmospheric refraction and the apparent radius of the sun.
+const (
+	sunriseElevation      = -0.833
+	goldenHourElevation   = 6.0
+	civilTwilightElev     = -6.0
+	nauticalTwilightElev  = -12.0
+	astronomicalTwiElev   = -18.0
+	degreesToRadians      = math.Pi / 180
+	radiansToDegrees      = 180 / math.Pi
+	julianDayUnixEpoch    = 2440587.5
+	julianDayJ2000        = 2451545.0
+	daysPerJulianCentury  = 36525.0
+	secondsPerDay         = 86400.0
+	minutesPerDegreeOfLon = 4.0
+)
+
+// sunPosition holds the sun's position for an instant, following the NOAA
+// solar calculator (accurate to about a minute for dates near the present).
+type sunPosition struct {
+	declination float64 // Solar declination in degrees (= subsolar latitude)
+	eqOfTime    float64 // Equation of time in minutes
+	subsolarLon float64 // Longitude where the sun is overhead, in degrees
+	eclipticLon float64 // Apparent ecliptic longitude in degrees
+}
+
+// julianDay converts a time to a Julian day number.
+func julianDay(t time.Time) float64 {
+	return float64(t.UTC().UnixNano())/1e9/secondsPerDay + julianDayUnixEpoch
+}
+
+// computeSunPosition calculates the declination, equation of time and
+// subsolar point for t.
+func computeSunPosition(t time.Time) sunPosition {
+	jc := (julianDay(t) - julianDayJ2000) / daysPerJulianCentury
+
+	meanLong := math.Mod(280.46646+jc*(36000.76983+jc*0.0003032), 360)
+	meanAnom := 357.52911 + jc*(35999.05029-0.0001537*jc)
+	eccent := 0.016708634 - jc*(0.000042037+0.0000001267*jc)
+
+	m := meanAnom * degreesToRadians
+	center := math.Sin(m)*(1.914602-jc*(0.004817+0.000014*jc)) +
+		math.Sin(2*m)*(0.019993-0.000101*jc) +
+		math.Sin(3*m)*0.000289
+	trueLong := meanLong + center
+	omega := (125.04 - 1934.136*jc) * degreesToRadians
+	appLong := trueLong - 0.00569 - 0.00478*math.Sin(omega)
+
+	meanObliq := 23 + (26+(21.448-jc*(46.815+jc*(0.00059-jc*0.001813)))/60)/60
+	obliq := (meanObliq + 0.00256*math.Cos(omega)) * degreesToRadians
+
+	decl := math.Asin(math.Sin(obliq)*math.Sin(appLong*degreesToRadians)) * radiansToDegrees
+
+	y := math.Pow(math.Tan(obliq/2), 2)
+	l0 := meanLong * degreesToRadians
+	eqTime := minutesPerDegreeOfLon * radiansToDegrees * (y*math.Sin(2*l0) -
+		2*eccent*math.Sin(m) +
+		4*eccent*y*math.Sin(m)*math.Cos(2*l0) -
+		0.5*y*y*math.Sin(4*l0) -
+		1.25*eccent*eccent*math.Sin(2*m))
+
+	// The sun is overhead where apparent solar time is noon
+	u := t.UTC()
+	utcMinutes := float64(u.Hour()*60+u.Minute()) + float64(u.Second())/60
+	lon := -(utcMinutes + eqTime - 720) / minutesPerDegreeOfLon
+
+	return sunPosition{
+		declination: decl,
+		eqOfTime:    eqTime,
+		subsolarLon: normalizeLongitude(lon),
+		eclipticLon: math.Mod(appLong+360, 360),
+	}
+}
+
+// elevationAt returns the sun's elevation above the horizon in degrees at the
+// given latitude and longitude, ignoring refraction.
+func (s sunPosition) elevationAt(lat, lon float64) float64 {
+	phi := lat * degreesToRadians
+	decl := s.declination * degreesToRadians
+	hourAngle := (lon - s.subsolarLon) * degreesToRadians
+	sinEl := math.Sin(phi)*math.Sin(decl) + math.Cos(phi)*math.Cos(decl)*math.Cos(hourAngle)
+	return math.Asin(math.Max(-1, math.Min(1, sinEl))) * radiansToDegrees
+}
+
+// normalizeLongitude wraps a longitude into [-180, 180).
+func normalizeLongitude(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+	return lon - 180
+}
+
+// dayPhaseForElevation classifies a solar elevation.
+// Returns: "day", "golden", "civil", "nautical", "astronomical", "night"
+func dayPhaseForElevation(elevation float64) string {
+	switch {
+	case elevation >= goldenHourElevation:
+		return "day"
+	case elevation >= sunriseElevation:
+		return "golden"
+	case elevation >= civilTwilightElev:
+		return "civil"
+	case elevation >= nauticalTwilightElev:
+		return "nautical"
+	case elevation >= astronomicalTwiElev:
+		return "astronomical"
+	default:
+		return "night"
+	}
+}
+
+// sunTimes holds the sun events for one location on one local calendar day.
+// Events that do not happen that day (e.g., during polar night) are zero.
+type sunTimes struct {
+	SolarNoon          time.Time
+	Sunrise            time.Time
+	Sunset             time.Time
+	CivilDawn          time.Time
+	CivilDusk          time.Time
+	GoldenMorningEnd   time.Time // Sun climbs above the golden hour band
+	GoldenEveningStart time.Time // Sun drops into the golden hour band
+	DayLength          time.Duration
+	AlwaysUp           bool // Midnight sun
+	AlwaysDown         bool // Polar night
+}
+
+// computeSunTimes calculates the sun events at a latitude/longitude for the
+// calendar day of day in its own location. Results are in day's location.
+func computeSunTimes(day time.Time, lat, lon float64) sunTimes {
+	loc := day.Location()
+	st := sunTimes{SolarNoon: solarNoon(day, lon).In(loc)}
+
+	if rise, ok := solarEventTime(day, lat, lon, sunriseElevation, true); ok {
+		st.Sunrise = rise.In(loc)
+	}
+	if set, ok := solarEventTime(day, lat, lon, sunriseElevation, false); ok {
+		st.Sunset = set.In(loc)
+	}
+	if dawn, ok := solarEventTime(day, lat, lon, civilTwilightElev, true); ok {
+		st.CivilDawn = dawn.In(loc)
+	}
+	if dusk, ok := solarEventTime(day, lat, lon, civilTwilightElev, false); ok {
+		st.CivilDusk = dusk.In(loc)
+	}
+	if end, ok := solarEventTime(day, lat, lon, goldenHourElevation, true); ok {
+		st.GoldenMorningEnd = end.In(loc)
+	}
+	if start, ok := solarEventTime(day, lat, lon, goldenHourElevation, false); ok {
+		st.GoldenEveningStart = start.In(loc)
+	}
+
+	switch {
+	case !st.Sunrise.IsZero() && !st.Sunset.IsZero():
+		st.DayLength = st.Sunset.Sub(st.Sunrise)
+	case computeSunPosition(st.SolarNoon).elevationAt(lat, lon) > sunriseElevation:
+		st.AlwaysUp = true
+		st.DayLength = 24 * time.Hour
+	default:
+		st.AlwaysDown = true
+	}
+	return st
+}
+
+// solarNoon returns when the sun crosses the meridian at lon on day's
+// calendar date in day's location. Far from UTC (Kiritimati, Apia) that
+// crossing can fall on the UTC day before or after.
+func solarNoon(day time.Time, lon float64) time.Time {
+	year, month, date := day.Date()
+	localNoon := time.Date(year, month, date, 12, 0, 0, 0, day.Location()).UTC()
+	midnight := time.Date(localNoon.Year(), localNoon.Month(), localNoon.Day(), 0, 0, 0, 0, time.UTC)
+	approx := midnight.Add(time.Duration((720 - minutesPerDegreeOfLon*lon) * float64(time.Minute)))
+	if d := approx.Sub(localNoon); d > 12*time.Hour {
+		midnight, approx = midnight.AddDate(0, 0, -1), approx.AddDate(0, 0, -1)
+	} else if d < -12*time.Hour {
+		midnight, approx = midnight.AddDate(0, 0, 1), approx.AddDate(0, 0, 1)
+	}
+	eqTime := computeSunPosition(approx).eqOfTime
+	return midnight.Add(time.Duration((720 - minutesPerDegreeOfLon*lon - eqTime) * float64(time.Minute)))
+}
+
+// solarEventTime finds when the sun crosses the given elevation on day's
+// calendar date, in the morning (rising) or evening. It returns false if the
+// sun stays above or below that elevation all day.
+func solarEventTime(day time.Time, lat, lon, elevation float64, rising bool) (time.Time, bool) {
+	noon := solarNoon(day, lon)
+	event := noon
+	phi := lat * degreesToRadians
+
+	// Refine once using the declination at the first estimate
+	for i := 0; i < 2; i++ {
+		decl := computeSunPosition(event).declination * degreesToRadians
+		denom := math.Cos(phi) * math.Cos(decl)
+		if denom == 0 {
+			return time.Time{}, false
+		}
+		cosH := (math.Sin(elevation*degreesToRadians) - math.Sin(phi)*math.Sin(decl)) / denom
+		if cosH > 1 || cosH < -1 {
+			return time.Time{}, false
+		}
+		offset := time.Duration(math.Acos(cosH) * radiansToDegrees * minutesPerDegreeOfLon * float64(time.Minute))
+		if rising {
+			event = noon.Add(-offset)
+		} else {
+			event = noon.Add(offset)
+		}
+	}
+	return event, true
+}
+
+// isSunRising reports whether the sun is east of the meridian at lon.
+func (s sunPosition) isSunRising(lon float64) bool {
+	return normalizeLongitude(lon-s.subsolarLon) < 0
+}
@@ -42,26 +42,29 @@ func (s *stopwatchMode) HandleKey(key rune) bool {
 	case 27: // Escape
 		// Stop the stopwatch in background
 		return false
-	case ' ', 's', 'S':
-		// Toggle start/pause
+	}
+	return false
+}
+
+// HandleAction runs a bound stopwatch action.
+func (s *stopwatchMode) HandleAction(action string) bool {
+	switch action {
+	case "stopwatch.toggle":
 		if s.state.running {
 			s.pause()
 		} else {
 			s.start()
 		}
-		return true
-	case 'r', 'R':
-		// Reset
+	case "stopwatch.reset":
 		s.reset()
-		return true
-	case 'l', 'L':
-		// Lap
+	case "stopwatch.lap":
 		if s.state.running {
 			s.lap()
 		}
-		return true
+	default:
+		return false
 	}
-	return false
+	return true
 }
 
 // start starts or resumes the stopwatch.
@@ -157,28 +160,29 @@ func (s *stopwatchMode) Render() string {
 	var b strings.Builder
 
 	// Header
-	b.WriteString("\n[yellow::b]━━━ STOPWATCH ━━━[-::-]\n\n")
+	b.WriteString("\n[yellow::b]━━━ " + T("STOPWATCH") + " ━━━[-::-]\n\n")
 
 	// Main stopwatch display
 	elapsed := s.getElapsed()
-	status := "[green]Running[white]"
+	status := "[green]" + T("Running") + "[white]"
 	if !s.state.running && elapsed > 0 {
-		status = "[yellow]Paused[white]"
+		status = "[yellow]" + T("Paused") + "[white]"
 	} else if !s.state.running && elapsed == 0 {
-		status = "[darkgray]Ready[white]"
+		status = "[darkgray]" + T("Ready") + "[white]"
 	}
 
 	b.WriteString(fmt.Sprintf("  [::b]%s[-]\n\n", formatDuration(elapsed)))
-	b.WriteString(fmt.Sprintf("  Status: %s\n\n", status))
+	b.WriteString(fmt.Sprintf("  %s %s\n\n", T("Status:"), status))
 
 	// Controls help
-	b.WriteString("  [darkgray]Controls:[white] Space=Start/Pause  R=Reset  L=Lap  Esc=Exit\n\n")
+	b.WriteString("  [darkgray]" + T("Controls:") + "[white] " +
+		KeyHelp([]string{"stopwatch.toggle", "stopwatch.reset", "stopwatch.lap"}, T("Esc=Exit")) + "\n\n")
 
 	// Laps
 	if len(s.state.laps) > 0 {
-		b.WriteString("  [aqua::b]Lap Times:[-::-]\n")
+		b.WriteString("  [aqua::b]" + T("Lap Times:") + "[-::-]\n")
 		for i, lap := range s.state.laps {
-			b.WriteString(fmt.Sprintf("    Lap %d: %s\n", i+1, formatDuration(lap)))
+			b.WriteString("    " + fmt.Sprintf(T("Lap %d: %s"), i+1, formatDuration(lap)) + "\n")
 		}
 	}
 
@@ -187,7 +191,7 @@ func (s *stopwatchMode) Render() string {
 
 // GetHelpText returns the help text for stopwatch mode.
 func (s *stopwatchMode) GetHelpText() string {
-	return "[darkgray]Keys:[white] Space=Start/Pause  R=Reset  L=Lap  Esc=Exit (continues)"
+	return "[darkgray]" + T("Keys:") + "[white] " + ContextKeyHelp(keyContextStopwatch, T("Esc=Exit (continues)"))
 }
 
 // HandleSpecialKeyEvent handles non-rune key events (Enter, Backspace, etc.).
@@ -0,0 +1,447 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Theme roles: the named colours every theme defines.
+const (
+	// UI chrome
+	roleBackground = "background"
+	roleText       = "text"
+	roleMuted      = "muted"
+	roleDim        = "dim"
+	roleSubtle     = "subtle"
+	roleAccent     = "accent"
+	roleGood       = "good"
+	roleWarn       = "warn"
+	roleBad        = "bad"
+	roleInfo       = "info"
+	roleBorder     = "border"
+
+	// Map
+	roleLand  = "land"
+	roleWater = "water"
+
+	// Day phases
+	roleDay          = "day"
+	roleGolden       = "golden"
+	roleCivil        = "civil"
+	roleNautical     = "nautical"
+	roleAstronomical = "astronomical"
+	roleNight        = "night"
+
+	// Markers and overlays
+	roleSun      = "sun"
+	roleMoon     = "moon"
+	roleZone     = "zone" // Zone boundaries, the zone reticle and the map cursor
+	roleBandEven = "band_even"
+	roleBandOdd  = "band_odd"
+	roleLeader   = "leader"
+)
+
+// uiTagRoles maps the basic tview colour names used in UI text to theme
+// roles, so views can keep writing "[yellow]" and still follow the theme.
+var uiTagRoles = map[string]string{
+	"white":    roleText,
+	"black":    roleBackground,
+	"darkgray": roleMuted,
+	"gray":     roleDim,
+	"silver":   roleSubtle,
+	"yellow":   roleAccent,
+	"green":    roleGood,
+	"orange":   roleWarn,
+	"red":      roleBad,
+	"aqua":     roleInfo,
+}
+
+// Theme is a named palette. Colours are tview colour names or #RRGGBB.
+type Theme struct {
+	Name    string
+	Colors  map[string]string // Role → colour
+	Markers []string          // City markers snap to this palette; empty keeps city colours
+}
+
+// darkTheme is the original look of the app.
+var darkTheme = Theme{
+	Name: "dark",
+	Colors: map[string]string{
+		roleBackground: "black", roleText: "white", roleMuted: "darkgray", roleDim: "gray",
+		roleSubtle: "silver", roleAccent: "yellow", roleGood: "green", roleWarn: "orange",
+		roleBad: "red", roleInfo: "aqua", roleBorder: "white",
+		roleLand: "green", roleWater: "black",
+		roleDay: "green", roleGolden: "yellow", roleCivil: "#FFA500", roleNautical: "#CD853F",
+		roleAstronomical: "#6A5ACD", roleNight: "#2F4F4F",
+		roleSun: "yellow", roleMoon: "#E0E0E0", roleZone: "#C080FF",
+		roleBandEven: "#1F3A5F", roleBandOdd: "#2E5984", roleLeader: "gray",
+	},
+}
+
+// builtinThemes are the themes selectable with -theme.
+var builtinThemes = []Theme{
+	darkTheme,
+	{
+		Name: "light",
+		Colors: map[string]string{
+			roleBackground: "#FAFAF5", roleText: "#1A1A1A", roleMuted: "#6B6B6B", roleDim: "#8A8A8A",
+			roleSubtle: "#4A4A4A", roleAccent: "#8A6D00", roleGood: "#1B7F1B", roleWarn: "#B35900",
+			roleBad: "#B00020", roleInfo: "#006B8F", roleBorder: "#4A4A4A",
+			roleLand: "#2E7D32", roleWater: "#DDEBF7",
+			roleDay: "#2E7D32", roleGolden: "#B8860B", roleCivil: "#D2691E", roleNautical: "#8B5A2B",
+			roleAstronomical: "#483D8B", roleNight: "#263238",
+			roleSun: "#C77C00", roleMoon: "#5A5A5A", roleZone: "#7B1FA2",
+			roleBandEven: "#C5D7EA", roleBandOdd: "#A9C4E0", roleLeader: "#8A8A8A",
+		},
+		Markers: []string{"#B71C1C", "#1565C0", "#2E7D32", "#6A1B9A", "#E65100", "#00838F", "#AD1457", "#4E342E"},
+	},
+	{
+		Name: "high-contrast",
+		Colors: map[string]string{
+			roleBackground: "#000000", roleText: "#FFFFFF", roleMuted: "#D0D0D0", roleDim: "#C0C0C0",
+			roleSubtle: "#E0E0E0", roleAccent: "#FFFF00", roleGood: "#00FF00", roleWarn: "#FFA500",
+			roleBad: "#FF3030", roleInfo: "#00FFFF", roleBorder: "#FFFFFF",
+			roleLand: "#00FF00", roleWater: "#000000",
+			roleDay: "#00FF00", roleGolden: "#FFFF00", roleCivil: "#FF8C00", roleNautical: "#FF00FF",
+			roleAstronomical: "#8080FF", roleNight: "#4060C0",
+			roleSun: "#FFFF00", roleMoon: "#FFFFFF", roleZone: "#FF00FF",
+			roleBandEven: "#000080", roleBandOdd: "#0000FF", roleLeader: "#FFFFFF",
+		},
+		Markers: []string{"#FFFFFF", "#FFFF00", "#00FFFF", "#FF00FF", "#00FF00", "#FF8C00", "#FF3030", "#8080FF"},
+	},
+	{
+		// Okabe-Ito palette: distinguishable with the common colour vision deficiencies
+		Name: "colorblind",
+		Colors: map[string]string{
+			roleBackground: "black", roleText: "white", roleMuted: "darkgray", roleDim: "gray",
+			roleSubtle: "silver", roleAccent: "#F0E442", roleGood: "#009E73", roleWarn: "#E69F00",
+			roleBad: "#D55E00", roleInfo: "#56B4E9", roleBorder: "white",
+			roleLand: "#56B4E9", roleWater: "black",
+			roleDay: "#F0E442", roleGolden: "#E69F00", roleCivil: "#D55E00", roleNautical: "#CC79A7",
+			roleAstronomical: "#0072B2", roleNight: "#1F3F6F",
+			roleSun: "#F0E442", roleMoon: "#FFFFFF", roleZone: "#CC79A7",
+			roleBandEven: "#0B2F4F", roleBandOdd: "#0072B2", roleLeader: "gray",
+		},
+		Markers: []string{"#E69F00", "#56B4E9", "#009E73", "#F0E442", "#0072B2", "#D55E00", "#CC79A7", "#FFFFFF"},
+	},
+}
+
+// Colour depths a terminal can show
+const (
+	colorDepthMono = 1 // No colour: attributes only
+	colorDepth16   = 16
+	colorDepth256  = 256
+	colorDepthTrue = 1 << 24
+)
+
+var (
+	themes         = append([]Theme{}, builtinThemes...) // Built-in and user themes
+	activeTheme    = darkTheme
+	colorDepth     = detectColorDepth()
+	markerPalette  []tcell.Color
+	themeCacheMu   sync.Mutex
+	themeTagCache  = make(map[string]string) // Colour or tag → themed colour or tag
+	themeTagRegexp = regexp.MustCompile(`^\[([a-zA-Z]+|#[0-9a-fA-F]{6}|-)?(?::([a-zA-Z]+|#[0-9a-fA-F]{6}|-)?)?((?::[^\[\]:]*){0,2})\]$`)
+)
+
+// detectColorDepth guesses the terminal's colour depth from the environment
+// the way most terminals advertise it. NO_COLOR or a dumb terminal turns
+// colour off.
+func detectColorDepth() int {
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return colorDepthMono
+	}
+	colorTerm := strings.ToLower(os.Getenv("COLORTERM"))
+	if (colorTerm == "truecolor" || colorTerm == "24bit") && os.Getenv("TCELL_TRUECOLOR") != "disable" {
+		return colorDepthTrue
+	}
+	if strings.Contains(os.Getenv("TERM"), "256") {
+		return colorDepth256
+	}
+	return colorDepth16
+}
+
+// SetColorMode sets the colour depth: "auto", "truecolor", "256", "16" or
+// "mono".
+func SetColorMode(mode string) error {
+	switch strings.ToLower(mode) {
+	case "", "auto":
+		colorDepth = detectColorDepth()
+	case "truecolor", "24bit":
+		colorDepth = colorDepthTrue
+	case "256":
+		colorDepth = colorDepth256
+	case "16":
+		colorDepth = colorDepth16
+	case "mono", "none":
+		colorDepth = colorDepthMono
+	default:
+		return fmt.Errorf("unknown colour mode %q (choose from auto, truecolor, 256, 16, mono)", mode)
+	}
+	resetThemeCache()
+	return nil
+}
+
+// RegisterUserThemes adds themes from the config. Each starts from a
+// built-in theme and replaces some of its colours.
+func RegisterUserThemes(configs []ThemeConfig) error {
+	for _, tc := range configs {
+		base := darkTheme
+		if tc.Base != "" {
+			found, ok := findTheme(tc.Base)
+			if !ok {
+				return fmt.Errorf("theme %q: unknown base theme %q", tc.Name, tc.Base)
+			}
+			base = found
+		}
+
+		theme := Theme{Name: tc.Name, Colors: make(map[string]string), Markers: base.Markers}
+		for role, color := range base.Colors {
+			theme.Colors[role] = color
+		}
+		for role, color := range tc.Colors {
+			if _, ok := darkTheme.Colors[role]; !ok {
+				return fmt.Errorf("theme %q: unknown colour role %q", tc.Name, role)
+			}
+			if !parseThemeColor(color).Valid() {
+				return fmt.Errorf("theme %q: invalid colour %q for %s", tc.Name, color, role)
+			}
+			theme.Colors[role] = color
+		}
+		if len(tc.Markers) > 0 {
+			theme.Markers = tc.Markers
+		}
+		themes = append(themes, theme)
+	}
+	return nil
+}
+
+// findTheme returns the theme with the given name (case-insensitive).
+func findTheme(name string) (Theme, bool) {
+	for i := len(themes) - 1; i >= 0; i-- { // User themes override built-ins
+		if strings.EqualFold(themes[i].Name, name) {
+			return themes[i], true
+		}
+	}
+	return Theme{}, false
+}
+
+// SetTheme makes the named theme active and applies its chrome colours to
+// tview. Views created before this keep their old background.
+func SetTheme(name string) error {
+	theme, ok := findTheme(name)
+	if !ok {
+		names := make([]string, len(themes))
+		for i, t := range themes {
+			names[i] = t.Name
+		}
+		return fmt.Errorf("unknown theme %q (choose from %s)", name, strings.Join(names, ", "))
+	}
+	activeTheme = theme
+	resetThemeCache()
+
+	tview.Styles.PrimitiveBackgroundColor = themeTcellColor(roleBackground)
+	tview.Styles.PrimaryTextColor = themeTcellColor(roleText)
+	tview.Styles.BorderColor = themeTcellColor(roleBorder)
+	tview.Styles.TitleColor = themeTcellColor(roleText)
+	tview.Styles.GraphicsColor = themeTcellColor(roleBorder)
+	tview.Styles.SecondaryTextColor = themeTcellColor(roleAccent)
+	tview.Styles.TertiaryTextColor = themeTcellColor(roleGood)
+	return nil
+}
+
+// ThemeName returns the name of the active theme.
+func ThemeName() string {
+	return activeTheme.Name
+}
+
+// resetThemeCache drops colours resolved for the previous theme or depth.
+func resetThemeCache() {
+	themeCacheMu.Lock()
+	defer themeCacheMu.Unlock()
+	themeTagCache = make(map[string]string)
+	markerPalette = nil
+	for _, m := range activeTheme.Markers {
+		markerPalette = append(markerPalette, parseThemeColor(m))
+	}
+}
+
+// parseThemeColor parses a colour name or #RRGGBB, case-insensitively.
+func parseThemeColor(name string) tcell.Color {
+	return tcell.GetColor(strings.ToLower(name))
+}
+
+// quantizeColor returns a colour as #RRGGBB, snapped to the nearest colour
+// the terminal can show so every depth gets a deliberate fallback.
+func quantizeColor(c tcell.Color) string {
+	if !c.Valid() {
+		return ""
+	}
+	switch colorDepth {
+	case colorDepthMono:
+		return "-"
+	case colorDepth256:
+		c = tcell.FindColor(c, terminalPalette(256))
+	case colorDepth16:
+		c = tcell.FindColor(c, terminalPalette(16))
+	}
+	return fmt.Sprintf("#%06X", c.Hex())
+}
+
+// terminalPalette returns the first n colours of the xterm palette.
+func terminalPalette(n int) []tcell.Color {
+	palette := make([]tcell.Color, n)
+	for i := range palette {
+		palette[i] = tcell.PaletteColor(i)
+	}
+	return palette
+}
+
+// themeColor returns a role's colour in the active theme as a tview colour.
+func themeColor(role string) string {
+	color, ok := activeTheme.Colors[role]
+	if !ok {
+		color = darkTheme.Colors[role]
+	}
+	return resolveColor(color)
+}
+
+// themeTcellColor returns a role's colour in the active theme.
+func themeTcellColor(role string) tcell.Color {
+	return tcell.GetColor(themeColor(role))
+}
+
+// resolveColor quantizes a colour name or #RRGGBB for the terminal. Names
+// that are not colours are returned unchanged.
+func resolveColor(name string) string {
+	themeCacheMu.Lock()
+	defer themeCacheMu.Unlock()
+	if cached, ok := themeTagCache[name]; ok {
+		return cached
+	}
+	resolved := name
+	if c := parseThemeColor(name); c.Valid() {
+		resolved = quantizeColor(c)
+	}
+	themeTagCache[name] = resolved
+	return resolved
+}
+
+// themedColorField resolves one colour field of a tag: UI colour names are
+// replaced by their theme role and every colour is quantized. Fields that
+// are not colours ("-", "") are returned unchanged.
+func themedColorField(field string) string {
+	if field == "" || field == "-" {
+		return field
+	}
+	if role, ok := uiTagRoles[strings.ToLower(field)]; ok {
+		return themeColor(role)
+	}
+	return resolveColor(field)
+}
+
+// themedMarkerColor returns a city's marker colour, snapped to the theme's
+// marker palette if it has one.
+func themedMarkerColor(c tcell.Color) string {
+	themeCacheMu.Lock()
+	palette := markerPalette
+	themeCacheMu.Unlock()
+	if len(palette) > 0 && c.Valid() {
+		c = tcell.FindColor(c, palette)
+	}
+	if tag := quantizeColor(c); tag != "" {
+		return tag
+	}
+	return themeColor(roleText)
+}
+
+// ThemeText applies the active theme to text with tview colour tags, just
+// before it is shown.
+func ThemeText(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+	for {
+		start := strings.IndexByte(text, '[')
+		if start < 0 {
+			break
+		}
+		length := strings.IndexAny(text[start+1:], "[]")
+		if length < 0 {
+			break
+		}
+		end := start + 1 + length
+		if text[end] == '[' { // Not a tag; look again from the inner bracket
+			b.WriteString(text[:end])
+			text = text[end:]
+			continue
+		}
+		b.WriteString(text[:start])
+		b.WriteString(themeTag(text[start : end+1]))
+		text = text[end+1:]
+	}
+	b.WriteString(text)
+	return b.String()
+}
+
+// monoTag rewrites a colour tag for a monochrome terminal. Colours become
+// the default, and a background colour becomes reverse video so selections
+// stay visible.
+func monoTag(fg, bg, rest string) string {
+	attrs, url := "", ""
+	if rest != "" {
+		fields := strings.SplitN(rest[1:], ":", 2)
+		attrs = fields[0]
+		if len(fields) > 1 {
+			url = ":" + fields[1]
+		}
+	}
+	switch {
+	case attrs == "-":
+	case bg == "-":
+		attrs += "R"
+	case bg != "":
+		attrs += "r"
+	}
+	if fg != "" {
+		fg = "-"
+	}
+	if bg != "" {
+		bg = "-"
+	}
+	return "[" + fg + ":" + bg + ":" + attrs + url + "]"
+}
+
+// themeTag applies the active theme to one colour tag.
+func themeTag(tag string) string {
+	themeCacheMu.Lock()
+	cached, ok := themeTagCache[tag]
+	themeCacheMu.Unlock()
+	if ok {
+		return cached
+	}
+
+	themed := tag
+	if m := themeTagRegexp.FindStringSubmatch(tag); m != nil && colorDepth == colorDepthMono && m[1]+m[2] != "" {
+		themed = monoTag(m[1], m[2], m[3])
+	} else if m != nil {
+		fg, bg := themedColorField(m[1]), themedColorField(m[2])
+		switch {
+		case fg == m[1] && bg == m[2]:
+		case strings.Contains(tag, ":"):
+			themed = "[" + fg + ":" + bg + m[3] + "]"
+		default:
+			themed = "[" + fg + "]"
+		}
+	}
+
+	themeCacheMu.Lock()
+	themeTagCache[tag] = themed
+	themeCacheMu.Unlock()
+	return themed
+}
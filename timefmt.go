@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// dateStyle holds the layouts of a date style: a full date with the year,
+// and a short one for tight spots such as the clock list.
+type dateStyle struct {
+	full  string
+	short string
+}
+
+// dateStyles are the selectable date styles.
+var dateStyles = map[string]dateStyle{
+	"text": {full: "Mon, 02 Jan 2006", short: "Mon, 02 Jan"},
+	"long": {full: "Monday, 02 January 2006", short: "Monday, 02 Jan"},
+	"iso":  {full: "2006-01-02", short: "Mon 01-02"},
+	"us":   {full: "Mon 01/02/2006", short: "Mon 01/02"},
+	"eu":   {full: "Mon 02.01.2006", short: "Mon 02.01."},
+}
+
+// dateStyleNames lists the date styles in the order shown in errors.
+var dateStyleNames = []string{"text", "long", "iso", "us", "eu"}
+
+// timeFormatState is the active clock and date format.
+type timeFormatState struct {
+	hour12       bool
+	seconds      bool
+	date         dateStyle
+	weekNumbers  bool
+	relativeDays bool
+}
+
+// global time format, 24-hour with seconds and text dates by default
+var timeFmt = timeFormatState{seconds: true, date: dateStyles["text"]}
+
+// SetTimeFormat validates and applies a time format configuration. Empty
+// fields keep the defaults; the date style defaults to the one of the
+// current language, so call SetLocale first.
+func SetTimeFormat(c TimeFormatConfig) error {
+	f := timeFormatState{seconds: true, date: dateStyles[currentLocale.dateStyle]}
+	switch strings.ToLower(c.Clock) {
+	case "", "24h", "24":
+	case "12h", "12":
+		f.hour12 = true
+	default:
+		return fmt.Errorf("unknown clock format %q (choose from 12h, 24h)", c.Clock)
+	}
+	if c.Seconds != nil {
+		f.seconds = *c.Seconds
+	}
+	if c.Date != "" {
+		style, ok := dateStyles[strings.ToLower(c.Date)]
+		if !ok {
+			return fmt.Errorf("unknown date style %q (choose from %s)", c.Date, strings.Join(dateStyleNames, ", "))
+		}
+		f.date = style
+	}
+	f.weekNumbers = c.WeekNumbers
+	f.relativeDays = c.RelativeDays
+	timeFmt = f
+	return nil
+}
+
+// FormatClock formats a time of day for the clock panels and the converter,
+// with seconds unless they are turned off. The result is always
+// ClockWidth() cells wide.
+func FormatClock(t time.Time) string {
+	if !timeFmt.seconds {
+		return FormatClockMinutes(t)
+	}
+	if timeFmt.hour12 {
+		return fmt.Sprintf("%11s", t.Format("3:04:05 PM"))
+	}
+	return t.Format("15:04:05")
+}
+
+// ClockWidth returns the width of FormatClock.
+func ClockWidth() int {
+	return len(FormatClock(time.Time{}))
+}
+
+// FormatClockMinutes formats a time of day without seconds, for lists,
+// schedules and the status bar. The result is always
+// ClockMinutesWidth() cells wide.
+func FormatClockMinutes(t time.Time) string {
+	if timeFmt.hour12 {
+		return fmt.Sprintf("%8s", t.Format("3:04 PM"))
+	}
+	return t.Format("15:04")
+}
+
+// ClockMinutesWidth returns the width of FormatClockMinutes.
+func ClockMinutesWidth() int {
+	return len(FormatClockMinutes(time.Time{}))
+}
+
+// FormatCompactClock formats a time of day as briefly as possible for map
+// labels, e.g. "15:04" or " 3:04p". The width is fixed so labels do not
+// move as the time changes.
+func FormatCompactClock(t time.Time) string {
+	if timeFmt.hour12 {
+		suffix := "a"
+		if t.Hour() >= 12 {
+			suffix = "p"
+		}
+		return fmt.Sprintf("%6s", t.Format("3:04")+suffix)
+	}
+	return t.Format("15:04")
+}
+
+// FormatHour formats the hour of a time only, e.g. "15" or "3p", for
+// places too narrow for minutes.
+func FormatHour(t time.Time) string {
+	if timeFmt.hour12 {
+		suffix := "a"
+		if t.Hour() >= 12 {
+			suffix = "p"
+		}
+		return t.Format("3") + suffix
+	}
+	return t.Format("15")
+}
+
+// HourWidth returns the widest FormatHour result.
+func HourWidth() int {
+	if timeFmt.hour12 {
+		return 3 // "12p"
+	}
+	return 2
+}
+
+// FormatDate formats a full date in the chosen style, with the ISO week
+// number if enabled, or as Today, Tomorrow or Yesterday if relative days
+// are enabled and the date is near today.
+func FormatDate(t time.Time) string {
+	if day, ok := relativeDay(t); ok {
+		return day
+	}
+	s := formatLocal(t, timeFmt.date.full)
+	if timeFmt.weekNumbers {
+		_, week := t.ISOWeek()
+		s += fmt.Sprintf(" W%02d", week)
+	}
+	return s
+}
+
+// FormatShortDate formats a date without the year in the chosen style, or
+// as a relative day if enabled.
+func FormatShortDate(t time.Time) string {
+	if day, ok := relativeDay(t); ok {
+		return day
+	}
+	return formatLocal(t, timeFmt.date.short)
+}
+
+// ShortDateWidth returns the widest FormatShortDate result in cells, so
+// columns of dates can be aligned.
+func ShortDateWidth() int {
+	// A week at the end of every month covers every weekday and month name
+	width := 0
+	for month := time.January; month <= time.December; month++ {
+		for day := 22; day < 29; day++ {
+			date := time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+			width = max(width, textWidth(formatLocal(date, timeFmt.date.short)))
+		}
+	}
+	if timeFmt.relativeDays {
+		for _, day := range []string{"Today", "Tomorrow", "Yesterday"} {
+			width = max(width, textWidth(T(day)))
+		}
+	}
+	return width
+}
+
+// relativeDay returns Today, Tomorrow or Yesterday if relative days are
+// enabled and the calendar date of t is that close to the local date.
+func relativeDay(t time.Time) (string, bool) {
+	if !timeFmt.relativeDays {
+		return "", false
+	}
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch int(day.Sub(today).Hours() / 24) {
+	case 0:
+		return T("Today"), true
+	case 1:
+		return T("Tomorrow"), true
+	case -1:
+		return T("Yesterday"), true
+	}
+	return "", false
+}
@@ -44,29 +44,6 @@ func (t *timerMode) HandleKey(key rune) bool {
 	switch key {
 	case 27: // Escape
 		return false
-	case ' ', 's', 'S':
-		// Toggle start/pause
-		if t.state.running {
-			t.pause()
-		} else {
-			t.start()
-		}
-		return true
-	case 'r', 'R':
-		// Reset
-		t.reset()
-		return true
-	case 't', 'T':
-		// Toggle input mode
-		t.state.inputMode = !t.state.inputMode
-		if !t.state.inputMode {
-			// Try to parse input when exiting input mode
-			if err := t.parseDuration(); err != nil {
-				// Keep input if invalid
-				t.state.inputMode = true
-			}
-		}
-		return true
 	case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 		if t.state.inputMode {
 			if len(t.state.inputDur) < 8 { // Max HH:MM:SS
@@ -90,6 +67,33 @@ func (t *timerMode) HandleKey(key rune) bool {
 	return false
 }
 
+// HandleAction runs a bound timer action.
+func (t *timerMode) HandleAction(action string) bool {
+	switch action {
+	case "timer.toggle":
+		if t.state.running {
+			t.pause()
+		} else {
+			t.start()
+		}
+	case "timer.reset":
+		t.reset()
+	case "timer.set":
+		// Toggle input mode
+		t.state.inputMode = !t.state.inputMode
+		if !t.state.inputMode {
+			// Try to parse input when exiting input mode
+			if err := t.parseDuration(); err != nil {
+				// Keep input if invalid
+				t.state.inputMode = true
+			}
+		}
+	default:
+		return false
+	}
+	return true
+}
+
 // HandleSpecialKeyEvent handles non-rune key events (Enter, Backspace, etc.).
 func (t *timerMode) HandleSpecialKeyEvent(key tcell.Key) bool {
 	switch key {
@@ -201,9 +205,11 @@ func (t *timerMode) start() {
 					t.ticker.Stop()
 					// Terminal bell
 					fmt.Print("\a")
+					Announce(T("Timer finished"))
 				}
 			case <-flashTicker.C:
-				if t.state.alarmTriggered {
+				// No flashing in the accessible layout
+				if t.state.alarmTriggered && !IsAccessibleMode() {
 					flashOn = !flashOn
 					t.state.flashState = flashOn
 				}
@@ -286,29 +292,29 @@ func (t *timerMode) Render() string {
 	var b strings.Builder
 
 	// Header
-	b.WriteString("\n[yellow::b]━━━ COUNTDOWN TIMER ━━━[-::-]\n\n")
+	b.WriteString("\n[yellow::b]━━━ " + T("COUNTDOWN TIMER") + " ━━━[-::-]\n\n")
 
 	// Main timer display
 	remaining := t.getRemaining()
 
 	// Flash effect when alarm triggered
 	if t.state.alarmTriggered && t.state.flashState {
-		b.WriteString(fmt.Sprintf("  [red::b]⚠ %s ⚠[-::-]\n\n", "ALARM!"))
+		b.WriteString(fmt.Sprintf("  [red::b]⚠ %s ⚠[-::-]\n\n", T("ALARM!")))
 	} else if t.state.alarmTriggered {
-		b.WriteString(fmt.Sprintf("  [yellow::b]  %s  [-::-]\n\n", "ALARM!"))
+		b.WriteString(fmt.Sprintf("  [yellow::b]  %s  [-::-]\n\n", T("ALARM!")))
 	} else {
 		b.WriteString(fmt.Sprintf("  [::b]%s[-]\n\n", formatTimerDuration(remaining)))
 	}
 
 	// Status
 	if t.state.running {
-		b.WriteString("  Status: [green]Running[white]\n")
+		b.WriteString("  " + T("Status:") + " [green]" + T("Running") + "[white]\n")
 	} else if t.state.alarmTriggered {
-		b.WriteString("  Status: [red]Alarm Triggered![white]  Press any key\n")
+		b.WriteString("  " + T("Status:") + " [red]" + T("Alarm Triggered!") + "[white]  " + T("Press any key") + "\n")
 	} else if remaining > 0 {
-		b.WriteString("  Status: [yellow]Paused[white]\n")
+		b.WriteString("  " + T("Status:") + " [yellow]" + T("Paused") + "[white]\n")
 	} else {
-		b.WriteString("  Status: [darkgray]Ready[white]\n")
+		b.WriteString("  " + T("Status:") + " [darkgray]" + T("Ready") + "[white]\n")
 	}
 
 	// Duration input
@@ -317,20 +323,30 @@ func (t *timerMode) Render() string {
 		if displayDur == "" {
 			displayDur = "HH:MM:SS"
 		}
-		b.WriteString(fmt.Sprintf("\n  [::b]Enter duration: [yellow]%s[-]\n", displayDur))
+		b.WriteString(fmt.Sprintf("\n  [::b]%s [yellow]%s[-]\n", T("Enter duration:"), displayDur))
 	} else if remaining == 0 && !t.state.alarmTriggered {
-		b.WriteString("\n  [darkgray]Press T to set duration[white]\n")
+		b.WriteString("\n  [darkgray]" + fmt.Sprintf(T("Press %s to set duration"), KeyLabel("timer.set")) + "[white]\n")
 	}
 
 	// Controls
-	b.WriteString("\n  [darkgray]Controls:[white] T=Set Time  Space=Start/Pause  R=Reset  Esc=Exit\n")
+	b.WriteString("\n  [darkgray]" + T("Controls:") + "[white] " +
+		KeyHelp([]string{"timer.set", "timer.toggle", "timer.reset"}, T("Esc=Exit")) + "\n")
 
 	return b.String()
 }
 
 // GetHelpText returns the help text for timer mode.
 func (t *timerMode) GetHelpText() string {
-	return "[darkgray]Keys:[white] T=Set Duration  Space=Start/Pause  R=Reset  Esc=Exit"
+	return "[darkgray]" + T("Keys:") + "[white] " + ContextKeyHelp(keyContextTimer, T("Esc=Exit"))
+}
+
+// HelpGroups returns the keys the timer handles itself, for the help
+// overlay.
+func (t *timerMode) HelpGroups() []helpGroup {
+	return []helpGroup{{title: T("Duration input"), entries: []helpEntry{
+		{keys: "0-9  :", help: T("Type the duration as HH:MM:SS, after Set Duration")},
+		{keys: "Backspace", help: T("Delete the last digit")},
+	}}}
 }
 
 // Stop stops the background ticker.
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"math"
+	"sync"
+)
+
+//go:generate go run gen_timezones.go gen_geometry.go -countries data/ne_110m_admin_0_countries.geojson -zonetab data/zone.tab
+
+// timezoneShape is one zone's embedded boundary rings.
+type timezoneShape struct {
+	Zone  string    // IANA name
+	Rings [][]int16 // Flat (lon, lat) pairs in hundredths of a degree
+}
+
+// zoneArea is a decoded zone boundary with its bounding box.
+type zoneArea struct {
+	zone           string
+	rings          []coastPolygon
+	minLon, maxLon float64
+	minLat, maxLat float64
+}
+
+var (
+	zoneAreasOnce sync.Once
+	zoneAreas     []zoneArea
+)
+
+// decodeTimezoneShapes converts the embedded zone boundaries to degrees.
+func decodeTimezoneShapes() {
+	zoneAreas = make([]zoneArea, 0, len(timezoneShapes))
+	for _, shape := range timezoneShapes {
+		a := zoneArea{
+			zone:   shape.Zone,
+			minLon: math.Inf(1), maxLon: math.Inf(-1),
+			minLat: math.Inf(1), maxLat: math.Inf(-1),
+		}
+		for _, flat := range shape.Rings {
+			p, ok := decodePolygon(flat)
+			if !ok {
+				continue
+			}
+			a.rings = append(a.rings, p)
+			a.minLon, a.maxLon = math.Min(a.minLon, p.minLon), math.Max(a.maxLon, p.maxLon)
+			a.minLat, a.maxLat = math.Min(a.minLat, p.minLat), math.Max(a.maxLat, p.maxLat)
+		}
+		if len(a.rings) > 0 {
+			zoneAreas = append(zoneAreas, a)
+		}
+	}
+}
+
+// zoneNameAt returns the IANA zone whose boundary encloses a point, or ""
+// at sea and when no boundaries are embedded. A zone's holes are rings
+// too, so a point is inside when an odd number of its rings enclose it.
+func zoneNameAt(lat, lon float64) string {
+	zoneAreasOnce.Do(decodeTimezoneShapes)
+	lon = normalizeLongitude(lon)
+	for i := range zoneAreas {
+		a := &zoneAreas[i]
+		if lat < a.minLat || lat > a.maxLat || lon < a.minLon || lon > a.maxLon {
+			continue
+		}
+		inside := false
+		for j := range a.rings {
+			if a.rings[j].contains(lat, lon) {
+				inside = !inside
+			}
+		}
+		if inside {
+			return a.zone
+		}
+	}
+	return ""
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Timezone overlay modes, cycled with 'z'
+const (
+	tzOverlayOff        = iota // No timezone overlay
+	tzOverlayBands             // UTC offset bands along the map edges
+	tzOverlayBoundaries        // Offset bands plus zone boundary outlines
+)
+
+// tzOverlayMode is the current timezone overlay mode
+var tzOverlayMode = tzOverlayOff
+
+// zoneCoastDegrees is how far off the embedded zone boundaries a point
+// still takes the zone of the land, covering coasts that simplification
+// moved inland.
+const zoneCoastDegrees = 0.3
+
+// mapZone is the timezone in effect at a point on the map.
+type mapZone struct {
+	Name     string         // IANA name, or "UTC+9" for nautical zones
+	Nautical bool           // No zone boundary encloses the point
+	Location *time.Location // Location used to compute the offset
+}
+
+var (
+	tzLocationMu    sync.Mutex
+	tzLocationCache = make(map[string]*time.Location)
+)
+
+// tzOffsetGridKey identifies the cached per-cell offsets of a map view.
+type tzOffsetGridKey struct {
+	cols, rows int
+	vp         Viewport
+	hour       time.Time // Offsets only change on the hour (DST transitions)
+}
+
+var (
+	tzOffsetGridMu    sync.Mutex
+	tzOffsetGridLast  tzOffsetGridKey
+	tzOffsetGridCells [][]int
+)
+
+// CycleTimezoneOverlay switches between no overlay, offset bands, and
+// offset bands with zone boundaries.
+func CycleTimezoneOverlay() {
+	tzOverlayMode = (tzOverlayMode + 1) % (tzOverlayBoundaries + 1)
+}
+
+// IsTimezoneOverlayEnabled reports whether the offset bands are shown.
+func IsTimezoneOverlayEnabled() bool {
+	return tzOverlayMode != tzOverlayOff
+}
+
+// IsTimezoneBoundariesEnabled reports whether zone boundaries are drawn.
+func IsTimezoneBoundariesEnabled() bool {
+	return tzOverlayMode == tzOverlayBoundaries
+}
+
+// loadZoneLocation loads a timezone, caching the result.
+func loadZoneLocation(name string) *time.Location {
+	tzLocationMu.Lock()
+	defer tzLocationMu.Unlock()
+	if loc, ok := tzLocationCache[name]; ok {
+		return loc
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		loc = nil
+	}
+	tzLocationCache[name] = loc
+	return loc
+}
+
+// nauticalZone returns the fixed nautical zone for a longitude (15° per hour).
+func nauticalZone(lon float64) mapZone {
+	hours := int(math.Round(normalizeLongitude(lon) / 15))
+	name := "UTC"
+	if hours != 0 {
+		name = fmt.Sprintf("UTC%+d", hours)
+	}
+	return mapZone{Name: name, Nautical: true, Location: time.FixedZone(name, hours*3600)}
+}
+
+// timezoneAt returns the timezone at a point from the embedded zone
+// boundaries, falling back to the nautical zone at sea and for zones the
+// system's tz database does not know. A point just off the simplified
+// coastline takes the zone of the land within zoneCoastDegrees of it.
+func timezoneAt(lat, lon float64) mapZone {
+	name := zoneNameAt(lat, lon)
+	for i := 0; name == "" && i < 8; i++ {
+		a := float64(i) * math.Pi / 4
+		name = zoneNameAt(lat+zoneCoastDegrees*math.Sin(a), lon+zoneCoastDegrees*math.Cos(a))
+	}
+	if name != "" {
+		if loc := loadZoneLocation(name); loc != nil {
+			return mapZone{Name: name, Location: loc}
+		}
+	}
+	return nauticalZone(lon)
+}
+
+// angularDistance returns the great-circle distance between two points, in degrees.
+func angularDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	p1, p2 := lat1*degreesToRadians, lat2*degreesToRadians
+	dLat := p2 - p1
+	dLon := (lon2 - lon1) * degreesToRadians
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(p1)*math.Cos(p2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * math.Asin(math.Min(1, math.Sqrt(a))) * radiansToDegrees
+}
+
+// formatZone returns a zone's name and current UTC offset, e.g. "Asia/Tokyo UTC+09:00".
+// Nautical zones are marked as such, since no zone boundary backs them.
+func formatZone(z mapZone, t time.Time) string {
+	local := t.In(z.Location)
+	if z.Nautical {
+		return fmt.Sprintf(T("%s (nautical)"), z.Name) + " " + strings.TrimSpace(FormatClockMinutes(local))
+	}
+	return fmt.Sprintf("%s UTC%s %s", z.Name, local.Format("-07:00"), strings.TrimSpace(FormatClockMinutes(local)))
+}
+
+// renderOffsetBands returns the strips drawn above and below the map: each
+// hour band of longitude shows its UTC offset on top and its time below.
+func renderOffsetBands(t time.Time, brailleCols, brailleRows int, vp Viewport) (top, bottom string) {
+	// Band of each column, read along the map's middle row
+	bands := make([]int, brailleCols)
+	for col := range bands {
+		_, lon, ok := vp.CellLatLon(col, brailleRows/2, brailleCols, brailleRows)
+		if !ok {
+			bands[col] = math.MinInt
+			continue
+		}
+		bands[col] = int(math.Round(lon / 15))
+	}
+
+	var topSB, bottomSB strings.Builder
+	for start := 0; start < brailleCols; {
+		end := start
+		for end < brailleCols && bands[end] == bands[start] {
+			end++
+		}
+		width := end - start
+		if bands[start] == math.MinInt {
+			topSB.WriteString(strings.Repeat(" ", width))
+			bottomSB.WriteString(strings.Repeat(" ", width))
+			start = end
+			continue
+		}
+
+		hours := bands[start]
+		color := themeColor(roleBandEven)
+		if hours%2 != 0 {
+			color = themeColor(roleBandOdd)
+		}
+		offset := fmt.Sprintf("%+d", hours)
+		if hours == 0 {
+			offset = "0"
+		}
+		local := t.UTC().Add(time.Duration(hours) * time.Hour)
+		clock := strings.TrimSpace(FormatCompactClock(local))
+		if len(clock) > width {
+			clock = FormatHour(local)
+		}
+		fmt.Fprintf(&topSB, "[white:%s]%s[-:-]", color, centerText(offset, width))
+		fmt.Fprintf(&bottomSB, "[silver:%s]%s[-:-]", color, centerText(clock, width))
+		start = end
+	}
+	return topSB.String(), bottomSB.String()
+}
+
+// centerText centres text in a field of the given width, or blanks the
+// field if the text does not fit.
+func centerText(text string, width int) string {
+	if len(text) > width {
+		return strings.Repeat(" ", width)
+	}
+	left := (width - len(text)) / 2
+	return strings.Repeat(" ", left) + text + strings.Repeat(" ", width-len(text)-left)
+}
+
+// overlayTimezoneBoundaries draws dotted outlines between map cells whose
+// current UTC offsets differ, read from the embedded zone boundaries.
+// Outlines only replace free map cells, never labels, markers or the cursor.
+func overlayTimezoneBoundaries(lines []string, taken *labelGrid, t time.Time, brailleCols, brailleRows int, vp Viewport) {
+	const outside = math.MinInt
+	offsets := zoneOffsetGrid(t, brailleCols, brailleRows, vp, outside)
+
+	for row := 0; row < brailleRows && row < len(lines); row++ {
+		for col := 0; col < brailleCols; col++ {
+			here := offsets[row][col]
+			if here == outside {
+				continue
+			}
+			right := col+1 < brailleCols && offsets[row][col+1] != outside && offsets[row][col+1] != here
+			below := row+1 < brailleRows && offsets[row+1][col] != outside && offsets[row+1][col] != here
+			var glyph string
+			switch {
+			case right && below:
+				glyph = MapGlyphs().zoneCross
+			case right:
+				glyph = MapGlyphs().zoneV
+			case below:
+				glyph = MapGlyphs().zoneH
+			default:
+				continue
+			}
+			overlayCell(lines, taken, col, row, fmt.Sprintf("[%s]%s[-]", themeColor(roleZone), glyph))
+		}
+	}
+}
+
+// zoneOffsetGrid returns the UTC offset, in seconds, at the centre of every
+// braille cell, or outside for cells off the projected map or at sea. The
+// last grid is cached until the view or the hour changes.
+func zoneOffsetGrid(t time.Time, brailleCols, brailleRows int, vp Viewport, outside int) [][]int {
+	key := tzOffsetGridKey{brailleCols, brailleRows, vp, t.Truncate(time.Hour)}
+	tzOffsetGridMu.Lock()
+	defer tzOffsetGridMu.Unlock()
+	if tzOffsetGridCells != nil && key == tzOffsetGridLast {
+		return tzOffsetGridCells
+	}
+
+	offsets := make([][]int, brailleRows)
+	for row := range offsets {
+		offsets[row] = make([]int, brailleCols)
+		for col := range offsets[row] {
+			lat, lon, ok := vp.CellLatLon(col, row, brailleCols, brailleRows)
+			if !ok {
+				offsets[row][col] = outside
+				continue
+			}
+			zone := timezoneAt(lat, lon)
+			if zone.Nautical {
+				// Nautical zones would rule the oceans into 15° strips
+				offsets[row][col] = outside
+				continue
+			}
+			_, offsets[row][col] = t.In(zone.Location).Zone()
+		}
+	}
+	tzOffsetGridLast, tzOffsetGridCells = key, offsets
+	return offsets
+}
+
+// overlayZoneCursor marks the centre of the map, whose zone is named in the
+// status bar, and returns that zone's label.
+func overlayZoneCursor(lines []string, taken *labelGrid, t time.Time, brailleCols, brailleRows int, vp Viewport) string {
+	col, row := brailleCols/2, brailleRows/2
+	lat, lon, ok := vp.CellLatLon(col, row, brailleCols, brailleRows)
+	if !ok {
+		return ""
+	}
+	overlayCell(lines, taken, col, row, fmt.Sprintf("[%s::b]%s[-:-:-]", themeColor(roleZone), MapGlyphs().cursor))
+	return fmt.Sprintf("[%s]%s %s[-]", themeColor(roleZone), MapGlyphs().cursor, formatZone(timezoneAt(lat, lon), t))
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// Zoom and pan limits for the map viewport.
+const (
+	viewportZoomStep   = 1.5   // Span is divided/multiplied by this per zoom step
+	viewportMinLonSpan = 10.0  // Degrees of longitude at maximum zoom
+	viewportPanStep    = 0.25  // Fraction of the visible span moved per pan step
+	viewportMaxLonSpan = 360.0 // Whole world
+)
+
+// Viewport is the area of the world shown on the map, as lat/lon bounds.
+// West may be greater than 180 or less than -180 when the view crosses
+// the antimeridian; longitudes are wrapped when sampling. Projection is how
+// the area is drawn; nil means equirectangular.
+type Viewport struct {
+	Name       string
+	North      float64
+	South      float64
+	West       float64
+	East       float64
+	Projection Projection
+}
+
+// worldViewport shows the whole world.
+var worldViewport = Viewport{Name: "World", North: 90, South: -90, West: -180, East: 180}
+
+// viewportPresets are the regional views cycled with 'v'.
+var viewportPresets = []Viewport{
+	worldViewport,
+	{Name: "North America", North: 72, South: 8, West: -170, East: -50},
+	{Name: "South America", North: 15, South: -57, West: -95, East: -30},
+	{Name: "Europe", North: 72, South: 34, West: -25, East: 45},
+	{Name: "Africa & Middle East", North: 42, South: -36, West: -20, East: 65},
+	{Name: "South Asia", North: 38, South: 4, West: 60, East: 100},
+	{Name: "SE Asia", North: 28, South: -12, West: 90, East: 130},
+	{Name: "East Asia", North: 54, South: 18, West: 100, East: 150},
+	{Name: "Oceania", North: 0, South: -50, West: 110, East: 180},
+}
+
+// mapViewport is the viewport currently shown on the map
+var mapViewport = worldViewport
+
+// viewportPresetIndex is the last preset selected with CycleViewport
+var viewportPresetIndex = 0
+
+// LonSpan returns the viewport's width in degrees of longitude.
+func (v Viewport) LonSpan() float64 {
+	return v.East - v.West
+}
+
+// LatSpan returns the viewport's height in degrees of latitude.
+func (v Viewport) LatSpan() float64 {
+	return v.North - v.South
+}
+
+// projection returns the viewport's projection, defaulting to equirectangular.
+func (v Viewport) projection() Projection {
+	if v.Projection == nil {
+		return equirectangularProjection{}
+	}
+	return v.Projection
+}
+
+// IsWorld reports whether the viewport shows the whole world.
+func (v Viewport) IsWorld() bool {
+	return v.LonSpan() >= viewportMaxLonSpan && v.LatSpan() >= 180
+}
+
+// Fit widens the viewport's shorter axis so that map dots are square, in
+// projected units at the viewport's centre, on a grid of the given braille
+// size (2x4 dots per cell). The world view is left as-is so it always fills
+// the screen.
+func (v Viewport) Fit(cols, rows int) Viewport {
+	if v.IsWorld() || cols <= 0 || rows <= 0 {
+		return v
+	}
+	dotsW := float64(cols * 2)
+	dotsH := float64(rows * 4)
+	lonPerDot := v.LonSpan() / dotsW
+	centerLat := (v.North + v.South) / 2
+	centerLon := (v.West + v.East) / 2
+	aspect := localAspect(v.projection(), centerLat)
+	latPerDot := v.LatSpan() * aspect / dotsH
+
+	if lonPerDot > latPerDot {
+		half := math.Min(lonPerDot/aspect*dotsH/2, 90)
+		v.North, v.South = centerLat+half, centerLat-half
+	} else {
+		half := math.Min(latPerDot*dotsW/2, viewportMaxLonSpan/2)
+		v.West, v.East = centerLon-half, centerLon+half
+	}
+	return v.clampLatitude()
+}
+
+// clampLatitude shifts the viewport back inside the poles.
+func (v Viewport) clampLatitude() Viewport {
+	if v.North > 90 {
+		v.South -= v.North - 90
+		v.North = 90
+	}
+	if v.South < -90 {
+		v.North += -90 - v.South
+		v.South = -90
+	}
+	return v
+}
+
+// Zoom scales the viewport around its centre; factor > 1 zooms in.
+func (v Viewport) Zoom(factor float64) Viewport {
+	lonSpan := math.Max(viewportMinLonSpan, math.Min(viewportMaxLonSpan, v.LonSpan()/factor))
+	latSpan := math.Max(viewportMinLonSpan/2, math.Min(180, v.LatSpan()/factor))
+	centerLat := (v.North + v.South) / 2
+	centerLon := (v.West + v.East) / 2
+
+	v.North, v.South = centerLat+latSpan/2, centerLat-latSpan/2
+	v.West, v.East = centerLon-lonSpan/2, centerLon+lonSpan/2
+	return v.clampLatitude()
+}
+
+// Pan moves the viewport by fractions of its span. Positive dLat moves
+// north and positive dLon moves east. Longitude wraps around the globe.
+func (v Viewport) Pan(dLat, dLon float64) Viewport {
+	latShift := dLat * v.LatSpan()
+	lonShift := dLon * v.LonSpan()
+	v.North += latShift
+	v.South += latShift
+	v.West += lonShift
+	v.East += lonShift
+
+	// Keep the centre within [-180, 180) so bounds stay small
+	centerLon := (v.West + v.East) / 2
+	if wrapped := normalizeLongitude(centerLon); wrapped != centerLon {
+		v.West += wrapped - centerLon
+		v.East += wrapped - centerLon
+	}
+	return v.clampLatitude()
+}
+
+// CellLatLon returns the latitude/longitude at the centre of a braille cell.
+// ok is false if the cell lies outside the projected map.
+func (v Viewport) CellLatLon(col, row, cols, rows int) (lat, lon float64, ok bool) {
+	return newMapProjector(v, cols, rows).fromGrid(float64(col)+0.5, float64(row)+0.5)
+}
+
+// ZoomMap zooms the map viewport in or out one step.
+func ZoomMap(in bool) {
+	factor := viewportZoomStep
+	if !in {
+		factor = 1 / viewportZoomStep
+	}
+	mapViewport = mapViewport.Zoom(factor)
+	if mapViewport.LonSpan() >= viewportMaxLonSpan {
+		mapViewport = worldViewportForProjection()
+	}
+	mapViewport.Name = ""
+}
+
+// PanMap moves the map viewport by one step in the given direction.
+// dLat and dLon are -1, 0 or 1.
+func PanMap(dLat, dLon int) {
+	mapViewport = mapViewport.Pan(float64(dLat)*viewportPanStep, float64(dLon)*viewportPanStep)
+	mapViewport.Name = ""
+}
+
+// CycleViewport switches the map to the next preset viewport.
+func CycleViewport() {
+	viewportPresetIndex = (viewportPresetIndex + 1) % len(viewportPresets)
+	mapViewport = viewportPresets[viewportPresetIndex]
+	if mapViewport.IsWorld() {
+		mapViewport = worldViewportForProjection()
+	}
+}
+
+// ResetViewport returns the map to the whole world.
+func ResetViewport() {
+	viewportPresetIndex = 0
+	mapViewport = worldViewportForProjection()
+}
+
+// GetViewportStatus returns the viewport label for the status bar, or an
+// empty string for the world view.
+func GetViewportStatus() string {
+	if mapViewport.IsWorld() {
+		return ""
+	}
+	if mapViewport.Name != "" {
+		return fmt.Sprintf("[aqua]%s[-]", T(mapViewport.Name))
+	}
+	return fmt.Sprintf("[aqua]%.0f°N %.0f°E ×%.1f[-]",
+		(mapViewport.North+mapViewport.South)/2,
+		normalizeLongitude((mapViewport.West+mapViewport.East)/2),
+		viewportMaxLonSpan/mapViewport.LonSpan())
+}